@@ -43,6 +43,38 @@ func (airByteClient *RequestMaker) CreateDestinationConnectorOnAirByte(
 	return response, nil
 }
 
+func (airByteClient *RequestMaker) EditDestinationConnectorOnAirByte(
+	requestBody models.EditDestinationConnectorRequestAirByte) (models.CreateDestinationConnectorResponseAirbyte, error) {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/destinations/update", env.Env.AirByteAddress)
+
+	var response models.CreateDestinationConnectorResponseAirbyte
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
 func (airByteClient *RequestMaker) GetDestinationDefinitions() (models.DestinationDefinitions, error) {
 	logger := utils.GetLogger()
 
@@ -67,6 +99,41 @@ func (airByteClient *RequestMaker) GetDestinationDefinitions() (models.Destinati
 	return response, nil
 }
 
+func (airByteClient *RequestMaker) GetDestinationDefinition(destinationDefinitionID string) (models.DestinationDefinition, error) {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/destination_definitions/get", env.Env.AirByteAddress)
+
+	requestBody := map[string]string{
+		"destinationDefinitionId": destinationDefinitionID,
+	}
+
+	var response models.DestinationDefinition
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
 func (airByteClient *RequestMaker) GetDestinationSpecification(destinationDefinitionID string) (models.DestinationSpecification, error) {
 	logger := utils.GetLogger()
 
@@ -224,6 +291,41 @@ func (airByteClient *RequestMaker) GetConfiguredSource(sourceId string) (models.
 	return response, nil
 }
 
+func (airByteClient *RequestMaker) GetSourceDefinition(sourceDefinitionID string) (models.SourceDefinition, error) {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/source_definitions/get", env.Env.AirByteAddress)
+
+	requestBody := map[string]string{
+		"sourceDefinitionId": sourceDefinitionID,
+	}
+
+	var response models.SourceDefinition
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
 func (airByteClient *RequestMaker) GetSourceSpecification(sourceDefinitionID string) (models.SourceSpecification, error) {
 	logger := utils.GetLogger()
 
@@ -294,6 +396,43 @@ func (airByteClient *RequestMaker) DiscoverSourceSchema(sourceId string) (models
 	return response, nil
 }
 
+// RefreshSourceSchema discovers the schema of the source bypassing the catalog cached by airbyte.
+func (airByteClient *RequestMaker) RefreshSourceSchema(sourceId string) (models.SourceSchema, error) {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/sources/discover_schema", env.Env.AirByteAddress)
+
+	requestBody := map[string]interface{}{
+		"sourceId":      sourceId,
+		"disable_cache": true,
+	}
+
+	var response models.SourceSchema
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
 func (airByteClient *RequestMaker) CheckDestinationConnection(requestBody map[string]interface{}) error {
 	logger := utils.GetLogger()
 	logger.Info("CheckDestinationConnection on AirByte called")
@@ -353,3 +492,257 @@ func (airByteClient *RequestMaker) CheckSourceConnection(requestBody map[string]
 
 	return nil
 }
+
+// CheckSourceConnectionByID checks a configured source with the configuration stored on airbyte, unlike the masked
+// configuration airbyte returns for the source its secrets are intact.
+func (airByteClient *RequestMaker) CheckSourceConnectionByID(sourceID string) error {
+	logger := utils.GetLogger()
+	logger.Info("CheckSourceConnectionByID on AirByte called")
+
+	airByteURL := fmt.Sprintf("%s/api/v1/sources/check_connection", env.Env.AirByteAddress)
+
+	var response models.CheckConnection
+
+	jsonData, err := json.Marshal(map[string]interface{}{"sourceId": sourceID})
+	if err != nil {
+		return err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status == "failed" {
+		return errors.New(response.Message)
+	}
+
+	return nil
+}
+
+func (airByteClient *RequestMaker) DeleteDestinationConnectorOnAirByte(airbyteDestinationID string) error {
+	logger := utils.GetLogger()
+	logger.Info("DeleteDestinationConnectorOnAirByte on AirByte called")
+
+	airByteURL := fmt.Sprintf("%s/api/v1/destinations/delete", env.Env.AirByteAddress)
+
+	jsonData, err := json.Marshal(map[string]interface{}{"destinationId": airbyteDestinationID})
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return err
+	}
+
+	return airByteClient.sendRequestWithoutResponse(airByteURL, bytes.NewBuffer(jsonData))
+}
+
+func (airByteClient *RequestMaker) DeleteSourceConnectorOnAirByte(airbyteSourceID string) error {
+	logger := utils.GetLogger()
+	logger.Info("DeleteSourceConnectorOnAirByte on AirByte called")
+
+	airByteURL := fmt.Sprintf("%s/api/v1/sources/delete", env.Env.AirByteAddress)
+
+	jsonData, err := json.Marshal(map[string]interface{}{"sourceId": airbyteSourceID})
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return err
+	}
+
+	return airByteClient.sendRequestWithoutResponse(airByteURL, bytes.NewBuffer(jsonData))
+}
+
+func (airByteClient *RequestMaker) CreateCustomSourceDefinition(workspaceID string,
+	definition models.CustomConnectorRequest) (models.SourceDefinition, error) {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/source_definitions/create_custom", env.Env.AirByteAddress)
+
+	requestBody := map[string]interface{}{
+		"workspaceId": workspaceID,
+		"sourceDefinition": map[string]string{
+			"name":             definition.Name,
+			"dockerRepository": definition.DockerRepository,
+			"dockerImageTag":   definition.DockerImageTag,
+			"documentationUrl": definition.DocumentationURL,
+			"icon":             definition.Icon,
+		},
+	}
+
+	var response models.SourceDefinition
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (airByteClient *RequestMaker) UpdateSourceDefinition(sourceDefinitionID string,
+	dockerImageTag string) (models.SourceDefinition, error) {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/source_definitions/update", env.Env.AirByteAddress)
+
+	requestBody := map[string]string{
+		"sourceDefinitionId": sourceDefinitionID,
+		"dockerImageTag":     dockerImageTag,
+	}
+
+	var response models.SourceDefinition
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (airByteClient *RequestMaker) DeleteSourceDefinition(sourceDefinitionID string) error {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/source_definitions/delete", env.Env.AirByteAddress)
+
+	jsonData, err := json.Marshal(map[string]string{"sourceDefinitionId": sourceDefinitionID})
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return err
+	}
+
+	return airByteClient.sendRequestWithoutResponse(airByteURL, bytes.NewBuffer(jsonData))
+}
+
+func (airByteClient *RequestMaker) CreateCustomDestinationDefinition(workspaceID string,
+	definition models.CustomConnectorRequest) (models.DestinationDefinition, error) {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/destination_definitions/create_custom", env.Env.AirByteAddress)
+
+	requestBody := map[string]interface{}{
+		"workspaceId": workspaceID,
+		"destinationDefinition": map[string]string{
+			"name":             definition.Name,
+			"dockerRepository": definition.DockerRepository,
+			"dockerImageTag":   definition.DockerImageTag,
+			"documentationUrl": definition.DocumentationURL,
+			"icon":             definition.Icon,
+		},
+	}
+
+	var response models.DestinationDefinition
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (airByteClient *RequestMaker) UpdateDestinationDefinition(destinationDefinitionID string,
+	dockerImageTag string) (models.DestinationDefinition, error) {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/destination_definitions/update", env.Env.AirByteAddress)
+
+	requestBody := map[string]string{
+		"destinationDefinitionId": destinationDefinitionID,
+		"dockerImageTag":          dockerImageTag,
+	}
+
+	var response models.DestinationDefinition
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (airByteClient *RequestMaker) DeleteDestinationDefinition(destinationDefinitionID string) error {
+	logger := utils.GetLogger()
+
+	airByteURL := fmt.Sprintf("%s/api/v1/destination_definitions/delete", env.Env.AirByteAddress)
+
+	jsonData, err := json.Marshal(map[string]string{"destinationDefinitionId": destinationDefinitionID})
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return err
+	}
+
+	return airByteClient.sendRequestWithoutResponse(airByteURL, bytes.NewBuffer(jsonData))
+}
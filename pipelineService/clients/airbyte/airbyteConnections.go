@@ -121,6 +121,23 @@ func (airByteClient *RequestMaker) UpdateConnection(
 	return response, nil
 }
 
+// DeleteConnection deletes the airbyte connection, its sync history is removed along with it.
+func (airByteClient *RequestMaker) DeleteConnection(connectionID string) error {
+	logger := utils.GetLogger()
+	logger.Info("DeleteConnection on airbyte called")
+
+	airByteURL := fmt.Sprintf("%s/api/v1/connections/delete", env.Env.AirByteAddress)
+
+	jsonData, err := json.Marshal(map[string]interface{}{"connectionId": connectionID})
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return err
+	}
+
+	return airByteClient.sendRequestWithoutResponse(airByteURL, bytes.NewBuffer(jsonData))
+}
+
 func (airByteClient *RequestMaker) SyncConnectionManually(requestBody map[string]interface{}) (models.ManualConnectionSyncResponse, error) {
 	logger := utils.GetLogger()
 	logger.Info("SyncConnectionManually from airbyte endpoint called")
@@ -293,3 +310,107 @@ func (airByteClient *RequestMaker) GetConnectionSummary(connectionID string) (mo
 
 	return response, nil
 }
+
+func (airByteClient *RequestMaker) CancelJob(jobID int) (models.ManualConnectionSyncResponse, error) {
+	logger := utils.GetLogger()
+	logger.Info("CancelJob on airByte endpoint called")
+
+	airByteURL := fmt.Sprintf("%s/api/v1/jobs/cancel", env.Env.AirByteAddress)
+
+	var response models.ManualConnectionSyncResponse
+
+	requestBody := map[string]interface{}{
+		"id": jobID,
+	}
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (airByteClient *RequestMaker) ResetConnection(connectionID string) (models.ManualConnectionSyncResponse, error) {
+	logger := utils.GetLogger()
+	logger.Info("ResetConnection on airByte endpoint called")
+
+	airByteURL := fmt.Sprintf("%s/api/v1/connections/reset", env.Env.AirByteAddress)
+
+	var response models.ManualConnectionSyncResponse
+
+	requestBody := map[string]interface{}{
+		"connectionId": connectionID,
+	}
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (airByteClient *RequestMaker) ResetConnectionStreams(request models.ResetConnectionStreamsRequest) (models.ManualConnectionSyncResponse, error) {
+	logger := utils.GetLogger()
+	logger.Info("ResetConnectionStreams on airByte endpoint called")
+
+	airByteURL := fmt.Sprintf("%s/api/v1/connections/reset/stream", env.Env.AirByteAddress)
+
+	var response models.ManualConnectionSyncResponse
+
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	return response, nil
+}
@@ -42,3 +42,31 @@ func (airByteClient *RequestMaker) sendRequest(airByteURL string, reqBody *bytes
 
 	return body, nil
 }
+
+// sendRequestWithoutResponse is used for the airbyte endpoints which return no content e.g. the delete endpoints.
+func (airByteClient *RequestMaker) sendRequestWithoutResponse(airByteURL string, reqBody *bytes.Buffer) error {
+	logger := utils.GetLogger()
+
+	if reqBody == nil {
+		reqBody = new(bytes.Buffer)
+	}
+
+	res, err := airByteClient.client.Post(airByteURL, "application/json", reqBody)
+	if err != nil {
+		logger.Error("request to airbyte failed")
+
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
+		body, _ := ioutil.ReadAll(res.Body)
+		logger.Error(string(body))
+		err = errors.New("request to airbyte was not successful")
+		logger.Error(err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -10,23 +10,42 @@ type AirByteQuerier interface {
 	GetWorkspaceID() (string, error)
 	CreateSourceConnectorOnAirByte(airbyte models.CreateSourceConnectorRequestAirbyte) (models.CreateSourceConnectorResponseAirbyte, error)
 	EditSourceConnectorOnAirByte(requestBody models.EditSourceConnectorRequestAirByte) (models.CreateSourceConnectorResponseAirbyte, error)
+	DeleteSourceConnectorOnAirByte(airbyteSourceID string) error
 	GetSourceDefinitions() (models.SourceDefinitions, error)
 	GetConfiguredSource(sourceId string) (models.ConfiguredSource, error)
+	GetSourceDefinition(sourceDefinitionID string) (models.SourceDefinition, error)
 	GetSourceSpecification(sourceDefinitionID string) (models.SourceSpecification, error)
+	CreateCustomSourceDefinition(workspaceID string, definition models.CustomConnectorRequest) (models.SourceDefinition, error)
+	UpdateSourceDefinition(sourceDefinitionID string, dockerImageTag string) (models.SourceDefinition, error)
+	DeleteSourceDefinition(sourceDefinitionID string) error
+	GetSourceConsentURL(request models.SourceOAuthRequestAirbyte) (string, error)
+	CompleteSourceOAuth(request models.SourceOAuthRequestAirbyte) (map[string]interface{}, error)
 	CreateConnection(request models.CreatePipelineAirbyteRequest) (models.CreatePipelineAirbyteResponse, error)
 	UpdateConnection(request models.UpdatePipelineAirByteRequest) (models.CreatePipelineAirbyteResponse, error)
+	DeleteConnection(connectionID string) error
 	DiscoverSourceSchema(sourceId string) (models.SourceSchema, error)
+	RefreshSourceSchema(sourceId string) (models.SourceSchema, error)
 	CreateDestinationConnectorOnAirByte(airbyte models.CreateDestinationConnectorRequestAirbyte) (models.CreateDestinationConnectorResponseAirbyte, error)
+	EditDestinationConnectorOnAirByte(requestBody models.EditDestinationConnectorRequestAirByte) (models.CreateDestinationConnectorResponseAirbyte, error)
+	DeleteDestinationConnectorOnAirByte(airbyteDestinationID string) error
 	GetDestinationDefinitions() (models.DestinationDefinitions, error)
+	GetDestinationDefinition(destinationDefinitionID string) (models.DestinationDefinition, error)
 	GetDestinationSpecification(destinationDefinitionID string) (models.DestinationSpecification, error)
+	CreateCustomDestinationDefinition(workspaceID string, definition models.CustomConnectorRequest) (models.DestinationDefinition, error)
+	UpdateDestinationDefinition(destinationDefinitionID string, dockerImageTag string) (models.DestinationDefinition, error)
+	DeleteDestinationDefinition(destinationDefinitionID string) error
 	GetConnectionDetails(connection map[string]interface{}) (models.ConnectionMeta, error)
 	SyncConnectionManually(requestBody map[string]interface{}) (models.ManualConnectionSyncResponse, error)
+	CancelJob(jobID int) (models.ManualConnectionSyncResponse, error)
+	ResetConnection(connectionID string) (models.ManualConnectionSyncResponse, error)
+	ResetConnectionStreams(request models.ResetConnectionStreamsRequest) (models.ManualConnectionSyncResponse, error)
 	FetchSyncHistory(request models.SyncHistoryRequest) (models.SyncHistoryResponse, error)
 	GetJobLogs(jobID int) (models.JobLogs, error)
 	GetConnectionSchema(connectionID string) (models.ConnectionSourceSchema, error)
 	GetConnectionSummary(connectionID string) (models.ConnectionSummaryAirByte, error)
 	CheckDestinationConnection(requestBody map[string]interface{}) error
 	CheckSourceConnection(requestBody map[string]interface{}) error
+	CheckSourceConnectionByID(sourceID string) error
 	GetConnection(requestBody map[string]interface{}) ([]byte, error)
 }
 
@@ -0,0 +1,90 @@
+package airbyte
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+func (airByteClient *RequestMaker) GetSourceConsentURL(request models.SourceOAuthRequestAirbyte) (string, error) {
+	logger := utils.GetLogger()
+	logger.Info("GetSourceConsentURL on AirByte called")
+
+	airByteURL := fmt.Sprintf("%s/api/v1/source_oauths/get_consent_url", env.Env.AirByteAddress)
+
+	var response struct {
+		ConsentURL string `json:"consentUrl"`
+	}
+
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return "", err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return "", err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return "", err
+	}
+
+	return response.ConsentURL, nil
+}
+
+// CompleteSourceOAuth exchanges the query params of the callback for the tokens of the source. The recent versions of
+// airbyte wrap the tokens in auth_payload along with the outcome of the request.
+func (airByteClient *RequestMaker) CompleteSourceOAuth(request models.SourceOAuthRequestAirbyte) (map[string]interface{},
+	error) {
+	logger := utils.GetLogger()
+	logger.Info("CompleteSourceOAuth on AirByte called")
+
+	airByteURL := fmt.Sprintf("%s/api/v1/source_oauths/complete_oauth", env.Env.AirByteAddress)
+
+	response := make(map[string]interface{})
+
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		logger.Error("failed to convert request body to json")
+
+		return response, err
+	}
+
+	body, err := airByteClient.sendRequest(airByteURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		logger.Error("failed to read response body from airbyte")
+
+		return response, err
+	}
+
+	payload, ok := response["auth_payload"].(map[string]interface{})
+	if !ok {
+		return response, nil
+	}
+
+	if succeeded, ok := response["request_succeeded"].(bool); ok && !succeeded {
+		return payload, errors.New(fmt.Sprint(response["request_error"]))
+	}
+
+	return payload, nil
+}
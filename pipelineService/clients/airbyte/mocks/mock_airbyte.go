@@ -34,6 +34,21 @@ func (m *MockAirByteQuerier) EXPECT() *MockAirByteQuerierMockRecorder {
 	return m.recorder
 }
 
+// CancelJob mocks base method.
+func (m *MockAirByteQuerier) CancelJob(arg0 int) (models.ManualConnectionSyncResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", arg0)
+	ret0, _ := ret[0].(models.ManualConnectionSyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockAirByteQuerierMockRecorder) CancelJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockAirByteQuerier)(nil).CancelJob), arg0)
+}
+
 // CheckDestinationConnection mocks base method.
 func (m *MockAirByteQuerier) CheckDestinationConnection(arg0 map[string]interface{}) error {
 	m.ctrl.T.Helper()
@@ -62,6 +77,35 @@ func (mr *MockAirByteQuerierMockRecorder) CheckSourceConnection(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSourceConnection", reflect.TypeOf((*MockAirByteQuerier)(nil).CheckSourceConnection), arg0)
 }
 
+// CheckSourceConnectionByID mocks base method.
+func (m *MockAirByteQuerier) CheckSourceConnectionByID(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSourceConnectionByID", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSourceConnectionByID indicates an expected call of CheckSourceConnectionByID.
+func (mr *MockAirByteQuerierMockRecorder) CheckSourceConnectionByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSourceConnectionByID", reflect.TypeOf((*MockAirByteQuerier)(nil).CheckSourceConnectionByID), arg0)
+}
+
+// CompleteSourceOAuth mocks base method.
+func (m *MockAirByteQuerier) CompleteSourceOAuth(arg0 models.SourceOAuthRequestAirbyte) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSourceOAuth", arg0)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteSourceOAuth indicates an expected call of CompleteSourceOAuth.
+func (mr *MockAirByteQuerierMockRecorder) CompleteSourceOAuth(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSourceOAuth", reflect.TypeOf((*MockAirByteQuerier)(nil).CompleteSourceOAuth), arg0)
+}
+
 // CreateConnection mocks base method.
 func (m *MockAirByteQuerier) CreateConnection(arg0 models.CreatePipelineAirbyteRequest) (models.CreatePipelineAirbyteResponse, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +121,36 @@ func (mr *MockAirByteQuerierMockRecorder) CreateConnection(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConnection", reflect.TypeOf((*MockAirByteQuerier)(nil).CreateConnection), arg0)
 }
 
+// CreateCustomDestinationDefinition mocks base method.
+func (m *MockAirByteQuerier) CreateCustomDestinationDefinition(arg0 string, arg1 models.CustomConnectorRequest) (models.DestinationDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomDestinationDefinition", arg0, arg1)
+	ret0, _ := ret[0].(models.DestinationDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomDestinationDefinition indicates an expected call of CreateCustomDestinationDefinition.
+func (mr *MockAirByteQuerierMockRecorder) CreateCustomDestinationDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomDestinationDefinition", reflect.TypeOf((*MockAirByteQuerier)(nil).CreateCustomDestinationDefinition), arg0, arg1)
+}
+
+// CreateCustomSourceDefinition mocks base method.
+func (m *MockAirByteQuerier) CreateCustomSourceDefinition(arg0 string, arg1 models.CustomConnectorRequest) (models.SourceDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomSourceDefinition", arg0, arg1)
+	ret0, _ := ret[0].(models.SourceDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomSourceDefinition indicates an expected call of CreateCustomSourceDefinition.
+func (mr *MockAirByteQuerierMockRecorder) CreateCustomSourceDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomSourceDefinition", reflect.TypeOf((*MockAirByteQuerier)(nil).CreateCustomSourceDefinition), arg0, arg1)
+}
+
 // CreateDestinationConnectorOnAirByte mocks base method.
 func (m *MockAirByteQuerier) CreateDestinationConnectorOnAirByte(arg0 models.CreateDestinationConnectorRequestAirbyte) (models.CreateDestinationConnectorResponseAirbyte, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +196,76 @@ func (mr *MockAirByteQuerierMockRecorder) CreateWorkspace(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspace", reflect.TypeOf((*MockAirByteQuerier)(nil).CreateWorkspace), arg0)
 }
 
+// DeleteConnection mocks base method.
+func (m *MockAirByteQuerier) DeleteConnection(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnection", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConnection indicates an expected call of DeleteConnection.
+func (mr *MockAirByteQuerierMockRecorder) DeleteConnection(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnection", reflect.TypeOf((*MockAirByteQuerier)(nil).DeleteConnection), arg0)
+}
+
+// DeleteDestinationConnectorOnAirByte mocks base method.
+func (m *MockAirByteQuerier) DeleteDestinationConnectorOnAirByte(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDestinationConnectorOnAirByte", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDestinationConnectorOnAirByte indicates an expected call of DeleteDestinationConnectorOnAirByte.
+func (mr *MockAirByteQuerierMockRecorder) DeleteDestinationConnectorOnAirByte(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDestinationConnectorOnAirByte", reflect.TypeOf((*MockAirByteQuerier)(nil).DeleteDestinationConnectorOnAirByte), arg0)
+}
+
+// DeleteDestinationDefinition mocks base method.
+func (m *MockAirByteQuerier) DeleteDestinationDefinition(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDestinationDefinition", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDestinationDefinition indicates an expected call of DeleteDestinationDefinition.
+func (mr *MockAirByteQuerierMockRecorder) DeleteDestinationDefinition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDestinationDefinition", reflect.TypeOf((*MockAirByteQuerier)(nil).DeleteDestinationDefinition), arg0)
+}
+
+// DeleteSourceConnectorOnAirByte mocks base method.
+func (m *MockAirByteQuerier) DeleteSourceConnectorOnAirByte(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSourceConnectorOnAirByte", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSourceConnectorOnAirByte indicates an expected call of DeleteSourceConnectorOnAirByte.
+func (mr *MockAirByteQuerierMockRecorder) DeleteSourceConnectorOnAirByte(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSourceConnectorOnAirByte", reflect.TypeOf((*MockAirByteQuerier)(nil).DeleteSourceConnectorOnAirByte), arg0)
+}
+
+// DeleteSourceDefinition mocks base method.
+func (m *MockAirByteQuerier) DeleteSourceDefinition(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSourceDefinition", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSourceDefinition indicates an expected call of DeleteSourceDefinition.
+func (mr *MockAirByteQuerierMockRecorder) DeleteSourceDefinition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSourceDefinition", reflect.TypeOf((*MockAirByteQuerier)(nil).DeleteSourceDefinition), arg0)
+}
+
 // DiscoverSourceSchema mocks base method.
 func (m *MockAirByteQuerier) DiscoverSourceSchema(arg0 string) (models.SourceSchema, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +281,21 @@ func (mr *MockAirByteQuerierMockRecorder) DiscoverSourceSchema(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverSourceSchema", reflect.TypeOf((*MockAirByteQuerier)(nil).DiscoverSourceSchema), arg0)
 }
 
+// EditDestinationConnectorOnAirByte mocks base method.
+func (m *MockAirByteQuerier) EditDestinationConnectorOnAirByte(arg0 models.EditDestinationConnectorRequestAirByte) (models.CreateDestinationConnectorResponseAirbyte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditDestinationConnectorOnAirByte", arg0)
+	ret0, _ := ret[0].(models.CreateDestinationConnectorResponseAirbyte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditDestinationConnectorOnAirByte indicates an expected call of EditDestinationConnectorOnAirByte.
+func (mr *MockAirByteQuerierMockRecorder) EditDestinationConnectorOnAirByte(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditDestinationConnectorOnAirByte", reflect.TypeOf((*MockAirByteQuerier)(nil).EditDestinationConnectorOnAirByte), arg0)
+}
+
 // EditSourceConnectorOnAirByte mocks base method.
 func (m *MockAirByteQuerier) EditSourceConnectorOnAirByte(arg0 models.EditSourceConnectorRequestAirByte) (models.CreateSourceConnectorResponseAirbyte, error) {
 	m.ctrl.T.Helper()
@@ -242,6 +401,21 @@ func (mr *MockAirByteQuerierMockRecorder) GetConnectionSummary(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionSummary", reflect.TypeOf((*MockAirByteQuerier)(nil).GetConnectionSummary), arg0)
 }
 
+// GetDestinationDefinition mocks base method.
+func (m *MockAirByteQuerier) GetDestinationDefinition(arg0 string) (models.DestinationDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDestinationDefinition", arg0)
+	ret0, _ := ret[0].(models.DestinationDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDestinationDefinition indicates an expected call of GetDestinationDefinition.
+func (mr *MockAirByteQuerierMockRecorder) GetDestinationDefinition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDestinationDefinition", reflect.TypeOf((*MockAirByteQuerier)(nil).GetDestinationDefinition), arg0)
+}
+
 // GetDestinationDefinitions mocks base method.
 func (m *MockAirByteQuerier) GetDestinationDefinitions() (models.DestinationDefinitions, error) {
 	m.ctrl.T.Helper()
@@ -287,6 +461,36 @@ func (mr *MockAirByteQuerierMockRecorder) GetJobLogs(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobLogs", reflect.TypeOf((*MockAirByteQuerier)(nil).GetJobLogs), arg0)
 }
 
+// GetSourceConsentURL mocks base method.
+func (m *MockAirByteQuerier) GetSourceConsentURL(arg0 models.SourceOAuthRequestAirbyte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourceConsentURL", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSourceConsentURL indicates an expected call of GetSourceConsentURL.
+func (mr *MockAirByteQuerierMockRecorder) GetSourceConsentURL(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceConsentURL", reflect.TypeOf((*MockAirByteQuerier)(nil).GetSourceConsentURL), arg0)
+}
+
+// GetSourceDefinition mocks base method.
+func (m *MockAirByteQuerier) GetSourceDefinition(arg0 string) (models.SourceDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourceDefinition", arg0)
+	ret0, _ := ret[0].(models.SourceDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSourceDefinition indicates an expected call of GetSourceDefinition.
+func (mr *MockAirByteQuerierMockRecorder) GetSourceDefinition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceDefinition", reflect.TypeOf((*MockAirByteQuerier)(nil).GetSourceDefinition), arg0)
+}
+
 // GetSourceDefinitions mocks base method.
 func (m *MockAirByteQuerier) GetSourceDefinitions() (models.SourceDefinitions, error) {
 	m.ctrl.T.Helper()
@@ -332,6 +536,51 @@ func (mr *MockAirByteQuerierMockRecorder) GetWorkspaceID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceID", reflect.TypeOf((*MockAirByteQuerier)(nil).GetWorkspaceID))
 }
 
+// RefreshSourceSchema mocks base method.
+func (m *MockAirByteQuerier) RefreshSourceSchema(arg0 string) (models.SourceSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSourceSchema", arg0)
+	ret0, _ := ret[0].(models.SourceSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshSourceSchema indicates an expected call of RefreshSourceSchema.
+func (mr *MockAirByteQuerierMockRecorder) RefreshSourceSchema(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSourceSchema", reflect.TypeOf((*MockAirByteQuerier)(nil).RefreshSourceSchema), arg0)
+}
+
+// ResetConnection mocks base method.
+func (m *MockAirByteQuerier) ResetConnection(arg0 string) (models.ManualConnectionSyncResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetConnection", arg0)
+	ret0, _ := ret[0].(models.ManualConnectionSyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetConnection indicates an expected call of ResetConnection.
+func (mr *MockAirByteQuerierMockRecorder) ResetConnection(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConnection", reflect.TypeOf((*MockAirByteQuerier)(nil).ResetConnection), arg0)
+}
+
+// ResetConnectionStreams mocks base method.
+func (m *MockAirByteQuerier) ResetConnectionStreams(arg0 models.ResetConnectionStreamsRequest) (models.ManualConnectionSyncResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetConnectionStreams", arg0)
+	ret0, _ := ret[0].(models.ManualConnectionSyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetConnectionStreams indicates an expected call of ResetConnectionStreams.
+func (mr *MockAirByteQuerierMockRecorder) ResetConnectionStreams(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConnectionStreams", reflect.TypeOf((*MockAirByteQuerier)(nil).ResetConnectionStreams), arg0)
+}
+
 // SyncConnectionManually mocks base method.
 func (m *MockAirByteQuerier) SyncConnectionManually(arg0 map[string]interface{}) (models.ManualConnectionSyncResponse, error) {
 	m.ctrl.T.Helper()
@@ -361,3 +610,33 @@ func (mr *MockAirByteQuerierMockRecorder) UpdateConnection(arg0 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnection", reflect.TypeOf((*MockAirByteQuerier)(nil).UpdateConnection), arg0)
 }
+
+// UpdateDestinationDefinition mocks base method.
+func (m *MockAirByteQuerier) UpdateDestinationDefinition(arg0, arg1 string) (models.DestinationDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDestinationDefinition", arg0, arg1)
+	ret0, _ := ret[0].(models.DestinationDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDestinationDefinition indicates an expected call of UpdateDestinationDefinition.
+func (mr *MockAirByteQuerierMockRecorder) UpdateDestinationDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDestinationDefinition", reflect.TypeOf((*MockAirByteQuerier)(nil).UpdateDestinationDefinition), arg0, arg1)
+}
+
+// UpdateSourceDefinition mocks base method.
+func (m *MockAirByteQuerier) UpdateSourceDefinition(arg0, arg1 string) (models.SourceDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSourceDefinition", arg0, arg1)
+	ret0, _ := ret[0].(models.SourceDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSourceDefinition indicates an expected call of UpdateSourceDefinition.
+func (mr *MockAirByteQuerierMockRecorder) UpdateSourceDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSourceDefinition", reflect.TypeOf((*MockAirByteQuerier)(nil).UpdateSourceDefinition), arg0, arg1)
+}
@@ -5,12 +5,29 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"pipelineService/env"
 	"pipelineService/utils"
 )
 
+// SessionMiddleware returns the middleware validating the session of the requests to the external routes, the
+// internal routes are called by the workers without a session and the health and swagger routes are public.
+func SessionMiddleware(authServiceClient AuthServiceQuerier) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		route := ctx.FullPath()
+
+		if utils.IsInternalRoute(route) || strings.Contains(route, "/health/") || strings.Contains(route, "/swagger/") {
+			ctx.Next()
+
+			return
+		}
+
+		authServiceClient.ValidateSession(ctx)
+	}
+}
+
 func (authServiceClient *RequestMaker) ValidateSession(ctx *gin.Context) {
 	logger := utils.GetLogger()
 	logger.Info("Middleware to validate sessionID called")
@@ -77,6 +94,8 @@ func (authServiceClient *RequestMaker) ValidateSession(ctx *gin.Context) {
 	ctx.Set("userID", response.Payload.User.Id)
 	ctx.Set("workspaceID", response.Payload.User.Workspace.Id)
 	ctx.Set("airbyteWorkspaceID", response.Payload.User.Workspace.AirbyteWorkspaceId)
+	ctx.Set("userRole", response.Payload.User.Role.Code)
+	ctx.Set("isSuperAdmin", response.Payload.User.IsZtnaSuperAdmin)
 
 	logger.Info("session validation successful")
 }
@@ -15,6 +15,12 @@ type WorkflowRunner interface {
 		userID int, workspaceID int, airbyteWorkspaceID string) error
 	TriggerUpdateConnectionWorkflow(ctx context.Context, workFlowOptions client.StartWorkflowOptions, updatePipelineRequest models.UpdatePipelineAirByteRequest,
 		connection models.Connection, userID int, workspaceID int, airbyteWorkspaceID string) error
+	TriggerCancelSyncWorkflow(ctx context.Context, workFlowOptions client.StartWorkflowOptions,
+		pipelineID string, userID int, workspaceID int) error
+	TriggerRetrySyncWorkflow(ctx context.Context, workFlowOptions client.StartWorkflowOptions,
+		pipelineID string, userID int, workspaceID int) error
+	TriggerResetConnectionWorkflow(ctx context.Context, workFlowOptions client.StartWorkflowOptions,
+		pipelineID string, streams []models.StreamDescriptor, userID int, workspaceID int) error
 }
 
 var _ WorkflowRunner = (*Workflows)(nil)
@@ -14,6 +14,9 @@ var (
 	DeletePipelineWorkflow   = env.Env.CadenceWorkerServiceName + "/v1/workflows/pipeline.DeletePipelineWorkflow"
 	CreateConnectionWorkflow = env.Env.CadenceWorkerServiceName + "/v1/workflows/pipeline.CreateConnectionWorkflow"
 	UpdateConnectionWorkflow = env.Env.CadenceWorkerServiceName + "/v1/workflows/pipeline.UpdateConnectionWorkflow"
+	CancelSyncWorkflow       = env.Env.CadenceWorkerServiceName + "/v1/workflows/pipeline.CancelSyncWorkflow"
+	RetrySyncWorkflow        = env.Env.CadenceWorkerServiceName + "/v1/workflows/pipeline.RetrySyncWorkflow"
+	ResetConnectionWorkflow  = env.Env.CadenceWorkerServiceName + "/v1/workflows/pipeline.ResetConnectionWorkflow"
 )
 
 func (wr *Workflows) TriggerSendEmailWorkflow(ctx context.Context, emailTemplate models.EmailTemplate, wfOptions client.StartWorkflowOptions) error {
@@ -70,3 +73,33 @@ func (wr *Workflows) TriggerUpdateConnectionWorkflow(ctx context.Context, workFl
 
 	return err
 }
+
+func (wr *Workflows) TriggerCancelSyncWorkflow(ctx context.Context, workFlowOptions client.StartWorkflowOptions,
+	pipelineID string, userID int, workspaceID int) error {
+	logger := utils.GetLogger()
+	logger.Info("TriggerCancelSyncWorkflow endpoint called")
+
+	_, err := wr.client.ExecuteWorkflow(ctx, workFlowOptions, CancelSyncWorkflow, pipelineID, userID, workspaceID)
+
+	return err
+}
+
+func (wr *Workflows) TriggerRetrySyncWorkflow(ctx context.Context, workFlowOptions client.StartWorkflowOptions,
+	pipelineID string, userID int, workspaceID int) error {
+	logger := utils.GetLogger()
+	logger.Info("TriggerRetrySyncWorkflow endpoint called")
+
+	_, err := wr.client.ExecuteWorkflow(ctx, workFlowOptions, RetrySyncWorkflow, pipelineID, userID, workspaceID)
+
+	return err
+}
+
+func (wr *Workflows) TriggerResetConnectionWorkflow(ctx context.Context, workFlowOptions client.StartWorkflowOptions,
+	pipelineID string, streams []models.StreamDescriptor, userID int, workspaceID int) error {
+	logger := utils.GetLogger()
+	logger.Info("TriggerResetConnectionWorkflow endpoint called")
+
+	_, err := wr.client.ExecuteWorkflow(ctx, workFlowOptions, ResetConnectionWorkflow, pipelineID, streams, userID, workspaceID)
+
+	return err
+}
@@ -15,6 +15,21 @@ func registerRoutes(server *assets.Server) {
 		assetRoutes.GET("/:id/transformed/preview/", server.PreviewTransformedAsset)
 		assetRoutes.GET("/pipeline/:id/", server.GetPipelineAssets)
 		assetRoutes.GET("/products/:id/transformed/", server.GetTransformedAssets)
+		assetRoutes.GET("/:id/columns/", server.GetAssetColumns)
+		assetRoutes.PUT("/:id/columns/:name/", server.EditAssetColumn)
+		assetRoutes.GET("/pipeline/:id/dictionary/", server.ExportPipelineDictionary)
+		assetRoutes.GET("/products/:id/dictionary/", server.ExportProductDictionary)
+		assetRoutes.GET("/:id/export/", server.ExportAsset)
+		assetRoutes.GET("/:id/profile/", server.GetAssetProfile)
+		assetRoutes.POST("/:id/profile/", server.ProfileAsset)
+		assetRoutes.GET("/exports/", server.GetAssetExports)
+		assetRoutes.GET("/exports/:id/", server.GetAssetExport)
+		assetRoutes.GET("/exports/:id/download/", server.DownloadAssetExport)
+	}
+
+	assetRoutes = server.RouterGroup.Group("assets/internal")
+	{
+		assetRoutes.POST("/exports/purge/", server.PurgeExpiredExports)
 	}
 }
 func CreateNewServer(dbStore db.Store, airbyteClient airbyte.AirByteClient,
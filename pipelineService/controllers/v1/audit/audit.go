@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"github.com/gin-gonic/gin"
+	"pipelineService/handlers/v1/audit"
+	"pipelineService/services/db"
+)
+
+func registerRoutes(server *audit.Server) {
+	auditRoutes := server.RouterGroup.Group("audit")
+	{
+		auditRoutes.GET("/", server.GetAuditLogs)
+		auditRoutes.GET("/export/", server.ExportAuditLogs)
+	}
+}
+
+// RegisterMiddleware has to be called before the routes of the other servers are registered on the group.
+func RegisterMiddleware(dbStore db.Store, rg *gin.RouterGroup) {
+	rg.Use(audit.AuditTrail(dbStore, rg.BasePath()))
+}
+
+func CreateNewServer(dbStore db.Store, router *gin.Engine, rg *gin.RouterGroup) {
+	server := &audit.Server{
+		Store:       dbStore,
+		Router:      router,
+		RouterGroup: rg,
+	}
+	registerRoutes(server)
+}
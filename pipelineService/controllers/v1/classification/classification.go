@@ -0,0 +1,33 @@
+package classification
+
+import (
+	"github.com/gin-gonic/gin"
+	"pipelineService/handlers/v1/classification"
+	"pipelineService/services/db"
+)
+
+func registerRoutes(server *classification.Server) {
+	classificationRoutes := server.RouterGroup.Group("classification")
+	{
+		classificationRoutes.GET("/rules/", server.GetClassificationRules)
+		classificationRoutes.POST("/rules/", server.CreateClassificationRule)
+		classificationRoutes.DELETE("/rules/:id/", server.DeleteClassificationRule)
+		classificationRoutes.POST("/assets/:id/", server.ClassifyAsset)
+		classificationRoutes.PUT("/assets/:id/columns/:name/", server.SetColumnClassifications)
+		classificationRoutes.GET("/data-products/:id/report/", server.GetSensitiveColumnsReport)
+	}
+
+	classificationRoutes = server.RouterGroup.Group("classification/internal")
+	{
+		classificationRoutes.POST("/classify/", server.ClassifyAssets)
+	}
+}
+
+func CreateNewServer(dbStore db.Store, router *gin.Engine, rg *gin.RouterGroup) {
+	server := &classification.Server{
+		Store:       dbStore,
+		Router:      router,
+		RouterGroup: rg,
+	}
+	registerRoutes(server)
+}
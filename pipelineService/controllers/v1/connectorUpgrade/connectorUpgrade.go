@@ -0,0 +1,31 @@
+package connectorUpgrade
+
+import (
+	"github.com/gin-gonic/gin"
+	"pipelineService/clients/airbyte"
+	"pipelineService/handlers/v1/connectorUpgrade"
+	"pipelineService/services/db"
+)
+
+func registerRoutes(server *connectorUpgrade.Server) {
+	connectorUpgradeRoutes := server.RouterGroup.Group("connector-upgrades")
+	{
+		connectorUpgradeRoutes.GET("/", server.GetConnectorUpgrades)
+		connectorUpgradeRoutes.POST("/", server.ScheduleConnectorUpgrade)
+		connectorUpgradeRoutes.GET("/versions/", server.GetConnectorVersions)
+		connectorUpgradeRoutes.GET("/:id/", server.GetConnectorUpgrade)
+		connectorUpgradeRoutes.POST("/:id/validate/", server.ValidateConnectorUpgrade)
+		connectorUpgradeRoutes.POST("/:id/commit/", server.CommitConnectorUpgrade)
+		connectorUpgradeRoutes.POST("/:id/rollback/", server.RollbackConnectorUpgrade)
+	}
+}
+
+func CreateNewServer(dbStore db.Store, airbyteClient airbyte.AirByteClient, router *gin.Engine, rg *gin.RouterGroup) {
+	server := &connectorUpgrade.Server{
+		Store:       dbStore,
+		Router:      router,
+		RouterGroup: rg,
+		Airbyte:     airbyteClient,
+	}
+	registerRoutes(server)
+}
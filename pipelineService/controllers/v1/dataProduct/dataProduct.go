@@ -17,6 +17,12 @@ func registerRoutes(server *dataProduct.Server) {
 		dataProductRoutes.GET("/:id/", server.GetDataProduct)
 		dataProductRoutes.POST("/:id/add-pipeline/", server.AddPipeline)
 		dataProductRoutes.PUT("/:id/", server.UpdateDataProduct)
+		dataProductRoutes.DELETE("/:id/", server.DeleteDataProduct)
+		dataProductRoutes.POST("/:id/restore/", server.RestoreDataProduct)
+		dataProductRoutes.GET("/:id/sla/", server.GetFreshnessSLA)
+		dataProductRoutes.PUT("/:id/sla/", server.SaveFreshnessSLA)
+		dataProductRoutes.DELETE("/:id/sla/", server.DeleteFreshnessSLA)
+		dataProductRoutes.GET("/:id/sla/events/", server.GetFreshnessSLAEvents)
 
 		dataProductRoutes.POST("/transformations/:id/", server.ApplyTransformations)
 		dataProductRoutes.GET("/transformations/:id/", server.GetTransformationDetails)
@@ -27,6 +33,7 @@ func registerRoutes(server *dataProduct.Server) {
 	{
 		dataProductRoutes.GET("/", server.GetProductDetails)
 		dataProductRoutes.POST("/transformations/assets/", server.SyncTransformedAssets)
+		dataProductRoutes.POST("/sla/evaluate/", server.EvaluateFreshnessSLAs)
 	}
 }
 
@@ -14,8 +14,20 @@ func registerRoutes(server *destination.Server) {
 		destinationRoutes.POST("/", server.ConfigureDestinationOnAirbyte)
 		destinationRoutes.GET("/", server.GetSupportedDestinations)
 		destinationRoutes.GET("/specification/", server.GetDestinationSpecification)
+		destinationRoutes.POST("/validate/", server.ValidateDestinationConfiguration)
 		destinationRoutes.GET("/configured/", server.GetConfiguredDestinations)
 		destinationRoutes.GET("/:id/summary/", server.GetDestinationSummary)
+		destinationRoutes.PUT("/:id/", server.UpdateDestination)
+		destinationRoutes.DELETE("/:id/", server.DeleteDestination)
+		destinationRoutes.POST("/:id/restore/", server.RestoreDestination)
+		destinationRoutes.PUT("/definitions/:id/", server.SetDestinationAvailability)
+		destinationRoutes.POST("/custom/", server.RegisterCustomDestination)
+		destinationRoutes.PUT("/custom/:id/", server.UpgradeCustomDestination)
+	}
+
+	destinationRoutes = server.RouterGroup.Group("destinations/internal")
+	{
+		destinationRoutes.POST("/definitions/reconcile/", server.ReconcileDestinationDefinitions)
 	}
 }
 func CreateNewServer(dbStore db.Store, airbyteClient airbyte.AirByteClient,
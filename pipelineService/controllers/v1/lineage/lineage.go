@@ -0,0 +1,25 @@
+package lineage
+
+import (
+	"github.com/gin-gonic/gin"
+	"pipelineService/handlers/v1/lineage"
+	"pipelineService/services/db"
+)
+
+func registerRoutes(server *lineage.Server) {
+	lineageRoutes := server.RouterGroup.Group("lineage")
+	{
+		lineageRoutes.GET("/", server.GetLineage)
+		lineageRoutes.GET("/openlineage/", server.GetOpenLineageEvents)
+		lineageRoutes.PUT("/products/:id/manifest/", server.SaveDbtManifest)
+	}
+}
+
+func CreateNewServer(dbStore db.Store, router *gin.Engine, rg *gin.RouterGroup) {
+	server := &lineage.Server{
+		Store:       dbStore,
+		Router:      router,
+		RouterGroup: rg,
+	}
+	registerRoutes(server)
+}
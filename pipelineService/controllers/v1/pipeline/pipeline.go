@@ -16,7 +16,12 @@ func registerRoutes(server *pipeline.Server) {
 		pipelineRoutes.PUT("/:id/", server.UpdatePipeline)
 		pipelineRoutes.GET("/", server.GetAllPipelines)
 		pipelineRoutes.GET("/:id/", server.GetPipeline)
-		pipelineRoutes.DELETE("/:id/", server.TriggerDeletePipeline)
+		pipelineRoutes.DELETE("/:id/", server.SoftDeletePipeline)
+		pipelineRoutes.POST("/:id/restore/", server.RestorePipeline)
+		pipelineRoutes.POST("/:id/sync/cancel/", server.CancelPipelineSync)
+		pipelineRoutes.POST("/:id/sync/retry/", server.RetryPipelineSync)
+		pipelineRoutes.POST("/:id/reset/", server.ResetPipeline)
+		pipelineRoutes.GET("/:id/operations/", server.GetPipelineOperations)
 		pipelineRoutes.POST("/connections/", server.CreatePipelineConnection)
 		pipelineRoutes.PUT("/connections/:id/", server.UpdatePipelineConnection)
 		pipelineRoutes.GET("/connections/sync/logs/:job_id/", server.GetJobLogsFromAirByte)
@@ -35,6 +40,10 @@ func registerRoutes(server *pipeline.Server) {
 		pipelineRoutes.GET("/:id/", server.GetPipelineSourceAndConnectionID)
 		pipelineRoutes.DELETE("/:id/", server.DeletePipeline)
 		pipelineRoutes.PATCH("/:id/", server.UpdatePipelineStatus)
+		pipelineRoutes.POST("/purge/", server.PurgeDeletedResources)
+		pipelineRoutes.POST("/:id/sync/cancel/", server.CancelPipelineSyncOnAirByte)
+		pipelineRoutes.POST("/:id/sync/retry/", server.RetryPipelineSyncOnAirByte)
+		pipelineRoutes.POST("/:id/reset/", server.ResetPipelineOnAirByte)
 
 		pipelineRoutes.POST("/schema/", server.CreatePipelineSchema)
 		pipelineRoutes.DELETE("/schema/:id/", server.DeletePipelineSchema)
@@ -0,0 +1,28 @@
+package policy
+
+import (
+	"github.com/gin-gonic/gin"
+	"pipelineService/handlers/v1/policy"
+	"pipelineService/services/db"
+)
+
+func registerRoutes(server *policy.Server) {
+	policyRoutes := server.RouterGroup.Group("policies")
+	{
+		policyRoutes.GET("/masking/", server.GetMaskingPolicies)
+		policyRoutes.POST("/masking/", server.CreateMaskingPolicy)
+		policyRoutes.DELETE("/masking/:id/", server.DeleteMaskingPolicy)
+		policyRoutes.GET("/row-filters/", server.GetRowFilters)
+		policyRoutes.POST("/row-filters/", server.CreateRowFilter)
+		policyRoutes.DELETE("/row-filters/:id/", server.DeleteRowFilter)
+	}
+}
+
+func CreateNewServer(dbStore db.Store, router *gin.Engine, rg *gin.RouterGroup) {
+	server := &policy.Server{
+		Store:       dbStore,
+		Router:      router,
+		RouterGroup: rg,
+	}
+	registerRoutes(server)
+}
@@ -0,0 +1,27 @@
+package quality
+
+import (
+	"github.com/gin-gonic/gin"
+	"pipelineService/handlers/v1/quality"
+	"pipelineService/services/db"
+)
+
+func registerRoutes(server *quality.Server) {
+	qualityRoutes := server.RouterGroup.Group("quality")
+	{
+		qualityRoutes.GET("/rules/", server.GetQualityRules)
+		qualityRoutes.POST("/rules/", server.CreateQualityRule)
+		qualityRoutes.DELETE("/rules/:id/", server.DeleteQualityRule)
+		qualityRoutes.GET("/results/", server.GetQualityResults)
+		qualityRoutes.POST("/assets/:id/check/", server.CheckAssetQuality)
+	}
+}
+
+func CreateNewServer(dbStore db.Store, router *gin.Engine, rg *gin.RouterGroup) {
+	server := &quality.Server{
+		Store:       dbStore,
+		Router:      router,
+		RouterGroup: rg,
+	}
+	registerRoutes(server)
+}
@@ -0,0 +1,35 @@
+package schemaChange
+
+import (
+	"github.com/gin-gonic/gin"
+	"pipelineService/clients/airbyte"
+	"pipelineService/clients/cadenceClient"
+	"pipelineService/handlers/v1/schemaChange"
+	"pipelineService/services/db"
+)
+
+func registerRoutes(server *schemaChange.Server) {
+	schemaChangeRoutes := server.RouterGroup.Group("schema-changes")
+	{
+		schemaChangeRoutes.GET("/", server.GetSchemaChanges)
+		schemaChangeRoutes.POST("/:id/approve/", server.ApproveSchemaChange)
+		schemaChangeRoutes.POST("/:id/reject/", server.RejectSchemaChange)
+	}
+
+	schemaChangeRoutes = server.RouterGroup.Group("schema-changes/internal")
+	{
+		schemaChangeRoutes.POST("/detect/", server.DetectSchemaDrift)
+	}
+}
+
+func CreateNewServer(dbStore db.Store, airbyteClient airbyte.AirByteClient,
+	router *gin.Engine, rg *gin.RouterGroup, cc cadenceClient.CadStore) {
+	server := &schemaChange.Server{
+		Store:         dbStore,
+		Router:        router,
+		RouterGroup:   rg,
+		Airbyte:       airbyteClient,
+		CadenceClient: cc,
+	}
+	registerRoutes(server)
+}
@@ -13,11 +13,31 @@ func registerRoutes(server *source.Server) {
 	{
 		sourceRoutes.POST("/", server.ConfigureSourceOnAirbyte)
 		sourceRoutes.PUT("/:id/", server.EditSourceOnAirByte)
+		sourceRoutes.DELETE("/:id/", server.DeleteSource)
 		sourceRoutes.GET("/", server.GetSupportedSources)
+		sourceRoutes.GET("/configured/", server.GetConfiguredSources)
+		sourceRoutes.POST("/:id/pipelines/", server.AttachSourceToPipeline)
 		sourceRoutes.GET("/:id/", server.GetConfiguredSource)
 		sourceRoutes.GET("/:id/summary/", server.GetConnectionSummary)
 		sourceRoutes.GET("/specification/", server.GetSourceSpecification)
+		sourceRoutes.POST("/validate/", server.ValidateSourceConfiguration)
 		sourceRoutes.GET("/discover/schema/", server.DiscoverSourceSchema)
+		sourceRoutes.PUT("/definitions/:id/", server.SetSourceAvailability)
+		sourceRoutes.POST("/custom/", server.RegisterCustomSource)
+		sourceRoutes.PUT("/custom/:id/", server.UpgradeCustomSource)
+		sourceRoutes.POST("/oauth/consent/", server.StartSourceOAuth)
+		sourceRoutes.GET("/oauth/callback/", server.CompleteSourceOAuth)
+		sourceRoutes.GET("/oauth/failures/", server.GetOAuthTokenFailures)
+		sourceRoutes.GET("/oauth/:id/", server.GetSourceOAuthFlow)
+		sourceRoutes.POST("/files/", server.UploadFileSource)
+		sourceRoutes.POST("/:id/files/", server.ReuploadFileSource)
+		sourceRoutes.GET("/:id/files/", server.GetFileUploads)
+	}
+
+	sourceRoutes = server.RouterGroup.Group("sources/internal")
+	{
+		sourceRoutes.POST("/definitions/reconcile/", server.ReconcileSourceDefinitions)
+		sourceRoutes.POST("/oauth/check/", server.CheckOAuthTokens)
 	}
 }
 func CreateNewServer(dbStore db.Store, airbyteClient airbyte.AirByteClient,
@@ -25,30 +25,29 @@ var doc = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/assets/pipeline/{id}": {
+        "/assets/exports/": {
             "get": {
-                "description": "Return the assets of a given pipeline",
+                "description": "Returns the exports of the assets of the workspace, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "assets"
                 ],
-                "summary": "Return the assets of a given pipeline",
+                "summary": "Return the exports of the workspace",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pipeline ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Asset ID",
+                        "name": "assetId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PipelineAssetsResponse"
+                            "$ref": "#/definitions/models.AssetExportsResponse"
                         }
                     },
                     "400": {
@@ -66,20 +65,20 @@ var doc = `{
                 }
             }
         },
-        "/assets/products/{id}/transformed": {
+        "/assets/exports/{id}/": {
             "get": {
-                "description": "Return the transformed assets of a given product",
+                "description": "Returns the status of an export, completed asynchronous exports have a download link",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "assets"
                 ],
-                "summary": "Return the transformed assets of a given product",
+                "summary": "Return an export",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Product ID",
+                        "description": "Export ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -89,7 +88,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.TransformedAssetsResponse"
+                            "$ref": "#/definitions/models.AssetExportResponse"
                         }
                     },
                     "400": {
@@ -98,6 +97,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -107,20 +112,24 @@ var doc = `{
                 }
             }
         },
-        "/assets/{id}/preview/": {
+        "/assets/exports/{id}/download/": {
             "get": {
-                "description": "Preview the data from destination",
+                "description": "Downloads the file of a completed asynchronous export, only the user who requested it can download it since the file is masked and filtered for their role",
                 "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/octet-stream",
+                    "application/gzip",
                     "application/json"
                 ],
                 "tags": [
                     "assets"
                 ],
-                "summary": "Preview the data from destination.",
+                "summary": "Download an asynchronous export",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Asset ID",
+                        "description": "Export ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -130,7 +139,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -139,6 +148,24 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -148,30 +175,21 @@ var doc = `{
                 }
             }
         },
-        "/assets/{id}/transformed/preview/": {
-            "get": {
-                "description": "Preview the data of transformed asset from destination",
+        "/assets/internal/exports/purge/": {
+            "post": {
+                "description": "Removes the files of the asynchronous exports completed before the retention window and marks the exports as expired, the exports which couldn't be removed are retried on the next run",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
-                ],
-                "summary": "Preview the data of transformed asset from destination.",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Asset ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "assets/internal"
                 ],
+                "summary": "Purge the expired exports",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.AssetExportsResponse"
                         }
                     },
                     "400": {
@@ -189,32 +207,30 @@ var doc = `{
                 }
             }
         },
-        "/auth-workflows/internal/pin/": {
-            "post": {
-                "description": "Sends verification pin via email",
+        "/assets/pipeline/{id}": {
+            "get": {
+                "description": "Return the assets of a given pipeline with their latest profile, the profiles of the assets with row filters enforced on the caller's role are left out and returned by the profile endpoint of the asset",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth-workflows/internal"
+                    "assets"
                 ],
-                "summary": "Sends Verification pin",
+                "summary": "Return the assets of a given pipeline",
                 "parameters": [
                     {
-                        "description": "Receiver Credentials",
-                        "name": "email",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.EmailTemplate"
-                        }
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.PipelineAssetsResponse"
                         }
                     },
                     "400": {
@@ -232,21 +248,37 @@ var doc = `{
                 }
             }
         },
-        "/data-products/": {
+        "/assets/pipeline/{id}/dictionary/": {
             "get": {
-                "description": "Returns a list of all the data products",
+                "description": "Exports the columns of all the raw assets of a pipeline as Markdown or CSV",
                 "produces": [
-                    "application/json"
+                    "text/markdown",
+                    "text/csv"
                 ],
                 "tags": [
-                    "data-products"
+                    "assets"
+                ],
+                "summary": "Export the data dictionary of a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format i.e. markdown or csv, defaults to markdown",
+                        "name": "format",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Returns all the data products",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DataProductListResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -262,32 +294,39 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates the data product",
+            }
+        },
+        "/assets/products/{id}/dictionary/": {
+            "get": {
+                "description": "Exports the columns of all the transformed assets of a data product as Markdown or CSV",
                 "produces": [
-                    "application/json"
+                    "text/markdown",
+                    "text/csv"
                 ],
                 "tags": [
-                    "data-products"
+                    "assets"
                 ],
-                "summary": "Create Data Product",
+                "summary": "Export the data dictionary of a data product",
                 "parameters": [
                     {
-                        "description": "Product Info",
-                        "name": "product",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.DataProduct"
-                        }
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format i.e. markdown or csv, defaults to markdown",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DataProductResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -305,26 +344,17 @@ var doc = `{
                 }
             }
         },
-        "/data-products/internal/": {
+        "/assets/products/{id}/transformed": {
             "get": {
-                "description": "returns the name of the data product that can be transformed",
+                "description": "Return the transformed assets of a given product with their latest profile, the profiles of the assets with row filters enforced on the caller's role are left out and returned by the profile endpoint of the asset",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "data-products/internal"
+                    "assets"
                 ],
-                "summary": "Returns updated data product",
+                "summary": "Return the transformed assets of a given product",
                 "parameters": [
-                    {
-                        "description": "Product Info",
-                        "name": "product",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.DataProduct"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "Product ID",
@@ -337,7 +367,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ProductAssetDetails"
+                            "$ref": "#/definitions/models.TransformedAssetsResponse"
                         }
                     },
                     "400": {
@@ -355,21 +385,30 @@ var doc = `{
                 }
             }
         },
-        "/data-products/internal/transformations/assets/": {
-            "post": {
-                "description": "deletes the already present assets and creates the new entries",
+        "/assets/{id}/columns/": {
+            "get": {
+                "description": "Returns the name, type, nullability and documentation of the columns of a raw or transformed asset",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "data-products/internal"
+                    "assets"
+                ],
+                "summary": "Return the columns of an asset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "syncs the transformed assets with the db",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ProductAssets"
+                            "$ref": "#/definitions/models.AssetColumnsResponse"
                         }
                     },
                     "400": {
@@ -387,23 +426,39 @@ var doc = `{
                 }
             }
         },
-        "/data-products/transformations/{id}": {
-            "get": {
-                "description": "Return DBT transformation details for a given product",
+        "/assets/{id}/columns/{name}/": {
+            "put": {
+                "description": "Updates the description and tags of a column, the caller is recorded as the one who documented it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "data-products"
+                    "assets"
                 ],
-                "summary": "Return DBT transformation details for a given product",
+                "summary": "Document a column of an asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Product ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column Name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Column documentation",
+                        "name": "column",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.EditAssetColumnRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -419,6 +474,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -426,53 +487,89 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Apply transformation",
+            }
+        },
+        "/assets/{id}/export/": {
+            "get": {
+                "description": "Streams the rows of a raw or transformed asset from its destination, the row filters of the data products and the masking policies of the workspace are enforced for the caller's role. Asynchronous exports are written to a file downloaded once completed. Every export is recorded.",
                 "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/octet-stream",
+                    "application/gzip",
                     "application/json"
                 ],
                 "tags": [
-                    "data-products"
+                    "assets"
                 ],
-                "summary": "Apply transformation",
+                "summary": "Export the rows of an asset as CSV, JSONL or Parquet",
                 "parameters": [
-                    {
-                        "description": "Source Details",
-                        "name": "configureSourceData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateSourceConnectorRequestAPI"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "Product ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Destination ID",
-                        "name": "destinationId",
+                        "description": "Format i.e. csv, jsonl or parquet",
+                        "name": "format",
                         "in": "query",
                         "required": true
                     },
+                    {
+                        "type": "boolean",
+                        "description": "Compress the export with gzip",
+                        "name": "gzip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Generate the export in the background",
+                        "name": "async",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Source ID",
-                        "name": "sourceId",
-                        "in": "query",
-                        "required": true
+                        "description": "Comma separated columns to export",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filters written as column:operator:value, repeatable",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column to order by, prefixed with - for descending order",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of rows, capped at the configured max rows",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePipelineRequest"
+                            "type": "string"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/models.AssetExportResponse"
                         }
                     },
                     "400": {
@@ -481,6 +578,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -490,39 +593,70 @@ var doc = `{
                 }
             }
         },
-        "/data-products/transformations/{id}/": {
-            "put": {
-                "description": "updates the data product",
+        "/assets/{id}/preview/": {
+            "get": {
+                "description": "Preview the data from destination, the row filters of the data products and the masking policies of the workspace are enforced for the caller's role. Postgres, MySQL, ClickHouse, SQL Server and the local CSV and JSON destinations can be previewed, the SSL mode, CA certificates and SSH tunnel of the destination are honored",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "data-products"
+                    "assets"
                 ],
-                "summary": "Returns updated data product",
+                "summary": "Preview the data from destination.",
                 "parameters": [
-                    {
-                        "description": "Transformation Info",
-                        "name": "transformation",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdatePipelineAirByteRequest"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "Product ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated columns to return",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filters written as column:operator:value, repeatable",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column to order by, prefixed with - for descending order",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of rows",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of rows to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the next page returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePipelineRequest"
+                            "$ref": "#/definitions/models.PreviewResponse"
                         }
                     },
                     "400": {
@@ -540,25 +674,20 @@ var doc = `{
                 }
             }
         },
-        "/data-products/{id}/": {
+        "/assets/{id}/profile/": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Returns a data product by ID",
+                "description": "Returns the row count and the column statistics of the latest profile of a raw or transformed asset, the statistics only cover the rows the row filters of the caller's role let through and the value statistics of the columns masked for the caller's role are left out",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "data-products"
+                    "assets"
                 ],
-                "summary": "Returns a data product",
+                "summary": "Return the latest profile of an asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Product ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -568,7 +697,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.GetDataProductViewResponse"
+                            "$ref": "#/definitions/models.AssetProfileResponse"
                         }
                     },
                     "400": {
@@ -577,6 +706,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -585,38 +720,29 @@ var doc = `{
                     }
                 }
             },
-            "put": {
-                "description": "updates the data product",
+            "post": {
+                "description": "Computes the row count and the statistics of the cataloged columns of a raw or transformed asset on its destination and stores them as its latest profile",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "data-products"
+                    "assets"
                 ],
-                "summary": "Returns updated data product",
+                "summary": "Profile an asset",
                 "parameters": [
-                    {
-                        "description": "Product Info",
-                        "name": "product",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.DataProduct"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "Product ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.DataProductResponse"
+                            "$ref": "#/definitions/models.AssetProfileResponse"
                         }
                     },
                     "400": {
@@ -625,6 +751,18 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -634,44 +772,70 @@ var doc = `{
                 }
             }
         },
-        "/data-products/{id}/add-pipeline/": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "A newly created binding is returned",
+        "/assets/{id}/transformed/preview/": {
+            "get": {
+                "description": "Preview the data of transformed asset from destination, the row filters of the data product and the masking policies of the workspace are enforced for the caller's role",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "data-products"
+                    "assets"
                 ],
-                "summary": "Returns product and pipeline ID",
+                "summary": "Preview the data of transformed asset from destination.",
                 "parameters": [
-                    {
-                        "description": "pipelines",
-                        "name": "pipelines",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.InputProductsPipelines"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "Product ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated columns to return",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filters written as column:operator:value, repeatable",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column to order by, prefixed with - for descending order",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of rows",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of rows to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the next page returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ProductsPipelinesResponse"
+                            "$ref": "#/definitions/models.PreviewResponse"
                         }
                     },
                     "400": {
@@ -689,21 +853,71 @@ var doc = `{
                 }
             }
         },
-        "/destinations/": {
+        "/audit/": {
             "get": {
-                "description": "Return all the destinations supported by cdpaas",
+                "description": "Returns the mutating actions performed in the workspace, latest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "destination"
+                    "audit"
+                ],
+                "summary": "Get Audit Logs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID of the actor",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action i.e. name of the endpoint",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource Type",
+                        "name": "resourceType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource ID",
+                        "name": "resourceId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Start of the time range in epoch milliseconds",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "End of the time range in epoch milliseconds",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entries to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get All Supported Destinations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SupportedDestinationResponse"
+                            "$ref": "#/definitions/models.AuditLogsResponse"
                         }
                     },
                     "400": {
@@ -719,32 +933,61 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Configures and creates a destination connector on Airbyte",
+            }
+        },
+        "/audit/export/": {
+            "get": {
+                "description": "Exports the mutating actions performed in the workspace as JSON lines, one entry per line",
                 "produces": [
-                    "application/json"
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "destination"
+                    "audit"
                 ],
-                "summary": "Create Destination on Airbyte and store related information in local database.",
+                "summary": "Export Audit Logs",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "requestBody",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateDestinationConnectorRequestAPI"
-                        }
+                        "type": "integer",
+                        "description": "User ID of the actor",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action i.e. name of the endpoint",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource Type",
+                        "name": "resourceType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource ID",
+                        "name": "resourceId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Start of the time range in epoch milliseconds",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "End of the time range in epoch milliseconds",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CreateDestinationConnectorResponseAPI"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -762,21 +1005,32 @@ var doc = `{
                 }
             }
         },
-        "/destinations/configured/": {
-            "get": {
-                "description": "Return all the destinations that are configured on cdpaas",
+        "/auth-workflows/internal/pin/": {
+            "post": {
+                "description": "Sends verification pin via email",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "destination"
+                    "auth-workflows/internal"
+                ],
+                "summary": "Sends Verification pin",
+                "parameters": [
+                    {
+                        "description": "Receiver Credentials",
+                        "name": "email",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.EmailTemplate"
+                        }
+                    }
                 ],
-                "summary": "Get All Configured Destinations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ConfiguredDestinationResponse"
+                            "$ref": "#/definitions/models.Response"
                         }
                     },
                     "400": {
@@ -794,22 +1048,22 @@ var doc = `{
                 }
             }
         },
-        "/destinations/specification/": {
-            "get": {
-                "description": "Return the specification of a given destination",
+        "/classification/assets/{id}/": {
+            "post": {
+                "description": "Samples the rows of a raw or transformed asset and labels its columns holding emails, phone numbers, national IDs, credit cards, IPs or matching the workspace rules, the labels pinned by the users are kept",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "destination"
+                    "classification"
                 ],
-                "summary": "Get Destination Specification",
+                "summary": "Classify Asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Destination Name",
-                        "name": "destination",
-                        "in": "query",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -817,7 +1071,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DestinationSpecification"
+                            "$ref": "#/definitions/models.AssetClassificationResponse"
                         }
                     },
                     "400": {
@@ -826,6 +1080,18 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -835,30 +1101,46 @@ var doc = `{
                 }
             }
         },
-        "/destinations/{id}/summary/": {
-            "get": {
-                "description": "Returns the destination's summary",
+        "/classification/assets/{id}/columns/{name}/": {
+            "put": {
+                "description": "Replaces the labels of a column, the labels are pinned so the classification of the asset keeps them unless pinned is false",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "destination"
+                    "classification"
                 ],
-                "summary": "Get Destination Summary",
+                "summary": "Set Column Classifications",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Destination ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column Name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Column labels",
+                        "name": "classifications",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ColumnClassificationRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DestinationSummaryResponse"
+                            "$ref": "#/definitions/models.Response"
                         }
                     },
                     "400": {
@@ -867,6 +1149,18 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -876,44 +1170,62 @@ var doc = `{
                 }
             }
         },
-        "/health/": {
+        "/classification/data-products/{id}/report/": {
             "get": {
-                "description": "Get health of the server which tell either server is up or down",
+                "description": "Returns the classified columns of the transformed assets of a data product and of the raw assets of its pipelines",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "classification"
                 ],
-                "summary": "Get Health",
-                "responses": {
-                    "200": {
-                        "description": ""
-                    },
-                    "400": {
-                        "description": ""
+                "summary": "Get Sensitive Columns Report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SensitiveColumnsReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
                     },
                     "500": {
-                        "description": ""
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
                     }
                 }
             }
         },
-        "/pipelines/": {
-            "get": {
-                "description": "Get all the pipelines",
+        "/classification/internal/classify/": {
+            "post": {
+                "description": "Samples every asset of the data dictionary and labels its sensitive columns",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
+                    "classification/internal"
                 ],
-                "summary": "Get pipelines",
+                "summary": "Classifies the assets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PipelineMetaDataResponse"
+                            "$ref": "#/definitions/models.ClassificationJobReportResponse"
                         }
                     },
                     "400": {
@@ -929,32 +1241,23 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates the pipeline and links it to the specified data product",
+            }
+        },
+        "/classification/rules/": {
+            "get": {
+                "description": "Returns the regex and dictionary rules the classifier applies along with the built-in ones",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
-                ],
-                "summary": "Create Pipeline",
-                "parameters": [
-                    {
-                        "description": "Pipeline Info",
-                        "name": "pipeline",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Pipeline"
-                        }
-                    }
+                    "classification"
                 ],
+                "summary": "Get Classification Rules",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PipelineResponse"
+                            "$ref": "#/definitions/models.ClassificationRulesResponse"
                         }
                     },
                     "400": {
@@ -970,26 +1273,24 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/pipelines/connections/": {
+            },
             "post": {
-                "description": "Creates a pipeline on airbyte using the specified sources and destinations",
+                "description": "Creates a regex or dictionary rule matched against the column names or the sampled values",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
+                    "classification"
                 ],
-                "summary": "Create pipeline on airbyte",
+                "summary": "Create Classification Rule",
                 "parameters": [
                     {
-                        "description": "Pipeline Info",
-                        "name": "pipeline",
+                        "description": "Classification Rule",
+                        "name": "rule",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePipelineRequest"
+                            "$ref": "#/definitions/models.ClassificationRule"
                         }
                     }
                 ],
@@ -997,7 +1298,7 @@ var doc = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePipelineResponse"
+                            "$ref": "#/definitions/models.ClassificationRuleResponse"
                         }
                     },
                     "400": {
@@ -1006,6 +1307,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1015,21 +1322,21 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/connections/sync/logs/{job_id}/": {
-            "get": {
-                "description": "Fetch logs of a connection from AirByte as per the job",
+        "/classification/rules/{id}/": {
+            "delete": {
+                "description": "Deletes a custom classification rule, the labels already written are replaced on the next classification",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
+                    "classification"
                 ],
-                "summary": "Fetch Job logs From AirByte",
+                "summary": "Delete Classification Rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Job ID",
-                        "name": "job_id",
+                        "description": "Rule ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -1038,7 +1345,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.JobLogs"
+                            "$ref": "#/definitions/models.Response"
                         }
                     },
                     "400": {
@@ -1047,6 +1354,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1056,30 +1369,21 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/connections/{connection_id}/schema/": {
+        "/connector-upgrades/": {
             "get": {
-                "description": "Fetch Source Schema of a connection from AirByte",
+                "description": "Returns the connector upgrades of the workspace, latest first. Only super admins can list upgrades",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
-                ],
-                "summary": "Fetch Source Schema from AirByte",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Connection ID",
-                        "name": "connection_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "connector-upgrades"
                 ],
+                "summary": "Get Connector Upgrades",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ConnectionSourceSchema"
+                            "$ref": "#/definitions/models.ConnectorUpgradesResponse"
                         }
                     },
                     "400": {
@@ -1088,6 +1392,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1095,32 +1405,35 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/pipelines/connections/{connection_id}/sync/": {
+            },
             "post": {
-                "description": "Triggers the sync basis the connection ID",
+                "description": "Schedules the upgrade of a source or destination definition to a new docker image tag. Airbyte runs a single version of a definition for the whole instance, so the upgrade applies to all the pipelines of every workspace using the definition and a single pipeline can't be upgraded as a canary. Only super admins can schedule upgrades",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
+                    "connector-upgrades"
                 ],
-                "summary": "manually triggers the sync on airbyte",
+                "summary": "Schedule Connector Upgrade",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Connection ID",
-                        "name": "connection_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Upgrade",
+                        "name": "upgrade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScheduleConnectorUpgradeRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.ManualConnectionSyncResponse"
+                            "$ref": "#/definitions/models.ConnectorUpgradeResponse"
                         }
                     },
                     "400": {
@@ -1129,6 +1442,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1138,30 +1457,21 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/connections/{connection_id}/sync/history/": {
+        "/connector-upgrades/versions/": {
             "get": {
-                "description": "Fetch Sync History From AirByte for a specific source",
+                "description": "Returns the pipelines of the workspace grouped by source and destination definition and the docker image tag of the definition",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
-                ],
-                "summary": "Fetch Sync History From AirByte",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Connection ID",
-                        "name": "connection_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "connector-upgrades"
                 ],
+                "summary": "Get Connector Versions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SyncHistoryResponse"
+                            "$ref": "#/definitions/models.ConnectorVersionsResponse"
                         }
                     },
                     "400": {
@@ -1179,29 +1489,20 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/connections/{id}/": {
-            "put": {
-                "description": "Updates an pipeline on airByte",
+        "/connector-upgrades/{id}/": {
+            "get": {
+                "description": "Returns a connector upgrade of the workspace with the checks, catalog changes and specification changes of its validation or commit. Only super admins can get upgrades",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
+                    "connector-upgrades"
                 ],
-                "summary": "Updates Pipeline on AirByte",
+                "summary": "Get Connector Upgrade",
                 "parameters": [
-                    {
-                        "description": "Pipeline Info",
-                        "name": "pipeline",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdatePipelineAirByteRequest"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "Connection ID",
+                        "description": "Upgrade ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1211,7 +1512,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePipelineResponse"
+                            "$ref": "#/definitions/models.ConnectorUpgradeResponse"
                         }
                     },
                     "400": {
@@ -1220,6 +1521,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1229,32 +1536,30 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/internal/assets/enable/": {
-            "patch": {
-                "description": "updates the is_enabled to true",
+        "/connector-upgrades/{id}/commit/": {
+            "post": {
+                "description": "Upgrades the definition to the new version on airbyte, for every workspace of the instance, then runs check_connection for the pipelines of every workspace using the definition and discover_schema for their sources. The previous version is restored and the upgrade fails when a check fails, otherwise the version is recorded in the catalogs with the changes of the discovered catalogs and of the specification. Only validated upgrades can be committed. Only super admins can commit upgrades",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
+                    "connector-upgrades"
                 ],
-                "summary": "is_enabled updated to true",
+                "summary": "Commit Connector Upgrade",
                 "parameters": [
                     {
-                        "description": "connection Id's",
-                        "name": "connectionIDs",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.EnableAssetsInternalRequest"
-                        }
+                        "type": "string",
+                        "description": "Upgrade ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.ConnectorUpgradeResponse"
                         }
                     },
                     "400": {
@@ -1263,6 +1568,18 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1272,24 +1589,30 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/internal/connections/": {
-            "get": {
-                "description": "Returns all existing connections",
+        "/connector-upgrades/{id}/rollback/": {
+            "post": {
+                "description": "Restores the previous version of a committed upgrade on airbyte and in the catalogs of every workspace. Only super admins can roll back upgrades",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
+                    "connector-upgrades"
+                ],
+                "summary": "Rollback Connector Upgrade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upgrade ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Returns all connections",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Connection"
-                            }
+                            "$ref": "#/definitions/models.ConnectorUpgradeResponse"
                         }
                     },
                     "400": {
@@ -1298,6 +1621,18 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1305,32 +1640,32 @@ var doc = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/connector-upgrades/{id}/validate/": {
             "post": {
-                "description": "Creates a pipeline on airbyte using the specified sources and destinations",
+                "description": "Creates a custom definition of the workspace running the new version, the definition the pipelines run isn't changed on airbyte. The destinations of the pipelines using the definition run check_connection with it, the configurations of the sources are validated against its specification as airbyte masks their secrets. The changes of the specification are listed and the upgrade fails when a check fails. The sources run check_connection and discover_schema with the new version when it is committed. Only super admins can validate upgrades",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
+                    "connector-upgrades"
                 ],
-                "summary": "Create pipeline on airbyte",
+                "summary": "Validate Connector Upgrade",
                 "parameters": [
                     {
-                        "description": "Pipeline Info",
-                        "name": "pipeline",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreatePipelineRequest"
-                        }
+                        "type": "string",
+                        "description": "Upgrade ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePipelineResponse"
+                            "$ref": "#/definitions/models.ConnectorUpgradeResponse"
                         }
                     },
                     "400": {
@@ -1339,6 +1674,18 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1346,35 +1693,23 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "updates all given connections in DB",
+            }
+        },
+        "/data-products/": {
+            "get": {
+                "description": "Returns a list of all the data products",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
-                ],
-                "summary": "update all given connections",
-                "parameters": [
-                    {
-                        "description": "Connection Instance",
-                        "name": "connections",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Connection"
-                            }
-                        }
-                    }
+                    "data-products"
                 ],
+                "summary": "Returns all the data products",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.DataProductListResponse"
                         }
                     },
                     "400": {
@@ -1390,41 +1725,32 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/pipelines/internal/connections/{id}/": {
-            "put": {
-                "description": "Updates an pipeline on airByte",
+            },
+            "post": {
+                "description": "Creates the data product",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
+                    "data-products"
                 ],
-                "summary": "Updates Pipeline on AirByte",
+                "summary": "Create Data Product",
                 "parameters": [
                     {
-                        "description": "Pipeline Info",
-                        "name": "pipeline",
+                        "description": "Product Info",
+                        "name": "product",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdatePipelineAirByteRequest"
+                            "$ref": "#/definitions/models.DataProduct"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "Connection ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePipelineResponse"
+                            "$ref": "#/definitions/models.DataProductResponse"
                         }
                     },
                     "400": {
@@ -1442,35 +1768,39 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/internal/pipeline_assets/": {
-            "post": {
-                "description": "create pipeline in Database(DB)",
+        "/data-products/internal/": {
+            "get": {
+                "description": "returns the name of the data product that can be transformed",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
+                    "data-products/internal"
                 ],
-                "summary": "create pipeline in Database(DB)",
+                "summary": "Returns updated data product",
                 "parameters": [
                     {
-                        "description": "Pipeline Assets",
-                        "name": "pipelineAssets",
+                        "description": "Product Info",
+                        "name": "product",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.PipelineAssets"
-                            }
+                            "$ref": "#/definitions/models.DataProduct"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.ProductAssetDetails"
                         }
                     },
                     "400": {
@@ -1488,32 +1818,21 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/internal/schema/": {
+        "/data-products/internal/sla/evaluate/": {
             "post": {
-                "description": "Creates a schema for a pipeline",
+                "description": "Computes the staleness of every data product with a freshness SLA from the latest successful syncs of its pipelines and transformation, and emits an event when an SLA is breached or recovered",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
-                ],
-                "summary": "Creates a schema for a pipeline",
-                "parameters": [
-                    {
-                        "description": "Pipeline Info",
-                        "name": "pipeline",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.PipelineSchemas"
-                        }
-                    }
+                    "data-products/internal"
                 ],
+                "summary": "Evaluates the freshness SLAs",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PipelineSchemas"
+                            "$ref": "#/definitions/models.FreshnessReportResponse"
                         }
                     },
                     "400": {
@@ -1531,30 +1850,21 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/internal/schema/{id}/": {
-            "delete": {
-                "description": "deletes the pipeline schema and related assets",
+        "/data-products/internal/transformations/assets/": {
+            "post": {
+                "description": "deletes the already present assets and creates the new entries",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
-                ],
-                "summary": "deletes the pipeline schema and related assets",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Schema ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "data-products/internal"
                 ],
+                "summary": "syncs the transformed assets with the db",
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.ProductAssets"
                         }
                     },
                     "400": {
@@ -1572,20 +1882,20 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/internal/{id}/": {
+        "/data-products/transformations/{id}": {
             "get": {
-                "description": "Returns a source and connection ID by pipeline ID",
+                "description": "Return DBT transformation details for a given product",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
+                    "data-products"
                 ],
-                "summary": "Returns a source and connection ID",
+                "summary": "Return DBT transformation details for a given product",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pipeline ID",
+                        "description": "Product ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1595,7 +1905,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.GetPipelineSourceAndConnectionID"
+                            "$ref": "#/definitions/models.Response"
                         }
                     },
                     "400": {
@@ -1612,29 +1922,52 @@ var doc = `{
                     }
                 }
             },
-            "delete": {
-                "description": "deletes a pipeline by ID",
+            "post": {
+                "description": "Apply transformation",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
+                    "data-products"
                 ],
-                "summary": "deletes a pipelines",
+                "summary": "Apply transformation",
                 "parameters": [
+                    {
+                        "description": "Source Details",
+                        "name": "configureSourceData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateSourceConnectorRequestAPI"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Pipeline ID",
+                        "description": "Product ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Destination ID",
+                        "name": "destinationId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "sourceId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.CreatePipelineRequest"
                         }
                     },
                     "400": {
@@ -1650,20 +1983,31 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "updates the pipeline_status to Deletion In-Progress",
+            }
+        },
+        "/data-products/transformations/{id}/": {
+            "put": {
+                "description": "updates the data product",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
+                    "data-products"
                 ],
-                "summary": "pipeline_status updated to Deletion In-Progress",
+                "summary": "Returns updated data product",
                 "parameters": [
+                    {
+                        "description": "Transformation Info",
+                        "name": "transformation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdatePipelineAirByteRequest"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Pipeline ID",
+                        "description": "Product ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1673,7 +2017,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.CreatePipelineRequest"
                         }
                     },
                     "400": {
@@ -1691,30 +2035,35 @@ var doc = `{
                 }
             }
         },
-        "/pipelines/internal/{id}/schema/": {
+        "/data-products/{id}/": {
             "get": {
-                "description": "fetches the pipeline schema from pipeline_id",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns a data product by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines/internal"
+                    "data-products"
                 ],
-                "summary": "fetches the pipeline schema",
+                "summary": "Returns a data product",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pipeline ID",
+                        "description": "Product ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.GetDataProductViewResponse"
                         }
                     },
                     "400": {
@@ -1730,22 +2079,29 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/pipelines/{id}": {
-            "get": {
-                "description": "Returns a pipeline by ID",
+            },
+            "put": {
+                "description": "updates the data product",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
+                    "data-products"
                 ],
-                "summary": "Returns a pipeline",
+                "summary": "Returns updated data product",
                 "parameters": [
+                    {
+                        "description": "Product Info",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DataProduct"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Pipeline ID",
+                        "description": "Product ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1755,7 +2111,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.GetPipelineDetailsResponse"
+                            "$ref": "#/definitions/models.DataProductResponse"
                         }
                     },
                     "400": {
@@ -1771,31 +2127,75 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/pipelines/{id}/": {
-            "put": {
-                "description": "Edits the pipeline name and governance",
+            },
+            "delete": {
+                "description": "soft deletes the data product, it can be restored until it is purged after the retention window",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
+                    "data-products"
                 ],
-                "summary": "Updates a Pipeline",
+                "summary": "soft deletes a data product",
                 "parameters": [
                     {
-                        "description": "Pipeline Info",
-                        "name": "pipeline",
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/data-products/{id}/add-pipeline/": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "A newly created binding is returned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "data-products"
+                ],
+                "summary": "Returns product and pipeline ID",
+                "parameters": [
+                    {
+                        "description": "pipelines",
+                        "name": "pipelines",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdatePipeline"
+                            "$ref": "#/definitions/models.InputProductsPipelines"
                         }
                     },
                     {
                         "type": "string",
-                        "description": "Pipeline ID",
+                        "description": "Product ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1805,7 +2205,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PipelineResponse"
+                            "$ref": "#/definitions/models.ProductsPipelinesResponse"
                         }
                     },
                     "400": {
@@ -1821,20 +2221,22 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "pipeline deletion workflow on temporal is triggered",
+            }
+        },
+        "/data-products/{id}/restore/": {
+            "post": {
+                "description": "restores a soft deleted data product",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pipelines"
+                    "data-products"
                 ],
-                "summary": "pipeline deletion workflow is triggered",
+                "summary": "restores a data product",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pipeline ID",
+                        "description": "Product ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1862,21 +2264,30 @@ var doc = `{
                 }
             }
         },
-        "/sources/": {
+        "/data-products/{id}/sla/": {
             "get": {
-                "description": "Return all the sources supported by cdpaas",
+                "description": "Returns the freshness SLA of the data product with its status as of its latest evaluation",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "source"
+                    "data-products"
+                ],
+                "summary": "Get Freshness SLA",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get All Supported Sources",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SupportedSourcesResponse"
+                            "$ref": "#/definitions/models.FreshnessSLAResponse"
                         }
                     },
                     "400": {
@@ -1885,6 +2296,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1893,31 +2310,38 @@ var doc = `{
                     }
                 }
             },
-            "post": {
-                "description": "Configures and creates a source connector on Airbyte",
+            "put": {
+                "description": "Declares how many minutes the data product may go without a successful sync of its pipelines and transformation, or changes the declared SLA",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "source"
+                    "data-products"
                 ],
-                "summary": "Create Source on Airbyte and store related information in local database. A connection is also created with the source in the database.",
+                "summary": "Save Freshness SLA",
                 "parameters": [
                     {
-                        "description": "Source Details",
-                        "name": "configureSourceData",
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Freshness SLA",
+                        "name": "sla",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateSourceConnectorRequestAPI"
+                            "$ref": "#/definitions/models.FreshnessSLARequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CreateSourceConnectorResponseAPI"
+                            "$ref": "#/definitions/models.FreshnessSLAResponse"
                         }
                     },
                     "400": {
@@ -1926,6 +2350,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1933,24 +2363,22 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/sources/discover/schema/": {
-            "get": {
-                "description": "Discover and Return the source schema from AirByte",
+            },
+            "delete": {
+                "description": "Removes the freshness SLA of the data product, its breach history is kept",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "source"
+                    "data-products"
                 ],
-                "summary": "Discover Source Schema",
+                "summary": "Delete Freshness SLA",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Source ID",
-                        "name": "source_id",
-                        "in": "query",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -1958,7 +2386,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SourceSchemaResponse"
+                            "$ref": "#/definitions/models.Response"
                         }
                     },
                     "400": {
@@ -1967,6 +2395,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1976,22 +2410,22 @@ var doc = `{
                 }
             }
         },
-        "/sources/specification/": {
+        "/data-products/{id}/sla/events/": {
             "get": {
-                "description": "Return the specification of a given source",
+                "description": "Returns the breaches and recoveries of the freshness SLA of the data product, latest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "source"
+                    "data-products"
                 ],
-                "summary": "Get Source Specification",
+                "summary": "Get Freshness SLA Events",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Source Name",
-                        "name": "source",
-                        "in": "query",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -1999,7 +2433,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SourceSpecification"
+                            "$ref": "#/definitions/models.FreshnessSLAEventsResponse"
                         }
                     },
                     "400": {
@@ -2017,30 +2451,29 @@ var doc = `{
                 }
             }
         },
-        "/sources/{id}/": {
+        "/destinations/": {
             "get": {
-                "description": "Get a Source from AirByte",
+                "description": "Return all the destinations supported by cdpaas with the release stage and version of their airbyte definition. The destinations disabled for the workspace are only listed with include_disabled",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "source"
+                    "destination"
                 ],
-                "summary": "Get Source from AirByte",
+                "summary": "Get All Supported Destinations",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Source ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "List the destinations disabled for the workspace",
+                        "name": "include_disabled",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ConfiguredSourceResponse"
+                            "$ref": "#/definitions/models.SupportedDestinationResponse"
                         }
                     },
                     "400": {
@@ -2057,38 +2490,31 @@ var doc = `{
                     }
                 }
             },
-            "put": {
-                "description": "Edit a source connector on AirByte",
+            "post": {
+                "description": "Configures and creates a destination connector on Airbyte",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "source"
+                    "destination"
                 ],
-                "summary": "Edit Source on AirByte",
+                "summary": "Create Destination on Airbyte and store related information in local database.",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Source ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Source Details",
-                        "name": "EditSourceData",
+                        "description": "request body",
+                        "name": "requestBody",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.EditSourceConnectorRequest"
+                            "$ref": "#/definitions/models.CreateDestinationConnectorRequestAPI"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.CreateDestinationConnectorResponseAPI"
                         }
                     },
                     "400": {
@@ -2106,30 +2532,21 @@ var doc = `{
                 }
             }
         },
-        "/sources/{id}/summary/": {
+        "/destinations/configured/": {
             "get": {
-                "description": "Returns Summary of a connection",
+                "description": "Return all the destinations that are configured on cdpaas",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "source"
-                ],
-                "summary": "Returns Summary of a connection",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Source ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "destination"
                 ],
+                "summary": "Get All Configured Destinations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ConnectionSummaryResponse"
+                            "$ref": "#/definitions/models.ConfiguredDestinationResponse"
                         }
                     },
                     "400": {
@@ -2147,24 +2564,27 @@ var doc = `{
                 }
             }
         },
-        "/workspaces/internal/": {
+        "/destinations/custom/": {
             "post": {
-                "description": "Creates a workspace on AirByte",
+                "description": "Registers the docker image of an in-house destination connector as a custom destination definition of the workspace on airbyte and lists it in the supported destinations of the workspace. Only admins can register custom destinations",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "workspaces/internal"
+                    "destination"
                 ],
-                "summary": "Create Workspace on AirByte and returns the workspace_id.",
+                "summary": "Register Custom Destination",
                 "parameters": [
                     {
-                        "description": "Workspace Info",
-                        "name": "createWorkspace",
+                        "description": "Custom Destination",
+                        "name": "connector",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.WorkspaceRequest"
+                            "$ref": "#/definitions/models.CustomConnectorRequest"
                         }
                     }
                 ],
@@ -2172,7 +2592,7 @@ var doc = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.WorkspaceResponse"
+                            "$ref": "#/definitions/models.CustomDestinationResponse"
                         }
                     },
                     "400": {
@@ -2181,6 +2601,12 @@ var doc = `{
                             "$ref": "#/definitions/models.Response"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2189,81 +2615,6184 @@ var doc = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.Config": {
-            "type": "object",
-            "properties": {
-                "aliasName": {
-                    "type": "string"
-                },
-                "cursorField": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "destinationSyncMode": {
-                    "type": "string"
-                },
-                "primaryKey": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        }
-                    }
-                },
-                "selected": {
-                    "type": "boolean"
-                },
-                "syncMode": {
-                    "type": "string"
-                }
-            }
         },
-        "models.ConfiguredDestination": {
-            "type": "object",
-            "required": [
-                "destinationId",
-                "destinationName",
-                "destinationType"
-            ],
-            "properties": {
-                "airbyteDestinationDefinitionId": {
+        "/destinations/custom/{id}/": {
+            "put": {
+                "description": "Upgrades a custom destination of the workspace to a new docker image tag. The configurations of the destinations of the workspace are checked against the specification of the new version and the upgrade is rolled back when one of them is incompatible. Only admins can upgrade custom destinations",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destination"
+                ],
+                "summary": "Upgrade Custom Destination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Airbyte Destination Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Version",
+                        "name": "upgrade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CustomConnectorUpgradeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CustomConnectorUpgradeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.CustomConnectorUpgradeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/definitions/{id}/": {
+            "put": {
+                "description": "Enables or disables a destination of the catalog for the workspace, the disabled destinations can't be configured. Only admins can change the destinations of the workspace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destination"
+                ],
+                "summary": "Enable or Disable Destination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Airbyte Destination Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Availability",
+                        "name": "availability",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ConnectorAvailabilityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkspaceConnectorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/internal/definitions/reconcile/": {
+            "post": {
+                "description": "Upserts the supported destinations with the docker image, version, release stage, icon and documentation of the destination definitions listed by airbyte. The destinations airbyte stopped listing are deactivated",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destinations/internal"
+                ],
+                "summary": "Reconcile Destination Definitions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ConnectorCatalogReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/specification/": {
+            "get": {
+                "description": "Return the specification of a given destination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destination"
+                ],
+                "summary": "Get Destination Specification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Destination Name",
+                        "name": "destination",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DestinationSpecification"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/validate/": {
+            "post": {
+                "description": "Dry run validating a destination configuration against the connection specification of its definition, the fields breaking it are reported one by one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destination"
+                ],
+                "summary": "Validate Destination Configuration",
+                "parameters": [
+                    {
+                        "description": "Destination Definition and Configuration",
+                        "name": "validation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ConfigValidationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ConfigValidationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/{id}/": {
+            "put": {
+                "description": "Checks the new configuration against the destination, updates the destination connector on Airbyte and stores the changes in local database",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destination"
+                ],
+                "summary": "Update Destination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Destination ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.EditDestinationConnectorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateDestinationConnectorResponseAPI"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft deletes a destination, the deletion is refused with the list of dependent resources if pipelines or data products are still using it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destination"
+                ],
+                "summary": "Delete Destination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Destination ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceDependenciesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/{id}/restore/": {
+            "post": {
+                "description": "Restores a soft deleted destination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destination"
+                ],
+                "summary": "Restore Destination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Destination ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/{id}/summary/": {
+            "get": {
+                "description": "Returns the destination's summary",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destination"
+                ],
+                "summary": "Get Destination Summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Destination ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DestinationSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/": {
+            "get": {
+                "description": "Get health of the server which tell either server is up or down",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Get Health",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/lineage/": {
+            "get": {
+                "description": "Returns the upstream and downstream lineage of a source, table or column of the workspace at table or column granularity. Nodes are identified as source:\u003csourceId\u003e, table:\u003cassetId\u003e or column:\u003cassetId\u003e:\u003ccolumnName\u003e",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lineage"
+                ],
+                "summary": "Get Lineage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node ID",
+                        "name": "node",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "upstream, downstream or both",
+                        "name": "direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Depth, 0 follows the lineage to its ends",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "table or column",
+                        "name": "granularity",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LineageGraphResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/lineage/openlineage/": {
+            "get": {
+                "description": "Exports the table lineage of a node as OpenLineage run events, one for every table written by a pipeline or the transformation of a data product. The written tables carry their schema and column lineage facets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lineage"
+                ],
+                "summary": "Export OpenLineage Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node ID",
+                        "name": "node",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "upstream, downstream or both",
+                        "name": "direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Depth, 0 follows the lineage to its ends",
+                        "name": "depth",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.OpenLineageEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/lineage/products/{id}/manifest/": {
+            "put": {
+                "description": "Uploads the manifest.json compiled by the dbt project transforming the data product, the lineage of its transformed assets follows the dependencies of their models. A new upload replaces the manifest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lineage"
+                ],
+                "summary": "Save dbt Manifest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "dbt manifest.json",
+                        "name": "manifest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DbtManifestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/": {
+            "get": {
+                "description": "Get all the pipelines",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Get pipelines",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineMetaDataResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates the pipeline and links it to the specified data product",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Create Pipeline",
+                "parameters": [
+                    {
+                        "description": "Pipeline Info",
+                        "name": "pipeline",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Pipeline"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/connections/": {
+            "post": {
+                "description": "Creates a pipeline on airbyte using the specified source, the source is replicated to every destination over an airbyte connection of its own. The destinations are connected all together or not at all",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Create pipeline on airbyte",
+                "parameters": [
+                    {
+                        "description": "Pipeline Info",
+                        "name": "pipeline",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePipelineRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePipelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/connections/sync/logs/{job_id}/": {
+            "get": {
+                "description": "Fetch logs of a connection from AirByte as per the job",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Fetch Job logs From AirByte",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.JobLogs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/connections/{connection_id}/schema/": {
+            "get": {
+                "description": "Fetch Source Schema of a connection from AirByte",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Fetch Source Schema from AirByte",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Connection ID",
+                        "name": "connection_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ConnectionSourceSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/connections/{connection_id}/sync/": {
+            "post": {
+                "description": "Triggers the sync basis the connection ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "manually triggers the sync on airbyte",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Connection ID",
+                        "name": "connection_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ManualConnectionSyncResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/connections/{connection_id}/sync/history/": {
+            "get": {
+                "description": "Fetch Sync History From AirByte for a specific source",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Fetch Sync History From AirByte",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Connection ID",
+                        "name": "connection_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SyncHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/connections/{id}/": {
+            "put": {
+                "description": "Updates an pipeline on airByte, the schedule and the catalog are applied to the connection of every destination of the pipeline",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Updates Pipeline on AirByte",
+                "parameters": [
+                    {
+                        "description": "Pipeline Info",
+                        "name": "pipeline",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdatePipelineAirByteRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Connection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePipelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/assets/enable/": {
+            "patch": {
+                "description": "updates the is_enabled to true",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "is_enabled updated to true",
+                "parameters": [
+                    {
+                        "description": "connection Id's",
+                        "name": "connectionIDs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.EnableAssetsInternalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/connections/": {
+            "get": {
+                "description": "Returns all existing connections",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "Returns all connections",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Connection"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a pipeline on airbyte using the specified sources and destinations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "Create pipeline on airbyte",
+                "parameters": [
+                    {
+                        "description": "Pipeline Info",
+                        "name": "pipeline",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePipelineRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePipelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "updates all given connections in DB",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "update all given connections",
+                "parameters": [
+                    {
+                        "description": "Connection Instance",
+                        "name": "connections",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Connection"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/connections/{id}/": {
+            "put": {
+                "description": "Updates an pipeline on airByte",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "Updates Pipeline on AirByte",
+                "parameters": [
+                    {
+                        "description": "Pipeline Info",
+                        "name": "pipeline",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdatePipelineAirByteRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Connection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePipelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/pipeline_assets/": {
+            "post": {
+                "description": "create pipeline in Database(DB)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "create pipeline in Database(DB)",
+                "parameters": [
+                    {
+                        "description": "Pipeline Assets",
+                        "name": "pipelineAssets",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PipelineAssets"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/purge/": {
+            "post": {
+                "description": "Triggers the deletion workflow of the pipelines and removes the sources, destinations and data products which were soft deleted before the retention window. The connectors are deleted on airbyte before their rows, the resources which couldn't be purged are reported as failures and retried on the next run",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "purges the soft deleted resources",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PurgeReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/schema/": {
+            "post": {
+                "description": "Creates a schema for a pipeline",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "Creates a schema for a pipeline",
+                "parameters": [
+                    {
+                        "description": "Pipeline Info",
+                        "name": "pipeline",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineSchemas"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineSchemas"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/schema/{id}/": {
+            "delete": {
+                "description": "deletes the pipeline schema and related assets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "deletes the pipeline schema and related assets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schema ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/{id}/": {
+            "get": {
+                "description": "Returns a source and connection ID by pipeline ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "Returns a source and connection ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GetPipelineSourceAndConnectionID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "permanently deletes a pipeline by ID, it is called by the deletion workflow once the airbyte resources are torn down",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "purges a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "updates the pipeline_status to Deletion In-Progress",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "pipeline_status updated to Deletion In-Progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/{id}/reset/": {
+            "post": {
+                "description": "Resets all the streams of every connection of the pipeline, or only the given ones, updates the pipeline_status and records an operation for each of them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "resets the connections on airbyte",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Streams to reset",
+                        "name": "operation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ManualConnectionSyncResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/{id}/schema/": {
+            "get": {
+                "description": "fetches the pipeline schema from pipeline_id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "fetches the pipeline schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/{id}/sync/cancel/": {
+            "post": {
+                "description": "Cancels the running sync job of every connection of the pipeline, updates the pipeline_status and records an operation for each of them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "cancels the running sync jobs on airbyte",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ManualConnectionSyncResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/internal/{id}/sync/retry/": {
+            "post": {
+                "description": "Re-runs the sync of every connection of the pipeline whose latest sync job failed, updates the pipeline_status and records an operation for each of them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines/internal"
+                ],
+                "summary": "retries the last failed sync jobs on airbyte",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ManualConnectionSyncResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/{id}": {
+            "get": {
+                "description": "Returns a pipeline by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Returns a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GetPipelineDetailsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/{id}/": {
+            "put": {
+                "description": "Edits the pipeline name and governance",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Updates a Pipeline",
+                "parameters": [
+                    {
+                        "description": "Pipeline Info",
+                        "name": "pipeline",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdatePipeline"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "soft deletes a pipeline along with its source and deactivates its airbyte connections, the status they had is recorded and it can be restored until it is purged after the retention window",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "soft deletes a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/{id}/operations/": {
+            "get": {
+                "description": "Returns the cancel, retry and reset operations performed on a pipeline, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "Returns the operations performed on a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineOperationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/{id}/reset/": {
+            "post": {
+                "description": "Triggers the workflow which resets the whole connection or only the selected streams on airbyte",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "resets the data of a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation confirmation and streams to reset",
+                        "name": "operation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/{id}/restore/": {
+            "post": {
+                "description": "restores a soft deleted pipeline along with its source and sets its airbyte connections back to the status they had before the deletion, a pipeline writing to a deleted destination can't be restored before the destination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "restores a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceDependenciesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/{id}/sync/cancel/": {
+            "post": {
+                "description": "Triggers the workflow which cancels the running sync job of the pipeline on airbyte",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "cancels the running sync of a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation confirmation",
+                        "name": "operation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines/{id}/sync/retry/": {
+            "post": {
+                "description": "Triggers the workflow which retries the last failed sync job of the pipeline on airbyte",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pipelines"
+                ],
+                "summary": "retries the last failed sync of a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation confirmation",
+                        "name": "operation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PipelineOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/policies/masking/": {
+            "get": {
+                "description": "Returns the masking policies applied on the previewed columns by their classification",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Get Masking Policies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MaskingPoliciesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a policy that hashes, redacts, partially masks or nulls the previewed values of the columns with the classification, for all the roles but the exempt ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Create Masking Policy",
+                "parameters": [
+                    {
+                        "description": "Masking Policy",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MaskingPolicy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.MaskingPolicyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/policies/masking/{id}/": {
+            "delete": {
+                "description": "Deletes a masking policy of the workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Delete Masking Policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Policy ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/policies/row-filters/": {
+            "get": {
+                "description": "Returns the row filters enforced on the previews of the assets of a data product",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Get Row Filters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "productId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RowFiltersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a condition the previewed rows of the assets of a data product must match, for all the roles but the exempt ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Create Row Filter",
+                "parameters": [
+                    {
+                        "description": "Row Filter",
+                        "name": "filter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RowFilter"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.RowFilterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/policies/row-filters/{id}/": {
+            "delete": {
+                "description": "Deletes a row filter of a data product",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Delete Row Filter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/quality/assets/{id}/check/": {
+            "post": {
+                "description": "Checks the quality rules of an asset on its destination in a new run, outside of its syncs and transformations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quality"
+                ],
+                "summary": "Check Asset Quality",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.QualityCheckResultsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/quality/results/": {
+            "get": {
+                "description": "Returns the latest results of the quality rules of an asset over its runs, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quality"
+                ],
+                "summary": "Get Quality Results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "assetId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "ruleId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Run ID",
+                        "name": "runId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.QualityCheckResultsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/quality/rules/": {
+            "get": {
+                "description": "Returns the expectations checked on the rows of an asset after every sync or transformation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quality"
+                ],
+                "summary": "Get Quality Rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "assetId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.QualityRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a not null, unique, accepted values, range, regex, row count delta or freshness expectation on an asset. A failed rule of error severity degrades the data products of the asset",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quality"
+                ],
+                "summary": "Create Quality Rule",
+                "parameters": [
+                    {
+                        "description": "Quality Rule",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.QualityRule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.QualityRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/quality/rules/{id}/": {
+            "delete": {
+                "description": "Deletes a quality rule of the workspace, its results are kept",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quality"
+                ],
+                "summary": "Delete Quality Rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/schema-changes/": {
+            "get": {
+                "description": "Returns the schema changes detected on the sources of the pipelines, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schema-changes"
+                ],
+                "summary": "Get Schema Changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pipeline ID",
+                        "name": "pipelineId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status i.e. pending, approved or rejected",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SchemaChangesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/schema-changes/internal/detect/": {
+            "post": {
+                "description": "Rediscovers the schema of the source of every pipeline and raises a pending change when streams or columns are added, removed or their types are changed. A drift identical to a rejected change isn't raised again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schema-changes/internal"
+                ],
+                "summary": "Detects schema drift",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SchemaDriftReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/schema-changes/{id}/approve/": {
+            "post": {
+                "description": "Approves a pending schema change and triggers the update connection workflow to apply the discovered catalog on Airbyte to the connection of every destination of the pipeline, the same change pending on the other connections is approved with it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schema-changes"
+                ],
+                "summary": "Approve Schema Change",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schema Change ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/schema-changes/{id}/reject/": {
+            "post": {
+                "description": "Rejects a pending schema change, the connection keeps its current catalog",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schema-changes"
+                ],
+                "summary": "Reject Schema Change",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schema Change ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/": {
+            "get": {
+                "description": "Return all the sources supported by cdpaas with the release stage and version of their airbyte definition. The sources disabled for the workspace are only listed with include_disabled",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get All Supported Sources",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "List the sources disabled for the workspace",
+                        "name": "include_disabled",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SupportedSourcesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Configures and creates a workspace source connector on Airbyte, which can be shared by multiple pipelines",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Create Source on Airbyte and store related information in local database. When a pipeline is given a connection is also created with the source in the database.",
+                "parameters": [
+                    {
+                        "description": "Source Details",
+                        "name": "configureSourceData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateSourceConnectorRequestAPI"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateSourceConnectorResponseAPI"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/configured/": {
+            "get": {
+                "description": "Return all the sources configured in the workspace along with the number of pipelines using them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get All Configured Sources",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkspaceSourcesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/custom/": {
+            "post": {
+                "description": "Registers the docker image of an in-house source connector as a custom source definition of the workspace on airbyte and lists it in the supported sources of the workspace. Only admins can register custom sources",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Register Custom Source",
+                "parameters": [
+                    {
+                        "description": "Custom Source",
+                        "name": "connector",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CustomConnectorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CustomSourceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/custom/{id}/": {
+            "put": {
+                "description": "Upgrades a custom source of the workspace to a new docker image tag. The configurations of the sources of the workspace are checked against the specification of the new version and the upgrade is rolled back when one of them is incompatible. Only admins can upgrade custom sources",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Upgrade Custom Source",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Airbyte Source Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Version",
+                        "name": "upgrade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CustomConnectorUpgradeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CustomConnectorUpgradeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.CustomConnectorUpgradeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/definitions/{id}/": {
+            "put": {
+                "description": "Enables or disables a source of the catalog for the workspace, the disabled sources can't be configured. Only admins can change the sources of the workspace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Enable or Disable Source",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Airbyte Source Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Availability",
+                        "name": "availability",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ConnectorAvailabilityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkspaceConnectorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/discover/schema/": {
+            "get": {
+                "description": "Discover and Return the source schema from AirByte",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Discover Source Schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "source_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SourceSchemaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/files/": {
+            "post": {
+                "description": "Stores a CSV, JSON Lines, Excel (xlsx) or Parquet file in the object store of the service and creates the airbyte file source reading it. The schema inferred from the first rows of the file is returned as its preview.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Upload File Source",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Source Name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.FileSourceUploadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/internal/definitions/reconcile/": {
+            "post": {
+                "description": "Upserts the supported sources with the docker image, version, release stage, icon and documentation of the source definitions listed by airbyte. The sources airbyte stopped listing are deactivated",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sources/internal"
+                ],
+                "summary": "Reconcile Source Definitions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ConnectorCatalogReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/internal/oauth/check/": {
+            "post": {
+                "description": "Internal endpoint checking the connection of every source authorized through OAuth, the sources failing the check are recorded as token refresh failures and the passing ones are resolved",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Check OAuth Tokens",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.OAuthTokenCheckReportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/oauth/callback/": {
+            "get": {
+                "description": "Completes the OAuth flow the state of the provider was issued for and redirects to the redirect url of the flow with its flowId and status. The tokens are never part of the redirect.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Complete Source OAuth",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OAuth State",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/oauth/consent/": {
+            "post": {
+                "description": "Returns the consent url of the OAuth provider of the source definition, the provider redirects the consent to the callback of the sources. The redirect url must be on one of the allowed frontend origins. Giving a source authorizes that source again once the consent completes, otherwise the flow is given to the source configuration with oauthFlowId.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Start Source OAuth",
+                "parameters": [
+                    {
+                        "description": "Source Definition and Redirect URL",
+                        "name": "consent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.OAuthConsentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.OAuthConsentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/oauth/failures/": {
+            "get": {
+                "description": "Returns the open token refresh failures of the sources of the workspace, a failure is resolved once its source connects again or is authorized through a new OAuth flow",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get OAuth Token Failures",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.OAuthTokenFailuresResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/oauth/{id}/": {
+            "get": {
+                "description": "Returns the status of an OAuth flow of the workspace and the error of a failed one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get Source OAuth Flow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OAuth Flow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.OAuthFlowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/specification/": {
+            "get": {
+                "description": "Return the specification of a given source",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get Source Specification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source Name",
+                        "name": "source",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SourceSpecification"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/validate/": {
+            "post": {
+                "description": "Dry run validating a source configuration against the connection specification of its definition, the fields breaking it are reported one by one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Validate Source Configuration",
+                "parameters": [
+                    {
+                        "description": "Source Definition and Configuration",
+                        "name": "validation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ConfigValidationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ConfigValidationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}/": {
+            "get": {
+                "description": "Get a Source from AirByte",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get Source from AirByte",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ConfiguredSourceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Edit a source connector on AirByte, the change applies to every pipeline reading from the source",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Edit Source on AirByte",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Source Details",
+                        "name": "EditSourceData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.EditSourceConnectorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft deletes a source, the deletion is refused with the list of dependent resources if a pipeline is still syncing from it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Delete Source",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceDependenciesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}/files/": {
+            "get": {
+                "description": "Returns the uploaded versions of the file of a file source with their preview, the latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get File Source Versions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FileUploadsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Stores the file as the next version of the file source and points the airbyte source at it, the previous versions are kept. With sync the pipelines reading from the source are synced with the new version, the pipelines whose sync couldn't be triggered are listed as failed.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Re-upload File Source",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sync the pipelines of the source",
+                        "name": "sync",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.FileSourceUploadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}/pipelines/": {
+            "post": {
+                "description": "Creates the connection of the pipeline with an already configured source, so the source is reused instead of configured again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Attach Source to Pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AttachSourceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}/summary/": {
+            "get": {
+                "description": "Returns Summary of a connection",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Returns Summary of a connection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ConnectionSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/internal/": {
+            "post": {
+                "description": "Creates a workspace on AirByte",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspaces/internal"
+                ],
+                "summary": "Create Workspace on AirByte and returns the workspace_id.",
+                "parameters": [
+                    {
+                        "description": "Workspace Info",
+                        "name": "createWorkspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkspaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkspaceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.AssetClassification": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "columns": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "sampled": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "models.AssetClassificationResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.AssetClassification"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.AssetColumn": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "assetName": {
+                    "type": "string",
+                    "example": "users"
+                },
+                "classifications": {
+                    "type": "string",
+                    "example": "[email]"
+                },
+                "classificationsPinned": {
+                    "description": "ClassificationsPinned marks the labels set by a user, the automatic classification doesn't overwrite them",
+                    "type": "boolean",
+                    "example": false
+                },
+                "columnId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Email address of the user"
+                },
+                "documentedAt": {
+                    "type": "integer",
+                    "example": 1660000000000
+                },
+                "documentedBy": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "email"
+                },
+                "nullable": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "parentId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "tags": {
+                    "type": "string",
+                    "example": "[contact, pii]"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "string"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.AssetColumnsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AssetColumn"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.AssetExport": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "assetName": {
+                    "type": "string",
+                    "example": "users"
+                },
+                "async": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "columns": {
+                    "type": "string",
+                    "example": "[id, email]"
+                },
+                "completedAt": {
+                    "type": "integer",
+                    "example": 1660000000000
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "downloadUrl": {
+                    "type": "string",
+                    "example": "/pipeline-service/api/v1/assets/exports/b251379e-01a1-11ec-82d6-a312edcd9c7b/download/"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "exportId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "filters": {
+                    "type": "string",
+                    "example": "[age:gte:18]"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "csv"
+                },
+                "gzip": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "requestedBy": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rowCount": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "rowLimit": {
+                    "type": "integer",
+                    "example": 1000000
+                },
+                "status": {
+                    "type": "string",
+                    "example": "completed"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.AssetExportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.AssetExport"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.AssetExportsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AssetExport"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.AssetProfile": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "columns": {
+                    "type": "string",
+                    "example": "[]"
+                },
+                "profileId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "profiledAt": {
+                    "type": "integer",
+                    "example": 1660000000000
+                },
+                "rowCount": {
+                    "type": "integer",
+                    "example": 1000
+                }
+            }
+        },
+        "models.AssetProfileResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.AssetProfile"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.AttachSourceRequest": {
+            "type": "object",
+            "required": [
+                "pipelineId"
+            ],
+            "properties": {
+                "pipelineId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                }
+            }
+        },
+        "models.AuditLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "EditSourceOnAirByte"
+                },
+                "actor": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "after": {
+                    "type": "string",
+                    "example": "{}"
+                },
+                "auditId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "before": {
+                    "type": "string",
+                    "example": "{}"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string",
+                    "example": "PUT"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/pipeline-service/api/v1/sources/:id/"
+                },
+                "requestId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "resourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "resourceType": {
+                    "type": "string",
+                    "example": "sources"
+                },
+                "statusCode": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.AuditLogsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditLog"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.ClassificationJobReport": {
+            "type": "object",
+            "properties": {
+                "classified": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.ClassificationJobReportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.ClassificationJobReport"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.ClassificationRule": {
+            "type": "object",
+            "required": [
+                "kind",
+                "label",
+                "target"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "integer"
+                },
+                "createdBy": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "regex"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "employee_id"
+                },
+                "pattern": {
+                    "type": "string",
+                    "example": "^EMP-[0-9]{6}$"
+                },
+                "ruleId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "value"
+                },
+                "values": {
+                    "type": "string",
+                    "example": "[emp_id, employee_number]"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.ClassificationRuleResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.ClassificationRule"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.ClassificationRulesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ClassificationRule"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.ColumnClassificationRequest": {
+            "type": "object",
+            "properties": {
+                "classifications": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "email"
+                    ]
+                },
+                "pinned": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "models.Config": {
+            "type": "object",
+            "properties": {
+                "aliasName": {
+                    "type": "string"
+                },
+                "cursorField": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "destinationSyncMode": {
+                    "type": "string"
+                },
+                "primaryKey": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "selected": {
+                    "type": "boolean"
+                },
+                "syncMode": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConfigFieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "credentials.password"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "is required"
+                }
+            }
+        },
+        "models.ConfigValidation": {
+            "type": "object",
+            "properties": {
+                "definitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ConfigFieldError"
+                    }
+                },
+                "valid": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "version": {
+                    "type": "string",
+                    "example": "0.4.4"
+                }
+            }
+        },
+        "models.ConfigValidationRequest": {
+            "type": "object",
+            "required": [
+                "connectionConfiguration",
+                "definitionId"
+            ],
+            "properties": {
+                "connectionConfiguration": {
+                    "type": "object"
+                },
+                "definitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                }
+            }
+        },
+        "models.ConfigValidationResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.ConfigValidation"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.ConfiguredDestination": {
+            "type": "object",
+            "required": [
+                "destinationId",
+                "destinationName",
+                "destinationType"
+            ],
+            "properties": {
+                "airbyteDestinationDefinitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "airbyteDestinationId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "destinationId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "destinationName": {
+                    "type": "string",
+                    "example": "example_destination"
+                },
+                "destinationType": {
+                    "type": "string",
+                    "example": "example_destination"
+                },
+                "host": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConfiguredDestinationResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ConfiguredDestination"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.ConfiguredSource": {
+            "type": "object",
+            "properties": {
+                "connectionConfiguration": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sourceDefinitionId": {
+                    "type": "string"
+                },
+                "sourceId": {
+                    "type": "string"
+                },
+                "sourceName": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConfiguredSourceResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.ConfiguredSource"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.Connection": {
+            "type": "object",
+            "properties": {
+                "airbyteConnectionId": {
+                    "type": "string"
+                },
+                "airbyteFrequencyUnits": {
+                    "type": "integer"
+                },
+                "airbyteLastRun": {
+                    "type": "integer"
+                },
+                "airbyteTimeUnit": {
+                    "type": "string"
+                },
+                "connectionId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "firstSucceededRunAt": {
+                    "type": "integer"
+                },
+                "isFirstRun": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "owner": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pipelineId": {
+                    "type": "string"
+                },
+                "sourceId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.ConnectionSourceSchema": {
+            "type": "object",
+            "properties": {
+                "connectionId": {
+                    "type": "string"
+                },
+                "destination": {
+                    "type": "object",
+                    "properties": {
+                        "connectionConfiguration": {
+                            "type": "object",
+                            "properties": {
+                                "user": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "destinationDefinitionId": {
+                            "type": "string"
+                        },
+                        "destinationId": {
+                            "type": "string"
+                        },
+                        "destinationName": {
+                            "type": "string"
+                        },
+                        "name": {
+                            "type": "string"
+                        },
+                        "workspaceId": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "destinationId": {
+                    "type": "string"
+                },
+                "isSyncing": {
+                    "type": "boolean"
+                },
+                "latestSyncJobCreatedAt": {
+                    "type": "integer"
+                },
+                "latestSyncJobStatus": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespaceDefinition": {
+                    "type": "string"
+                },
+                "namespaceFormat": {
+                    "type": "string"
+                },
+                "operationIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Operations"
+                    }
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "resourceRequirements": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.ResourceRequirements"
+                },
+                "schedule": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.Schedule"
+                },
+                "source": {
+                    "type": "object",
+                    "properties": {
+                        "connectionConfiguration": {
+                            "type": "object",
+                            "properties": {
+                                "user": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "name": {
+                            "type": "string"
+                        },
+                        "sourceDefinitionId": {
+                            "type": "string"
+                        },
+                        "sourceId": {
+                            "type": "string"
+                        },
+                        "sourceName": {
+                            "type": "string"
+                        },
+                        "workspaceId": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "sourceId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "syncCatalog": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.SyncCatalog"
+                }
+            }
+        },
+        "models.ConnectionSummaryAirByte": {
+            "type": "object",
+            "properties": {
+                "schedule": {
+                    "type": "object",
+                    "properties": {
+                        "timeUnit": {
+                            "type": "string"
+                        },
+                        "units": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConnectionSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "airByteSummary": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.ConnectionSummaryAirByte"
+                },
+                "configurationDate": {
+                    "type": "integer"
+                },
+                "configuredBy": {
+                    "type": "object"
+                },
+                "sourceName": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConnectorAvailabilityRequest": {
+            "type": "object",
+            "required": [
+                "enabled"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "models.ConnectorCatalogReport": {
+            "type": "object",
+            "properties": {
+                "deactivated": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "synced": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "syncedAt": {
+                    "type": "integer",
+                    "example": 1650000000000
+                }
+            }
+        },
+        "models.ConnectorCatalogReportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.ConnectorCatalogReport"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.ConnectorUpgrade": {
+            "type": "object",
+            "properties": {
+                "airbyteDefinitionId": {
+                    "type": "string",
+                    "example": "decd338e-5647-4c0b-adf4-da0e75f5a750"
+                },
+                "checks": {
+                    "type": "string",
+                    "example": "[]"
+                },
+                "connectorType": {
+                    "type": "string",
+                    "example": "source"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "createdBy": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "previousVersion": {
+                    "type": "string",
+                    "example": "0.4.4"
+                },
+                "specChanges": {
+                    "type": "string",
+                    "example": "[]"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "scheduled"
+                },
+                "updatedAt": {
+                    "type": "integer"
+                },
+                "upgradeId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "0.4.8"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.ConnectorUpgradeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.ConnectorUpgrade"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.ConnectorUpgradesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ConnectorUpgrade"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.ConnectorVersion": {
+            "type": "object",
+            "properties": {
+                "airbyteDefinitionId": {
+                    "type": "string",
+                    "example": "decd338e-5647-4c0b-adf4-da0e75f5a750"
+                },
+                "connectorType": {
+                    "type": "string",
+                    "example": "source"
+                },
+                "dockerRepository": {
+                    "type": "string",
+                    "example": "airbyte/source-postgres"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Postgres"
+                },
+                "pipelines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ConnectorVersionPipeline"
+                    }
+                },
+                "version": {
+                    "type": "string",
+                    "example": "0.4.4"
+                }
+            }
+        },
+        "models.ConnectorVersionPipeline": {
+            "type": "object",
+            "properties": {
+                "pipelineId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "pipelineName": {
+                    "type": "string",
+                    "example": "pipeline-1"
+                }
+            }
+        },
+        "models.ConnectorVersionsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ConnectorVersion"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.CreateDestinationConnectorRequestAPI": {
+            "type": "object",
+            "required": [
+                "connectionConfiguration",
+                "destinationDefinitionId",
+                "destinationName",
+                "name"
+            ],
+            "properties": {
+                "connectionConfiguration": {
+                    "type": "string"
+                },
+                "destinationDefinitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "destinationName": {
+                    "type": "string",
+                    "example": "Postgres"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "example_destination_name"
+                }
+            }
+        },
+        "models.CreateDestinationConnectorResponseAPI": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.CreateDestinationConnectorResponseData"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.CreateDestinationConnectorResponseData": {
+            "type": "object",
+            "properties": {
+                "destinationId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "destinationName": {
+                    "type": "string",
+                    "example": "Postgres"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "example_destination"
+                }
+            }
+        },
+        "models.CreatePipelineRequest": {
+            "type": "object",
+            "required": [
+                "prefix",
+                "sourceId",
+                "syncCatalog"
+            ],
+            "properties": {
+                "destinationId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "destinationIds": {
+                    "description": "DestinationIDs fans the source out to several destinations, each of them gets an airbyte connection",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    ]
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Operations"
+                    }
+                },
+                "pipelineId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "t1"
+                },
+                "schedule": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.Schedule"
+                },
+                "sourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "syncCatalog": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.SyncCatalog"
+                }
+            }
+        },
+        "models.CreatePipelineResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.CreateSourceConnectorRequestAPI": {
+            "type": "object",
+            "required": [
+                "connectionConfiguration",
+                "name",
+                "sourceDefinitionId"
+            ],
+            "properties": {
+                "connectionConfiguration": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "example_source_name"
+                },
+                "oauthFlowId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "pipelineId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "sourceDefinitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                }
+            }
+        },
+        "models.CreateSourceConnectorResponseAPI": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.CreateSourceConnectorResposneData"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.CreateSourceConnectorResposneData": {
+            "type": "object",
+            "properties": {
+                "connectionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "pipelineId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "sourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "sourceName": {
+                    "type": "string",
+                    "example": "example_source"
+                }
+            }
+        },
+        "models.CustomConnectorRequest": {
+            "type": "object",
+            "required": [
+                "dockerImageTag",
+                "dockerRepository",
+                "documentationUrl",
+                "name"
+            ],
+            "properties": {
+                "dockerImageTag": {
+                    "type": "string",
+                    "example": "1.0.0"
+                },
+                "dockerRepository": {
+                    "type": "string",
+                    "example": "registry.example.com/source-billing"
+                },
+                "documentationUrl": {
+                    "type": "string",
+                    "example": "https://wiki.example.com/connectors/billing"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Billing API"
+                }
+            }
+        },
+        "models.CustomConnectorUpgrade": {
+            "type": "object",
+            "properties": {
+                "airbyteDefinitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "incompatible": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IncompatibleConnector"
+                    }
+                },
+                "previousVersion": {
+                    "type": "string",
+                    "example": "1.0.0"
+                },
+                "upgraded": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.1.0"
+                }
+            }
+        },
+        "models.CustomConnectorUpgradeRequest": {
+            "type": "object",
+            "required": [
+                "dockerImageTag"
+            ],
+            "properties": {
+                "dockerImageTag": {
+                    "type": "string",
+                    "example": "1.1.0"
+                }
+            }
+        },
+        "models.CustomConnectorUpgradeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.CustomConnectorUpgrade"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.CustomDestinationResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.SupportedDestinations"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.CustomSourceResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.SupportedSources"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.DataProduct": {
+            "type": "object",
+            "properties": {
+                "dataDomain": {
+                    "type": "string",
+                    "example": "data-product-1"
+                },
+                "dataProductGovernance": {
+                    "type": "string",
+                    "example": "[sales, marketing]"
+                },
+                "dataProductStatus": {
+                    "type": "string",
+                    "example": "draft"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "This data product will help you in creating better visualizations"
+                },
+                "lastUpdated": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "data-product-1"
+                },
+                "owner": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "productID": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.DataProductListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GetAllDataProductsView"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.DataProductResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.DataProduct"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.DataProductView": {
+            "type": "object",
+            "properties": {
+                "dataDomain": {
+                    "type": "string",
+                    "example": "data-product-1"
+                },
+                "dataProductGovernance": {
+                    "type": "string",
+                    "example": "[sales, marketing]"
+                },
+                "dataProductStatus": {
+                    "type": "string",
+                    "example": "completed"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "This data product will help you in creating better visualizations"
+                },
+                "lastUpdated": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "data-product-1"
+                },
+                "pipelines": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": true
+                    }
+                },
+                "productID": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.Dbt": {
+            "type": "object",
+            "properties": {
+                "dbtArguments": {
+                    "type": "string"
+                },
+                "dockerImage": {
+                    "type": "string"
+                },
+                "gitRepoBranch": {
+                    "type": "string"
+                },
+                "gitRepoUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DbtManifest": {
+            "type": "object",
+            "properties": {
+                "models": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "orders",
+                        "customers"
+                    ]
+                },
+                "productId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "uploadedAt": {
+                    "type": "integer",
+                    "example": 1660000000000
+                },
+                "uploadedBy": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.DbtManifestResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.DbtManifest"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.DestinationSpecification": {
+            "type": "object",
+            "properties": {
+                "advancedAuth": {
+                    "type": "object"
+                },
+                "authSpecification": {
+                    "type": "object"
+                },
+                "connectionSpecification": {
+                    "type": "object"
+                },
+                "destinationDefinitionId": {
+                    "type": "string"
+                },
+                "documentationUrl": {
+                    "type": "string"
+                },
+                "jobInfo": {
+                    "type": "object"
+                },
+                "supportedDestinationSyncModes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "supportsDbt": {
+                    "type": "boolean"
+                },
+                "supportsNormalization": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.DestinationSummaryResponse": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "destinationName",
+                "owner"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "integer"
+                },
+                "destinationName": {
+                    "type": "string",
+                    "example": "example_destination"
+                },
+                "owner": {
+                    "type": "object"
+                }
+            }
+        },
+        "models.EditAssetColumnRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Email address of the user"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "contact",
+                        "pii"
+                    ]
+                }
+            }
+        },
+        "models.EditDestinationConnectorRequest": {
+            "type": "object",
+            "required": [
+                "connectionConfiguration"
+            ],
+            "properties": {
+                "connectionConfiguration": {
+                    "type": "string"
+                },
+                "destinationName": {
+                    "type": "string",
+                    "example": "example_destination_name"
+                }
+            }
+        },
+        "models.EditSourceConnectorRequest": {
+            "type": "object",
+            "required": [
+                "connectionConfiguration"
+            ],
+            "properties": {
+                "connectionConfiguration": {
+                    "type": "object"
+                }
+            }
+        },
+        "models.EmailTemplate": {
+            "type": "object",
+            "required": [
+                "body",
+                "subject",
+                "to_email",
+                "to_name"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "to_email": {
+                    "type": "string"
+                },
+                "to_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EnableAssetsInternalRequest": {
+            "type": "object",
+            "properties": {
+                "connectionIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.FileColumn": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "order_id"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "integer"
+                }
+            }
+        },
+        "models.FilePreview": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FileColumn"
+                    }
+                },
+                "numRows": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": true
+                    }
+                }
+            }
+        },
+        "models.FileSourceUpload": {
+            "type": "object",
+            "properties": {
+                "failedPipelines": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "format": {
+                    "type": "string",
+                    "example": "csv"
+                },
+                "preview": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.FilePreview"
+                },
+                "sourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "sourceName": {
+                    "type": "string",
+                    "example": "orders"
+                },
+                "syncedPipelines": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "uploadId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.FileSourceUploadResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.FileSourceUpload"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.FileUpload": {
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "createdBy": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "fileName": {
+                    "type": "string",
+                    "example": "orders.csv"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "csv"
+                },
+                "preview": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 20480
+                },
+                "sourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "uploadId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.FileUploadsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FileUpload"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.FreshnessReport": {
+            "type": "object",
+            "properties": {
+                "breached": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "evaluated": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "recovered": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.FreshnessReportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.FreshnessReport"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.FreshnessSLA": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "integer",
+                    "example": 1660000000000
+                },
+                "createdBy": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "evaluatedAt": {
+                    "type": "integer",
+                    "example": 1660000000000
+                },
+                "lastUpdatedAt": {
+                    "type": "integer",
+                    "example": 1660000000000
+                },
+                "maxStalenessMinutes": {
+                    "type": "integer",
+                    "example": 360
+                },
+                "productId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "stalenessMinutes": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "status": {
+                    "type": "string",
+                    "example": "met"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.FreshnessSLAEvent": {
+            "type": "object",
+            "properties": {
+                "eventId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "lastUpdatedAt": {
+                    "type": "integer",
+                    "example": 1660000000000
+                },
+                "maxStalenessMinutes": {
+                    "type": "integer",
+                    "example": 360
+                },
+                "occurredAt": {
+                    "type": "integer",
+                    "example": 1660000000000
+                },
+                "productId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "stalenessMinutes": {
+                    "type": "integer",
+                    "example": 412
+                },
+                "type": {
+                    "type": "string",
+                    "example": "breached"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.FreshnessSLAEventsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FreshnessSLAEvent"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.FreshnessSLARequest": {
+            "type": "object",
+            "required": [
+                "maxStalenessMinutes"
+            ],
+            "properties": {
+                "maxStalenessMinutes": {
+                    "type": "integer",
+                    "example": 360
+                }
+            }
+        },
+        "models.FreshnessSLAResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.FreshnessSLA"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.GetAllDataProductsView": {
+            "type": "object",
+            "properties": {
+                "dataDomain": {
+                    "type": "string",
+                    "example": "data-product-1"
+                },
+                "dataProductGovernance": {
+                    "type": "string",
+                    "example": "[sales, marketing]"
+                },
+                "dataProductStatus": {
+                    "type": "string",
+                    "example": "draft"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "This data product will help you in creating better visualizations"
+                },
+                "lastUpdated": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "data-product-1"
+                },
+                "owner": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pipelineCount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "productID": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.GetDataProductView": {
+            "type": "object",
+            "properties": {
+                "dataProduct": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.DataProductView"
+                },
+                "owner": {
+                    "type": "object"
+                },
+                "quality": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.QualityCheckResult"
+                    }
+                }
+            }
+        },
+        "models.GetDataProductViewResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.GetDataProductView"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.GetPipelineDetails": {
+            "type": "object",
+            "properties": {
+                "owner": {
+                    "type": "object"
+                },
+                "pipeline": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.PipelineView"
+                },
+                "quality": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.QualityCheckResult"
+                    }
+                }
+            }
+        },
+        "models.GetPipelineDetailsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.GetPipelineDetails"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.GetPipelineSourceAndConnectionID": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.PipelineSourceAndConnectionID"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.IncompatibleConnector": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ConfigFieldError"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "billing"
+                }
+            }
+        },
+        "models.InputProductsPipelines": {
+            "type": "object",
+            "properties": {
+                "pipelines": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"a152379e-01a1-11ec-82d6-a312edcd9c7b\"",
+                        " \"a152379e-01a1-11ec-82d6-a312edcd9c7b\"]"
+                    ]
+                }
+            }
+        },
+        "models.Job": {
+            "type": "object",
+            "properties": {
+                "configId": {
+                    "type": "string"
+                },
+                "configType": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.JobLogs": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "logs": {
+                                "type": "object",
+                                "properties": {
+                                    "logLines": {
+                                        "type": "array",
+                                        "items": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "models.LineageEdge": {
+            "type": "object",
+            "properties": {
+                "from": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "table:b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "airbyteDestinationId": {
+                "jobId": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "destinationId": {
+                "origin": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "pipeline"
                 },
-                "destinationName": {
+                "to": {
                     "type": "string",
-                    "example": "example_destination"
+                    "example": "table:a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                }
+            }
+        },
+        "models.LineageGraph": {
+            "type": "object",
+            "properties": {
+                "direction": {
+                    "type": "string",
+                    "example": "both"
                 },
-                "destinationType": {
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LineageEdge"
+                    }
+                },
+                "granularity": {
                     "type": "string",
-                    "example": "example_destination"
+                    "example": "table"
                 },
-                "host": {
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LineageNode"
+                    }
+                },
+                "root": {
+                    "type": "string",
+                    "example": "table:b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                }
+            }
+        },
+        "models.LineageGraphResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.LineageGraph"
+                },
+                "errors": {
                     "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
                 }
             }
         },
-        "models.ConfiguredDestinationResponse": {
+        "models.LineageNode": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "column": {
+                    "type": "string",
+                    "example": "email"
+                },
+                "dataType": {
+                    "type": "string",
+                    "example": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "table:b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "users"
+                },
+                "namespace": {
+                    "type": "string",
+                    "example": "postgres://localhost:5432/warehouse"
+                },
+                "parentId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "schema": {
+                    "type": "string",
+                    "example": "sales"
+                },
+                "table": {
+                    "type": "string",
+                    "example": "_airbyte_raw_users"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "table"
+                }
+            }
+        },
+        "models.ManualConnectionSyncResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "job": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.Job"
+                }
+            }
+        },
+        "models.MaskingPoliciesResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ConfiguredDestination"
+                        "$ref": "#/definitions/models.MaskingPolicy"
                     }
                 },
                 "errors": {
@@ -2275,35 +8804,48 @@ var doc = `{
                 }
             }
         },
-        "models.ConfiguredSource": {
+        "models.MaskingPolicy": {
             "type": "object",
+            "required": [
+                "classification",
+                "method"
+            ],
             "properties": {
-                "connectionConfiguration": {
-                    "type": "object"
+                "classification": {
+                    "type": "string",
+                    "example": "email"
                 },
-                "name": {
-                    "type": "string"
+                "createdAt": {
+                    "type": "integer"
                 },
-                "sourceDefinitionId": {
-                    "type": "string"
+                "createdBy": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "sourceId": {
-                    "type": "string"
+                "exemptRoles": {
+                    "type": "string",
+                    "example": "[admin]"
                 },
-                "sourceName": {
-                    "type": "string"
+                "method": {
+                    "type": "string",
+                    "example": "partial"
+                },
+                "policyId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
                 "workspaceId": {
-                    "type": "string"
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "models.ConfiguredSourceResponse": {
+        "models.MaskingPolicyResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "object",
-                    "$ref": "#/definitions/models.ConfiguredSource"
+                    "$ref": "#/definitions/models.MaskingPolicy"
                 },
                 "errors": {
                     "type": "string"
@@ -2314,43 +8856,103 @@ var doc = `{
                 }
             }
         },
-        "models.Connection": {
+        "models.Normalization": {
             "type": "object",
             "properties": {
-                "airbyteConnectionId": {
+                "option": {
                     "type": "string"
+                }
+            }
+        },
+        "models.OAuthConsent": {
+            "type": "object",
+            "properties": {
+                "consentUrl": {
+                    "type": "string",
+                    "example": "https://accounts.google.com/o/oauth2/v2/auth?client_id=...\u0026state=..."
                 },
-                "airbyteFrequencyUnits": {
-                    "type": "integer"
+                "flowId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                }
+            }
+        },
+        "models.OAuthConsentRequest": {
+            "type": "object",
+            "required": [
+                "airbyteSourceDefinitionId",
+                "redirectUrl"
+            ],
+            "properties": {
+                "airbyteSourceDefinitionId": {
+                    "type": "string",
+                    "example": "71607ba1-c0ac-4799-8049-7f4b90dd50f7"
                 },
-                "airbyteLastRun": {
-                    "type": "integer"
+                "oAuthInputConfiguration": {
+                    "type": "object",
+                    "additionalProperties": true
                 },
-                "airbyteTimeUnit": {
-                    "type": "string"
+                "redirectUrl": {
+                    "type": "string",
+                    "example": "https://app.example.com/sources/oauth"
                 },
-                "connectionId": {
+                "sourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                }
+            }
+        },
+        "models.OAuthConsentResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.OAuthConsent"
+                },
+                "errors": {
                     "type": "string"
                 },
-                "createdAt": {
-                    "type": "integer"
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.OAuthFlow": {
+            "type": "object",
+            "properties": {
+                "airbyteSourceDefinitionId": {
+                    "type": "string",
+                    "example": "71607ba1-c0ac-4799-8049-7f4b90dd50f7"
                 },
-                "firstSucceededRunAt": {
+                "completedAt": {
                     "type": "integer"
                 },
-                "isFirstRun": {
-                    "type": "boolean",
-                    "example": false
+                "createdAt": {
+                    "type": "integer"
                 },
-                "owner": {
+                "createdBy": {
                     "type": "integer",
                     "example": 1
                 },
-                "pipelineId": {
+                "error": {
                     "type": "string"
                 },
+                "flowId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "redirectUrl": {
+                    "type": "string",
+                    "example": "https://app.example.com/sources/oauth"
+                },
+                "sourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
                 "status": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "pending"
                 },
                 "workspaceId": {
                     "type": "integer",
@@ -2358,262 +8960,317 @@ var doc = `{
                 }
             }
         },
-        "models.ConnectionSourceSchema": {
+        "models.OAuthFlowResponse": {
             "type": "object",
             "properties": {
-                "connectionId": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.OAuthFlow"
+                },
+                "errors": {
                     "type": "string"
                 },
-                "destination": {
-                    "type": "object",
-                    "properties": {
-                        "connectionConfiguration": {
-                            "type": "object",
-                            "properties": {
-                                "user": {
-                                    "type": "string"
-                                }
-                            }
-                        },
-                        "destinationDefinitionId": {
-                            "type": "string"
-                        },
-                        "destinationId": {
-                            "type": "string"
-                        },
-                        "destinationName": {
-                            "type": "string"
-                        },
-                        "name": {
-                            "type": "string"
-                        },
-                        "workspaceId": {
-                            "type": "string"
-                        }
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.OAuthTokenCheckReport": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "failed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "models.OAuthTokenCheckReportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.OAuthTokenCheckReport"
                 },
-                "destinationId": {
+                "errors": {
                     "type": "string"
                 },
-                "isSyncing": {
-                    "type": "boolean"
-                },
-                "latestSyncJobCreatedAt": {
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.OAuthTokenFailure": {
+            "type": "object",
+            "properties": {
+                "detectedAt": {
                     "type": "integer"
                 },
-                "latestSyncJobStatus": {
-                    "type": "string"
+                "error": {
+                    "type": "string",
+                    "example": "invalid_grant: Token has been expired or revoked."
                 },
-                "name": {
-                    "type": "string"
+                "failureId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "namespaceDefinition": {
-                    "type": "string"
+                "resolvedAt": {
+                    "type": "integer"
                 },
-                "namespaceFormat": {
-                    "type": "string"
+                "sourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "operationIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "sourceName": {
+                    "type": "string",
+                    "example": "google-sheets"
                 },
-                "operations": {
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.OAuthTokenFailuresResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Operations"
+                        "$ref": "#/definitions/models.OAuthTokenFailure"
                     }
                 },
-                "prefix": {
+                "errors": {
                     "type": "string"
                 },
-                "resourceRequirements": {
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.OpenLineageDataset": {
+            "type": "object",
+            "properties": {
+                "facets": {
                     "type": "object",
-                    "$ref": "#/definitions/models.ResourceRequirements"
+                    "additionalProperties": true
                 },
-                "schedule": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.Schedule"
+                "name": {
+                    "type": "string",
+                    "example": "sales.users"
                 },
-                "source": {
-                    "type": "object",
-                    "properties": {
-                        "connectionConfiguration": {
-                            "type": "object",
-                            "properties": {
-                                "user": {
-                                    "type": "string"
-                                }
-                            }
-                        },
-                        "name": {
-                            "type": "string"
-                        },
-                        "sourceDefinitionId": {
-                            "type": "string"
-                        },
-                        "sourceId": {
-                            "type": "string"
-                        },
-                        "sourceName": {
-                            "type": "string"
-                        },
-                        "workspaceId": {
-                            "type": "string"
-                        }
+                "namespace": {
+                    "type": "string",
+                    "example": "postgres://localhost:5432/warehouse"
+                }
+            }
+        },
+        "models.OpenLineageEventsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OpenLineageRunEvent"
                     }
                 },
-                "sourceId": {
+                "errors": {
                     "type": "string"
                 },
                 "status": {
-                    "type": "string"
-                },
-                "syncCatalog": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.SyncCatalog"
+                    "type": "string",
+                    "example": "success"
                 }
             }
         },
-        "models.ConnectionSummaryAirByte": {
+        "models.OpenLineageJob": {
             "type": "object",
             "properties": {
-                "schedule": {
-                    "type": "object",
-                    "properties": {
-                        "timeUnit": {
-                            "type": "string"
-                        },
-                        "units": {
-                            "type": "integer"
-                        }
-                    }
+                "name": {
+                    "type": "string",
+                    "example": "pipeline.b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "status": {
-                    "type": "string"
+                "namespace": {
+                    "type": "string",
+                    "example": "pipelineService"
                 }
             }
         },
-        "models.ConnectionSummaryResponse": {
+        "models.OpenLineageRun": {
             "type": "object",
             "properties": {
-                "airByteSummary": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.ConnectionSummaryAirByte"
-                },
-                "configurationDate": {
-                    "type": "integer"
-                },
-                "configuredBy": {
-                    "type": "object"
-                },
-                "sourceName": {
-                    "type": "string"
+                "runId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 }
             }
         },
-        "models.CreateDestinationConnectorRequestAPI": {
+        "models.OpenLineageRunEvent": {
             "type": "object",
-            "required": [
-                "connectionConfiguration",
-                "destinationDefinitionId",
-                "destinationName",
-                "name"
-            ],
             "properties": {
-                "connectionConfiguration": {
-                    "type": "string"
+                "eventTime": {
+                    "type": "string",
+                    "example": "2022-08-08T22:13:20Z"
                 },
-                "destinationDefinitionId": {
+                "eventType": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "COMPLETE"
                 },
-                "destinationName": {
+                "inputs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OpenLineageDataset"
+                    }
+                },
+                "job": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.OpenLineageJob"
+                },
+                "outputs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OpenLineageDataset"
+                    }
+                },
+                "producer": {
                     "type": "string",
-                    "example": "Postgres"
+                    "example": "pipelineService"
                 },
-                "name": {
+                "run": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.OpenLineageRun"
+                },
+                "schemaURL": {
                     "type": "string",
-                    "example": "example_destination_name"
+                    "example": "https://openlineage.io/spec/1-0-5/OpenLineage.json#/definitions/RunEvent"
                 }
             }
         },
-        "models.CreateDestinationConnectorResponseAPI": {
+        "models.Operations": {
             "type": "object",
             "properties": {
-                "data": {
+                "name": {
+                    "type": "string"
+                },
+                "operatorConfiguration": {
                     "type": "object",
-                    "$ref": "#/definitions/models.CreateDestinationConnectorResponseData"
+                    "$ref": "#/definitions/models.OperatorConfiguration"
                 },
-                "errors": {
+                "workspaceId": {
                     "type": "string"
+                }
+            }
+        },
+        "models.OperatorConfiguration": {
+            "type": "object",
+            "properties": {
+                "dbt": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.Dbt"
                 },
-                "status": {
-                    "type": "string",
-                    "example": "success"
+                "normalization": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.Normalization"
+                },
+                "operatorType": {
+                    "type": "string"
                 }
             }
         },
-        "models.CreateDestinationConnectorResponseData": {
+        "models.Pipeline": {
             "type": "object",
+            "required": [
+                "name",
+                "pipelineGovernance"
+            ],
             "properties": {
-                "destinationId": {
+                "createdAt": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "pipeline-1"
                 },
-                "destinationName": {
+                "owner": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pipelineGovernance": {
                     "type": "string",
-                    "example": "Postgres"
+                    "example": "[sales, marketing]"
                 },
-                "name": {
+                "pipelineID": {
                     "type": "string",
-                    "example": "example_destination"
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "models.CreatePipelineRequest": {
+        "models.PipelineAssets": {
             "type": "object",
-            "required": [
-                "destinationId",
-                "prefix",
-                "sourceId",
-                "syncCatalog"
-            ],
             "properties": {
+                "assetID": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "columns": {
+                    "type": "string",
+                    "example": "{}"
+                },
                 "destinationId": {
+                    "description": "DestinationID is the destination holding the asset, the assets of a pipeline with a single destination have none",
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "operations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Operations"
-                    }
+                "isEnabled": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "prefix": {
+                "name": {
                     "type": "string",
-                    "example": "t1"
+                    "example": "schema"
                 },
-                "schedule": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.Schedule"
+                "owner": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "sourceId": {
+                "pipelineID": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "syncCatalog": {
+                "pipelineSchemaID": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "profile": {
                     "type": "object",
-                    "$ref": "#/definitions/models.SyncCatalog"
+                    "$ref": "#/definitions/models.AssetProfile"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "models.CreatePipelineResponse": {
+        "models.PipelineAssetsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "string"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PipelineAssets"
+                    }
                 },
                 "errors": {
                     "type": "string"
@@ -2624,38 +9281,46 @@ var doc = `{
                 }
             }
         },
-        "models.CreateSourceConnectorRequestAPI": {
+        "models.PipelineDestination": {
             "type": "object",
-            "required": [
-                "connectionConfiguration",
-                "name",
-                "pipelineId",
-                "sourceDefinitionId"
-            ],
             "properties": {
-                "connectionConfiguration": {
-                    "type": "object"
+                "airbyteConnectionId": {
+                    "type": "string"
                 },
-                "name": {
+                "airbyteLastRun": {
+                    "type": "integer",
+                    "example": 1645517210
+                },
+                "airbyteStatus": {
                     "type": "string",
-                    "example": "example_source_name"
+                    "example": "succeeded"
                 },
-                "pipelineId": {
+                "connectionId": {
                     "type": "string",
                     "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "sourceDefinitionId": {
+                "destinationId": {
                     "type": "string",
                     "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "destinationName": {
+                    "type": "string",
+                    "example": "redshift"
+                },
+                "destinationType": {
+                    "type": "string",
+                    "example": "postgres"
                 }
             }
         },
-        "models.CreateSourceConnectorResponseAPI": {
+        "models.PipelineMetaDataResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.CreateSourceConnectorResposneData"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PipelinesMetaData"
+                    }
                 },
                 "errors": {
                     "type": "string"
@@ -2666,74 +9331,72 @@ var doc = `{
                 }
             }
         },
-        "models.CreateSourceConnectorResposneData": {
+        "models.PipelineOperation": {
             "type": "object",
             "properties": {
                 "connectionId": {
                     "type": "string",
                     "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "pipelineId": {
-                    "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
-                },
-                "sourceId": {
-                    "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
-                },
-                "sourceName": {
-                    "type": "string",
-                    "example": "example_source"
-                }
-            }
-        },
-        "models.DataProduct": {
-            "type": "object",
-            "properties": {
-                "dataDomain": {
-                    "type": "string",
-                    "example": "data-product-1"
+                "createdAt": {
+                    "type": "integer"
                 },
-                "dataProductGovernance": {
-                    "type": "string",
-                    "example": "[sales, marketing]"
+                "jobId": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "dataProductStatus": {
+                "jobStatus": {
                     "type": "string",
-                    "example": "draft"
+                    "example": "running"
                 },
-                "description": {
+                "operation": {
                     "type": "string",
-                    "example": "This data product will help you in creating better visualizations"
-                },
-                "lastUpdated": {
-                    "type": "integer"
+                    "example": "reset"
                 },
-                "name": {
+                "operationId": {
                     "type": "string",
-                    "example": "data-product-1"
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
                 "owner": {
                     "type": "integer",
                     "example": 1
                 },
-                "productID": {
+                "pipelineId": {
                     "type": "string",
                     "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
+                "streams": {
+                    "type": "string",
+                    "example": "[public.users]"
+                },
                 "workspaceId": {
                     "type": "integer",
                     "example": 1
                 }
             }
         },
-        "models.DataProductListResponse": {
+        "models.PipelineOperationRequest": {
+            "type": "object",
+            "properties": {
+                "confirm": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "streams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StreamDescriptor"
+                    }
+                }
+            }
+        },
+        "models.PipelineOperationsResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.GetAllDataProductsView"
+                        "$ref": "#/definitions/models.PipelineOperation"
                     }
                 },
                 "errors": {
@@ -2745,12 +9408,12 @@ var doc = `{
                 }
             }
         },
-        "models.DataProductResponse": {
+        "models.PipelineResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "object",
-                    "$ref": "#/definitions/models.DataProduct"
+                    "$ref": "#/definitions/models.Pipeline"
                 },
                 "errors": {
                     "type": "string"
@@ -2761,203 +9424,224 @@ var doc = `{
                 }
             }
         },
-        "models.DataProductView": {
+        "models.PipelineSchemas": {
             "type": "object",
             "properties": {
-                "dataDomain": {
-                    "type": "string",
-                    "example": "data-product-1"
-                },
-                "dataProductGovernance": {
-                    "type": "string",
-                    "example": "[sales, marketing]"
-                },
-                "dataProductStatus": {
+                "name": {
                     "type": "string",
-                    "example": "completed"
+                    "example": "schema"
                 },
-                "description": {
+                "pipelineID": {
                     "type": "string",
-                    "example": "This data product will help you in creating better visualizations"
-                },
-                "lastUpdated": {
-                    "type": "integer"
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "name": {
+                "prefix": {
                     "type": "string",
-                    "example": "data-product-1"
-                },
-                "pipelines": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "additionalProperties": true
-                    }
+                    "example": "schema"
                 },
-                "productID": {
+                "schemaID": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
-                },
-                "workspaceId": {
-                    "type": "integer",
-                    "example": 1
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 }
             }
         },
-        "models.Dbt": {
+        "models.PipelineSourceAndConnectionID": {
             "type": "object",
             "properties": {
-                "dbtArguments": {
+                "airbyteConnectionId": {
                     "type": "string"
                 },
-                "dockerImage": {
-                    "type": "string"
+                "airbyteConnectionIds": {
+                    "description": "AirByteConnectionIDs holds the airbyte connection of every destination of the pipeline",
+                    "type": "string",
+                    "example": "[b251379e-01a1-11ec-82d6-a312edcd9c7b]"
                 },
-                "gitRepoBranch": {
-                    "type": "string"
+                "connectionId": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "gitRepoUrl": {
-                    "type": "string"
+                "pipelineID": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "sourceID": {
+                    "type": "string",
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 }
             }
         },
-        "models.DestinationSpecification": {
+        "models.PipelineView": {
             "type": "object",
+            "required": [
+                "destinationId",
+                "name",
+                "pipelineGovernance"
+            ],
             "properties": {
-                "advancedAuth": {
-                    "type": "object"
+                "airbyteConnectionId": {
+                    "type": "string"
                 },
-                "authSpecification": {
-                    "type": "object"
+                "airbyteLastRun": {
+                    "type": "integer",
+                    "example": 1645517210
                 },
-                "connectionSpecification": {
-                    "type": "object"
+                "airbyteStatus": {
+                    "type": "string",
+                    "example": "Inactive"
                 },
-                "destinationDefinitionId": {
-                    "type": "string"
+                "connectionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "documentationUrl": {
-                    "type": "string"
+                "createdAt": {
+                    "type": "integer"
                 },
-                "jobInfo": {
-                    "type": "object"
+                "dataProduct": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": true
+                    }
                 },
-                "supportedDestinationSyncModes": {
+                "destinationId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "destinationName": {
+                    "type": "string",
+                    "example": "redshift"
+                },
+                "destinations": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.PipelineDestination"
                     }
                 },
-                "supportsDbt": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string",
+                    "example": "pipeline-1"
+                },
+                "pipelineGovernance": {
+                    "type": "string",
+                    "example": "[sales, marketing]"
+                },
+                "pipelineID": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "sourceID": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "supportsNormalization": {
-                    "type": "boolean"
+                "sourceName": {
+                    "type": "string",
+                    "example": "postgres"
                 }
             }
         },
-        "models.DestinationSummaryResponse": {
+        "models.PreviewColumn": {
             "type": "object",
-            "required": [
-                "createdAt",
-                "destinationName",
-                "owner"
-            ],
             "properties": {
-                "createdAt": {
-                    "type": "integer"
-                },
-                "destinationName": {
+                "name": {
                     "type": "string",
-                    "example": "example_destination"
+                    "example": "email"
                 },
-                "owner": {
-                    "type": "object"
+                "type": {
+                    "type": "string",
+                    "example": "varchar"
                 }
             }
         },
-        "models.EditSourceConnectorRequest": {
+        "models.PreviewResponse": {
             "type": "object",
-            "required": [
-                "connectionConfiguration"
-            ],
             "properties": {
-                "connectionConfiguration": {
-                    "type": "object"
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.PreviewResult"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
                 }
             }
         },
-        "models.EmailTemplate": {
+        "models.PreviewResult": {
             "type": "object",
-            "required": [
-                "body",
-                "subject",
-                "to_email",
-                "to_name"
-            ],
             "properties": {
-                "body": {
-                    "type": "string"
-                },
-                "subject": {
-                    "type": "string"
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PreviewColumn"
+                    }
                 },
-                "to_email": {
+                "nextCursor": {
                     "type": "string"
                 },
-                "to_name": {
-                    "type": "string"
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": true
+                    }
                 }
             }
         },
-        "models.EnableAssetsInternalRequest": {
+        "models.ProductAssetDetails": {
             "type": "object",
             "properties": {
-                "connectionIDs": {
+                "owner": {
+                    "type": "integer"
+                },
+                "productID": {
+                    "type": "string"
+                },
+                "schemaName": {
+                    "type": "string"
+                },
+                "tableNames": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
+                },
+                "workspaceId": {
+                    "type": "integer"
                 }
             }
         },
-        "models.GetAllDataProductsView": {
+        "models.ProductAssets": {
             "type": "object",
             "properties": {
-                "dataDomain": {
-                    "type": "string",
-                    "example": "data-product-1"
-                },
-                "dataProductGovernance": {
-                    "type": "string",
-                    "example": "[sales, marketing]"
-                },
-                "dataProductStatus": {
+                "assetID": {
                     "type": "string",
-                    "example": "draft"
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "description": {
+                "columns": {
                     "type": "string",
-                    "example": "This data product will help you in creating better visualizations"
+                    "example": "{}"
                 },
-                "lastUpdated": {
-                    "type": "integer"
+                "isEnabled": {
+                    "type": "boolean",
+                    "example": false
                 },
                 "name": {
                     "type": "string",
-                    "example": "data-product-1"
+                    "example": "schema"
                 },
                 "owner": {
                     "type": "integer",
                     "example": 1
                 },
-                "pipelineCount": {
-                    "type": "integer",
-                    "example": 0
-                },
                 "productID": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "profile": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.AssetProfile"
                 },
                 "workspaceId": {
                     "type": "integer",
@@ -2965,53 +9649,12 @@ var doc = `{
                 }
             }
         },
-        "models.GetDataProductView": {
-            "type": "object",
-            "properties": {
-                "dataProduct": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.DataProductView"
-                },
-                "owner": {
-                    "type": "object"
-                }
-            }
-        },
-        "models.GetDataProductViewResponse": {
+        "models.ProductsPipelinesResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.GetDataProductView"
-                },
-                "errors": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string",
-                    "example": "success"
-                }
-            }
-        },
-        "models.GetPipelineDetails": {
-            "type": "object",
-            "properties": {
-                "owner": {
-                    "type": "object"
-                },
-                "pipeline": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.PipelineView"
-                }
-            }
-        },
-        "models.GetPipelineDetailsResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.GetPipelineDetails"
-                },
                 "errors": {
                     "type": "string"
                 },
@@ -3021,199 +9664,218 @@ var doc = `{
                 }
             }
         },
-        "models.GetPipelineSourceAndConnectionID": {
+        "models.PurgeFailure": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.PipelineSourceAndConnectionID"
+                "error": {
+                    "type": "string",
+                    "example": "failed to delete the source on airbyte"
                 },
-                "errors": {
-                    "type": "string"
+                "resourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "status": {
-                    "type": "string"
+                "resourceType": {
+                    "type": "string",
+                    "example": "source"
                 }
             }
         },
-        "models.InputProductsPipelines": {
+        "models.PurgeReport": {
             "type": "object",
             "properties": {
-                "pipelines": {
+                "dataProducts": {
                     "type": "array",
                     "items": {
                         "type": "string"
-                    },
-                    "example": [
-                        "[\"a152379e-01a1-11ec-82d6-a312edcd9c7b\"",
-                        " \"a152379e-01a1-11ec-82d6-a312edcd9c7b\"]"
-                    ]
-                }
-            }
-        },
-        "models.Job": {
-            "type": "object",
-            "properties": {
-                "configId": {
-                    "type": "string"
-                },
-                "configType": {
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "status": {
-                    "type": "string"
+                    }
                 },
-                "updatedAt": {
-                    "type": "integer"
-                }
-            }
-        },
-        "models.JobLogs": {
-            "type": "object",
-            "properties": {
-                "attempts": {
+                "destinations": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "logs": {
-                                "type": "object",
-                                "properties": {
-                                    "logLines": {
-                                        "type": "array",
-                                        "items": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            }
-                        }
+                        "type": "string"
                     }
-                }
-            }
-        },
-        "models.ManualConnectionSyncResponse": {
-            "type": "object",
-            "properties": {
-                "attempts": {
+                },
+                "failures": {
                     "type": "array",
                     "items": {
-                        "type": "object"
+                        "$ref": "#/definitions/models.PurgeFailure"
                     }
                 },
-                "job": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.Job"
-                }
-            }
-        },
-        "models.Normalization": {
-            "type": "object",
-            "properties": {
-                "option": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.Operations": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "operatorConfiguration": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.OperatorConfiguration"
+                "pipelines": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "workspaceId": {
-                    "type": "string"
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "models.OperatorConfiguration": {
+        "models.PurgeReportResponse": {
             "type": "object",
             "properties": {
-                "dbt": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.Dbt"
-                },
-                "normalization": {
+                "data": {
                     "type": "object",
-                    "$ref": "#/definitions/models.Normalization"
+                    "$ref": "#/definitions/models.PurgeReport"
                 },
-                "operatorType": {
+                "errors": {
                     "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
                 }
             }
         },
-        "models.Pipeline": {
+        "models.QualityCheckResult": {
             "type": "object",
-            "required": [
-                "name",
-                "pipelineGovernance"
-            ],
             "properties": {
-                "createdAt": {
-                    "type": "integer"
+                "assetId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "name": {
+                "check": {
                     "type": "string",
-                    "example": "pipeline-1"
+                    "example": "not_null"
                 },
-                "owner": {
+                "checkedAt": {
                     "type": "integer",
-                    "example": 1
+                    "example": 1660000000000
                 },
-                "pipelineGovernance": {
+                "column": {
                     "type": "string",
-                    "example": "[sales, marketing]"
+                    "example": "email"
                 },
-                "pipelineID": {
+                "error": {
+                    "type": "string"
+                },
+                "failedRows": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "observed": {
+                    "type": "string",
+                    "example": "12 of 1000 rows failed"
+                },
+                "passed": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "resultId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "rowCount": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "ruleId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "runId": {
                     "type": "string",
                     "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
+                "severity": {
+                    "type": "string",
+                    "example": "error"
+                },
                 "workspaceId": {
                     "type": "integer",
                     "example": 1
                 }
             }
         },
-        "models.PipelineAssets": {
+        "models.QualityCheckResultsResponse": {
             "type": "object",
             "properties": {
-                "assetID": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.QualityCheckResult"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string",
-                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "success"
+                }
+            }
+        },
+        "models.QualityRule": {
+            "type": "object",
+            "required": [
+                "assetId",
+                "check"
+            ],
+            "properties": {
+                "assetId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "columns": {
+                "assetName": {
                     "type": "string",
-                    "example": "{}"
+                    "example": "users"
                 },
-                "isEnabled": {
-                    "type": "boolean",
-                    "example": false
+                "check": {
+                    "type": "string",
+                    "example": "not_null"
                 },
-                "name": {
+                "column": {
                     "type": "string",
-                    "example": "schema"
+                    "example": "email"
                 },
-                "owner": {
+                "createdAt": {
+                    "type": "integer"
+                },
+                "createdBy": {
                     "type": "integer",
                     "example": 1
                 },
-                "pipelineID": {
+                "max": {
+                    "type": "number",
+                    "example": 100
+                },
+                "maxAgeMinutes": {
+                    "type": "integer",
+                    "example": 1440
+                },
+                "maxDeltaPercent": {
+                    "type": "number",
+                    "example": 20
+                },
+                "min": {
+                    "type": "number",
+                    "example": 0
+                },
+                "parentId": {
+                    "description": "ParentID and AssetName identify the asset across the syncs recreating it, the rule is checked on its latest asset",
                     "type": "string",
-                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "pipelineSchemaID": {
+                "pattern": {
                     "type": "string",
-                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "^[A-Z]{2}$"
+                },
+                "ruleId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "severity": {
+                    "type": "string",
+                    "example": "error"
+                },
+                "values": {
+                    "type": "string",
+                    "example": "[active, inactive]"
                 },
                 "workspaceId": {
                     "type": "integer",
@@ -3221,14 +9883,12 @@ var doc = `{
                 }
             }
         },
-        "models.PipelineAssetsResponse": {
+        "models.QualityRuleResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PipelineAssets"
-                    }
+                    "type": "object",
+                    "$ref": "#/definitions/models.QualityRule"
                 },
                 "errors": {
                     "type": "string"
@@ -3239,13 +9899,13 @@ var doc = `{
                 }
             }
         },
-        "models.PipelineMetaDataResponse": {
+        "models.QualityRulesResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PipelinesMetaData"
+                        "$ref": "#/definitions/models.QualityRule"
                     }
                 },
                 "errors": {
@@ -3257,171 +9917,226 @@ var doc = `{
                 }
             }
         },
-        "models.PipelineResponse": {
+        "models.ResourceDependenciesResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "object",
-                    "$ref": "#/definitions/models.Pipeline"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ResourceDependency"
+                    }
                 },
                 "errors": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Destination is used by other resources"
                 },
                 "status": {
                     "type": "string",
-                    "example": "success"
+                    "example": "error"
                 }
             }
         },
-        "models.PipelineSchemas": {
+        "models.ResourceDependency": {
             "type": "object",
             "properties": {
-                "name": {
-                    "type": "string",
-                    "example": "schema"
+                "deleted": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "pipelineID": {
+                "name": {
                     "type": "string",
-                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "pipeline-1"
                 },
-                "prefix": {
+                "resourceId": {
                     "type": "string",
-                    "example": "schema"
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "schemaID": {
+                "resourceType": {
                     "type": "string",
-                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "pipeline"
                 }
             }
         },
-        "models.PipelineSourceAndConnectionID": {
+        "models.ResourceRequirements": {
             "type": "object",
             "properties": {
-                "airbyteConnectionId": {
+                "cpu_limit": {
                     "type": "string"
                 },
-                "pipelineID": {
-                    "type": "string",
-                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                "cpu_request": {
+                    "type": "string"
                 },
-                "sourceID": {
-                    "type": "string",
-                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                "memory_limit": {
+                    "type": "string"
+                },
+                "memory_request": {
+                    "type": "string"
                 }
             }
         },
-        "models.PipelineView": {
+        "models.Response": {
             "type": "object",
-            "required": [
-                "destinationId",
-                "name",
-                "pipelineGovernance"
-            ],
             "properties": {
-                "airbyteConnectionId": {
-                    "type": "string"
-                },
-                "airbyteLastRun": {
-                    "type": "integer",
-                    "example": 1645517210
+                "data": {
+                    "type": "object"
                 },
-                "airbyteStatus": {
-                    "type": "string",
-                    "example": "Inactive"
+                "errors": {
+                    "type": "string"
                 },
-                "connectionId": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RowFilter": {
+            "type": "object",
+            "required": [
+                "column",
+                "operator",
+                "productId"
+            ],
+            "properties": {
+                "column": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "region"
                 },
                 "createdAt": {
                     "type": "integer"
                 },
-                "dataProduct": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "additionalProperties": true
-                    }
-                },
-                "destinationId": {
-                    "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                "createdBy": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "destinationName": {
+                "exemptRoles": {
                     "type": "string",
-                    "example": "redshift"
+                    "example": "[admin]"
                 },
-                "name": {
+                "filterId": {
                     "type": "string",
-                    "example": "pipeline-1"
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "pipelineGovernance": {
+                "operator": {
                     "type": "string",
-                    "example": "[sales, marketing]"
+                    "example": "in"
                 },
-                "pipelineID": {
+                "productId": {
                     "type": "string",
                     "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "sourceID": {
+                "values": {
                     "type": "string",
-                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "[EU, UK]"
                 },
-                "sourceName": {
-                    "type": "string",
-                    "example": "postgres"
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "models.ProductAssetDetails": {
+        "models.RowFilterResponse": {
             "type": "object",
             "properties": {
-                "owner": {
-                    "type": "integer"
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.RowFilter"
                 },
-                "productID": {
+                "errors": {
                     "type": "string"
                 },
-                "schemaName": {
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.RowFiltersResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RowFilter"
+                    }
+                },
+                "errors": {
                     "type": "string"
                 },
-                "tableNames": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "models.Schedule": {
+            "type": "object",
+            "properties": {
+                "timeUnit": {
+                    "type": "string",
+                    "example": "hours"
+                },
+                "units": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.ScheduleConnectorUpgradeRequest": {
+            "type": "object",
+            "required": [
+                "airbyteDefinitionId",
+                "connectorType",
+                "version"
+            ],
+            "properties": {
+                "airbyteDefinitionId": {
+                    "type": "string",
+                    "example": "decd338e-5647-4c0b-adf4-da0e75f5a750"
+                },
+                "connectorType": {
+                    "type": "string",
+                    "example": "source"
                 },
-                "workspaceId": {
-                    "type": "integer"
+                "version": {
+                    "type": "string",
+                    "example": "0.4.8"
                 }
             }
         },
-        "models.ProductAssets": {
+        "models.SchemaChange": {
             "type": "object",
             "properties": {
-                "assetID": {
+                "changeId": {
                     "type": "string",
-                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "columns": {
+                "changes": {
                     "type": "string",
-                    "example": "{}"
+                    "example": "[]"
                 },
-                "isEnabled": {
-                    "type": "boolean",
-                    "example": false
+                "connectionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "name": {
+                "createdAt": {
+                    "type": "integer"
+                },
+                "pipelineId": {
                     "type": "string",
-                    "example": "schema"
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "owner": {
+                "reviewedAt": {
+                    "type": "integer"
+                },
+                "reviewedBy": {
                     "type": "integer",
                     "example": 1
                 },
-                "productID": {
+                "status": {
                     "type": "string",
-                    "example": "b251379e-01a1-11ec-82d6-a312edcd9c7b"
+                    "example": "pending"
+                },
+                "updatedAt": {
+                    "type": "integer"
                 },
                 "workspaceId": {
                     "type": "integer",
@@ -3429,11 +10144,14 @@ var doc = `{
                 }
             }
         },
-        "models.ProductsPipelinesResponse": {
+        "models.SchemaChangesResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "string"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SchemaChange"
+                    }
                 },
                 "errors": {
                     "type": "string"
@@ -3444,47 +10162,96 @@ var doc = `{
                 }
             }
         },
-        "models.ResourceRequirements": {
+        "models.SchemaDriftReport": {
             "type": "object",
             "properties": {
-                "cpu_limit": {
-                    "type": "string"
-                },
-                "cpu_request": {
-                    "type": "string"
+                "checked": {
+                    "type": "integer",
+                    "example": 10
                 },
-                "memory_limit": {
-                    "type": "string"
+                "drifted": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "memory_request": {
-                    "type": "string"
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "models.Response": {
+        "models.SchemaDriftReportResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "object"
+                    "type": "object",
+                    "$ref": "#/definitions/models.SchemaDriftReport"
                 },
                 "errors": {
                     "type": "string"
                 },
                 "status": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "success"
                 }
             }
         },
-        "models.Schedule": {
+        "models.SensitiveColumn": {
             "type": "object",
             "properties": {
-                "timeUnit": {
+                "assetId": {
                     "type": "string",
-                    "example": "hours"
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
-                "units": {
-                    "type": "integer",
-                    "example": 1
+                "assetName": {
+                    "type": "string",
+                    "example": "users"
+                },
+                "assetType": {
+                    "type": "string",
+                    "example": "raw"
+                },
+                "classifications": {
+                    "type": "string",
+                    "example": "[email]"
+                },
+                "column": {
+                    "type": "string",
+                    "example": "email"
+                }
+            }
+        },
+        "models.SensitiveColumnsReport": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SensitiveColumn"
+                    }
+                },
+                "productId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                }
+            }
+        },
+        "models.SensitiveColumnsReportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.SensitiveColumnsReport"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
                 }
             }
         },
@@ -3687,6 +10454,22 @@ var doc = `{
                 }
             }
         },
+        "models.StreamDescriptor": {
+            "type": "object",
+            "required": [
+                "streamName"
+            ],
+            "properties": {
+                "streamName": {
+                    "type": "string",
+                    "example": "users"
+                },
+                "streamNamespace": {
+                    "type": "string",
+                    "example": "public"
+                }
+            }
+        },
         "models.Streams": {
             "type": "object",
             "properties": {
@@ -3726,17 +10509,60 @@ var doc = `{
                 "type"
             ],
             "properties": {
+                "airbyteDefinitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "custom": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "dockerRepository": {
+                    "type": "string",
+                    "example": "airbyte/destination-postgres"
+                },
+                "documentationUrl": {
+                    "type": "string",
+                    "example": "https://docs.airbyte.io/integrations/destinations/postgres"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "icon": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string",
                     "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
                 },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
                 "name": {
                     "type": "string",
                     "example": "destination name"
                 },
+                "releaseStage": {
+                    "type": "string",
+                    "example": "beta"
+                },
+                "syncedAt": {
+                    "type": "integer",
+                    "example": 1650000000000
+                },
                 "type": {
                     "type": "string",
                     "example": "destination type"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "0.3.21"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
@@ -3749,6 +10575,29 @@ var doc = `{
                 "type"
             ],
             "properties": {
+                "airbyteDefinitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "custom": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "dockerRepository": {
+                    "type": "string",
+                    "example": "airbyte/source-postgres"
+                },
+                "documentationUrl": {
+                    "type": "string",
+                    "example": "https://docs.airbyte.io/integrations/sources/postgres"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "icon": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string",
                     "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
@@ -3761,9 +10610,25 @@ var doc = `{
                     "type": "string",
                     "example": "source name"
                 },
+                "releaseStage": {
+                    "type": "string",
+                    "example": "generally_available"
+                },
+                "syncedAt": {
+                    "type": "integer",
+                    "example": 1650000000000
+                },
                 "type": {
                     "type": "string",
                     "example": "source type"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "0.4.4"
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
@@ -3963,6 +10828,51 @@ var doc = `{
                 }
             }
         },
+        "models.WorkspaceConnector": {
+            "type": "object",
+            "properties": {
+                "airbyteDefinitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "connectorType": {
+                    "type": "string",
+                    "example": "source"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "updatedAt": {
+                    "type": "integer",
+                    "example": 1650000000000
+                },
+                "updatedBy": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "workspaceId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.WorkspaceConnectorResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.WorkspaceConnector"
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
         "models.WorkspaceRequest": {
             "type": "object",
             "properties": {
@@ -3993,6 +10903,56 @@ var doc = `{
                     "example": "success"
                 }
             }
+        },
+        "models.WorkspaceSource": {
+            "type": "object",
+            "properties": {
+                "airbyteSourceDefinitionId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "airbyteSourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "owner": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pipelines": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "sourceId": {
+                    "type": "string",
+                    "example": "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+                },
+                "sourceName": {
+                    "type": "string",
+                    "example": "example_source"
+                }
+            }
+        },
+        "models.WorkspaceSourcesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkspaceSource"
+                    }
+                },
+                "errors": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
         }
     }
 }`
@@ -3,6 +3,10 @@ package env
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -24,6 +28,27 @@ type envFile struct {
 	CadenceServiceName       string
 	CadenceWorkerServiceName string
 	DefaultCSVSourcePath     string
+	SoftDeleteRetentionDays  int
+	PreviewMaxLimit          int
+	PreviewPoolMaxSize       int
+	PreviewPoolMaxOpenConns  int
+	PreviewPoolIdleTimeout   time.Duration
+	PreviewQueryTimeout      time.Duration
+	PreviewCacheTTL          time.Duration
+	ExportMaxRows            int
+	ExportDirectory          string
+	ExportRetentionHours     int
+	OAuthRedirectURL         string
+	OAuthAllowedOrigins      []string
+	FileStore                string
+	FileUploadDirectory      string
+	FileUploadMaxSizeMB      int64
+	AirbyteLocalRoot         string
+	FileStoreS3Endpoint      string
+	FileStoreS3Bucket        string
+	FileStoreS3Region        string
+	FileStoreS3AccessKeyID   string
+	FileStoreS3SecretKey     string
 }
 
 var Env *envFile
@@ -77,6 +102,104 @@ func init() {
 		defaultCSVSourcePath = "/local/temp.csv"
 	}
 
+	// soft deleted resources are purged after the retention window
+	softDeleteRetentionDays, err := strconv.Atoi(os.Getenv("SOFT_DELETE_RETENTION_DAYS"))
+	if err != nil || softDeleteRetentionDays <= 0 {
+		softDeleteRetentionDays = 30
+	}
+
+	// previews are paged, a page can't be larger than the max limit
+	previewMaxLimit, err := strconv.Atoi(os.Getenv("PREVIEW_MAX_LIMIT"))
+	if err != nil || previewMaxLimit <= 0 {
+		previewMaxLimit = 1000
+	}
+
+	// the connections to the destinations are pooled per destination, the pools of the least recently previewed
+	// destinations are closed past the max and the ones left idle are closed after the idle timeout
+	previewPoolMaxDestinations, err := strconv.Atoi(os.Getenv("PREVIEW_POOL_MAX_DESTINATIONS"))
+	if err != nil || previewPoolMaxDestinations <= 0 {
+		previewPoolMaxDestinations = 20
+	}
+
+	previewPoolMaxOpenConns, err := strconv.Atoi(os.Getenv("PREVIEW_POOL_MAX_OPEN_CONNS"))
+	if err != nil || previewPoolMaxOpenConns <= 0 {
+		previewPoolMaxOpenConns = 4
+	}
+
+	previewPoolIdleTimeoutSeconds, err := strconv.Atoi(os.Getenv("PREVIEW_POOL_IDLE_TIMEOUT_SECONDS"))
+	if err != nil || previewPoolIdleTimeoutSeconds <= 0 {
+		previewPoolIdleTimeoutSeconds = 300
+	}
+
+	// a preview query running past the timeout is cancelled on the destination
+	previewQueryTimeoutSeconds, err := strconv.Atoi(os.Getenv("PREVIEW_QUERY_TIMEOUT_SECONDS"))
+	if err != nil || previewQueryTimeoutSeconds <= 0 {
+		previewQueryTimeoutSeconds = 30
+	}
+
+	// the results of identical previews are cached for the TTL, the cache is off unless a TTL is set
+	previewCacheTTLSeconds, err := strconv.Atoi(os.Getenv("PREVIEW_CACHE_TTL_SECONDS"))
+	if err != nil || previewCacheTTLSeconds < 0 {
+		previewCacheTTLSeconds = 0
+	}
+
+	// exports are capped so a single download can't dump an unbounded table
+	exportMaxRows, err := strconv.Atoi(os.Getenv("EXPORT_MAX_ROWS"))
+	if err != nil || exportMaxRows <= 0 {
+		exportMaxRows = 1000000
+	}
+
+	exportDirectory := os.Getenv("EXPORT_DIRECTORY")
+	if exportDirectory == "" {
+		exportDirectory = filepath.Join(os.TempDir(), "asset-exports")
+	}
+
+	// the files of the asynchronous exports are removed after the retention window
+	exportRetentionHours, err := strconv.Atoi(os.Getenv("EXPORT_RETENTION_HOURS"))
+	if err != nil || exportRetentionHours <= 0 {
+		exportRetentionHours = 24
+	}
+
+	// the OAuth providers redirect the consent back to the callback of the sources
+	oauthRedirectURL := os.Getenv("OAUTH_REDIRECT_URL")
+	if oauthRedirectURL == "" {
+		oauthRedirectURL = fmt.Sprintf("http://localhost:%s/pipeline-service/api/v1/sources/oauth/callback/", serverPort)
+	}
+
+	// the callback only redirects the consents back to the origins of the frontends
+	oauthAllowedOrigins := []string{"http://localhost:3000"}
+	if origins := os.Getenv("OAUTH_ALLOWED_ORIGINS"); origins != "" {
+		oauthAllowedOrigins = strings.Split(origins, ",")
+	}
+
+	// the uploaded files are stored in the directory airbyte mounts as the parent of the default CSV source path,
+	// unless an S3 compatible object store is configured
+	fileStore := os.Getenv("FILE_STORE")
+	if fileStore == "" {
+		fileStore = "local"
+	}
+
+	fileUploadDirectory := os.Getenv("FILE_UPLOAD_DIRECTORY")
+	if fileUploadDirectory == "" {
+		fileUploadDirectory = "/tmp/airbyte_local"
+	}
+
+	fileUploadMaxSizeMB, err := strconv.ParseInt(os.Getenv("FILE_UPLOAD_MAX_SIZE_MB"), 10, 64)
+	if err != nil || fileUploadMaxSizeMB <= 0 {
+		fileUploadMaxSizeMB = 100
+	}
+
+	// the local destinations of airbyte write under /local, the directory mounted there
+	airbyteLocalRoot := os.Getenv("AIRBYTE_LOCAL_ROOT")
+	if airbyteLocalRoot == "" {
+		airbyteLocalRoot = "/tmp/airbyte_local"
+	}
+
+	fileStoreS3Region := os.Getenv("FILE_STORE_S3_REGION")
+	if fileStoreS3Region == "" {
+		fileStoreS3Region = "us-east-1"
+	}
+
 	Env = &envFile{
 		BuildEnv:                 buildEnv,
 		ServerPort:               serverPort,
@@ -94,5 +217,26 @@ func init() {
 		CadenceServiceName:       os.Getenv("CADENCE_SERVICE_NAME"),
 		CadenceWorkerServiceName: os.Getenv("CADENCE_WORKER_SERVICE_NAME"),
 		DefaultCSVSourcePath:     defaultCSVSourcePath,
+		SoftDeleteRetentionDays:  softDeleteRetentionDays,
+		PreviewMaxLimit:          previewMaxLimit,
+		PreviewPoolMaxSize:       previewPoolMaxDestinations,
+		PreviewPoolMaxOpenConns:  previewPoolMaxOpenConns,
+		PreviewPoolIdleTimeout:   time.Duration(previewPoolIdleTimeoutSeconds) * time.Second,
+		PreviewQueryTimeout:      time.Duration(previewQueryTimeoutSeconds) * time.Second,
+		PreviewCacheTTL:          time.Duration(previewCacheTTLSeconds) * time.Second,
+		ExportMaxRows:            exportMaxRows,
+		ExportDirectory:          exportDirectory,
+		ExportRetentionHours:     exportRetentionHours,
+		OAuthRedirectURL:         oauthRedirectURL,
+		OAuthAllowedOrigins:      oauthAllowedOrigins,
+		FileStore:                fileStore,
+		FileUploadDirectory:      fileUploadDirectory,
+		FileUploadMaxSizeMB:      fileUploadMaxSizeMB,
+		AirbyteLocalRoot:         airbyteLocalRoot,
+		FileStoreS3Endpoint:      os.Getenv("FILE_STORE_S3_ENDPOINT"),
+		FileStoreS3Bucket:        os.Getenv("FILE_STORE_S3_BUCKET"),
+		FileStoreS3Region:        fileStoreS3Region,
+		FileStoreS3AccessKeyID:   os.Getenv("FILE_STORE_S3_ACCESS_KEY_ID"),
+		FileStoreS3SecretKey:     os.Getenv("FILE_STORE_S3_SECRET_ACCESS_KEY"),
 	}
 }
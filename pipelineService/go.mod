@@ -3,11 +3,14 @@ module pipelineService
 go 1.16
 
 require (
+	github.com/ClickHouse/clickhouse-go v1.5.4
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
+	github.com/denisenkom/go-mssqldb v0.12.0
 	github.com/gin-contrib/cors v1.3.1
 	github.com/gin-gonic/gin v1.7.7
 	github.com/go-openapi/spec v0.20.5 // indirect
 	github.com/go-openapi/swag v0.21.1 // indirect
+	github.com/go-sql-driver/mysql v1.6.0
 	github.com/gofrs/uuid v4.2.0+incompatible
 	github.com/gogo/googleapis v1.4.1 // indirect
 	github.com/golang/mock v1.6.0
@@ -15,6 +18,7 @@ require (
 	github.com/google/uuid v1.3.0 // indirect
 	github.com/jackc/pgconn v1.11.0
 	github.com/jackc/pgerrcode v0.0.0-20201024163028-a0d42d470451
+	github.com/jackc/pgx/v4 v4.15.0
 	github.com/joho/godotenv v1.4.0
 	github.com/json-iterator/go v1.1.12 // indirect
 	github.com/lib/pq v1.10.2
@@ -32,6 +36,7 @@ require (
 	go.uber.org/cadence v0.19.0
 	go.uber.org/yarpc v1.60.0
 	go.uber.org/zap v1.21.0
+	golang.org/x/crypto v0.0.0-20220214200702-86341886e292
 	golang.org/x/net v0.0.0-20220412020605-290c469a71a5 // indirect
 	golang.org/x/sys v0.0.0-20220412015802-83041a38b14a // indirect
 	golang.org/x/time v0.0.0-20210723032227-1f47c861a9ac // indirect
@@ -1,15 +1,23 @@
 package assets
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
-	"github.com/tidwall/gjson"
+	"gorm.io/gorm"
 	"pipelineService/clients/airbyte"
 	"pipelineService/clients/authService"
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/services/assetPreview"
 	"pipelineService/services/db"
+	"pipelineService/services/destinationPool"
+	"pipelineService/services/profiling"
 	"pipelineService/utils"
 )
 
@@ -23,11 +31,17 @@ type Server struct {
 
 // PreviewAsset preview the data from destination
 // @Summary Preview the data from destination.
-// @Description Preview the data from destination
+// @Description Preview the data from destination, the row filters of the data products and the masking policies of the workspace are enforced for the caller's role. Postgres, MySQL, ClickHouse, SQL Server and the local CSV and JSON destinations can be previewed, the SSL mode, CA certificates and SSH tunnel of the destination are honored
 // @Tags assets
 // @Produce  json
 // @Param id path string true "Asset ID"
-// @Success 200 {object} models.Response
+// @Param columns query string false "Comma separated columns to return"
+// @Param filter query []string false "Filters written as column:operator:value, repeatable" collectionFormat(multi)
+// @Param orderBy query string false "Column to order by, prefixed with - for descending order"
+// @Param limit query int false "Maximum number of rows"
+// @Param offset query int false "Number of rows to skip"
+// @Param cursor query string false "Cursor of the next page returned by the previous page"
+// @Success 200 {object} models.PreviewResponse
 // @Failure 400 {object} models.Response
 // @Failure 500 {object} models.Response
 // @Router /assets/{id}/preview/ [get].
@@ -44,6 +58,14 @@ func (server *Server) PreviewAsset(ctx *gin.Context) {
 		return
 	}
 
+	var previewRequest models.PreviewRequest
+	if err = ctx.ShouldBindQuery(&previewRequest); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
 	assetDetails, err := server.Store.GetAssetDetails(assetID)
 	if err != nil {
 		logger.Error(err.Error())
@@ -53,8 +75,7 @@ func (server *Server) PreviewAsset(ctx *gin.Context) {
 		return
 	}
 
-	dbConn, err := db.GetClient(assetDetails.Host, assetDetails.UserName, assetDetails.Password, assetDetails.DbName, assetDetails.Port, "disable")
-
+	governance, err := server.getPreviewGovernance(ctx, assetID)
 	if err != nil {
 		logger.Error(err.Error())
 		statusCode, errMsg := utils.ParseDBError(err, "Assets")
@@ -63,11 +84,23 @@ func (server *Server) PreviewAsset(ctx *gin.Context) {
 		return
 	}
 
-	defer db.CloseConnection(dbConn)
+	previewQuery, err := governance.buildPreviewQuery(previewRequest)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if !assetPreview.Supports(assetDetails.DestinationType) {
+		errMsg := fmt.Sprintf("preview of %s destinations isn't supported", assetDetails.DestinationType)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
 
-	tableName := fmt.Sprintf("%s_%s%s", utils.AIRBYTE_DEFAULT_PREFIX, assetDetails.Prefix, assetDetails.Name)
+		return
+	}
 
-	assetData, err := server.Store.PreviewData(dbConn, assetDetails.SchemaName, tableName)
+	preview, err := assetPreview.Preview(ctx.Request.Context(), server.Store, assetDetails, previewQuery)
 
 	if err != nil {
 		logger.Error(err.Error())
@@ -76,13 +109,40 @@ func (server *Server) PreviewAsset(ctx *gin.Context) {
 		return
 	}
 
-	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", assetData)
+	preview.Rows = governance.mask(preview.Rows)
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", preview)
 	logger.Info("PreviewAsset endpoint returned")
 }
 
+// getPreviewGovernance loads the row filters and masking policies enforced on the preview of the asset, the policies
+// are the ones of the workspace of the asset whatever the workspace of the caller.
+func (server *Server) getPreviewGovernance(ctx *gin.Context, assetID uuid.UUID) (previewGovernance, error) {
+	governance := previewGovernance{role: utils.GetUserRoleFromContext(ctx)}
+
+	var err error
+
+	if governance.columns, err = server.Store.GetAssetColumns(assetID); err != nil {
+		return governance, err
+	}
+
+	if governance.filters, err = server.Store.GetAssetRowFilters(assetID); err != nil {
+		return governance, err
+	}
+
+	workspaceID, err := server.Store.GetAssetWorkspaceID(assetID)
+	if err != nil {
+		return governance, err
+	}
+
+	governance.policies, err = server.Store.GetMaskingPolicies(workspaceID)
+
+	return governance, err
+}
+
 // GetPipelineAssets return the assets of a given pipeline
 // @Summary Return the assets of a given pipeline
-// @Description Return the assets of a given pipeline
+// @Description Return the assets of a given pipeline with their latest profile, the profiles of the assets with row filters enforced on the caller's role are left out and returned by the profile endpoint of the asset
 // @Tags assets
 // @Produce  json
 // @Param id path string true "Pipeline ID"
@@ -113,13 +173,24 @@ func (server *Server) GetPipelineAssets(ctx *gin.Context) {
 		return
 	}
 
+	assetIDs := make([]string, 0, len(assets))
+	for _, asset := range assets {
+		assetIDs = append(assetIDs, asset.AssetID)
+	}
+
+	profiles := server.getAssetProfiles(ctx, assetIDs)
+
+	for i := range assets {
+		assets[i].Profile = profiles[assets[i].AssetID]
+	}
+
 	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", assets)
 	logger.Info("GetPipelineAssets endpoint returned")
 }
 
 // GetTransformedAssets return the transformed assets of a given product
 // @Summary Return the transformed assets of a given product
-// @Description Return the transformed assets of a given product
+// @Description Return the transformed assets of a given product with their latest profile, the profiles of the assets with row filters enforced on the caller's role are left out and returned by the profile endpoint of the asset
 // @Tags assets
 // @Produce  json
 // @Param id path string true "Product ID"
@@ -149,17 +220,34 @@ func (server *Server) GetTransformedAssets(ctx *gin.Context) {
 		return
 	}
 
+	assetIDs := make([]string, 0, len(transformedAssets))
+	for _, asset := range transformedAssets {
+		assetIDs = append(assetIDs, asset.AssetID)
+	}
+
+	profiles := server.getAssetProfiles(ctx, assetIDs)
+
+	for i := range transformedAssets {
+		transformedAssets[i].Profile = profiles[transformedAssets[i].AssetID]
+	}
+
 	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", transformedAssets)
 	logger.Info("GetTransformedAssets endpoint returned")
 }
 
 // PreviewTransformedAsset preview the data of transformed asset from destination
 // @Summary Preview the data of transformed asset from destination.
-// @Description Preview the data of transformed asset from destination
+// @Description Preview the data of transformed asset from destination, the row filters of the data product and the masking policies of the workspace are enforced for the caller's role
 // @Tags assets
 // @Produce  json
 // @Param id path string true "Asset ID"
-// @Success 200 {object} models.Response
+// @Param columns query string false "Comma separated columns to return"
+// @Param filter query []string false "Filters written as column:operator:value, repeatable" collectionFormat(multi)
+// @Param orderBy query string false "Column to order by, prefixed with - for descending order"
+// @Param limit query int false "Maximum number of rows"
+// @Param offset query int false "Number of rows to skip"
+// @Param cursor query string false "Cursor of the next page returned by the previous page"
+// @Success 200 {object} models.PreviewResponse
 // @Failure 400 {object} models.Response
 // @Failure 500 {object} models.Response
 // @Router /assets/{id}/transformed/preview/ [get].
@@ -176,6 +264,14 @@ func (server *Server) PreviewTransformedAsset(ctx *gin.Context) {
 		return
 	}
 
+	var previewRequest models.PreviewRequest
+	if err = ctx.ShouldBindQuery(&previewRequest); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
 	assetDetails, err := server.Store.GetTransformedAssetDetails(assetID)
 	if err != nil {
 		logger.Error(err.Error())
@@ -185,15 +281,7 @@ func (server *Server) PreviewTransformedAsset(ctx *gin.Context) {
 		return
 	}
 
-	destinationConfig := assetDetails.DestinationConfiguration.String()
-	host := gjson.Get(destinationConfig, "host").String()
-	username := gjson.Get(destinationConfig, "username").String()
-	password := gjson.Get(destinationConfig, "password").String()
-	database := gjson.Get(destinationConfig, "database").String()
-	port := gjson.Get(destinationConfig, "port").String()
-
-	dbConn, err := db.GetClient(host, username, password, database, port, "disable")
-
+	governance, err := server.getPreviewGovernance(ctx, assetID)
 	if err != nil {
 		logger.Error(err.Error())
 		statusCode, errMsg := utils.ParseDBError(err, "Assets")
@@ -202,9 +290,15 @@ func (server *Server) PreviewTransformedAsset(ctx *gin.Context) {
 		return
 	}
 
-	defer db.CloseConnection(dbConn)
+	previewQuery, err := governance.buildPreviewQuery(previewRequest)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
 
-	assetData, err := server.Store.PreviewData(dbConn, assetDetails.ProductName, assetDetails.AssetName)
+	preview, err := assetPreview.PreviewTransformed(ctx.Request.Context(), server.Store, assetDetails, previewQuery)
 
 	if err != nil {
 		logger.Error(err.Error())
@@ -213,6 +307,637 @@ func (server *Server) PreviewTransformedAsset(ctx *gin.Context) {
 		return
 	}
 
-	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", assetData)
+	preview.Rows = governance.mask(preview.Rows)
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", preview)
 	logger.Info("PreviewAsset endpoint returned")
 }
+
+// GetAssetColumns returns the data dictionary of an asset
+// @Summary Return the columns of an asset
+// @Description Returns the name, type, nullability and documentation of the columns of a raw or transformed asset
+// @Tags assets
+// @Produce  json
+// @Param id path string true "Asset ID"
+// @Success 200 {object} models.AssetColumnsResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/{id}/columns/ [get].
+func (server *Server) GetAssetColumns(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetAssetColumns endpoint called")
+
+	assetID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	columns, err := server.Store.GetAssetColumns(assetID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Asset Columns")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", columns)
+	logger.Info("GetAssetColumns endpoint returned")
+}
+
+// EditAssetColumn documents a column of an asset
+// @Summary Document a column of an asset
+// @Description Updates the description and tags of a column, the caller is recorded as the one who documented it
+// @Tags assets
+// @Produce  json
+// @Param id path string true "Asset ID"
+// @Param name path string true "Column Name"
+// @Param column body models.EditAssetColumnRequest true "Column documentation"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/{id}/columns/{name}/ [put].
+func (server *Server) EditAssetColumn(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("EditAssetColumn endpoint called")
+
+	userID, _, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only editors can document the assets"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	assetID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var editRequest models.EditAssetColumnRequest
+	if err = ctx.ShouldBindJSON(&editRequest); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	column, err := server.Store.UpdateAssetColumn(models.AssetColumn{
+		AssetID:      assetID.String(),
+		Name:         ctx.Param("name"),
+		Description:  editRequest.Description,
+		Tags:         editRequest.Tags,
+		DocumentedBy: userID,
+		DocumentedAt: time.Now().UnixMilli(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Asset Column")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", column)
+	logger.Info("EditAssetColumn endpoint returned")
+}
+
+// ExportPipelineDictionary exports the data dictionary of a pipeline
+// @Summary Export the data dictionary of a pipeline
+// @Description Exports the columns of all the raw assets of a pipeline as Markdown or CSV
+// @Tags assets
+// @Produce  text/markdown,text/csv
+// @Param id path string true "Pipeline ID"
+// @Param format query string false "Format i.e. markdown or csv, defaults to markdown"
+// @Success 200 {string} string
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/pipeline/{id}/dictionary/ [get].
+func (server *Server) ExportPipelineDictionary(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ExportPipelineDictionary endpoint called")
+
+	server.exportDataDictionary(ctx, "pipeline")
+
+	logger.Info("ExportPipelineDictionary endpoint returned")
+}
+
+// ExportProductDictionary exports the data dictionary of a data product
+// @Summary Export the data dictionary of a data product
+// @Description Exports the columns of all the transformed assets of a data product as Markdown or CSV
+// @Tags assets
+// @Produce  text/markdown,text/csv
+// @Param id path string true "Product ID"
+// @Param format query string false "Format i.e. markdown or csv, defaults to markdown"
+// @Success 200 {string} string
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/products/{id}/dictionary/ [get].
+func (server *Server) ExportProductDictionary(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ExportProductDictionary endpoint called")
+
+	server.exportDataDictionary(ctx, "product")
+
+	logger.Info("ExportProductDictionary endpoint returned")
+}
+
+func (server *Server) exportDataDictionary(ctx *gin.Context, parent string) {
+	logger := utils.GetLogger()
+
+	parentID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var dictionaryRequest models.DataDictionaryRequest
+	if err = ctx.ShouldBindQuery(&dictionaryRequest); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	columns, err := server.Store.GetDataDictionary(parentID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Data Dictionary")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	fileName := fmt.Sprintf("%s-%s-dictionary", parent, parentID)
+
+	ctx.Status(http.StatusOK)
+
+	if dictionaryRequest.Format == utils.DICTIONARY_FORMAT_CSV {
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.csv", fileName))
+		ctx.Writer.Header().Set("Content-Type", "text/csv")
+
+		err = writeCSVDictionary(ctx.Writer, columns)
+	} else {
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.md", fileName))
+		ctx.Writer.Header().Set("Content-Type", "text/markdown")
+
+		err = writeMarkdownDictionary(ctx.Writer, fmt.Sprintf("Data dictionary of %s %s", parent, parentID), columns)
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+	}
+}
+
+// ExportAsset exports the rows of an asset
+// @Summary Export the rows of an asset as CSV, JSONL or Parquet
+// @Description Streams the rows of a raw or transformed asset from its destination, the row filters of the data products and the masking policies of the workspace are enforced for the caller's role. Asynchronous exports are written to a file downloaded once completed. Every export is recorded.
+// @Tags assets
+// @Produce  text/csv,application/x-ndjson,application/octet-stream,application/gzip,json
+// @Param id path string true "Asset ID"
+// @Param format query string true "Format i.e. csv, jsonl or parquet"
+// @Param gzip query bool false "Compress the export with gzip"
+// @Param async query bool false "Generate the export in the background"
+// @Param columns query string false "Comma separated columns to export"
+// @Param filter query []string false "Filters written as column:operator:value, repeatable" collectionFormat(multi)
+// @Param orderBy query string false "Column to order by, prefixed with - for descending order"
+// @Param limit query int false "Maximum number of rows, capped at the configured max rows"
+// @Success 200 {string} string
+// @Success 202 {object} models.AssetExportResponse
+// @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/{id}/export/ [get].
+func (server *Server) ExportAsset(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ExportAsset endpoint called")
+
+	assetID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var exportRequest models.AssetExportRequest
+	if err = ctx.ShouldBindQuery(&exportRequest); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	table, err := server.Store.GetAssetTable(assetID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, "Asset doesn't exists", nil)
+
+		return
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Assets")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if !destinationPool.SupportsQueries(table.DestinationType) {
+		errMsg := fmt.Sprintf("export of %s destinations isn't supported", table.DestinationType)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	governance, err := server.getPreviewGovernance(ctx, assetID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Assets")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	exportQuery, err := governance.buildExportQuery(exportRequest, table.Raw)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	export, err := server.Store.CreateAssetExport(models.AssetExport{
+		AssetID:     assetID.String(),
+		AssetName:   table.AssetName,
+		Format:      exportRequest.Format,
+		Gzip:        exportRequest.Gzip,
+		Async:       exportRequest.Async,
+		Columns:     exportQuery.Columns,
+		Filters:     exportRequest.Filters,
+		RowLimit:    exportQuery.Limit,
+		Status:      utils.EXPORT_STATUS_PENDING,
+		RequestedBy: userID,
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Export")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	methods := governance.maskingMethods()
+
+	if exportRequest.Async {
+		go server.generateExport(export, table, exportQuery, methods)
+
+		export.DownloadURL = server.exportDownloadURL(export.ExportID)
+
+		utils.BuildResponse(ctx, http.StatusAccepted, utils.SUCCESS, "", export)
+		logger.Info("ExportAsset endpoint returned")
+
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", exportFileName(table.AssetName, export.Format, export.Gzip)))
+	ctx.Header("Content-Type", exportContentType(export.Format, export.Gzip))
+
+	rows, err := writeExport(ctx.Writer, table, exportQuery, methods, server.Store, export.Format, export.Gzip)
+
+	server.completeExport(export, rows, err)
+
+	// failures before the first byte is streamed are still reported as an error response
+	if err != nil && !ctx.Writer.Written() {
+		ctx.Writer.Header().Del("Content-Disposition")
+		ctx.Writer.Header().Del("Content-Type")
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "export failed", nil)
+	}
+
+	logger.Info("ExportAsset endpoint returned")
+}
+
+// GetAssetExports returns the exports of the workspace
+// @Summary Return the exports of the workspace
+// @Description Returns the exports of the assets of the workspace, newest first
+// @Tags assets
+// @Produce  json
+// @Param assetId query string false "Asset ID"
+// @Success 200 {object} models.AssetExportsResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/exports/ [get].
+func (server *Server) GetAssetExports(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetAssetExports endpoint called")
+
+	var filter models.AssetExportFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	exports, err := server.Store.GetAssetExports(workspaceID, filter)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Exports")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	for i := range exports {
+		if isDownloadable(exports[i], userID) {
+			exports[i].DownloadURL = server.exportDownloadURL(exports[i].ExportID)
+		}
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", exports)
+	logger.Info("GetAssetExports endpoint returned")
+}
+
+// GetAssetExport returns an export
+// @Summary Return an export
+// @Description Returns the status of an export, completed asynchronous exports have a download link
+// @Tags assets
+// @Produce  json
+// @Param id path string true "Export ID"
+// @Success 200 {object} models.AssetExportResponse
+// @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/exports/{id}/ [get].
+func (server *Server) GetAssetExport(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetAssetExport endpoint called")
+
+	export, ok := server.getWorkspaceExport(ctx)
+	if !ok {
+		return
+	}
+
+	userID, _, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if isDownloadable(export, userID) {
+		export.DownloadURL = server.exportDownloadURL(export.ExportID)
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", export)
+	logger.Info("GetAssetExport endpoint returned")
+}
+
+// DownloadAssetExport downloads an asynchronous export
+// @Summary Download an asynchronous export
+// @Description Downloads the file of a completed asynchronous export, only the user who requested it can download it since the file is masked and filtered for their role
+// @Tags assets
+// @Produce  text/csv,application/x-ndjson,application/octet-stream,application/gzip,json
+// @Param id path string true "Export ID"
+// @Success 200 {string} string
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 409 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/exports/{id}/download/ [get].
+func (server *Server) DownloadAssetExport(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DownloadAssetExport endpoint called")
+
+	export, ok := server.getWorkspaceExport(ctx)
+	if !ok {
+		return
+	}
+
+	// the file holds the rows masked and filtered for the role of the requester
+	if userID, _, _ := utils.GetUserAndWorkspaceIDFromContext(ctx); export.RequestedBy != userID {
+		errMsg := "Only the requester can download the export"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if export.Status != utils.EXPORT_STATUS_COMPLETED || export.FilePath == "" {
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, fmt.Sprintf("Export is %s", export.Status), nil)
+
+		return
+	}
+
+	ctx.Header("Content-Type", exportContentType(export.Format, export.Gzip))
+	ctx.FileAttachment(export.FilePath, exportFileName(export.AssetName, export.Format, export.Gzip))
+	logger.Info("DownloadAssetExport endpoint returned")
+}
+
+// PurgeExpiredExports removes the files of the exports completed before the retention window
+// @Summary Purge the expired exports
+// @Description Removes the files of the asynchronous exports completed before the retention window and marks the exports as expired, the exports which couldn't be removed are retried on the next run
+// @Tags assets/internal
+// @Produce  json
+// @Success 200 {object} models.AssetExportsResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/internal/exports/purge/ [post].
+func (server *Server) PurgeExpiredExports(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("PurgeExpiredExports internal endpoint called")
+
+	completedBefore := time.Now().Add(-time.Duration(env.Env.ExportRetentionHours) * time.Hour)
+
+	exports, err := server.Store.GetExpiredAssetExports(completedBefore)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Exports")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	expired := make([]models.AssetExport, 0, len(exports))
+
+	for _, export := range exports {
+		if err = os.Remove(export.FilePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logger.Error(err.Error())
+
+			continue
+		}
+
+		export.Status = utils.EXPORT_STATUS_EXPIRED
+		export.FilePath = ""
+
+		if err = server.Store.UpdateAssetExport(export); err != nil {
+			logger.Error(err.Error())
+
+			continue
+		}
+
+		expired = append(expired, export)
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", expired)
+	logger.Info("PurgeExpiredExports internal endpoint returned")
+}
+
+// isDownloadable reports whether the file of the export can be downloaded by the user, the file is only handed to the
+// user who requested it since it holds the rows masked and filtered for their role.
+func isDownloadable(export models.AssetExport, userID int) bool {
+	return export.Status == utils.EXPORT_STATUS_COMPLETED && export.Async && export.RequestedBy == userID
+}
+
+// getWorkspaceExport returns the export of the path, the exports of the other workspaces are reported as missing.
+func (server *Server) getWorkspaceExport(ctx *gin.Context) (models.AssetExport, bool) {
+	logger := utils.GetLogger()
+
+	exportID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return models.AssetExport{}, false
+	}
+
+	export, err := server.Store.GetAssetExport(exportID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Export")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return export, false
+	}
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if export.WorkspaceID != workspaceID {
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, "Export doesn't exists", nil)
+
+		return export, false
+	}
+
+	return export, true
+}
+
+// GetAssetProfile returns the latest profile of an asset
+// @Summary Return the latest profile of an asset
+// @Description Returns the row count and the column statistics of the latest profile of a raw or transformed asset, the statistics only cover the rows the row filters of the caller's role let through and the value statistics of the columns masked for the caller's role are left out
+// @Tags assets
+// @Produce  json
+// @Param id path string true "Asset ID"
+// @Success 200 {object} models.AssetProfileResponse
+// @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/{id}/profile/ [get].
+func (server *Server) GetAssetProfile(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetAssetProfile endpoint called")
+
+	assetID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	profile, err := server.getAssetProfile(ctx, assetID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Asset Profile")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if profile == nil {
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, "Asset hasn't been profiled", nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", profile)
+	logger.Info("GetAssetProfile endpoint returned")
+}
+
+// ProfileAsset profiles an asset
+// @Summary Profile an asset
+// @Description Computes the row count and the statistics of the cataloged columns of a raw or transformed asset on its destination and stores them as its latest profile
+// @Tags assets
+// @Produce  json
+// @Param id path string true "Asset ID"
+// @Success 201 {object} models.AssetProfileResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /assets/{id}/profile/ [post].
+func (server *Server) ProfileAsset(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ProfileAsset endpoint called")
+
+	if !utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only editors can profile the assets"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	assetID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if _, err = profiling.ProfileAsset(server.Store, assetID); err != nil {
+		logger.Error(err.Error())
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, "Asset doesn't exists", nil)
+
+			return
+		}
+
+		if errors.Is(err, destinationPool.ErrUnsupportedDestination) {
+			utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+			return
+		}
+
+		statusCode, errMsg := utils.ParseDBError(err, "Asset Profile")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	profile, err := server.getAssetProfile(ctx, assetID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Asset Profile")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", profile)
+	logger.Info("ProfileAsset endpoint returned")
+}
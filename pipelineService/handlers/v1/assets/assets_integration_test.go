@@ -0,0 +1,1849 @@
+package assets_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"pipelineService/env"
+	"pipelineService/handlers/v1/test"
+	"pipelineService/models/v1"
+	mockStore "pipelineService/services/db/mocks"
+	"pipelineService/utils"
+)
+
+// TestGetAssetColumns tests all the scenarios while getting the columns of an asset.
+func TestGetAssetColumns(t *testing.T) {
+	aID, _ := uuid.NewV1()
+	mockColumns := []models.AssetColumn{createRandomAssetColumn("users"), createRandomAssetColumn("users")}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		assetID       string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidID",
+
+			assetID: "invalid",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetColumns(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_DBError",
+
+			assetID: aID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			assetID: aID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(mockColumns, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockColumns}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/%s/columns/", test.BaseURL, testCase.assetID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestEditAssetColumn tests all the scenarios while documenting a column of an asset.
+func TestEditAssetColumn(t *testing.T) {
+	aID, _ := uuid.NewV1()
+	mockColumn := createRandomAssetColumn("users")
+	mockColumn.AssetID = aID.String()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		body          interface{}
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidBody",
+
+			body: map[string]interface{}{"tags": "pii"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().UpdateAssetColumn(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_ColumnDoesNotExist",
+
+			body: models.EditAssetColumnRequest{Description: "Email address of the user"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().UpdateAssetColumn(gomock.Any()).Times(1).
+					Return(models.AssetColumn{}, errors.New("Column doesn't exists"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			body: models.EditAssetColumnRequest{Description: "Email address of the user", Tags: []string{"pii"}},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().UpdateAssetColumn(gomock.Any()).Times(1).DoAndReturn(
+					func(column models.AssetColumn) (models.AssetColumn, error) {
+						require.Equal(t, aID.String(), column.AssetID)
+						require.Equal(t, mockColumn.Name, column.Name)
+						require.Equal(t, "Email address of the user", column.Description)
+						require.Equal(t, []string{"pii"}, []string(column.Tags))
+						require.Equal(t, 1122, column.DocumentedBy)
+						require.NotZero(t, column.DocumentedAt)
+
+						return mockColumn, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/%s/columns/%s/", test.BaseURL, aID, mockColumn.Name)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPut, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestExportPipelineDictionary tests that the data dictionary of a pipeline is exported as Markdown and CSV.
+func TestExportPipelineDictionary(t *testing.T) {
+	pID, _ := uuid.NewV1()
+
+	mockColumns := []models.AssetColumn{
+		createRandomAssetColumn("orders"),
+		createRandomAssetColumn("users"),
+		createRandomAssetColumn("users"),
+	}
+	mockColumns[1].Description = "Email | contact\nof the user"
+	mockColumns[1].Tags = []string{"contact", "pii"}
+	mockColumns[1].DocumentedBy = 1122
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidFormat",
+
+			query: map[string]string{"format": "pdf"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDataDictionary(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_Markdown",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDataDictionary(pID).Times(1).Return(mockColumns, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/markdown", recorder.Header().Get("Content-Type"))
+
+				body := recorder.Body.String()
+				require.Equal(t, 1, strings.Count(body, "## orders\n"))
+				require.Equal(t, 1, strings.Count(body, "## users\n"))
+				require.Contains(t, body, fmt.Sprintf("| %s | %s | %t | Email \\| contact of the user | contact, pii | 1122 |\n",
+					mockColumns[1].Name, mockColumns[1].Type, mockColumns[1].Nullable))
+			},
+		},
+		{
+			testScenario: "Success_CSV",
+
+			query: map[string]string{"format": utils.DICTIONARY_FORMAT_CSV},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDataDictionary(pID).Times(1).Return(mockColumns, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, len(mockColumns)+1)
+				require.Equal(t, []string{"users", mockColumns[1].Name, mockColumns[1].Type,
+					fmt.Sprint(mockColumns[1].Nullable), mockColumns[1].Description, "contact, pii", "1122"}, records[2])
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/pipeline/%s/dictionary/", test.BaseURL, pID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestPreviewAsset tests the scenarios while previewing an asset that fail before the destination is queried.
+func TestPreviewAsset(t *testing.T) {
+	aID, _ := uuid.NewV1()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_AssetDBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(models.AssetDetails{}, sql.ErrConnDone)
+				store.EXPECT().GetAssetRowFilters(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_RowFiltersDBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(models.AssetDetails{Name: "users"}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().PreviewData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_InvalidLimit",
+			query:        map[string]string{"limit": "-1"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_InvalidFilter",
+			query:        map[string]string{"filter": "age:between:1"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(models.AssetDetails{Name: "users"}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+				store.EXPECT().PreviewData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_OrderByMaskedColumn",
+			query:        map[string]string{"orderBy": "-email"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				column := createRandomAssetColumn("users")
+				column.Name = "email"
+				column.Classifications = []string{"email"}
+
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(models.AssetDetails{Name: "users"}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{column}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return([]models.MaskingPolicy{{Classification: "email", Method: utils.MASKING_METHOD_HASH}}, nil)
+				store.EXPECT().PreviewData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_OrderByColumnMaskedInAssetWorkspace",
+			query:        map[string]string{"orderBy": "email"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				column := createRandomAssetColumn("users")
+				column.Name = "email"
+				column.Classifications = []string{"email"}
+
+				// the asset is shared from another workspace, its policies apply instead of the caller's
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(models.AssetDetails{Name: "users"}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{column}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(3344, nil)
+				store.EXPECT().GetMaskingPolicies(3344).Times(1).Return([]models.MaskingPolicy{{Classification: "email", Method: utils.MASKING_METHOD_HASH}}, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(0)
+				store.EXPECT().PreviewData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnsupportedDestination",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(models.AssetDetails{Name: "users", DestinationType: "bigquery"}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+				store.EXPECT().PreviewData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PreviewSQLData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "preview of bigquery destinations isn't supported")
+			},
+		},
+		{
+			testScenario: "BadRequest_InvalidCursor",
+			query:        map[string]string{"orderBy": "id", "cursor": "not-a-cursor"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(models.AssetDetails{Name: "users"}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+				store.EXPECT().PreviewData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/%s/preview/", test.BaseURL, aID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestPreviewLocalDestinationAsset tests the previews of the assets synced to the local destinations of airbyte, their
+// raw tables are read from the files the destinations write.
+func TestPreviewLocalDestinationAsset(t *testing.T) {
+	aID, _ := uuid.NewV1()
+
+	localRoot := t.TempDir()
+	previousRoot := env.Env.AirbyteLocalRoot
+	env.Env.AirbyteLocalRoot = localRoot
+
+	t.Cleanup(func() {
+		env.Env.AirbyteLocalRoot = previousRoot
+	})
+
+	require.NoError(t, os.MkdirAll(filepath.Join(localRoot, "json_data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(localRoot, "json_data", "_airbyte_raw_app_users.jsonl"), []byte(
+		`{"_airbyte_ab_id":"1","_airbyte_emitted_at":1645517210000,"_airbyte_data":{"name":"grace","age":31}}`+"\n"+
+			`{"_airbyte_ab_id":"2","_airbyte_emitted_at":1645517210000,"_airbyte_data":{"name":"alan"}}`+"\n"+
+			`{"_airbyte_ab_id":"3","_airbyte_emitted_at":1645517210000,"_airbyte_data":{"name":"ada","age":45}}`+"\n"+
+			`{"_airbyte_ab_id":"4","_airbyte_emitted_at":1645517210000,"_airbyte_data":{"name":"linus","age":12}}`+"\n"),
+		0o644))
+
+	// the records after the first two can't be read, an unordered page of two rows stops before them
+	require.NoError(t, os.WriteFile(filepath.Join(localRoot, "json_data", "_airbyte_raw_app_events.jsonl"), []byte(
+		`{"_airbyte_ab_id":"1","_airbyte_emitted_at":1645517210000,"_airbyte_data":{"name":"signup"}}`+"\n"+
+			`{"_airbyte_ab_id":"2","_airbyte_emitted_at":1645517210000,"_airbyte_data":{"name":"login"}}`+"\n"+
+			`{"_airbyte_ab_id":"3",`+"\n"),
+		0o644))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(localRoot, "csv_data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(localRoot, "csv_data", "_airbyte_raw_users.csv"), []byte(
+		"_airbyte_ab_id,_airbyte_emitted_at,_airbyte_data\n"+
+			`1,1645517210000,"{""name"":""grace""}"`+"\n"),
+		0o644))
+
+	jsonAsset := models.AssetDetails{
+		Name:            "users",
+		Prefix:          "app_",
+		SchemaName:      "public",
+		DestinationType: utils.DESTINATION_TYPE_LOCAL_JSON,
+		Configuration:   datatypes.JSON(`{"destination_path":"/local/json_data"}`),
+	}
+
+	csvAsset := models.AssetDetails{
+		Name:            "users",
+		SchemaName:      "public",
+		DestinationType: utils.DESTINATION_TYPE_CSV,
+		Configuration:   datatypes.JSON(`{"destination_path":"/local/csv_data"}`),
+	}
+
+	ageColumn := createRandomAssetColumn("users")
+	ageColumn.Name = "age"
+	ageColumn.Type = "integer"
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_MissingFile",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				asset := csvAsset
+				asset.Name = "orders"
+
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(asset, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_JSONLFilteredAndOrdered",
+			query:        map[string]string{"columns": "name", "filter": "age:gt:20", "orderBy": "-age", "limit": "1"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(jsonAsset, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{ageColumn}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.PreviewResult{
+						Columns:    []models.PreviewColumn{{Name: "name", Type: "text"}},
+						Rows:       []map[string]interface{}{{"name": "ada", "age": 45}},
+						NextCursor: utils.EncodePreviewCursor(models.PreviewCursor{Value: "45", Type: "numeric"}),
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_JSONLOrderedPageWithOffset",
+			query:        map[string]string{"columns": "name", "orderBy": "age", "offset": "1", "limit": "2"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(jsonAsset, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{ageColumn}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.PreviewResult{
+						Columns: []models.PreviewColumn{{Name: "name", Type: "text"}},
+						Rows: []map[string]interface{}{
+							{"name": "grace", "age": 31},
+							{"name": "ada", "age": 45},
+						},
+						NextCursor: utils.EncodePreviewCursor(models.PreviewCursor{Value: "45", Type: "numeric"}),
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_JSONLUnorderedPageStopsReading",
+			query:        map[string]string{"columns": "name", "limit": "2"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				asset := jsonAsset
+				asset.Name = "events"
+
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(asset, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.PreviewResult{
+						Columns: []models.PreviewColumn{{Name: "name", Type: "text"}},
+						Rows:    []map[string]interface{}{{"name": "signup"}, {"name": "login"}},
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_CSVRawRecords",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(csvAsset, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.PreviewResult{
+						Columns: []models.PreviewColumn{
+							{Name: utils.AIRBYTE_AB_ID_COLUMN, Type: "text"},
+							{Name: utils.AIRBYTE_EMITTED_AT_COLUMN, Type: "numeric"},
+							{Name: utils.AIRBYTE_DATA_COLUMN, Type: "json"},
+						},
+						Rows: []map[string]interface{}{{
+							utils.AIRBYTE_AB_ID_COLUMN:      "1",
+							utils.AIRBYTE_EMITTED_AT_COLUMN: "1645517210000",
+							utils.AIRBYTE_DATA_COLUMN:       map[string]interface{}{"name": "grace"},
+						}},
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/%s/preview/", test.BaseURL, aID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestPreviewAssetResultCache tests the cache of the results of identical previews, the scenarios run in order and
+// share the cache.
+func TestPreviewAssetResultCache(t *testing.T) {
+	aID, _ := uuid.NewV1()
+	dID, _ := uuid.NewV1()
+
+	localRoot := t.TempDir()
+	previousRoot, previousTTL := env.Env.AirbyteLocalRoot, env.Env.PreviewCacheTTL
+	env.Env.AirbyteLocalRoot, env.Env.PreviewCacheTTL = localRoot, time.Minute
+
+	t.Cleanup(func() {
+		env.Env.AirbyteLocalRoot, env.Env.PreviewCacheTTL = previousRoot, previousTTL
+	})
+
+	rawTable := filepath.Join(localRoot, "_airbyte_raw_users.jsonl")
+	require.NoError(t, os.WriteFile(rawTable, []byte(
+		`{"_airbyte_ab_id":"1","_airbyte_emitted_at":1645517210000,"_airbyte_data":{"name":"ada"}}`+"\n"), 0o644))
+
+	asset := models.AssetDetails{
+		Name:            "users",
+		SchemaName:      "public",
+		DestinationID:   dID.String(),
+		DestinationType: utils.DESTINATION_TYPE_LOCAL_JSON,
+		Configuration:   datatypes.JSON(`{"destination_path":"/local"}`),
+	}
+
+	nameColumn := createRandomAssetColumn("users")
+	nameColumn.Name = "name"
+	nameColumn.Classifications = []string{"name"}
+
+	query := map[string]string{"columns": "name"}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Success_MaskedForTheCaller",
+			query:        query,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(asset, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{nameColumn}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return([]models.MaskingPolicy{{Classification: "name", Method: utils.MASKING_METHOD_REDACT}}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), utils.REDACTED_VALUE)
+
+				// the next previews are served from the cache
+				require.NoError(t, os.Remove(rawTable))
+			},
+		},
+		{
+			testScenario: "Success_CachedResultNotMasked",
+			query:        query,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(asset, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{nameColumn}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.PreviewResult{
+						Columns: []models.PreviewColumn{{Name: "name", Type: "text"}},
+						Rows:    []map[string]interface{}{{"name": "ada"}},
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "BadRequest_OtherQueryNotCached",
+			query:        map[string]string{"columns": "name", "limit": "5"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(asset, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{nameColumn}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_CredentialsChanged",
+			query:        query,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				edited := asset
+				edited.Configuration = datatypes.JSON(`{"destination_path":"/local/"}`)
+
+				store.EXPECT().GetAssetDetails(aID).Times(1).Return(edited, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{nameColumn}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/%s/preview/", test.BaseURL, aID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestPreviewAssetThroughTunnel tests the scenarios while previewing an asset of a destination behind a bastion host.
+func TestPreviewAssetThroughTunnel(t *testing.T) {
+	aID, _ := uuid.NewV1()
+
+	bastion, hostKey, forwards := startBastion(t, "secret")
+	bastionHost, bastionPort, err := net.SplitHostPort(bastion)
+	require.NoError(t, err)
+
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	otherHostKey, err := ssh.NewPublicKey(otherKey.Public())
+	require.NoError(t, err)
+
+	hostKeyOf := func(key ssh.PublicKey) string {
+		return fmt.Sprintf(`"host_key":%q`, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))))
+	}
+
+	tunnelAsset := func(tunnelMethod string, sslMode string) models.AssetDetails {
+		dID, _ := uuid.NewV1()
+
+		return models.AssetDetails{
+			Name:            "users",
+			SchemaName:      "public",
+			DestinationID:   dID.String(),
+			DestinationType: utils.DESTINATION_TYPE_POSTGRES,
+			Configuration: datatypes.JSON(fmt.Sprintf(`{"host":"warehouse.internal","port":5432,"database":"dwh",`+
+				`"username":"reader","password":"reader","ssl_mode":%s,"tunnel_method":%s}`, sslMode, tunnelMethod)),
+		}
+	}
+
+	passwordTunnel := func(password string, hostVerification string) string {
+		return fmt.Sprintf(`{"tunnel_method":"%s","tunnel_host":"%s","tunnel_port":%s,"tunnel_user":"bastion",`+
+			`"tunnel_user_password":"%s",%s}`, utils.TUNNEL_METHOD_SSH_PASSWORD, bastionHost, bastionPort, password,
+			hostVerification)
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		asset         models.AssetDetails
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidSSHKey",
+			asset: tunnelAsset(fmt.Sprintf(`{"tunnel_method":"%s","tunnel_host":"%s","tunnel_port":%s,`+
+				`"tunnel_user":"bastion","ssh_key":"not a key",%s}`, utils.TUNNEL_METHOD_SSH_KEY, bastionHost, bastionPort,
+				hostKeyOf(hostKey)),
+				`{"mode":"disable"}`),
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Empty(t, forwards)
+			},
+		},
+		{
+			testScenario: "BadRequest_TunnelAuthFailed",
+			asset:        tunnelAsset(passwordTunnel("wrong", hostKeyOf(hostKey)), `{"mode":"disable"}`),
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Empty(t, forwards)
+			},
+		},
+		{
+			testScenario: "BadRequest_InvalidCACertificate",
+			asset: tunnelAsset(passwordTunnel("secret", hostKeyOf(hostKey)),
+				`{"mode":"verify-ca","ca_certificate":"not a certificate"}`),
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Empty(t, forwards)
+			},
+		},
+		{
+			testScenario: "BadRequest_NoHostKey",
+			asset: tunnelAsset(fmt.Sprintf(`{"tunnel_method":"%s","tunnel_host":"%s","tunnel_port":%s,`+
+				`"tunnel_user":"bastion","tunnel_user_password":"secret"}`, utils.TUNNEL_METHOD_SSH_PASSWORD, bastionHost,
+				bastionPort), `{"mode":"disable"}`),
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Empty(t, forwards)
+			},
+		},
+		{
+			testScenario: "BadRequest_HostKeyMismatch",
+			asset:        tunnelAsset(passwordTunnel("secret", hostKeyOf(otherHostKey)), `{"mode":"disable"}`),
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Empty(t, forwards)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnknownHost",
+			asset: tunnelAsset(passwordTunnel("secret", fmt.Sprintf(`"known_hosts":%q`,
+				knownhosts.Line([]string{bastion}, otherHostKey))), `{"mode":"disable"}`),
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Empty(t, forwards)
+			},
+		},
+		{
+			testScenario: "BadRequest_ForwardedThroughBastion",
+			asset:        tunnelAsset(passwordTunnel("secret", hostKeyOf(hostKey)), `{"mode":"disable"}`),
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				// the bastion rejects the forward, the destination was asked for through it
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, "warehouse.internal:5432", <-forwards)
+			},
+		},
+		{
+			testScenario: "BadRequest_ForwardedThroughKnownHost",
+			asset: tunnelAsset(passwordTunnel("secret", fmt.Sprintf(`"known_hosts":%q`,
+				knownhosts.Line([]string{bastion}, hostKey))), `{"mode":"disable"}`),
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, "warehouse.internal:5432", <-forwards)
+			},
+		},
+		{
+			testScenario: "BadRequest_ForwardedWithoutVerification",
+			asset:        tunnelAsset(passwordTunnel("secret", `"skip_host_key_verification":true`), `{"mode":"disable"}`),
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, "warehouse.internal:5432", <-forwards)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			store.EXPECT().GetAssetDetails(aID).Times(1).Return(testCase.asset, nil)
+			store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+			store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+			store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+			store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+			store.EXPECT().PreviewData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/%s/preview/", test.BaseURL, aID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// startBastion starts an SSH server accepting the password of the bastion user and returns its address and host key,
+// it records the destinations the forwards are asked for and rejects them.
+func startBastion(t *testing.T, password string) (string, ssh.PublicKey, chan string) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, given []byte) (*ssh.Permissions, error) {
+			if conn.User() == "bastion" && string(given) == password {
+				return nil, nil
+			}
+
+			return nil, errors.New("access denied")
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		listener.Close()
+	})
+
+	forwards := make(chan string, 16)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				_, channels, requests, err := ssh.NewServerConn(conn, config)
+				if err != nil {
+					conn.Close()
+
+					return
+				}
+
+				go ssh.DiscardRequests(requests)
+
+				for channel := range channels {
+					var target struct {
+						Host       string
+						Port       uint32
+						OriginHost string
+						OriginPort uint32
+					}
+
+					_ = ssh.Unmarshal(channel.ExtraData(), &target)
+
+					select {
+					case forwards <- net.JoinHostPort(target.Host, strconv.Itoa(int(target.Port))):
+					default:
+					}
+
+					_ = channel.Reject(ssh.ConnectionFailed, "destination unreachable")
+				}
+			}()
+		}
+	}()
+
+	return listener.Addr().String(), signer.PublicKey(), forwards
+}
+
+// TestExportAsset tests all the scenarios while exporting an asset.
+func TestExportAsset(t *testing.T) {
+	aID, _ := uuid.NewV1()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidFormat",
+			query:        map[string]string{"format": "xml"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetTable(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound_Asset",
+			query:        map[string]string{"format": "csv"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetTable(aID).Times(1).Return(models.AssetTable{}, gorm.ErrRecordNotFound)
+				store.EXPECT().CreateAssetExport(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnsupportedDestination",
+			query:        map[string]string{"format": "csv", "async": "true"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetTable(aID).Times(1).
+					Return(models.AssetTable{AssetName: "users", Raw: true, DestinationType: "clickhouse"}, nil)
+				store.EXPECT().CreateAssetExport(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "export of clickhouse destinations isn't supported")
+			},
+		},
+		{
+			testScenario: "BadRequest_InvalidFilter",
+			query:        map[string]string{"format": "jsonl", "filter": "age"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetTable(aID).Times(1).Return(models.AssetTable{AssetName: "users", Raw: true, DestinationType: "postgres"}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+				store.EXPECT().CreateAssetExport(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_CreateExportDBError",
+			query:        map[string]string{"format": "parquet", "gzip": "true"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				column := createRandomAssetColumn("users")
+
+				store.EXPECT().GetAssetTable(aID).Times(1).Return(models.AssetTable{AssetName: "users", Raw: true, DestinationType: "postgres"}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{column}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+				store.EXPECT().CreateAssetExport(gomock.Any()).Times(1).
+					DoAndReturn(func(export models.AssetExport) (models.AssetExport, error) {
+						require.Equal(t, utils.EXPORT_FORMAT_PARQUET, export.Format)
+						require.True(t, export.Gzip)
+						require.Equal(t, []string{column.Name}, []string(export.Columns))
+						require.Equal(t, utils.EXPORT_STATUS_PENDING, export.Status)
+						require.Equal(t, 1122, export.RequestedBy)
+
+						return models.AssetExport{}, sql.ErrConnDone
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/%s/export/", test.BaseURL, aID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestDownloadAssetExport tests all the scenarios while downloading an asynchronous export.
+func TestDownloadAssetExport(t *testing.T) {
+	exportFile, err := os.CreateTemp("", "export-*.csv")
+	require.NoError(t, err)
+
+	defer os.Remove(exportFile.Name())
+
+	_, err = exportFile.WriteString("id,email\n1,user@example.com\n")
+	require.NoError(t, err)
+	require.NoError(t, exportFile.Close())
+
+	export := createRandomAssetExport()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "NotFound_OtherWorkspace",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				otherExport := export
+				otherExport.WorkspaceID = 1
+
+				store.EXPECT().GetAssetExport(export.ExportID).Times(1).Return(otherExport, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Forbidden_OtherRequester",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				adminExport := export
+				adminExport.RequestedBy = 3344
+				adminExport.FilePath = exportFile.Name()
+
+				store.EXPECT().GetAssetExport(export.ExportID).Times(1).Return(adminExport, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				require.NotContains(t, recorder.Body.String(), "user@example.com")
+			},
+		},
+		{
+			testScenario: "Conflict_Pending",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				pendingExport := export
+				pendingExport.Status = utils.EXPORT_STATUS_PENDING
+
+				store.EXPECT().GetAssetExport(export.ExportID).Times(1).Return(pendingExport, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				completedExport := export
+				completedExport.FilePath = exportFile.Name()
+
+				store.EXPECT().GetAssetExport(export.ExportID).Times(1).Return(completedExport, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Header().Get("Content-Disposition"), "users.csv")
+
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Equal(t, [][]string{{"id", "email"}, {"1", "user@example.com"}}, records)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/exports/%s/download/", test.BaseURL, export.ExportID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestPurgeExpiredExports tests all the scenarios while purging the files of the expired exports.
+func TestPurgeExpiredExports(t *testing.T) {
+	exportDirectory := t.TempDir()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_DBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetExpiredAssetExports(gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().UpdateAssetExport(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				export := createRandomAssetExport()
+				export.FilePath = filepath.Join(exportDirectory, "users.csv")
+				require.NoError(t, os.WriteFile(export.FilePath, []byte("id\n1\n"), 0o600))
+
+				// the file of a missing export is already gone, the export is still marked as expired
+				missingExport := createRandomAssetExport()
+				missingExport.FilePath = filepath.Join(exportDirectory, "missing.csv")
+
+				store.EXPECT().GetExpiredAssetExports(gomock.Any()).Times(1).
+					DoAndReturn(func(completedBefore time.Time) ([]models.AssetExport, error) {
+						require.WithinDuration(t, time.Now().Add(-24*time.Hour), completedBefore, time.Minute)
+
+						return []models.AssetExport{export, missingExport}, nil
+					})
+				store.EXPECT().UpdateAssetExport(gomock.Any()).Times(2).
+					DoAndReturn(func(expired models.AssetExport) error {
+						require.Equal(t, utils.EXPORT_STATUS_EXPIRED, expired.Status)
+						require.Empty(t, expired.FilePath)
+
+						return nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.NoFileExists(t, filepath.Join(exportDirectory, "users.csv"))
+
+				var res models.AssetExportsResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Len(t, res.Data, 2)
+			},
+		},
+		{
+			testScenario: "Success_UpdateFailedLeftOut",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				export := createRandomAssetExport()
+				export.FilePath = filepath.Join(exportDirectory, "orders.csv")
+
+				store.EXPECT().GetExpiredAssetExports(gomock.Any()).Times(1).Return([]models.AssetExport{export}, nil)
+				store.EXPECT().UpdateAssetExport(gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res models.AssetExportsResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Empty(t, res.Data)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/internal/exports/purge/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetAssetExport tests all the scenarios while getting the status of an export.
+func TestGetAssetExport(t *testing.T) {
+	export := createRandomAssetExport()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_DBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetExport(export.ExportID).Times(1).Return(models.AssetExport{}, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetExport(export.ExportID).Times(1).Return(export, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				expectedExport := export
+				expectedExport.DownloadURL = fmt.Sprintf("%sassets/exports/%s/download/", test.BaseURL, export.ExportID)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   expectedExport}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_OtherRequesterWithoutDownload",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				adminExport := export
+				adminExport.RequestedBy = 3344
+
+				store.EXPECT().GetAssetExport(export.ExportID).Times(1).Return(adminExport, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				expectedExport := export
+				expectedExport.RequestedBy = 3344
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   expectedExport}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/exports/%s/", test.BaseURL, export.ExportID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetAssetProfile tests all the scenarios while getting the latest profile of an asset.
+func TestGetAssetProfile(t *testing.T) {
+	aID, _ := uuid.NewV1()
+	column := createRandomAssetColumn("users")
+	column.Classifications = []string{"email"}
+
+	mean := 12.5
+	columnProfiles := []models.ColumnProfile{
+		{
+			Name:          column.Name,
+			Type:          "string",
+			DistinctCount: 2,
+			TopValues:     []models.ValueCount{{Value: "user@example.com", Count: 2}},
+			Length:        &models.LengthProfile{Min: 10, Max: 16, Mean: 13},
+		},
+		{Name: "age", Type: "integer", DistinctCount: 2, Mean: &mean},
+	}
+
+	profileColumns, err := json.Marshal(columnProfiles)
+	require.NoError(t, err)
+
+	profile := models.AssetProfile{AssetID: aID.String(), RowCount: 3, Columns: profileColumns, ProfiledAt: 1660000000000}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "NotFound_NotProfiled",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetLatestAssetProfiles([]string{aID.String()}).Times(1).Return([]models.AssetProfile{}, nil)
+				store.EXPECT().GetMaskingPolicies(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_DBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetLatestAssetProfiles([]string{aID.String()}).Times(1).Return(nil, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_MaskedColumn",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetLatestAssetProfiles([]string{aID.String()}).Times(1).Return([]models.AssetProfile{profile}, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).
+					Return([]models.MaskingPolicy{{Classification: "email", Method: utils.MASKING_METHOD_REDACT}}, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{column}, nil)
+				store.EXPECT().GetAssetTable(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				maskedProfiles := []models.ColumnProfile{
+					{Name: column.Name, Type: "string", DistinctCount: 2},
+					columnProfiles[1],
+				}
+				maskedColumns, e := json.Marshal(maskedProfiles)
+				require.NoError(t, e)
+
+				maskedProfile := profile
+				maskedProfile.Columns = maskedColumns
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   maskedProfile}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_RowFilterOnOtherAsset",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetLatestAssetProfiles([]string{aID.String()}).Times(1).Return([]models.AssetProfile{profile}, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).
+					Return([]models.RowFilter{{Column: "region", Operator: utils.ROW_FILTER_EQ, Values: []string{"eu"}}}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{column}, nil)
+				store.EXPECT().GetAssetTable(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   profile}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "BadRequest_RowFilteredProfileOnUnsupportedDestination",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetLatestAssetProfiles([]string{aID.String()}).Times(1).Return([]models.AssetProfile{profile}, nil)
+				store.EXPECT().GetAssetWorkspaceID(aID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(aID).Times(1).
+					Return([]models.RowFilter{{Column: column.Name, Operator: utils.ROW_FILTER_EQ, Values: []string{"user@example.com"}}}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return([]models.AssetColumn{column}, nil)
+				store.EXPECT().GetAssetTable(aID).Times(1).
+					Return(models.AssetTable{AssetName: "users", DestinationType: "mssql"}, nil)
+				store.EXPECT().ProfileTable(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAssetProfile(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.NotContains(t, recorder.Body.String(), "user@example.com")
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/%s/profile/", test.BaseURL, aID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetPipelineAssetsProfiles tests that the asset lists carry the stored profiles without profiling the assets on
+// their destination, and that the profiles which can't be returned are left out rather than failing the list.
+func TestGetPipelineAssetsProfiles(t *testing.T) {
+	pID, _ := uuid.NewV1()
+	filteredID, _ := uuid.NewV1()
+	profiledID, _ := uuid.NewV1()
+	column := createRandomAssetColumn("users")
+
+	assets := []models.PipelineAssets{
+		{AssetID: filteredID.String(), Name: "users"},
+		{AssetID: profiledID.String(), Name: "orders"},
+	}
+	profiles := []models.AssetProfile{
+		{AssetID: filteredID.String(), RowCount: 3, Columns: []byte("[]"), ProfiledAt: 1660000000000},
+		{AssetID: profiledID.String(), RowCount: 5, Columns: []byte("[]"), ProfiledAt: 1660000000000},
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Success_RowFilteredProfileLeftOut",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetPipelineAssets(pID).Times(1).Return(assets, nil)
+				store.EXPECT().GetLatestAssetProfiles([]string{filteredID.String(), profiledID.String()}).Times(1).
+					Return(profiles, nil)
+				store.EXPECT().GetAssetWorkspaceID(gomock.Any()).Times(2).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(filteredID).Times(1).
+					Return([]models.RowFilter{{Column: column.Name, Operator: utils.ROW_FILTER_EQ, Values: []string{"eu"}}}, nil)
+				store.EXPECT().GetAssetColumns(filteredID).Times(1).Return([]models.AssetColumn{column}, nil)
+				store.EXPECT().GetAssetRowFilters(profiledID).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetTable(gomock.Any()).Times(0)
+				store.EXPECT().ProfileTable(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				expected := []models.PipelineAssets{assets[0], assets[1]}
+				expected[1].Profile = &profiles[1]
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   expected}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_ProfileErrorLeftOut",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetPipelineAssets(pID).Times(1).Return(assets, nil)
+				store.EXPECT().GetLatestAssetProfiles(gomock.Any()).Times(1).Return(profiles, nil)
+				store.EXPECT().GetAssetWorkspaceID(filteredID).Times(1).Return(0, sql.ErrConnDone)
+				store.EXPECT().GetAssetWorkspaceID(profiledID).Times(1).Return(1122, nil)
+				store.EXPECT().GetMaskingPolicies(1122).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetRowFilters(profiledID).Times(1).Return(nil, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				expected := []models.PipelineAssets{assets[0], assets[1]}
+				expected[1].Profile = &profiles[1]
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   expected}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_ProfilesDBErrorLeftOut",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetPipelineAssets(pID).Times(1).Return(assets, nil)
+				store.EXPECT().GetLatestAssetProfiles(gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   assets}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/pipeline/%s/", test.BaseURL, pID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestProfileAsset tests the scenarios of profiling an asset which fail before reaching its destination.
+func TestProfileAsset(t *testing.T) {
+	aID, _ := uuid.NewV1()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "NotFound_Asset",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetTable(aID).Times(1).Return(models.AssetTable{}, gorm.ErrRecordNotFound)
+				store.EXPECT().ProfileTable(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_ColumnsDBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetTable(aID).Times(1).Return(models.AssetTable{AssetName: "users", Raw: true}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().CreateAssetProfile(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnsupportedDestination",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetTable(aID).Times(1).
+					Return(models.AssetTable{AssetName: "users", Raw: true, DestinationType: "mssql"}, nil)
+				store.EXPECT().GetAssetColumns(aID).Times(1).Return(nil, nil)
+				store.EXPECT().ProfileTable(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAssetProfile(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.ASSETS, store, nil, nil)
+			url := fmt.Sprintf("%sassets/%s/profile/", test.BaseURL, aID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+func createRandomAssetExport() models.AssetExport {
+	eID, _ := uuid.NewV1()
+	aID, _ := uuid.NewV1()
+
+	return models.AssetExport{
+		ExportID:    eID,
+		AssetID:     aID.String(),
+		AssetName:   "users",
+		Format:      utils.EXPORT_FORMAT_CSV,
+		Async:       true,
+		RowLimit:    1000,
+		RowCount:    1,
+		Status:      utils.EXPORT_STATUS_COMPLETED,
+		RequestedBy: 1122,
+		WorkspaceID: 1122,
+	}
+}
+
+func createRandomAssetColumn(assetName string) models.AssetColumn {
+	cID, _ := uuid.NewV1()
+	aID, _ := uuid.NewV1()
+	pID, _ := uuid.NewV1()
+
+	return models.AssetColumn{
+		ColumnID:    cID,
+		AssetID:     aID.String(),
+		ParentID:    pID.String(),
+		AssetName:   assetName,
+		Name:        utils.RandomString(8),
+		Type:        "string",
+		Nullable:    true,
+		Position:    int(utils.RandomInt(1, 10)),
+		WorkspaceID: 1122,
+	}
+}
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+
+	os.Exit(m.Run())
+}
@@ -0,0 +1,82 @@
+package assets
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"pipelineService/models/v1"
+)
+
+var dictionaryHeader = []string{"Asset", "Column", "Type", "Nullable", "Description", "Tags", "Documented By"}
+
+func dictionaryRow(column models.AssetColumn) []string {
+	documentedBy := ""
+	if column.DocumentedBy != 0 {
+		documentedBy = strconv.Itoa(column.DocumentedBy)
+	}
+
+	return []string{
+		column.AssetName,
+		column.Name,
+		column.Type,
+		strconv.FormatBool(column.Nullable),
+		column.Description,
+		strings.Join(column.Tags, ", "),
+		documentedBy,
+	}
+}
+
+// writeCSVDictionary writes the data dictionary as a single CSV table.
+func writeCSVDictionary(w io.Writer, columns []models.AssetColumn) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(dictionaryHeader); err != nil {
+		return err
+	}
+
+	for _, column := range columns {
+		if err := writer.Write(dictionaryRow(column)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// writeMarkdownDictionary writes the data dictionary with a section and a table per asset, the columns are expected
+// to be ordered by asset.
+func writeMarkdownDictionary(w io.Writer, title string, columns []models.AssetColumn) error {
+	if _, err := fmt.Fprintf(w, "# %s\n", title); err != nil {
+		return err
+	}
+
+	escaper := strings.NewReplacer("|", "\\|", "\r\n", " ", "\n", " ")
+	asset := ""
+
+	for i, column := range columns {
+		if i == 0 || column.AssetName != asset {
+			asset = column.AssetName
+
+			if _, err := fmt.Fprintf(w, "\n## %s\n\n| %s |\n|%s\n", asset,
+				strings.Join(dictionaryHeader[1:], " | "), strings.Repeat(" --- |", len(dictionaryHeader)-1)); err != nil {
+				return err
+			}
+		}
+
+		row := dictionaryRow(column)[1:]
+		for j := range row {
+			row[j] = escaper.Replace(row[j])
+		}
+
+		if _, err := fmt.Fprintf(w, "| %s |\n", strings.Join(row, " | ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
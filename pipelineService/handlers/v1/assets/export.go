@@ -0,0 +1,328 @@
+package assets
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/services/destinationPool"
+	"pipelineService/utils"
+)
+
+// buildExportQuery selects the rows of the export like a preview, capped at the configured max rows. Raw assets are
+// exported as their cataloged columns instead of the JSON data column.
+func (g previewGovernance) buildExportQuery(request models.AssetExportRequest, raw bool) (models.PreviewQuery, error) {
+	query, err := g.buildPreviewQuery(models.PreviewRequest{
+		Columns: request.Columns,
+		Filters: request.Filters,
+		OrderBy: request.OrderBy,
+	})
+	if err != nil {
+		return query, err
+	}
+
+	query.Limit = request.Limit
+	if query.Limit <= 0 || query.Limit > env.Env.ExportMaxRows {
+		query.Limit = env.Env.ExportMaxRows
+	}
+
+	if raw && len(query.Columns) == 0 {
+		for _, column := range g.columns {
+			query.Columns = append(query.Columns, column.Name)
+		}
+	}
+
+	return query, nil
+}
+
+func exportFileName(assetName string, format string, compress bool) string {
+	fileName := fmt.Sprintf("%s.%s", assetName, format)
+	if compress {
+		fileName += ".gz"
+	}
+
+	return fileName
+}
+
+func exportContentType(format string, compress bool) string {
+	if compress {
+		return "application/gzip"
+	}
+
+	switch format {
+	case utils.EXPORT_FORMAT_CSV:
+		return "text/csv"
+	case utils.EXPORT_FORMAT_JSONL:
+		return "application/x-ndjson"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+func (server *Server) exportDownloadURL(exportID uuid.UUID) string {
+	return path.Join(server.RouterGroup.BasePath(), "assets/exports", exportID.String(), "download") + "/"
+}
+
+// writeExport streams the rows of the asset to the writer in the format of the export, masked for the caller. It
+// returns the number of rows written.
+func writeExport(writer io.Writer, table models.AssetTable, query models.PreviewQuery, methods map[string]string,
+	store db.Store, format string, compress bool) (int64, error) {
+	dbConn, err := destinationPool.AssetPostgres(table)
+	if err != nil {
+		return 0, err
+	}
+
+	var gzipWriter *gzip.Writer
+
+	if compress {
+		gzipWriter = gzip.NewWriter(writer)
+		writer = gzipWriter
+	}
+
+	rowWriter := newExportWriter(format, writer)
+
+	var rows int64
+
+	var columnNames []string
+
+	columns, err := store.StreamData(dbConn, table.Schema, table.Table, query,
+		func(columns []models.PreviewColumn, row map[string]interface{}) error {
+			if columnNames == nil {
+				columnNames = exportColumnNames(columns)
+				if err := rowWriter.writeHeader(columnNames); err != nil {
+					return err
+				}
+			}
+
+			maskRow(row, methods)
+
+			values := make([]interface{}, len(columnNames))
+			for i, column := range columnNames {
+				values[i] = row[column]
+			}
+
+			rows++
+
+			return rowWriter.writeRow(values)
+		})
+	if err != nil {
+		return rows, err
+	}
+
+	if columnNames == nil {
+		if err = rowWriter.writeHeader(exportColumnNames(columns)); err != nil {
+			return rows, err
+		}
+	}
+
+	if err = rowWriter.close(); err != nil {
+		return rows, err
+	}
+
+	if gzipWriter != nil {
+		return rows, gzipWriter.Close()
+	}
+
+	return rows, nil
+}
+
+// generateExport writes an asynchronous export to the export directory and records its outcome.
+func (server *Server) generateExport(export models.AssetExport, table models.AssetTable, query models.PreviewQuery,
+	methods map[string]string) {
+	var rows int64
+
+	var file *os.File
+
+	filePath := filepath.Join(env.Env.ExportDirectory, exportFileName(export.ExportID.String(), export.Format, export.Gzip))
+
+	// the export runs after the response, a panic while streaming it fails the export instead of the service
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			if file != nil {
+				_ = file.Close()
+				_ = os.Remove(filePath)
+			}
+
+			server.completeExport(export, rows, fmt.Errorf("export failed: %v", recovered))
+		}
+	}()
+
+	err := os.MkdirAll(env.Env.ExportDirectory, 0o750)
+	if err == nil {
+		file, err = os.Create(filePath)
+		if err == nil {
+			rows, err = writeExport(file, table, query, methods, server.Store, export.Format, export.Gzip)
+
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+
+			if err != nil {
+				_ = os.Remove(filePath)
+			}
+		}
+	}
+
+	if err == nil {
+		export.FilePath = filePath
+	}
+
+	server.completeExport(export, rows, err)
+}
+
+func (server *Server) completeExport(export models.AssetExport, rows int64, err error) {
+	logger := utils.GetLogger()
+
+	export.RowCount = rows
+	export.Status = utils.EXPORT_STATUS_COMPLETED
+	export.CompletedAt = time.Now().UnixMilli()
+
+	if err != nil {
+		logger.Error(err.Error())
+
+		export.Status = utils.EXPORT_STATUS_FAILED
+		export.Error = err.Error()
+	}
+
+	if err = server.Store.UpdateAssetExport(export); err != nil {
+		logger.Error(err.Error())
+	}
+}
+
+func exportColumnNames(columns []models.PreviewColumn) []string {
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		names = append(names, column.Name)
+	}
+
+	return names
+}
+
+// exportText returns the text of a value, nested documents are written as JSON.
+func exportText(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case []byte:
+		return string(typed)
+	case time.Time:
+		return typed.Format(time.RFC3339Nano)
+	case map[string]interface{}, []interface{}:
+		document, _ := json.Marshal(typed)
+
+		return string(document)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
+
+type exportWriter interface {
+	writeHeader(columns []string) error
+	writeRow(values []interface{}) error
+	close() error
+}
+
+func newExportWriter(format string, writer io.Writer) exportWriter {
+	switch format {
+	case utils.EXPORT_FORMAT_JSONL:
+		return &jsonlExportWriter{encoder: json.NewEncoder(writer)}
+	case utils.EXPORT_FORMAT_PARQUET:
+		return &parquetExportWriter{writer: writer}
+	default:
+		return &csvExportWriter{writer: csv.NewWriter(writer)}
+	}
+}
+
+type csvExportWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvExportWriter) writeHeader(columns []string) error {
+	return w.writer.Write(columns)
+}
+
+func (w *csvExportWriter) writeRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		record[i] = exportText(value)
+	}
+
+	return w.writer.Write(record)
+}
+
+func (w *csvExportWriter) close() error {
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
+
+type jsonlExportWriter struct {
+	encoder *json.Encoder
+	columns []string
+}
+
+func (w *jsonlExportWriter) writeHeader(columns []string) error {
+	w.columns = columns
+
+	return nil
+}
+
+func (w *jsonlExportWriter) writeRow(values []interface{}) error {
+	record := make(map[string]interface{}, len(values))
+
+	for i, value := range values {
+		switch typed := value.(type) {
+		case []byte:
+			record[w.columns[i]] = string(typed)
+		default:
+			record[w.columns[i]] = typed
+		}
+	}
+
+	return w.encoder.Encode(record)
+}
+
+func (w *jsonlExportWriter) close() error {
+	return nil
+}
+
+// parquetExportWriter writes all the columns as nullable UTF8 strings.
+type parquetExportWriter struct {
+	writer  io.Writer
+	parquet *utils.ParquetWriter
+}
+
+func (w *parquetExportWriter) writeHeader(columns []string) (err error) {
+	w.parquet, err = utils.NewParquetWriter(w.writer, columns)
+
+	return err
+}
+
+func (w *parquetExportWriter) writeRow(values []interface{}) error {
+	record := make([]*string, len(values))
+
+	for i, value := range values {
+		if value != nil {
+			text := exportText(value)
+			record[i] = &text
+		}
+	}
+
+	return w.parquet.WriteRow(record)
+}
+
+func (w *parquetExportWriter) close() error {
+	return w.parquet.Close()
+}
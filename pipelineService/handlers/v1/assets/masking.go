@@ -0,0 +1,140 @@
+package assets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// maskingStrength orders the methods so the strongest one wins when a column carries several classifications.
+var maskingStrength = map[string]int{
+	utils.MASKING_METHOD_PARTIAL: 1,
+	utils.MASKING_METHOD_HASH:    2,
+	utils.MASKING_METHOD_REDACT:  3,
+	utils.MASKING_METHOD_NULL:    4,
+}
+
+// previewGovernance holds what is enforced on the preview of an asset for the caller's role.
+type previewGovernance struct {
+	role     string
+	columns  []models.AssetColumn
+	filters  []models.RowFilter
+	policies []models.MaskingPolicy
+}
+
+func isExempt(role string, exemptRoles []string) bool {
+	for _, exemptRole := range exemptRoles {
+		if exemptRole == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rowFilters returns the filters enforced on the caller. When the asset is cataloged, the filters on columns it
+// doesn't have are left out since they target the other assets of the data product.
+func (g previewGovernance) rowFilters() []models.RowFilter {
+	columns := make(map[string]bool, len(g.columns))
+	for _, column := range g.columns {
+		columns[column.Name] = true
+	}
+
+	filters := make([]models.RowFilter, 0, len(g.filters))
+
+	for _, filter := range g.filters {
+		if isExempt(g.role, filter.ExemptRoles) {
+			continue
+		}
+
+		if len(columns) > 0 && !columns[filter.Column] {
+			continue
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters
+}
+
+// maskingMethods returns the masking method of each classified column enforced on the caller.
+func (g previewGovernance) maskingMethods() map[string]string {
+	policies := make(map[string]string, len(g.policies))
+	for _, policy := range g.policies {
+		if !isExempt(g.role, policy.ExemptRoles) {
+			policies[policy.Classification] = policy.Method
+		}
+	}
+
+	methods := make(map[string]string)
+
+	for _, column := range g.columns {
+		for _, classification := range column.Classifications {
+			method, ok := policies[classification]
+			if ok && maskingStrength[method] > maskingStrength[methods[column.Name]] {
+				methods[column.Name] = method
+			}
+		}
+	}
+
+	return methods
+}
+
+// mask applies the masking policies on the previewed rows, including the columns nested in the data column of raw rows.
+func (g previewGovernance) mask(rows []map[string]interface{}) []map[string]interface{} {
+	methods := g.maskingMethods()
+	if len(methods) == 0 {
+		return rows
+	}
+
+	for _, row := range rows {
+		maskRow(row, methods)
+	}
+
+	return rows
+}
+
+func maskRow(row map[string]interface{}, methods map[string]string) {
+	maskColumns(row, methods)
+
+	if data, ok := utils.DecodeAirbyteData(row); ok {
+		maskColumns(data, methods)
+		utils.EncodeAirbyteData(row, data)
+	}
+}
+
+func maskColumns(row map[string]interface{}, methods map[string]string) {
+	for column, method := range methods {
+		if value, ok := row[column]; ok && value != nil {
+			row[column] = maskValue(method, value)
+		}
+	}
+}
+
+func maskValue(method string, value interface{}) interface{} {
+	text := fmt.Sprint(value)
+
+	switch method {
+	case utils.MASKING_METHOD_HASH:
+		hash := sha256.Sum256([]byte(text))
+
+		return hex.EncodeToString(hash[:])
+	case utils.MASKING_METHOD_REDACT:
+		return utils.REDACTED_VALUE
+	case utils.MASKING_METHOD_PARTIAL:
+		runes := []rune(text)
+		if len(runes) <= utils.MASKING_VISIBLE_CHARS {
+			return strings.Repeat("*", len(runes))
+		}
+
+		visible := len(runes) - utils.MASKING_VISIBLE_CHARS
+
+		return strings.Repeat("*", visible) + string(runes[visible:])
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,126 @@
+package assets
+
+import (
+	"fmt"
+	"strings"
+
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// buildPreviewQuery parses the preview request of the caller and adds the row filters enforced on it. Masked columns
+// can be selected but can't be filtered or ordered on, their values would leak through the matched rows and cursors.
+func (g previewGovernance) buildPreviewQuery(request models.PreviewRequest) (models.PreviewQuery, error) {
+	query := models.PreviewQuery{
+		Limit:    request.Limit,
+		Offset:   request.Offset,
+		RawTypes: make(map[string]string, len(g.columns)),
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = utils.PREVIEW_DATA_LIMIT
+	}
+
+	if query.Limit > env.Env.PreviewMaxLimit {
+		query.Limit = env.Env.PreviewMaxLimit
+	}
+
+	for _, column := range g.columns {
+		query.RawTypes[column.Name] = column.Type
+	}
+
+	masked := g.maskingMethods()
+
+	for _, column := range strings.Split(request.Columns, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			query.Columns = append(query.Columns, column)
+		}
+	}
+
+	for _, expression := range request.Filters {
+		parts := strings.SplitN(expression, ":", 3)
+		if len(parts) < 2 || parts[0] == "" {
+			return query, fmt.Errorf("filter %q must be written as column:operator:value", expression)
+		}
+
+		filter := models.PreviewFilter{Column: parts[0], Operator: parts[1]}
+		if len(parts) == 3 {
+			filter.Values = strings.Split(parts[2], ",")
+		}
+
+		if err := validatePreviewFilter(filter); err != nil {
+			return query, err
+		}
+
+		if _, ok := masked[filter.Column]; ok {
+			return query, fmt.Errorf("column %s is masked and can't be filtered", filter.Column)
+		}
+
+		query.Filters = append(query.Filters, filter)
+	}
+
+	query.OrderBy = strings.TrimPrefix(request.OrderBy, "-")
+	query.Descending = strings.HasPrefix(request.OrderBy, "-")
+
+	if _, ok := masked[query.OrderBy]; ok {
+		return query, fmt.Errorf("column %s is masked and can't be ordered", query.OrderBy)
+	}
+
+	if request.Cursor != "" {
+		if query.OrderBy == "" {
+			return query, fmt.Errorf("cursor requires orderBy")
+		}
+
+		if request.Offset > 0 {
+			return query, fmt.Errorf("cursor and offset can't be used together")
+		}
+
+		cursor, err := utils.DecodePreviewCursor(request.Cursor)
+		if err != nil {
+			return query, err
+		}
+
+		query.After = &cursor
+	}
+
+	query.Filters = append(query.Filters, previewFilters(g.rowFilters())...)
+
+	return query, nil
+}
+
+// previewFilters converts the row filters enforced on the caller to the filters of a preview query.
+func previewFilters(rowFilters []models.RowFilter) []models.PreviewFilter {
+	filters := make([]models.PreviewFilter, 0, len(rowFilters))
+
+	for _, rowFilter := range rowFilters {
+		filters = append(filters, models.PreviewFilter{
+			Column:   rowFilter.Column,
+			Operator: rowFilter.Operator,
+			Values:   rowFilter.Values,
+		})
+	}
+
+	return filters
+}
+
+func validatePreviewFilter(filter models.PreviewFilter) error {
+	switch filter.Operator {
+	case utils.ROW_FILTER_IS_NULL, utils.ROW_FILTER_NOT_NULL:
+		if len(filter.Values) > 0 {
+			return fmt.Errorf("filter operator %s takes no value", filter.Operator)
+		}
+	case utils.ROW_FILTER_IN, utils.ROW_FILTER_NOT_IN:
+		if len(filter.Values) == 0 {
+			return fmt.Errorf("filter operator %s requires values", filter.Operator)
+		}
+	case utils.ROW_FILTER_EQ, utils.ROW_FILTER_NEQ, utils.ROW_FILTER_GT, utils.ROW_FILTER_GTE, utils.ROW_FILTER_LT, utils.ROW_FILTER_LTE:
+		if len(filter.Values) != 1 {
+			return fmt.Errorf("filter operator %s requires exactly one value", filter.Operator)
+		}
+	default:
+		return fmt.Errorf("filter operator %s is not supported", filter.Operator)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package assets
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"pipelineService/models/v1"
+	"pipelineService/services/profiling"
+	"pipelineService/utils"
+)
+
+// getAssetProfiles returns the latest profile of each of the assets of a list, masked for the caller with the masking
+// policies of the workspace of the asset. The stored profiles cover every row, so the assets with row filters enforced
+// on the caller are left out instead of being profiled again for every asset of the list, the profile endpoint of the
+// asset returns their statistics. The profiles which can't be governed are left out rather than failing the list.
+func (server *Server) getAssetProfiles(ctx *gin.Context, assetIDs []string) map[string]*models.AssetProfile {
+	logger := utils.GetLogger()
+	profiles := make(map[string]*models.AssetProfile)
+
+	latestProfiles, err := server.Store.GetLatestAssetProfiles(assetIDs)
+	if err != nil {
+		logger.Error(err.Error())
+
+		return profiles
+	}
+
+	role := utils.GetUserRoleFromContext(ctx)
+	workspacePolicies := make(map[int][]models.MaskingPolicy)
+
+	for i := range latestProfiles {
+		profile, err := server.governProfile(latestProfiles[i], role, workspacePolicies, false)
+		if err != nil {
+			logger.Error(err.Error())
+
+			continue
+		}
+
+		if profile != nil {
+			profiles[profile.AssetID] = profile
+		}
+	}
+
+	return profiles
+}
+
+// getAssetProfile returns the latest profile of the asset masked for the caller, the asset is profiled again on the rows
+// the caller can preview when row filters are enforced on the caller. It returns nil when the asset hasn't been
+// profiled.
+func (server *Server) getAssetProfile(ctx *gin.Context, assetID uuid.UUID) (*models.AssetProfile, error) {
+	latestProfiles, err := server.Store.GetLatestAssetProfiles([]string{assetID.String()})
+	if err != nil || len(latestProfiles) == 0 {
+		return nil, err
+	}
+
+	return server.governProfile(latestProfiles[0], utils.GetUserRoleFromContext(ctx),
+		make(map[int][]models.MaskingPolicy), true)
+}
+
+// governProfile enforces the masking policies and the row filters of the workspace of the asset on its profile for the
+// role. The profile of an asset with row filters enforced on the role is computed again on the rows the role can
+// preview when filterRows is set, it is left out otherwise.
+func (server *Server) governProfile(profile models.AssetProfile, role string,
+	workspacePolicies map[int][]models.MaskingPolicy, filterRows bool) (*models.AssetProfile, error) {
+	assetID := uuid.FromStringOrNil(profile.AssetID)
+	governance := previewGovernance{role: role}
+
+	workspaceID, err := server.Store.GetAssetWorkspaceID(assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, ok := workspacePolicies[workspaceID]
+	if !ok {
+		if policies, err = server.Store.GetMaskingPolicies(workspaceID); err != nil {
+			return nil, err
+		}
+
+		workspacePolicies[workspaceID] = policies
+	}
+
+	governance.policies = policies
+
+	if governance.filters, err = server.Store.GetAssetRowFilters(assetID); err != nil {
+		return nil, err
+	}
+
+	if len(governance.filters) > 0 || len(governance.policies) > 0 {
+		if governance.columns, err = server.Store.GetAssetColumns(assetID); err != nil {
+			return nil, err
+		}
+	}
+
+	// the stored profile covers every row, the callers restricted by row filters get the statistics of the rows
+	// their preview returns instead
+	if rowFilters := governance.rowFilters(); len(rowFilters) > 0 {
+		if !filterRows {
+			return nil, nil
+		}
+
+		profile, err = profiling.ProfileFilteredAsset(server.Store, assetID, governance.columns, previewFilters(rowFilters))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(governance.policies) > 0 {
+		if profile, err = maskProfile(profile, governance.maskingMethods()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &profile, nil
+}
+
+// maskProfile drops the value statistics of the columns masked for the caller, their counts are kept.
+func maskProfile(profile models.AssetProfile, methods map[string]string) (models.AssetProfile, error) {
+	if len(methods) == 0 {
+		return profile, nil
+	}
+
+	var columns []models.ColumnProfile
+	if err := json.Unmarshal(profile.Columns, &columns); err != nil {
+		return profile, err
+	}
+
+	for i := range columns {
+		if _, ok := methods[columns[i].Name]; ok {
+			columns[i].Min, columns[i].Max, columns[i].Mean = nil, nil, nil
+			columns[i].TopValues = nil
+			columns[i].Length = nil
+		}
+	}
+
+	var err error
+	profile.Columns, err = json.Marshal(columns)
+
+	return profile, err
+}
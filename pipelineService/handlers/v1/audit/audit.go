@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+type Server struct {
+	Store       db.Store
+	Router      *gin.Engine
+	RouterGroup *gin.RouterGroup
+}
+
+// GetAuditLogs returns the audit log of the workspace
+// @Summary Get Audit Logs
+// @Description Returns the mutating actions performed in the workspace, latest first
+// @Tags audit
+// @Produce  json
+// @Param actor query int false "User ID of the actor"
+// @Param action query string false "Action i.e. name of the endpoint"
+// @Param resourceType query string false "Resource Type"
+// @Param resourceId query string false "Resource ID"
+// @Param from query int false "Start of the time range in epoch milliseconds"
+// @Param to query int false "End of the time range in epoch milliseconds"
+// @Param limit query int false "Maximum number of entries"
+// @Param offset query int false "Number of entries to skip"
+// @Success 200 {object} models.AuditLogsResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /audit/ [get].
+func (server *Server) GetAuditLogs(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetAuditLogs endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	var filter models.AuditLogFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = utils.AUDIT_DEFAULT_LIMIT
+	}
+
+	if filter.Limit > utils.AUDIT_MAX_LIMIT {
+		filter.Limit = utils.AUDIT_MAX_LIMIT
+	}
+
+	auditLogs, err := server.Store.GetAuditLogs(workspaceID, filter)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Audit Logs")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", auditLogs)
+	logger.Info("GetAuditLogs endpoint returned successfully")
+}
+
+// ExportAuditLogs exports the audit log of the workspace as JSONL
+// @Summary Export Audit Logs
+// @Description Exports the mutating actions performed in the workspace as JSON lines, one entry per line
+// @Tags audit
+// @Produce  application/x-ndjson
+// @Param actor query int false "User ID of the actor"
+// @Param action query string false "Action i.e. name of the endpoint"
+// @Param resourceType query string false "Resource Type"
+// @Param resourceId query string false "Resource ID"
+// @Param from query int false "Start of the time range in epoch milliseconds"
+// @Param to query int false "End of the time range in epoch milliseconds"
+// @Success 200 {string} string
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /audit/export/ [get].
+func (server *Server) ExportAuditLogs(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ExportAuditLogs endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	var filter models.AuditLogFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	// the export contains all the matching entries
+	filter.Limit = 0
+	filter.Offset = 0
+
+	auditLogs, err := server.Store.GetAuditLogs(workspaceID, filter)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Audit Logs")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	ctx.Header("Content-Disposition", "attachment; filename=audit.jsonl")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Header().Set("Content-Type", "application/x-ndjson")
+
+	encoder := json.NewEncoder(ctx.Writer)
+	for _, auditLog := range auditLogs {
+		if err = encoder.Encode(auditLog); err != nil {
+			logger.Error(err.Error())
+
+			return
+		}
+	}
+
+	logger.Info("ExportAuditLogs endpoint returned successfully")
+}
@@ -0,0 +1,340 @@
+package audit_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"pipelineService/controllers/v1/audit"
+	"pipelineService/handlers/v1/test"
+	"pipelineService/models/v1"
+	mockStore "pipelineService/services/db/mocks"
+	"pipelineService/utils"
+)
+
+// TestGetAuditLogs tests all the scenarios while getting the audit log of a workspace.
+func TestGetAuditLogs(t *testing.T) {
+	mockAuditLogs := []models.AuditLog{createRandomAuditLog(), createRandomAuditLog()}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest",
+
+			query: map[string]string{"actor": "invalid"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAuditLogs(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_DBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAuditLogs(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			query: map[string]string{"resourceType": "sources", "limit": "5000"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				filter := models.AuditLogFilter{ResourceType: "sources", Limit: utils.AUDIT_MAX_LIMIT}
+
+				store.EXPECT().GetAuditLogs(1122, filter).Times(1).Return(mockAuditLogs, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockAuditLogs}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.AUDIT, store, nil, nil)
+			url := fmt.Sprintf("%saudit/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestExportAuditLogs tests that the audit log is exported as one JSON entry per line.
+func TestExportAuditLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuditLogs := []models.AuditLog{createRandomAuditLog(), createRandomAuditLog()}
+
+	store := mockStore.NewMockStore(ctrl)
+	store.EXPECT().GetAuditLogs(1122, models.AuditLogFilter{}).Times(1).Return(mockAuditLogs, nil)
+
+	server := test.NewTestServer(test.AUDIT, store, nil, nil)
+	url := fmt.Sprintf("%saudit/export/", test.BaseURL)
+	recorder, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/x-ndjson", recorder.Header().Get("Content-Type"))
+
+	lines := strings.Split(strings.TrimSpace(recorder.Body.String()), "\n")
+	require.Len(t, lines, len(mockAuditLogs))
+
+	for i, line := range lines {
+		var auditLog models.AuditLog
+		require.NoError(t, json.Unmarshal([]byte(line), &auditLog))
+		require.Equal(t, mockAuditLogs[i].AuditID, auditLog.AuditID)
+	}
+}
+
+// TestAuditTrail tests that the mutating requests are recorded with their diff and redacted secrets.
+func TestAuditTrail(t *testing.T) {
+	sID, _ := uuid.NewV1()
+	mockSourceID := sID.String()
+
+	testCaseSuite := []struct {
+		testScenario string
+		method       string
+		resource     string
+		contentType  string
+		body         string
+		buildStubs   func(store *mockStore.MockStore)
+	}{
+		{
+			testScenario: "GetRequestIsNotRecorded",
+
+			method: http.MethodGet,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAuditSnapshot(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAuditLog(gomock.Any()).Times(0)
+			},
+		},
+		{
+			testScenario: "InternalRequestIsNotRecorded",
+
+			method:   http.MethodPost,
+			resource: "pipelines/internal",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAuditSnapshot(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAuditLog(gomock.Any()).Times(0)
+			},
+		},
+		{
+			testScenario: "RecordsDiff",
+
+			method: http.MethodPut,
+
+			body: `{"connectionConfiguration":{"password":"secret"}}`,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().GetAuditSnapshot("sources", mockSourceID).Times(1).
+						Return(datatypes.JSON(`{"name":"old","owner":1,"password":"old"}`), nil),
+					store.EXPECT().GetAuditSnapshot("sources", mockSourceID).Times(1).
+						Return(datatypes.JSON(`{"name":"new","owner":1,"password":"new"}`), nil),
+				)
+				store.EXPECT().CreateAuditLog(gomock.Any()).Times(1).DoAndReturn(func(auditLog models.AuditLog) error {
+					require.Equal(t, 1122, auditLog.Actor)
+					require.Equal(t, 1122, auditLog.WorkspaceID)
+					require.Equal(t, "sources", auditLog.ResourceType)
+					require.Equal(t, mockSourceID, auditLog.ResourceID)
+					require.Equal(t, "request-id", auditLog.RequestID)
+					require.Equal(t, http.StatusOK, auditLog.StatusCode)
+					require.JSONEq(t, `{"name":"old"}`, string(auditLog.Before))
+					require.JSONEq(t, `{"name":"new"}`, string(auditLog.After))
+
+					return nil
+				})
+			},
+		},
+		{
+			testScenario: "RecordsRedactedRequestBody",
+
+			method: http.MethodPut,
+
+			body: `{"connectionConfiguration":{"host":"localhost","password":"secret"}}`,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAuditSnapshot("sources", mockSourceID).Times(2).Return(nil, nil)
+				store.EXPECT().CreateAuditLog(gomock.Any()).Times(1).DoAndReturn(func(auditLog models.AuditLog) error {
+					require.JSONEq(t, fmt.Sprintf(`{"connectionConfiguration":{"host":"localhost","password":"%s"}}`,
+						utils.REDACTED_VALUE), string(auditLog.After))
+
+					return nil
+				})
+			},
+		},
+		{
+			testScenario: "RedactsDestinationConfiguration",
+
+			method:   http.MethodPut,
+			resource: "destinations",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().GetAuditSnapshot("destinations", mockSourceID).Times(1).
+						Return(datatypes.JSON(`{"name":"dwh","configuration_details":"{\"host\":\"old\",\"password\":\"hunter2\"}"}`), nil),
+					store.EXPECT().GetAuditSnapshot("destinations", mockSourceID).Times(1).
+						Return(datatypes.JSON(`{"name":"dwh","configuration_details":"{\"host\":\"new\",\"password\":\"hunter2\"}"}`), nil),
+				)
+				store.EXPECT().CreateAuditLog(gomock.Any()).Times(1).DoAndReturn(func(auditLog models.AuditLog) error {
+					require.NotContains(t, string(auditLog.Before), "hunter2")
+					require.NotContains(t, string(auditLog.After), "hunter2")
+					require.JSONEq(t, fmt.Sprintf(`{"configuration_details":{"host":"old","password":"%s"}}`,
+						utils.REDACTED_VALUE), string(auditLog.Before))
+					require.JSONEq(t, fmt.Sprintf(`{"configuration_details":{"host":"new","password":"%s"}}`,
+						utils.REDACTED_VALUE), string(auditLog.After))
+
+					return nil
+				})
+			},
+		},
+		{
+			testScenario: "MultipartBodyNotRecorded",
+
+			method:      http.MethodPost,
+			contentType: "multipart/form-data; boundary=audit",
+			body:        "--audit\r\nContent-Disposition: form-data; name=\"password\"\r\n\r\nsecret\r\n--audit--\r\n",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAuditSnapshot("sources", mockSourceID).Times(2).Return(nil, nil)
+				store.EXPECT().CreateAuditLog(gomock.Any()).Times(1).DoAndReturn(func(auditLog models.AuditLog) error {
+					require.Empty(t, auditLog.After)
+
+					return nil
+				})
+			},
+		},
+		{
+			testScenario: "LargeBodyNotRecorded",
+
+			method: http.MethodPut,
+			body:   fmt.Sprintf(`{"description":"%s"}`, strings.Repeat("a", utils.AUDIT_MAX_BODY_BYTES)),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAuditSnapshot("sources", mockSourceID).Times(2).Return(nil, nil)
+				store.EXPECT().CreateAuditLog(gomock.Any()).Times(1).DoAndReturn(func(auditLog models.AuditLog) error {
+					require.Empty(t, auditLog.After)
+
+					return nil
+				})
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			resource := testCase.resource
+			if resource == "" {
+				resource = "sources"
+			}
+
+			router := gin.New()
+			grp := router.Group(test.BaseURL)
+			audit.RegisterMiddleware(store, grp)
+			grp.Handle(testCase.method, "/"+resource+"/:id/", func(ctx *gin.Context) {
+				// the handler reads the whole body whether or not it was recorded
+				body, err := ioutil.ReadAll(ctx.Request.Body)
+				require.NoError(t, err)
+				require.Equal(t, testCase.body, string(body))
+
+				ctx.Status(http.StatusOK)
+			})
+
+			url := fmt.Sprintf("%s%s/%s/", test.BaseURL, resource, mockSourceID)
+			req, err := http.NewRequest(testCase.method, url, bytes.NewBufferString(testCase.body))
+			require.NoError(t, err)
+
+			if testCase.contentType != "" {
+				req.Header.Set("Content-Type", testCase.contentType)
+			}
+
+			test.MockAddAuthorization(req)
+			req.Header.Set(utils.REQUEST_ID_HEADER, "request-id")
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+		})
+	}
+}
+
+// createRandomAuditLog populates and returns the AuditLog Model with random values.
+func createRandomAuditLog() models.AuditLog {
+	aID, _ := uuid.NewV1()
+	rID, _ := uuid.NewV1()
+
+	return models.AuditLog{
+		AuditID:      aID.String(),
+		Actor:        int(utils.RandomInt(1, 10)),
+		WorkspaceID:  1122,
+		Action:       utils.RandomString(10),
+		Method:       http.MethodPut,
+		ResourceType: "sources",
+		ResourceID:   rID.String(),
+		RequestID:    utils.RandomString(10),
+		StatusCode:   http.StatusOK,
+		CreatedAt:    utils.RandomInt(1, 1000),
+	}
+}
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+
+	os.Exit(m.Run())
+}
@@ -0,0 +1,147 @@
+package audit
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+// AuditTrail returns the middleware which appends an entry to the audit log for every mutating request of the users.
+// The affected resource is snapshotted through the store before and after the handler runs, and only
+// the changed fields are kept. Resources without a snapshot record the request body as their after state, the
+// secrets of both states are redacted.
+func AuditTrail(store db.Store, basePath string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// the internal routes complete the requests of the users through the workers, the user request is the one
+		// recorded
+		if ctx.Request.Method == http.MethodGet || ctx.Request.Method == http.MethodHead ||
+			ctx.Request.Method == http.MethodOptions || utils.IsInternalRoute(ctx.FullPath()) {
+			ctx.Next()
+
+			return
+		}
+
+		logger := utils.GetLogger()
+
+		requestID := ctx.GetHeader(utils.REQUEST_ID_HEADER)
+		if requestID == "" {
+			generatedID, _ := uuid.NewV4()
+			requestID = generatedID.String()
+		}
+
+		ctx.Writer.Header().Set(utils.REQUEST_ID_HEADER, requestID)
+
+		requestBody := readAuditBody(ctx)
+
+		resourceType, resourceID := getAuditResource(ctx, basePath)
+
+		var before, after []byte
+
+		var err error
+
+		if resourceID != "" {
+			before, err = store.GetAuditSnapshot(resourceType, resourceID)
+			if err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		ctx.Next()
+
+		if resourceID != "" {
+			after, err = store.GetAuditSnapshot(resourceType, resourceID)
+			if err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		if after == nil && ctx.Request.Method != http.MethodDelete {
+			after = requestBody
+		}
+
+		before, after = utils.DiffDocuments(utils.RedactSecrets(before), utils.RedactSecrets(after))
+
+		userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+		auditLog := models.AuditLog{
+			Actor:        userID,
+			WorkspaceID:  workspaceID,
+			Action:       getAuditAction(ctx.HandlerName()),
+			Method:       ctx.Request.Method,
+			Path:         ctx.FullPath(),
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+			Before:       before,
+			After:        after,
+			RequestID:    requestID,
+			StatusCode:   ctx.Writer.Status(),
+		}
+
+		if err = store.CreateAuditLog(auditLog); err != nil {
+			logger.Error(err.Error())
+		}
+	}
+}
+
+// readAuditBody returns the body of the request to record when its resource has no snapshot, the body is handed back
+// to the handler untouched. The multipart uploads and the bodies larger than AUDIT_MAX_BODY_BYTES aren't recorded nor
+// buffered whole.
+func readAuditBody(ctx *gin.Context) []byte {
+	if ctx.Request.Body == nil || strings.HasPrefix(ctx.ContentType(), "multipart/") {
+		return nil
+	}
+
+	body, _ := ioutil.ReadAll(io.LimitReader(ctx.Request.Body, utils.AUDIT_MAX_BODY_BYTES+1))
+	ctx.Request.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), ctx.Request.Body), Closer: ctx.Request.Body}
+
+	if len(body) > utils.AUDIT_MAX_BODY_BYTES {
+		return nil
+	}
+
+	return body
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// getAuditResource returns the resource type and ID of the request from its route, the resource
+// is the static segment preceding the first path parameter e.g. sources/:id/ or pipelines/connections/:id/.
+func getAuditResource(ctx *gin.Context, basePath string) (string, string) {
+	route := strings.TrimPrefix(ctx.FullPath(), basePath)
+	segments := make([]string, 0)
+
+	for _, segment := range strings.Split(route, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") && i > 0 {
+			return segments[i-1], ctx.Param(segment[1:])
+		}
+	}
+
+	if len(segments) == 0 {
+		return "", ""
+	}
+
+	return segments[0], ""
+}
+
+// getAuditAction returns the name of the handler e.g. pipelineService/handlers/v1/source.(*Server).EditSourceOnAirByte-fm.
+func getAuditAction(handlerName string) string {
+	handlerName = handlerName[strings.LastIndex(handlerName, ".")+1:]
+
+	return strings.TrimSuffix(handlerName, "-fm")
+}
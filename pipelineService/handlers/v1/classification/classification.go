@@ -0,0 +1,488 @@
+package classification
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+	"pipelineService/services/assetPreview"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+var errNoCatalogedColumns = errors.New("Asset has no cataloged columns")
+
+type Server struct {
+	Store       db.Store
+	Router      *gin.Engine
+	RouterGroup *gin.RouterGroup
+}
+
+// GetClassificationRules returns the custom classification rules of the workspace
+// @Summary Get Classification Rules
+// @Description Returns the regex and dictionary rules the classifier applies along with the built-in ones
+// @Tags classification
+// @Produce  json
+// @Success 200 {object} models.ClassificationRulesResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /classification/rules/ [get].
+func (server *Server) GetClassificationRules(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetClassificationRules endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	rules, err := server.Store.GetClassificationRules(workspaceID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Classification Rules")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", rules)
+	logger.Info("GetClassificationRules endpoint returned")
+}
+
+// CreateClassificationRule creates a custom classification rule
+// @Summary Create Classification Rule
+// @Description Creates a regex or dictionary rule matched against the column names or the sampled values
+// @Tags classification
+// @Produce  json
+// @Param rule body models.ClassificationRule true "Classification Rule"
+// @Success 201 {object} models.ClassificationRuleResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /classification/rules/ [post].
+func (server *Server) CreateClassificationRule(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("CreateClassificationRule endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only editors can manage the classification rules"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	var rule models.ClassificationRule
+	if err := ctx.ShouldBindJSON(&rule); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err := validateRule(rule); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	rule.RuleID = uuid.Nil
+	rule.CreatedBy = userID
+	rule.WorkspaceID = workspaceID
+	rule.CreatedAt = 0
+
+	createdRule, err := server.Store.CreateClassificationRule(rule)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Classification Rule")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", createdRule)
+	logger.Info("CreateClassificationRule endpoint returned")
+}
+
+// DeleteClassificationRule deletes a custom classification rule
+// @Summary Delete Classification Rule
+// @Description Deletes a custom classification rule, the labels already written are replaced on the next classification
+// @Tags classification
+// @Produce  json
+// @Param id path string true "Rule ID"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /classification/rules/{id}/ [delete].
+func (server *Server) DeleteClassificationRule(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DeleteClassificationRule endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only editors can manage the classification rules"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	ruleID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err = server.Store.DeleteClassificationRule(ruleID, workspaceID); err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Classification Rule")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Classification rule deleted successfully")
+	logger.Info("DeleteClassificationRule endpoint returned")
+}
+
+// ClassifyAsset classifies the columns of an asset
+// @Summary Classify Asset
+// @Description Samples the rows of a raw or transformed asset and labels its columns holding emails, phone numbers, national IDs, credit cards, IPs or matching the workspace rules, the labels pinned by the users are kept
+// @Tags classification
+// @Produce  json
+// @Param id path string true "Asset ID"
+// @Success 200 {object} models.AssetClassificationResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /classification/assets/{id}/ [post].
+func (server *Server) ClassifyAsset(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ClassifyAsset endpoint called")
+
+	if !utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only editors can classify the assets"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	assetID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if !server.assetInWorkspace(ctx, assetID) {
+		return
+	}
+
+	classification, err := server.classifyAsset(ctx.Request.Context(), assetID)
+	if errors.Is(err, errNoCatalogedColumns) {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Asset")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", classification)
+	logger.Info("ClassifyAsset endpoint returned")
+}
+
+// SetColumnClassifications labels a column of an asset by hand
+// @Summary Set Column Classifications
+// @Description Replaces the labels of a column, the labels are pinned so the classification of the asset keeps them unless pinned is false
+// @Tags classification
+// @Produce  json
+// @Param id path string true "Asset ID"
+// @Param name path string true "Column Name"
+// @Param classifications body models.ColumnClassificationRequest true "Column labels"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /classification/assets/{id}/columns/{name}/ [put].
+func (server *Server) SetColumnClassifications(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("SetColumnClassifications endpoint called")
+
+	if !utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only editors can classify the assets"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	assetID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var classificationRequest models.ColumnClassificationRequest
+	if err = ctx.ShouldBindJSON(&classificationRequest); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if !server.assetInWorkspace(ctx, assetID) {
+		return
+	}
+
+	pinned := classificationRequest.Pinned == nil || *classificationRequest.Pinned
+
+	labels := classificationRequest.Classifications
+	if labels == nil {
+		labels = []string{}
+	}
+
+	column, err := server.Store.UpdateColumnClassifications(assetID, ctx.Param("name"), labels, pinned)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Asset Column")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", column)
+	logger.Info("SetColumnClassifications endpoint returned")
+}
+
+// assetInWorkspace responds with a 404 when the asset doesn't belong to the workspace of the caller.
+func (server *Server) assetInWorkspace(ctx *gin.Context, assetID uuid.UUID) bool {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	assetWorkspaceID, err := server.Store.GetAssetWorkspaceID(assetID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && assetWorkspaceID != workspaceID) {
+		errMsg := "Asset doesn't exist"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, errMsg, nil)
+
+		return false
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Asset")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return false
+	}
+
+	return true
+}
+
+// GetSensitiveColumnsReport returns the sensitive columns of a data product
+// @Summary Get Sensitive Columns Report
+// @Description Returns the classified columns of the transformed assets of a data product and of the raw assets of its pipelines
+// @Tags classification
+// @Produce  json
+// @Param id path string true "Product ID"
+// @Success 200 {object} models.SensitiveColumnsReportResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /classification/data-products/{id}/report/ [get].
+func (server *Server) GetSensitiveColumnsReport(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetSensitiveColumnsReport endpoint called")
+
+	productID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	columns, err := server.Store.GetSensitiveColumns(productID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Sensitive Columns")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	report := models.SensitiveColumnsReport{
+		ProductID: productID.String(),
+		Columns:   columns,
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", report)
+	logger.Info("GetSensitiveColumnsReport endpoint returned")
+}
+
+// ClassifyAssets classifies the columns of all the cataloged assets
+// @Summary Classifies the assets
+// @Description Samples every asset of the data dictionary and labels its sensitive columns
+// @Tags classification/internal
+// @Produce  json
+// @Success 200 {object} models.ClassificationJobReportResponse
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /classification/internal/classify/ [post].
+func (server *Server) ClassifyAssets(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ClassifyAssets internal endpoint called")
+
+	assetIDs, err := server.Store.GetClassifiableAssets()
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Assets")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	report := models.ClassificationJobReport{
+		Classified: make([]string, 0, len(assetIDs)),
+		Failures:   make([]string, 0),
+	}
+
+	for _, id := range assetIDs {
+		assetID, _ := uuid.FromString(id)
+
+		if _, err = server.classifyAsset(ctx.Request.Context(), assetID); err != nil {
+			logger.Error(err.Error())
+			report.Failures = append(report.Failures, id)
+
+			continue
+		}
+
+		report.Classified = append(report.Classified, id)
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", report)
+	logger.Info("ClassifyAssets internal endpoint successfully returned")
+}
+
+// classifyAsset samples the asset through the preview path and writes the labels onto its cataloged columns, the
+// columns pinned by the users are left out.
+func (server *Server) classifyAsset(ctx context.Context, assetID uuid.UUID) (models.AssetClassification, error) {
+	classification := models.AssetClassification{AssetID: assetID.String()}
+
+	columns, err := server.Store.GetAssetColumns(assetID)
+	if err != nil {
+		return classification, err
+	}
+
+	if len(columns) == 0 {
+		return classification, errNoCatalogedColumns
+	}
+
+	rules, err := server.Store.GetClassificationRules(columns[0].WorkspaceID)
+	if err != nil {
+		return classification, err
+	}
+
+	columnNames := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if !column.ClassificationsPinned {
+			columnNames = append(columnNames, column.Name)
+		}
+	}
+
+	if len(columnNames) == 0 {
+		classification.Columns = map[string][]string{}
+
+		return classification, nil
+	}
+
+	sample, err := server.sampleAsset(ctx, assetID)
+	if err != nil {
+		return classification, err
+	}
+
+	classifiers := append(append([]classifier{}, builtinClassifiers...), workspaceClassifiers(rules)...)
+
+	classification.Sampled = len(sample)
+	classification.Columns = classifyColumns(columnNames, sample, classifiers)
+
+	return classification, server.Store.SetColumnClassifications(assetID.String(), classification.Columns)
+}
+
+// sampleAsset reads the first rows of the asset with the previewer of its destination.
+func (server *Server) sampleAsset(ctx context.Context, assetID uuid.UUID) ([]map[string]interface{}, error) {
+	query := models.PreviewQuery{Limit: utils.CLASSIFICATION_SAMPLE_ROWS}
+
+	assetDetails, err := server.Store.GetAssetDetails(assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if assetDetails.Name != "" {
+		preview, err := assetPreview.Preview(ctx, server.Store, assetDetails, query)
+		if err != nil {
+			return nil, err
+		}
+
+		sample := preview.Rows
+
+		// the columns of the raw rows are nested in the data column
+		for i, row := range sample {
+			if data, ok := utils.DecodeAirbyteData(row); ok {
+				sample[i] = data
+			}
+		}
+
+		return sample, nil
+	}
+
+	transformedAssetDetails, err := server.Store.GetTransformedAssetDetails(assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := assetPreview.PreviewTransformed(ctx, server.Store, transformedAssetDetails, query)
+
+	return preview.Rows, err
+}
+
+func validateRule(rule models.ClassificationRule) error {
+	switch rule.Kind {
+	case utils.CLASSIFICATION_RULE_REGEX:
+		if rule.Pattern == "" {
+			return errors.New("pattern is required for regex rules")
+		}
+
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return err
+		}
+	case utils.CLASSIFICATION_RULE_DICTIONARY:
+		if len(rule.Values) == 0 {
+			return errors.New("values are required for dictionary rules")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,533 @@
+package classification_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"pipelineService/handlers/v1/test"
+	"pipelineService/models/v1"
+	mockStore "pipelineService/services/db/mocks"
+	"pipelineService/utils"
+)
+
+// TestCreateClassificationRule tests all the scenarios while creating a custom classification rule.
+func TestCreateClassificationRule(t *testing.T) {
+	mockRule := createRandomClassificationRule()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		body          interface{}
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidKind",
+
+			body: models.ClassificationRule{Label: "employee_id", Kind: "script", Target: utils.CLASSIFICATION_TARGET_VALUE},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateClassificationRule(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_InvalidPattern",
+
+			body: models.ClassificationRule{Label: "employee_id", Kind: utils.CLASSIFICATION_RULE_REGEX,
+				Target: utils.CLASSIFICATION_TARGET_VALUE, Pattern: "^EMP-[0-9"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateClassificationRule(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_MissingValues",
+
+			body: models.ClassificationRule{Label: "employee_id", Kind: utils.CLASSIFICATION_RULE_DICTIONARY,
+				Target: utils.CLASSIFICATION_TARGET_NAME},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateClassificationRule(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			body: models.ClassificationRule{Label: mockRule.Label, Kind: mockRule.Kind, Target: mockRule.Target,
+				Pattern: mockRule.Pattern, WorkspaceID: 1},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateClassificationRule(gomock.Any()).Times(1).DoAndReturn(
+					func(rule models.ClassificationRule) (models.ClassificationRule, error) {
+						require.Equal(t, mockRule.Pattern, rule.Pattern)
+						require.Equal(t, 1122, rule.CreatedBy)
+						require.Equal(t, 1122, rule.WorkspaceID)
+
+						return mockRule, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockRule}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.CLASSIFICATION, store, nil, nil)
+			url := fmt.Sprintf("%sclassification/rules/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestDeleteClassificationRule tests all the scenarios while deleting a custom classification rule.
+func TestDeleteClassificationRule(t *testing.T) {
+	ruleID, _ := uuid.NewV1()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_RuleDoesNotExist",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().DeleteClassificationRule(ruleID, 1122).Times(1).
+					Return(errors.New("Classification rule doesn't exists"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().DeleteClassificationRule(ruleID, 1122).Times(1).Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.CLASSIFICATION, store, nil, nil)
+			url := fmt.Sprintf("%sclassification/rules/%s/", test.BaseURL, ruleID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodDelete, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestClassifyAsset tests the scenarios while classifying an asset that fail before the asset is sampled.
+func TestClassifyAsset(t *testing.T) {
+	assetID, _ := uuid.NewV1()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		role          string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Forbidden_Viewer",
+
+			role: utils.USER_ROLE_VIEWER,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(gomock.Any()).Times(0)
+				store.EXPECT().GetAssetColumns(gomock.Any()).Times(0)
+				store.EXPECT().SetColumnClassifications(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound_OtherWorkspace",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(assetID).Times(1).Return(2233, nil)
+				store.EXPECT().GetAssetColumns(gomock.Any()).Times(0)
+				store.EXPECT().SetColumnClassifications(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound_Asset",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(assetID).Times(1).Return(0, gorm.ErrRecordNotFound)
+				store.EXPECT().GetAssetColumns(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_NoCatalogedColumns",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(assetID).Times(1).Return(1122, nil)
+				store.EXPECT().GetAssetColumns(assetID).Times(1).Return([]models.AssetColumn{}, nil)
+				store.EXPECT().GetAssetDetails(gomock.Any()).Times(0)
+				store.EXPECT().SetColumnClassifications(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				var res models.Response
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, "Asset has no cataloged columns", res.Errors)
+			},
+		},
+		{
+			testScenario: "OK_PinnedColumnsKept",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(assetID).Times(1).Return(1122, nil)
+				store.EXPECT().GetAssetColumns(assetID).Times(1).
+					Return([]models.AssetColumn{{AssetID: assetID.String(), Name: "email", WorkspaceID: 1122,
+						Classifications: pq.StringArray{}, ClassificationsPinned: true}}, nil)
+				store.EXPECT().GetClassificationRules(1122).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetDetails(gomock.Any()).Times(0)
+				store.EXPECT().SetColumnClassifications(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_DBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(assetID).Times(1).Return(1122, nil)
+				store.EXPECT().GetAssetColumns(assetID).Times(1).
+					Return([]models.AssetColumn{{AssetID: assetID.String(), Name: "email", WorkspaceID: 1122}}, nil)
+				store.EXPECT().GetClassificationRules(1122).Times(1).Return(nil, nil)
+				store.EXPECT().GetAssetDetails(assetID).Times(1).Return(models.AssetDetails{}, sql.ErrConnDone)
+				store.EXPECT().SetColumnClassifications(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			role := testCase.role
+			if role == "" {
+				role = utils.USER_ROLE_EDITOR
+			}
+
+			server := test.NewTestServer(test.CLASSIFICATION, store, nil, nil)
+			url := fmt.Sprintf("%sclassification/assets/%s/", test.BaseURL, assetID)
+			expectedResp, err := test.MakeHttpRequest(withRole(server, role), http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestSetColumnClassifications tests all the scenarios while labelling a column by hand.
+func TestSetColumnClassifications(t *testing.T) {
+	assetID, _ := uuid.NewV1()
+	unpinned := false
+
+	testCaseSuite := []struct {
+		testScenario  string
+		role          string
+		body          interface{}
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "OK_Pinned",
+
+			body: models.ColumnClassificationRequest{Classifications: []string{utils.CLASSIFICATION_EMAIL}},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(assetID).Times(1).Return(1122, nil)
+				store.EXPECT().UpdateColumnClassifications(assetID, "email", []string{utils.CLASSIFICATION_EMAIL}, true).
+					Times(1).Return(models.AssetColumn{Name: "email", ClassificationsPinned: true}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			testScenario: "OK_Unpinned",
+
+			body: models.ColumnClassificationRequest{Pinned: &unpinned},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(assetID).Times(1).Return(1122, nil)
+				store.EXPECT().UpdateColumnClassifications(assetID, "email", []string{}, false).
+					Times(1).Return(models.AssetColumn{Name: "email"}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Forbidden_Viewer",
+
+			role: utils.USER_ROLE_VIEWER,
+
+			body: models.ColumnClassificationRequest{Classifications: []string{utils.CLASSIFICATION_EMAIL}},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().UpdateColumnClassifications(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound_OtherWorkspace",
+
+			body: models.ColumnClassificationRequest{Classifications: []string{utils.CLASSIFICATION_EMAIL}},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(assetID).Times(1).Return(2233, nil)
+				store.EXPECT().UpdateColumnClassifications(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnknownColumn",
+
+			body: models.ColumnClassificationRequest{Classifications: []string{utils.CLASSIFICATION_EMAIL}},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetWorkspaceID(assetID).Times(1).Return(1122, nil)
+				store.EXPECT().UpdateColumnClassifications(assetID, "email", gomock.Any(), true).
+					Times(1).Return(models.AssetColumn{}, errors.New("Column doesn't exists"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			role := testCase.role
+			if role == "" {
+				role = utils.USER_ROLE_EDITOR
+			}
+
+			server := test.NewTestServer(test.CLASSIFICATION, store, nil, nil)
+			body, err := json.Marshal(testCase.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%sclassification/assets/%s/columns/email/", test.BaseURL, assetID)
+			expectedResp, err := test.MakeHttpRequest(withRole(server, role), http.MethodPut, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetSensitiveColumnsReport tests all the scenarios while getting the sensitive columns of a data product.
+func TestGetSensitiveColumnsReport(t *testing.T) {
+	productID, _ := uuid.NewV1()
+
+	mockColumns := []models.SensitiveColumn{
+		createRandomSensitiveColumn("raw", utils.CLASSIFICATION_EMAIL),
+		createRandomSensitiveColumn("transformed", utils.CLASSIFICATION_PHONE),
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_DBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSensitiveColumns(productID).Times(1).Return(nil, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSensitiveColumns(productID).Times(1).Return(mockColumns, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   models.SensitiveColumnsReport{ProductID: productID.String(), Columns: mockColumns}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.CLASSIFICATION, store, nil, nil)
+			url := fmt.Sprintf("%sclassification/data-products/%s/report/", test.BaseURL, productID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+func createRandomClassificationRule() models.ClassificationRule {
+	ruleID, _ := uuid.NewV1()
+
+	return models.ClassificationRule{
+		RuleID:      ruleID,
+		Label:       utils.RandomString(8),
+		Kind:        utils.CLASSIFICATION_RULE_REGEX,
+		Target:      utils.CLASSIFICATION_TARGET_VALUE,
+		Pattern:     "^EMP-[0-9]{6}$",
+		CreatedBy:   1122,
+		WorkspaceID: 1122,
+		CreatedAt:   utils.RandomInt(1, 1000),
+	}
+}
+
+func createRandomSensitiveColumn(assetType string, label string) models.SensitiveColumn {
+	assetID, _ := uuid.NewV1()
+
+	return models.SensitiveColumn{
+		AssetID:         assetID.String(),
+		AssetName:       utils.RandomString(8),
+		AssetType:       assetType,
+		Column:          utils.RandomString(8),
+		Classifications: pq.StringArray{label},
+	}
+}
+
+// withRole sends the requests to the server with the role of the caller.
+func withRole(server http.Handler, role string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Set("userRole", role)
+		server.ServeHTTP(w, r)
+	})
+}
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+
+	os.Exit(m.Run())
+}
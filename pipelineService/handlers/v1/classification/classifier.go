@@ -0,0 +1,251 @@
+package classification
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// classifier labels a column when its name matches or when enough of the sampled values match.
+type classifier struct {
+	label      string
+	matchName  func(name string) bool
+	matchValue func(value string) bool
+}
+
+var (
+	emailRegex = regexp.MustCompile(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}$`)
+	phoneRegex = regexp.MustCompile(`^\+?[0-9][0-9 ().\-]{5,22}[0-9]$`)
+	ssnRegex   = regexp.MustCompile(`^[0-9]{3}-[0-9]{2}-[0-9]{4}$`)
+	cardRegex  = regexp.MustCompile(`^[0-9][0-9 \-]{11,21}[0-9]$`)
+)
+
+var builtinClassifiers = []classifier{
+	{
+		label:      utils.CLASSIFICATION_EMAIL,
+		matchName:  nameHasToken("email", "e_mail", "email_address"),
+		matchValue: emailRegex.MatchString,
+	},
+	{
+		label:     utils.CLASSIFICATION_PHONE,
+		matchName: nameHasToken("phone", "phone_number", "mobile", "telephone", "cell"),
+		matchValue: func(value string) bool {
+			digits := countDigits(value)
+
+			return phoneRegex.MatchString(value) && digits >= 10 && digits <= 15
+		},
+	},
+	{
+		label:      utils.CLASSIFICATION_NATIONAL_ID,
+		matchName:  nameHasToken("ssn", "social_security_number", "national_id", "nin", "passport", "passport_number", "tax_id"),
+		matchValue: ssnRegex.MatchString,
+	},
+	{
+		label:     utils.CLASSIFICATION_CREDIT_CARD,
+		matchName: nameHasToken("credit_card", "card_number", "cc_number", "pan"),
+		matchValue: func(value string) bool {
+			digits := countDigits(value)
+
+			return cardRegex.MatchString(value) && digits >= 13 && digits <= 19 && luhnValid(value)
+		},
+	},
+	{
+		label:     utils.CLASSIFICATION_IP_ADDRESS,
+		matchName: nameHasToken("ip", "ip_address", "ipv4", "ipv6"),
+		matchValue: func(value string) bool {
+			return net.ParseIP(value) != nil
+		},
+	},
+}
+
+// workspaceClassifiers converts the custom rules of a workspace, rules that can't be compiled are skipped.
+func workspaceClassifiers(rules []models.ClassificationRule) []classifier {
+	classifiers := make([]classifier, 0, len(rules))
+
+	for _, rule := range rules {
+		var match func(string) bool
+
+		switch rule.Kind {
+		case utils.CLASSIFICATION_RULE_REGEX:
+			pattern, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				continue
+			}
+
+			match = pattern.MatchString
+		case utils.CLASSIFICATION_RULE_DICTIONARY:
+			values := make(map[string]bool, len(rule.Values))
+			for _, value := range rule.Values {
+				values[strings.ToLower(strings.TrimSpace(value))] = true
+			}
+
+			match = func(value string) bool {
+				return values[strings.ToLower(strings.TrimSpace(value))]
+			}
+		default:
+			continue
+		}
+
+		if rule.Target == utils.CLASSIFICATION_TARGET_NAME {
+			classifiers = append(classifiers, classifier{label: rule.Label, matchName: match})
+		} else {
+			classifiers = append(classifiers, classifier{label: rule.Label, matchValue: match})
+		}
+	}
+
+	return classifiers
+}
+
+// classifyColumns returns the labels of the columns of the sampled rows, columns without a label are omitted.
+func classifyColumns(columns []string, sample []map[string]interface{}, classifiers []classifier) map[string][]string {
+	classifications := make(map[string][]string)
+
+	for _, column := range columns {
+		values := sampledValues(sample, column)
+		labels := make([]string, 0)
+
+		for _, c := range classifiers {
+			if c.matchName != nil && c.matchName(column) {
+				labels = appendLabel(labels, c.label)
+
+				continue
+			}
+
+			if c.matchValue != nil && len(values) > 0 {
+				matched := 0
+
+				for _, value := range values {
+					if c.matchValue(value) {
+						matched++
+					}
+				}
+
+				if float64(matched)/float64(len(values)) >= utils.CLASSIFICATION_MATCH_THRESHOLD {
+					labels = appendLabel(labels, c.label)
+				}
+			}
+		}
+
+		if len(labels) > 0 {
+			sort.Strings(labels)
+			classifications[column] = labels
+		}
+	}
+
+	return classifications
+}
+
+// sampledValues returns the non empty textual values of a column, numbers and other types are not inspected since
+// identifiers and amounts would be mistaken for phone or card numbers.
+func sampledValues(sample []map[string]interface{}, column string) []string {
+	values := make([]string, 0, len(sample))
+
+	for _, row := range sample {
+		var value string
+
+		switch v := row[column].(type) {
+		case string:
+			value = v
+		case []byte:
+			value = string(v)
+		default:
+			continue
+		}
+
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// nameHasToken matches the column names containing any of the keywords as whole words, in snake or camel case.
+func nameHasToken(keywords ...string) func(string) bool {
+	return func(name string) bool {
+		normalized := fmt.Sprintf("_%s_", normalizeName(name))
+
+		for _, keyword := range keywords {
+			if strings.Contains(normalized, fmt.Sprintf("_%s_", keyword)) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func normalizeName(name string) string {
+	var builder strings.Builder
+
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			if i > 0 && unicode.IsLower(runes[i-1]) {
+				builder.WriteRune('_')
+			}
+
+			builder.WriteRune(unicode.ToLower(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			builder.WriteRune(r)
+		default:
+			builder.WriteRune('_')
+		}
+	}
+
+	return builder.String()
+}
+
+func countDigits(value string) int {
+	digits := 0
+
+	for _, r := range value {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+
+	return digits
+}
+
+// luhnValid checks the card number checksum, it tells card numbers apart from other long numbers.
+func luhnValid(value string) bool {
+	sum := 0
+	double := false
+
+	for i := len(value) - 1; i >= 0; i-- {
+		if value[i] < '0' || value[i] > '9' {
+			continue
+		}
+
+		digit := int(value[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+func appendLabel(labels []string, label string) []string {
+	for _, existing := range labels {
+		if existing == label {
+			return labels
+		}
+	}
+
+	return append(labels, label)
+}
@@ -0,0 +1,374 @@
+package connectorUpgrade
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"pipelineService/clients/airbyte"
+	"pipelineService/models/v1"
+	"pipelineService/services/connectorUpgrade"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+type Server struct {
+	Store       db.Store
+	Router      *gin.Engine
+	RouterGroup *gin.RouterGroup
+	Airbyte     airbyte.AirByteQuerier
+}
+
+// GetConnectorVersions returns the pipelines grouped by the connector definitions and versions they run
+// @Summary Get Connector Versions
+// @Description Returns the pipelines of the workspace grouped by source and destination definition and the docker image tag of the definition
+// @Tags connector-upgrades
+// @Produce  json
+// @Success 200 {object} models.ConnectorVersionsResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /connector-upgrades/versions/ [get].
+func (server *Server) GetConnectorVersions(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetConnectorVersions endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	connectors, err := server.Store.GetPipelineConnectors(workspaceID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Pipeline Connectors")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", connectorUpgrade.GroupVersions(connectors))
+	logger.Info("GetConnectorVersions endpoint returned")
+}
+
+// GetConnectorUpgrades returns the connector upgrades of the workspace
+// @Summary Get Connector Upgrades
+// @Description Returns the connector upgrades of the workspace, latest first. Only super admins can list upgrades
+// @Tags connector-upgrades
+// @Produce  json
+// @Success 200 {object} models.ConnectorUpgradesResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /connector-upgrades/ [get].
+func (server *Server) GetConnectorUpgrades(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetConnectorUpgrades endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !canUpgradeConnectors(ctx) {
+		return
+	}
+
+	upgrades, err := server.Store.GetConnectorUpgrades(workspaceID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connector Upgrades")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", upgrades)
+	logger.Info("GetConnectorUpgrades endpoint returned")
+}
+
+// GetConnectorUpgrade returns a connector upgrade with the result of its validation
+// @Summary Get Connector Upgrade
+// @Description Returns a connector upgrade of the workspace with the checks, catalog changes and specification changes of its validation or commit. Only super admins can get upgrades
+// @Tags connector-upgrades
+// @Produce  json
+// @Param id path string true "Upgrade ID"
+// @Success 200 {object} models.ConnectorUpgradeResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /connector-upgrades/{id}/ [get].
+func (server *Server) GetConnectorUpgrade(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetConnectorUpgrade endpoint called")
+
+	upgrade, ok := server.getConnectorUpgrade(ctx)
+	if !ok {
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", upgrade)
+	logger.Info("GetConnectorUpgrade endpoint returned")
+}
+
+// ScheduleConnectorUpgrade schedules the upgrade of a connector definition to a new version
+// @Summary Schedule Connector Upgrade
+// @Description Schedules the upgrade of a source or destination definition to a new docker image tag. Airbyte runs a single version of a definition for the whole instance, so the upgrade applies to all the pipelines of every workspace using the definition and a single pipeline can't be upgraded as a canary. Only super admins can schedule upgrades
+// @Tags connector-upgrades
+// @Accept  json
+// @Produce  json
+// @Param upgrade body models.ScheduleConnectorUpgradeRequest true "Upgrade"
+// @Success 201 {object} models.ConnectorUpgradeResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /connector-upgrades/ [post].
+func (server *Server) ScheduleConnectorUpgrade(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ScheduleConnectorUpgrade endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !canUpgradeConnectors(ctx) {
+		return
+	}
+
+	var request models.ScheduleConnectorUpgradeRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	upgrade := models.ConnectorUpgrade{
+		ConnectorType: request.ConnectorType,
+		DefinitionID:  request.DefinitionID,
+		Version:       request.Version,
+		Status:        utils.CONNECTOR_UPGRADE_STATUS_SCHEDULED,
+		Checks:        []byte("[]"),
+		SpecChanges:   []byte("[]"),
+		WorkspaceID:   workspaceID,
+		CreatedBy:     userID,
+	}
+
+	previousVersion, err := connectorUpgrade.CurrentVersion(server.Store, workspaceID, request.ConnectorType,
+		request.DefinitionID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Supported Connector")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if previousVersion == request.Version {
+		errMsg := fmt.Sprintf("The %s definition already runs version %s", request.ConnectorType, request.Version)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	upgrade.PreviousVersion = previousVersion
+
+	pipelines, err := connectorUpgrade.AffectedPipelines(server.Store, upgrade)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Pipeline Connectors")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	// the version applies to the whole instance, it isn't committed without a pipeline to check it
+	if len(pipelines) == 0 {
+		errMsg := fmt.Sprintf("No pipeline uses the %s definition", request.ConnectorType)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	upgrade, err = server.Store.CreateConnectorUpgrade(upgrade)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connector Upgrade")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", upgrade)
+	logger.Info("ScheduleConnectorUpgrade endpoint returned")
+}
+
+// ValidateConnectorUpgrade checks the pipelines of the upgrade against the new version before it is applied
+// @Summary Validate Connector Upgrade
+// @Description Creates a custom definition of the workspace running the new version, the definition the pipelines run isn't changed on airbyte. The destinations of the pipelines using the definition run check_connection with it, the configurations of the sources are validated against its specification as airbyte masks their secrets. The changes of the specification are listed and the upgrade fails when a check fails. The sources run check_connection and discover_schema with the new version when it is committed. Only super admins can validate upgrades
+// @Tags connector-upgrades
+// @Produce  json
+// @Param id path string true "Upgrade ID"
+// @Success 200 {object} models.ConnectorUpgradeResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 409 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /connector-upgrades/{id}/validate/ [post].
+func (server *Server) ValidateConnectorUpgrade(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ValidateConnectorUpgrade endpoint called")
+
+	upgrade, ok := server.getUpgradeInStatus(ctx, utils.CONNECTOR_UPGRADE_STATUS_SCHEDULED,
+		utils.CONNECTOR_UPGRADE_STATUS_VALIDATED, utils.CONNECTOR_UPGRADE_STATUS_FAILED)
+	if !ok {
+		return
+	}
+
+	_, _, airbyteWorkspaceID := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	upgrade, err := connectorUpgrade.Validate(server.Store, server.Airbyte, airbyteWorkspaceID, upgrade)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connector Upgrade")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", upgrade)
+	logger.Info("ValidateConnectorUpgrade endpoint returned")
+}
+
+// CommitConnectorUpgrade applies the validated version to the connector definition
+// @Summary Commit Connector Upgrade
+// @Description Upgrades the definition to the new version on airbyte, for every workspace of the instance, then runs check_connection for the pipelines of every workspace using the definition and discover_schema for their sources. The previous version is restored and the upgrade fails when a check fails, otherwise the version is recorded in the catalogs with the changes of the discovered catalogs and of the specification. Only validated upgrades can be committed. Only super admins can commit upgrades
+// @Tags connector-upgrades
+// @Produce  json
+// @Param id path string true "Upgrade ID"
+// @Success 200 {object} models.ConnectorUpgradeResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 409 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /connector-upgrades/{id}/commit/ [post].
+func (server *Server) CommitConnectorUpgrade(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("CommitConnectorUpgrade endpoint called")
+
+	upgrade, ok := server.getUpgradeInStatus(ctx, utils.CONNECTOR_UPGRADE_STATUS_VALIDATED)
+	if !ok {
+		return
+	}
+
+	upgrade, err := connectorUpgrade.Commit(server.Store, server.Airbyte, upgrade)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connector Upgrade")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", upgrade)
+	logger.Info("CommitConnectorUpgrade endpoint returned")
+}
+
+// RollbackConnectorUpgrade restores the version the connector definition ran before the upgrade
+// @Summary Rollback Connector Upgrade
+// @Description Restores the previous version of a committed upgrade on airbyte and in the catalogs of every workspace. Only super admins can roll back upgrades
+// @Tags connector-upgrades
+// @Produce  json
+// @Param id path string true "Upgrade ID"
+// @Success 200 {object} models.ConnectorUpgradeResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 409 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /connector-upgrades/{id}/rollback/ [post].
+func (server *Server) RollbackConnectorUpgrade(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("RollbackConnectorUpgrade endpoint called")
+
+	upgrade, ok := server.getUpgradeInStatus(ctx, utils.CONNECTOR_UPGRADE_STATUS_COMMITTED)
+	if !ok {
+		return
+	}
+
+	upgrade, err := connectorUpgrade.Rollback(server.Store, server.Airbyte, upgrade)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connector Upgrade")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", upgrade)
+	logger.Info("RollbackConnectorUpgrade endpoint returned")
+}
+
+// getConnectorUpgrade checks that the caller can upgrade connectors and returns the upgrade of the request when it
+// belongs to the workspace of the caller.
+func (server *Server) getConnectorUpgrade(ctx *gin.Context) (models.ConnectorUpgrade, bool) {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !canUpgradeConnectors(ctx) {
+		return models.ConnectorUpgrade{}, false
+	}
+
+	upgradeID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return models.ConnectorUpgrade{}, false
+	}
+
+	upgrade, err := server.Store.GetConnectorUpgrade(upgradeID)
+	if err == nil && upgrade.WorkspaceID != workspaceID {
+		err = fmt.Errorf("connector upgrade %s doesn't belong to workspace %d", upgradeID, workspaceID)
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connector Upgrade")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return upgrade, false
+	}
+
+	return upgrade, true
+}
+
+// getUpgradeInStatus returns the upgrade of the request when it is in one of the statuses.
+func (server *Server) getUpgradeInStatus(ctx *gin.Context, statuses ...string) (models.ConnectorUpgrade, bool) {
+	logger := utils.GetLogger()
+
+	upgrade, ok := server.getConnectorUpgrade(ctx)
+	if !ok {
+		return upgrade, false
+	}
+
+	for _, status := range statuses {
+		if upgrade.Status == status {
+			return upgrade, true
+		}
+	}
+
+	errMsg := "Connector upgrade is " + upgrade.Status
+	logger.Error(errMsg)
+	utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, nil)
+
+	return upgrade, false
+}
+
+// canUpgradeConnectors checks that the caller is a super admin, airbyte runs a single version of a definition for the
+// whole instance so an upgrade changes the pipelines of every workspace.
+func canUpgradeConnectors(ctx *gin.Context) bool {
+	if utils.IsSuperAdminFromContext(ctx) {
+		return true
+	}
+
+	errMsg := "Only super admins can upgrade connectors"
+	utils.GetLogger().Error(errMsg)
+	utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+	return false
+}
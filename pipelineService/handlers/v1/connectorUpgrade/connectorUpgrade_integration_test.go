@@ -0,0 +1,847 @@
+package connectorUpgrade_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	mockairbyte "pipelineService/clients/airbyte/mocks"
+	"pipelineService/handlers/v1/test"
+	"pipelineService/models/v1"
+	mockStore "pipelineService/services/db/mocks"
+	"pipelineService/utils"
+)
+
+// TestGetConnectorVersions tests all the scenarios while listing the pipelines by connector version.
+func TestGetConnectorVersions(t *testing.T) {
+	postgres := createRandomPipelineConnector(utils.CONNECTOR_TYPE_SOURCE)
+	// both pipelines read from postgres
+	otherPostgres := createRandomPipelineConnector(utils.CONNECTOR_TYPE_SOURCE)
+	otherPostgres.DefinitionID = postgres.DefinitionID
+	otherPostgres.DockerImageTag = postgres.DockerImageTag
+	otherPostgres.Name = postgres.Name
+	warehouse := createRandomPipelineConnector(utils.CONNECTOR_TYPE_DESTINATION)
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Success",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetPipelineConnectors(1122).Times(1).
+					Return([]models.PipelineConnector{postgres, warehouse, otherPostgres}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res models.ConnectorVersionsResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Len(t, res.Data, 2)
+
+				require.Equal(t, utils.CONNECTOR_TYPE_SOURCE, res.Data[0].ConnectorType)
+				require.Equal(t, postgres.DockerImageTag, res.Data[0].Version)
+				require.Equal(t, []models.ConnectorVersionPipeline{
+					{PipelineID: postgres.PipelineID, PipelineName: postgres.PipelineName},
+					{PipelineID: otherPostgres.PipelineID, PipelineName: otherPostgres.PipelineName},
+				}, res.Data[0].Pipelines)
+
+				require.Equal(t, utils.CONNECTOR_TYPE_DESTINATION, res.Data[1].ConnectorType)
+				require.Len(t, res.Data[1].Pipelines, 1)
+			},
+		},
+		{
+			testScenario: "BadRequest",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetPipelineConnectors(1122).Times(1).
+					Return([]models.PipelineConnector{}, errors.New("Something went wrong"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.CONNECTOR_UPGRADE, store, nil, nil)
+			url := test.BaseURL + "connector-upgrades/versions/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestScheduleConnectorUpgrade tests all the scenarios while scheduling a connector upgrade.
+func TestScheduleConnectorUpgrade(t *testing.T) {
+	source := createRandomPipelineConnector(utils.CONNECTOR_TYPE_SOURCE)
+	request := models.ScheduleConnectorUpgradeRequest{
+		ConnectorType: utils.CONNECTOR_TYPE_SOURCE,
+		DefinitionID:  source.DefinitionID,
+		Version:       "0.4.8",
+	}
+	supportedSource := models.SupportedSources{AirbyteDefinitionID: source.DefinitionID, DockerImageTag: "0.4.4"}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		role          string
+		superAdmin    bool
+		body          func() models.ScheduleConnectorUpgradeRequest
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Forbidden_Editor",
+
+			role: utils.USER_ROLE_EDITOR,
+			body: func() models.ScheduleConnectorUpgradeRequest { return request },
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateConnectorUpgrade(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			// the version changes the pipelines of every workspace
+			testScenario: "Forbidden_WorkspaceAdmin",
+
+			role: utils.USER_ROLE_ADMIN,
+			body: func() models.ScheduleConnectorUpgradeRequest { return request },
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateConnectorUpgrade(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_SameVersion",
+
+			role:       utils.USER_ROLE_ADMIN,
+			superAdmin: true,
+			body: func() models.ScheduleConnectorUpgradeRequest {
+				sameVersion := request
+				sameVersion.Version = supportedSource.DockerImageTag
+
+				return sameVersion
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(1122, source.DefinitionID).Times(1).Return(supportedSource, nil)
+				store.EXPECT().CreateConnectorUpgrade(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnknownDefinition",
+
+			role:       utils.USER_ROLE_ADMIN,
+			superAdmin: true,
+			body:       func() models.ScheduleConnectorUpgradeRequest { return request },
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(1122, source.DefinitionID).Times(1).
+					Return(models.SupportedSources{}, sql.ErrNoRows)
+				store.EXPECT().CreateConnectorUpgrade(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_NoPipelineUsingDefinition",
+
+			role:       utils.USER_ROLE_ADMIN,
+			superAdmin: true,
+			body:       func() models.ScheduleConnectorUpgradeRequest { return request },
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(1122, source.DefinitionID).Times(1).Return(supportedSource, nil)
+				store.EXPECT().GetDefinitionConnectors(utils.CONNECTOR_TYPE_SOURCE, source.DefinitionID).Times(1).
+					Return([]models.PipelineConnector{}, nil)
+				store.EXPECT().CreateConnectorUpgrade(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Created",
+
+			role:       utils.USER_ROLE_ADMIN,
+			superAdmin: true,
+			body:       func() models.ScheduleConnectorUpgradeRequest { return request },
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(1122, source.DefinitionID).Times(1).Return(supportedSource, nil)
+				store.EXPECT().GetDefinitionConnectors(utils.CONNECTOR_TYPE_SOURCE, source.DefinitionID).Times(1).
+					Return([]models.PipelineConnector{source}, nil)
+				store.EXPECT().CreateConnectorUpgrade(gomock.Any()).Times(1).
+					DoAndReturn(func(upgrade models.ConnectorUpgrade) (models.ConnectorUpgrade, error) {
+						require.Equal(t, supportedSource.DockerImageTag, upgrade.PreviousVersion)
+						require.Equal(t, request.Version, upgrade.Version)
+						require.Equal(t, utils.CONNECTOR_UPGRADE_STATUS_SCHEDULED, upgrade.Status)
+						require.Equal(t, 1122, upgrade.WorkspaceID)
+
+						return upgrade, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			body, e := json.Marshal(testCase.body())
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.CONNECTOR_UPGRADE, store, nil, nil)
+			url := test.BaseURL + "connector-upgrades/"
+			expectedResp, err := test.MakeHttpRequest(withRole(server, testCase.role, testCase.superAdmin),
+				http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestValidateConnectorUpgrade tests all the scenarios while validating a connector upgrade.
+func TestValidateConnectorUpgrade(t *testing.T) {
+	source := createRandomPipelineConnector(utils.CONNECTOR_TYPE_SOURCE)
+	destination := createRandomPipelineConnector(utils.CONNECTOR_TYPE_DESTINATION)
+
+	testCaseSuite := []struct {
+		testScenario  string
+		upgrade       models.ConnectorUpgrade
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Conflict_Committed",
+
+			upgrade: createRandomConnectorUpgrade(source, utils.CONNECTOR_UPGRADE_STATUS_COMMITTED),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateSourceDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().UpdateConnectorUpgrade(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_Source",
+
+			upgrade: createRandomConnectorUpgrade(source, utils.CONNECTOR_UPGRADE_STATUS_SCHEDULED),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				candidateID := stubSourceCandidate(querier, source,
+					`{"properties":{"host":{"type":"string"},"ssl":{"type":"boolean"}},"required":["host"]}`)
+				querier.EXPECT().DeleteSourceDefinition(candidateID).Times(1).Return(nil)
+				// the definition of the instance isn't changed before the commit
+				querier.EXPECT().UpdateSourceDefinition(gomock.Any(), gomock.Any()).Times(0)
+				querier.EXPECT().CheckSourceConnectionByID(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDefinitionConnectors(utils.CONNECTOR_TYPE_SOURCE, source.DefinitionID).Times(1).
+					Return([]models.PipelineConnector{source}, nil)
+				store.EXPECT().UpdateConnectorUpgrade(gomock.Any(), utils.CONNECTOR_UPGRADE_STATUS_SCHEDULED).Times(1).
+					Return(nil)
+				store.EXPECT().UpdateSupportedSourceVersion(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				upgrade := decodeConnectorUpgrade(t, recorder)
+				require.Equal(t, utils.CONNECTOR_UPGRADE_STATUS_VALIDATED, upgrade.Status)
+
+				var checks []models.ConnectorUpgradeCheck
+				require.NoError(t, json.Unmarshal(upgrade.Checks, &checks))
+				require.Equal(t, []models.ConnectorUpgradeCheck{{
+					PipelineID:     source.PipelineID,
+					PipelineName:   source.PipelineName,
+					WorkspaceID:    source.WorkspaceID,
+					Passed:         true,
+					CatalogChanges: []models.SchemaFieldChange{},
+				}}, checks)
+
+				var specChanges []models.SpecFieldChange
+				require.NoError(t, json.Unmarshal(upgrade.SpecChanges, &specChanges))
+				require.Equal(t, []models.SpecFieldChange{
+					{Field: "ssl", ChangeType: utils.SCHEMA_CHANGE_FIELD_ADDED, NewType: "boolean"},
+				}, specChanges)
+			},
+		},
+		{
+			testScenario: "Failed_SourceBreaksSpecification",
+
+			upgrade: createRandomConnectorUpgrade(source, utils.CONNECTOR_UPGRADE_STATUS_SCHEDULED),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				candidateID := stubSourceCandidate(querier, source,
+					`{"properties":{"host":{"type":"string"},"port":{"type":"integer"}},"required":["host","port"]}`)
+				querier.EXPECT().DeleteSourceDefinition(candidateID).Times(1).Return(nil)
+				querier.EXPECT().UpdateSourceDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDefinitionConnectors(utils.CONNECTOR_TYPE_SOURCE, source.DefinitionID).Times(1).
+					Return([]models.PipelineConnector{source}, nil)
+				store.EXPECT().UpdateConnectorUpgrade(gomock.Any(), utils.CONNECTOR_UPGRADE_STATUS_SCHEDULED).Times(1).
+					Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				upgrade := decodeConnectorUpgrade(t, recorder)
+				require.Equal(t, utils.CONNECTOR_UPGRADE_STATUS_FAILED, upgrade.Status)
+
+				var checks []models.ConnectorUpgradeCheck
+				require.NoError(t, json.Unmarshal(upgrade.Checks, &checks))
+				require.Len(t, checks, 1)
+				require.False(t, checks[0].Passed)
+				require.Contains(t, checks[0].Error, "port")
+
+				var specChanges []models.SpecFieldChange
+				require.NoError(t, json.Unmarshal(upgrade.SpecChanges, &specChanges))
+				require.Equal(t, []models.SpecFieldChange{
+					{Field: "port", ChangeType: utils.SCHEMA_CHANGE_FIELD_ADDED, NewType: "integer"},
+					{Field: "port", ChangeType: utils.SPEC_CHANGE_REQUIRED_ADDED},
+				}, specChanges)
+			},
+		},
+		{
+			testScenario: "Failed_DestinationCheck",
+
+			upgrade: createRandomConnectorUpgrade(destination, utils.CONNECTOR_UPGRADE_STATUS_SCHEDULED),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				candidateID := uuid.Must(uuid.NewV4()).String()
+
+				querier.EXPECT().GetDestinationSpecification(destination.DefinitionID).Times(1).
+					Return(models.DestinationSpecification{}, nil)
+				querier.EXPECT().GetDestinationDefinition(destination.DefinitionID).Times(1).
+					Return(models.DestinationDefinition{
+						Name: destination.Name, DockerRepository: destination.DockerRepository,
+					}, nil)
+				querier.EXPECT().CreateCustomDestinationDefinition(test.AirByteWorkspaceID, models.CustomConnectorRequest{
+					Name:             destination.Name + " 0.4.8",
+					DockerRepository: destination.DockerRepository,
+					DockerImageTag:   "0.4.8",
+				}).Times(1).Return(models.DestinationDefinition{DestinationDefinitionID: candidateID}, nil)
+				querier.EXPECT().GetDestinationSpecification(candidateID).Times(1).
+					Return(models.DestinationSpecification{}, nil)
+				// the destination runs check_connection with the new version
+				querier.EXPECT().CheckDestinationConnection(map[string]interface{}{
+					"destinationDefinitionId": candidateID,
+					"connectionConfiguration": destination.Configuration,
+				}).Times(1).Return(errors.New("Could not connect with provided configuration"))
+				querier.EXPECT().DeleteDestinationDefinition(candidateID).Times(1).Return(nil)
+				querier.EXPECT().UpdateDestinationDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDefinitionConnectors(utils.CONNECTOR_TYPE_DESTINATION, destination.DefinitionID).
+					Times(1).Return([]models.PipelineConnector{destination}, nil)
+				store.EXPECT().UpdateConnectorUpgrade(gomock.Any(), utils.CONNECTOR_UPGRADE_STATUS_SCHEDULED).Times(1).
+					Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				upgrade := decodeConnectorUpgrade(t, recorder)
+				require.Equal(t, utils.CONNECTOR_UPGRADE_STATUS_FAILED, upgrade.Status)
+
+				var checks []models.ConnectorUpgradeCheck
+				require.NoError(t, json.Unmarshal(upgrade.Checks, &checks))
+				require.Len(t, checks, 1)
+				require.False(t, checks[0].Passed)
+				require.Equal(t, "Could not connect with provided configuration", checks[0].Error)
+			},
+		},
+		{
+			testScenario: "BadRequest_DBError",
+
+			upgrade: createRandomConnectorUpgrade(source, utils.CONNECTOR_UPGRADE_STATUS_FAILED),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateCustomSourceDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDefinitionConnectors(utils.CONNECTOR_TYPE_SOURCE, source.DefinitionID).Times(1).
+					Return(nil, sql.ErrConnDone)
+				store.EXPECT().UpdateConnectorUpgrade(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			store.EXPECT().GetConnectorUpgrade(testCase.upgrade.UpgradeID).Times(1).Return(testCase.upgrade, nil)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			server := test.NewTestServer(test.CONNECTOR_UPGRADE, store, airByte, nil)
+			url := fmt.Sprintf("%sconnector-upgrades/%s/validate/", test.BaseURL, testCase.upgrade.UpgradeID)
+			expectedResp, err := test.MakeHttpRequest(withRole(server, utils.USER_ROLE_ADMIN, true), http.MethodPost,
+				url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestCommitConnectorUpgrade tests all the scenarios while committing a connector upgrade.
+func TestCommitConnectorUpgrade(t *testing.T) {
+	source := createRandomPipelineConnector(utils.CONNECTOR_TYPE_SOURCE)
+
+	testCaseSuite := []struct {
+		testScenario  string
+		upgrade       models.ConnectorUpgrade
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Conflict_Failed",
+
+			upgrade: createRandomConnectorUpgrade(source, utils.CONNECTOR_UPGRADE_STATUS_FAILED),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateSourceDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().UpdateSupportedSourceVersion(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			upgrade: createRandomConnectorUpgrade(source, utils.CONNECTOR_UPGRADE_STATUS_VALIDATED),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				gomock.InOrder(
+					querier.EXPECT().GetSourceSpecification(source.DefinitionID).Times(1).
+						Return(createSpecification(`{"properties":{"host":{"type":"string"}},"required":["host"]}`), nil),
+					querier.EXPECT().UpdateSourceDefinition(source.DefinitionID, "0.4.8").Times(1).
+						Return(models.SourceDefinition{}, nil),
+					querier.EXPECT().GetSourceSpecification(source.DefinitionID).Times(1).
+						Return(createSpecification(`{"properties":{"host":{"type":"string"},"port":{"type":"integer"}},`+
+							`"required":["host","port"]}`), nil),
+					querier.EXPECT().CheckSourceConnectionByID(source.AirbyteActorID).Times(1).Return(nil),
+				)
+				querier.EXPECT().RefreshSourceSchema(source.AirbyteActorID).Times(1).
+					Return(createSourceSchema(t, map[string]interface{}{
+						"id":    map[string]interface{}{"type": "integer"},
+						"email": map[string]interface{}{"type": "string"},
+					}), nil)
+				querier.EXPECT().GetConnectionSchema(source.AirbyteConnectionID).Times(1).
+					Return(createConnectionSchema(map[string]interface{}{
+						"id": map[string]interface{}{"type": "integer"},
+					}), nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDefinitionConnectors(utils.CONNECTOR_TYPE_SOURCE, source.DefinitionID).Times(1).
+					Return([]models.PipelineConnector{source}, nil)
+				store.EXPECT().UpdateSupportedSourceVersion(source.DefinitionID, "0.4.8").Times(1).Return(nil)
+				store.EXPECT().UpdateConnectorUpgrade(gomock.Any(), utils.CONNECTOR_UPGRADE_STATUS_VALIDATED).Times(1).
+					Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				upgrade := decodeConnectorUpgrade(t, recorder)
+				require.Equal(t, utils.CONNECTOR_UPGRADE_STATUS_COMMITTED, upgrade.Status)
+
+				var checks []models.ConnectorUpgradeCheck
+				require.NoError(t, json.Unmarshal(upgrade.Checks, &checks))
+				require.Equal(t, []models.ConnectorUpgradeCheck{{
+					PipelineID:   source.PipelineID,
+					PipelineName: source.PipelineName,
+					WorkspaceID:  source.WorkspaceID,
+					Passed:       true,
+					CatalogChanges: []models.SchemaFieldChange{{
+						Stream: "users", Field: "email", ChangeType: utils.SCHEMA_CHANGE_FIELD_ADDED, NewType: "string",
+					}},
+				}}, checks)
+
+				var specChanges []models.SpecFieldChange
+				require.NoError(t, json.Unmarshal(upgrade.SpecChanges, &specChanges))
+				require.Equal(t, []models.SpecFieldChange{
+					{Field: "port", ChangeType: utils.SCHEMA_CHANGE_FIELD_ADDED, NewType: "integer"},
+					{Field: "port", ChangeType: utils.SPEC_CHANGE_REQUIRED_ADDED},
+				}, specChanges)
+			},
+		},
+		{
+			testScenario: "Failed_CheckRestoresPreviousVersion",
+
+			upgrade: createRandomConnectorUpgrade(source, utils.CONNECTOR_UPGRADE_STATUS_VALIDATED),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				gomock.InOrder(
+					querier.EXPECT().UpdateSourceDefinition(source.DefinitionID, "0.4.8").Times(1).
+						Return(models.SourceDefinition{}, nil),
+					querier.EXPECT().CheckSourceConnectionByID(source.AirbyteActorID).Times(1).
+						Return(errors.New("Could not connect with provided configuration")),
+					querier.EXPECT().UpdateSourceDefinition(source.DefinitionID, "0.4.4").Times(1).
+						Return(models.SourceDefinition{}, nil),
+				)
+				querier.EXPECT().GetSourceSpecification(source.DefinitionID).Times(2).
+					Return(models.SourceSpecification{}, nil)
+				querier.EXPECT().RefreshSourceSchema(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDefinitionConnectors(utils.CONNECTOR_TYPE_SOURCE, source.DefinitionID).Times(1).
+					Return([]models.PipelineConnector{source}, nil)
+				store.EXPECT().UpdateSupportedSourceVersion(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateConnectorUpgrade(gomock.Any(), utils.CONNECTOR_UPGRADE_STATUS_VALIDATED).Times(1).
+					Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				upgrade := decodeConnectorUpgrade(t, recorder)
+				require.Equal(t, utils.CONNECTOR_UPGRADE_STATUS_FAILED, upgrade.Status)
+
+				var checks []models.ConnectorUpgradeCheck
+				require.NoError(t, json.Unmarshal(upgrade.Checks, &checks))
+				require.Len(t, checks, 1)
+				require.Equal(t, "Could not connect with provided configuration", checks[0].Error)
+			},
+		},
+		{
+			testScenario: "BadRequest_AirbyteError",
+
+			upgrade: createRandomConnectorUpgrade(source, utils.CONNECTOR_UPGRADE_STATUS_VALIDATED),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceSpecification(source.DefinitionID).Times(1).
+					Return(models.SourceSpecification{}, errors.New("AirByte Server is Down"))
+				querier.EXPECT().UpdateSourceDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDefinitionConnectors(utils.CONNECTOR_TYPE_SOURCE, source.DefinitionID).Times(1).
+					Return([]models.PipelineConnector{source}, nil)
+				store.EXPECT().UpdateConnectorUpgrade(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			store.EXPECT().GetConnectorUpgrade(testCase.upgrade.UpgradeID).Times(1).Return(testCase.upgrade, nil)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			server := test.NewTestServer(test.CONNECTOR_UPGRADE, store, airByte, nil)
+			url := fmt.Sprintf("%sconnector-upgrades/%s/commit/", test.BaseURL, testCase.upgrade.UpgradeID)
+			expectedResp, err := test.MakeHttpRequest(withRole(server, utils.USER_ROLE_ADMIN, true), http.MethodPost,
+				url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestRollbackConnectorUpgrade tests all the scenarios while rolling back a connector upgrade.
+func TestRollbackConnectorUpgrade(t *testing.T) {
+	destination := createRandomPipelineConnector(utils.CONNECTOR_TYPE_DESTINATION)
+	upgrade := createRandomConnectorUpgrade(destination, utils.CONNECTOR_UPGRADE_STATUS_COMMITTED)
+
+	testCaseSuite := []struct {
+		testScenario  string
+		role          string
+		superAdmin    bool
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Forbidden_Editor",
+
+			role: utils.USER_ROLE_EDITOR,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateDestinationDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetConnectorUpgrade(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Forbidden_WorkspaceAdmin",
+
+			role: utils.USER_ROLE_ADMIN,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateDestinationDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetConnectorUpgrade(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			role:       utils.USER_ROLE_ADMIN,
+			superAdmin: true,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateDestinationDefinition(destination.DefinitionID, "0.4.4").Times(1).
+					Return(models.DestinationDefinition{}, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetConnectorUpgrade(upgrade.UpgradeID).Times(1).Return(upgrade, nil)
+				store.EXPECT().UpdateSupportedDestinationVersion(destination.DefinitionID, "0.4.4").Times(1).Return(nil)
+				store.EXPECT().UpdateConnectorUpgrade(gomock.Any(), utils.CONNECTOR_UPGRADE_STATUS_COMMITTED).Times(1).
+					Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, utils.CONNECTOR_UPGRADE_STATUS_ROLLED_BACK, decodeConnectorUpgrade(t, recorder).Status)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			server := test.NewTestServer(test.CONNECTOR_UPGRADE, store, airByte, nil)
+			url := fmt.Sprintf("%sconnector-upgrades/%s/rollback/", test.BaseURL, upgrade.UpgradeID)
+			expectedResp, err := test.MakeHttpRequest(withRole(server, testCase.role, testCase.superAdmin),
+				http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// withRole sends the requests to the server with the role of the caller and whether they are a super admin.
+func withRole(server http.Handler, role string, superAdmin bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Set("userRole", role)
+		r.Header.Set("isSuperAdmin", strconv.FormatBool(superAdmin))
+		server.ServeHTTP(w, r)
+	})
+}
+
+// stubSourceCandidate expects the candidate definition of the source running the new version to be created and the
+// configuration of the source to be validated against its specification, it returns the ID of the candidate.
+func stubSourceCandidate(querier *mockairbyte.MockAirByteQuerier, source models.PipelineConnector,
+	connectionSpecification string) string {
+	candidateID := uuid.Must(uuid.NewV4()).String()
+
+	querier.EXPECT().GetSourceSpecification(source.DefinitionID).Times(1).
+		Return(createSpecification(`{"properties":{"host":{"type":"string"}},"required":["host"]}`), nil)
+	querier.EXPECT().GetSourceDefinition(source.DefinitionID).Times(1).
+		Return(models.SourceDefinition{Name: source.Name, DockerRepository: source.DockerRepository}, nil)
+	querier.EXPECT().CreateCustomSourceDefinition(test.AirByteWorkspaceID, models.CustomConnectorRequest{
+		Name:             source.Name + " 0.4.8",
+		DockerRepository: source.DockerRepository,
+		DockerImageTag:   "0.4.8",
+	}).Times(1).Return(models.SourceDefinition{SourceDefinitionID: candidateID}, nil)
+	querier.EXPECT().GetSourceDefinition(candidateID).Times(1).
+		Return(models.SourceDefinition{SourceDefinitionID: candidateID, DockerImageTag: "0.4.8"}, nil)
+	querier.EXPECT().GetSourceSpecification(candidateID).Times(2).
+		Return(createSpecification(connectionSpecification), nil)
+	querier.EXPECT().GetConfiguredSource(source.AirbyteActorID).Times(1).
+		Return(models.ConfiguredSource{ConnectionConfiguration: map[string]interface{}{"host": "db"}}, nil)
+
+	return candidateID
+}
+
+func createRandomPipelineConnector(connectorType string) models.PipelineConnector {
+	pID, _ := uuid.NewV1()
+	definitionID, _ := uuid.NewV1()
+
+	return models.PipelineConnector{
+		PipelineID:          pID.String(),
+		PipelineName:        utils.RandomString(8),
+		WorkspaceID:         int(utils.RandomInt(1, 1000)),
+		ConnectorType:       connectorType,
+		DefinitionID:        definitionID.String(),
+		Name:                utils.RandomString(6),
+		DockerRepository:    "airbyte/" + connectorType + "-" + utils.RandomString(6),
+		DockerImageTag:      "0.4.4",
+		AirbyteConnectionID: utils.RandomString(10),
+		AirbyteActorID:      utils.RandomString(10),
+		Configuration:       []byte(`{"host":"db"}`),
+	}
+}
+
+func createRandomConnectorUpgrade(connector models.PipelineConnector, status string) models.ConnectorUpgrade {
+	upgradeID, _ := uuid.NewV1()
+
+	return models.ConnectorUpgrade{
+		UpgradeID:       upgradeID,
+		ConnectorType:   connector.ConnectorType,
+		DefinitionID:    connector.DefinitionID,
+		PreviousVersion: connector.DockerImageTag,
+		Version:         "0.4.8",
+		Status:          status,
+		Checks:          []byte("[]"),
+		SpecChanges:     []byte("[]"),
+		WorkspaceID:     1122,
+		CreatedBy:       1122,
+	}
+}
+
+func createSpecification(connectionSpecification string) models.SourceSpecification {
+	var specification models.SourceSpecification
+	_ = json.Unmarshal([]byte(connectionSpecification), &specification.ConnectionSpecification)
+
+	return specification
+}
+
+func createConnectionSchema(properties map[string]interface{}) models.ConnectionSourceSchema {
+	return models.ConnectionSourceSchema{
+		SyncCatalog: models.SyncCatalog{Streams: []models.Streams{{
+			Stream: models.Stream{
+				Name:       "users",
+				JsonSchema: map[string]interface{}{"type": "object", "properties": properties},
+			},
+			Config: models.Config{SyncMode: "full_refresh", Selected: true},
+		}}},
+	}
+}
+
+func createSourceSchema(t *testing.T, properties map[string]interface{}) models.SourceSchema {
+	var sourceSchema models.SourceSchema
+
+	catalog, err := json.Marshal(map[string]interface{}{"catalog": createConnectionSchema(properties).SyncCatalog})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(catalog, &sourceSchema))
+
+	return sourceSchema
+}
+
+func decodeConnectorUpgrade(t *testing.T, recorder *httptest.ResponseRecorder) models.ConnectorUpgrade {
+	var res models.ConnectorUpgradeResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+
+	return res.Data
+}
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+
+	os.Exit(m.Run())
+}
@@ -2,16 +2,22 @@ package dataProduct
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
 	"pipelineService/clients/airbyte"
 	"pipelineService/clients/authService"
 	"pipelineService/clients/cadenceClient"
 	"pipelineService/handlers/v1/pipeline"
 	"pipelineService/models/v1"
+	"pipelineService/services/dataQuality"
 	"pipelineService/services/db"
+	"pipelineService/services/destinationPool"
+	"pipelineService/services/profiling"
 	"pipelineService/utils"
 )
 
@@ -112,6 +118,15 @@ func (server *Server) GetDataProduct(ctx *gin.Context) {
 
 	dataProduct.Owner = authResponse.Payload.UserInfo
 
+	dataProduct.Quality, err = server.Store.GetProductQualityResults(dataProductID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Quality Results")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
 	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", dataProduct)
 	logger.Info("GetDataProduct endpoint returned successfully")
 }
@@ -435,10 +450,108 @@ func (server *Server) SyncTransformedAssets(ctx *gin.Context) {
 		return
 	}
 
+	// the data dictionary is refreshed on a best effort basis, the assets are already synced
+	for _, productAsset := range productAssestDetails {
+		if err := server.syncTransformedAssetColumns(productAsset); err != nil {
+			logger.Error(err.Error())
+		}
+	}
+
+	// the transformation succeeded, its assets are profiled and their quality rules are checked in the background
+	// since it scans them
+	go server.inspectTransformedAssets(productAssestDetails)
+
 	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", nil)
 	logger.Info("SyncTransformedAssets endpoint returned successfully")
 }
 
+// syncTransformedAssetColumns populates the data dictionary of the transformed assets from the information schema
+// of the destination they are materialized in.
+func (server *Server) syncTransformedAssetColumns(productAsset models.ProductAssetDetails) error {
+	productID, err := uuid.FromString(productAsset.ProductID)
+	if err != nil {
+		return err
+	}
+
+	transformedAssets, err := server.Store.GetTransformedAssets(productID)
+	if err != nil || len(transformedAssets) == 0 {
+		return err
+	}
+
+	assetID, _ := uuid.FromString(transformedAssets[0].AssetID)
+
+	assetDetails, err := server.Store.GetTransformedAssetDetails(assetID)
+	if err != nil {
+		return err
+	}
+
+	// the pooled connection honors the SSL mode and the tunnel of the destination
+	dbConn, err := destinationPool.Postgres(assetDetails.DestinationID, assetDetails.DestinationConfiguration.String())
+	if err != nil {
+		return err
+	}
+
+	tableColumns, err := server.Store.GetTableColumns(dbConn, productAsset.Schema, productAsset.Table)
+	if err != nil {
+		return err
+	}
+
+	assets := make(map[string]models.ProductAssets)
+	for _, asset := range transformedAssets {
+		assets[asset.Name] = asset
+	}
+
+	columns := make([]models.AssetColumn, 0, len(tableColumns))
+
+	for _, tableColumn := range tableColumns {
+		asset, ok := assets[tableColumn.TableName]
+		if !ok {
+			continue
+		}
+
+		columns = append(columns, models.AssetColumn{
+			AssetID:     asset.AssetID,
+			ParentID:    asset.ProductID,
+			AssetName:   asset.Name,
+			Name:        tableColumn.ColumnName,
+			Type:        tableColumn.DataType,
+			Nullable:    tableColumn.IsNullable == "YES",
+			Position:    tableColumn.Position,
+			WorkspaceID: asset.WorkspaceID,
+		})
+	}
+
+	return server.Store.SyncAssetColumns(productAsset.ProductID, columns)
+}
+
+func (server *Server) inspectTransformedAssets(productAssetDetails []models.ProductAssetDetails) {
+	logger := utils.GetLogger()
+
+	for _, productAsset := range productAssetDetails {
+		productID, err := uuid.FromString(productAsset.ProductID)
+		if err != nil {
+			logger.Error(err.Error())
+
+			continue
+		}
+
+		transformedAssets, err := server.Store.GetTransformedAssets(productID)
+		if err != nil {
+			logger.Error(err.Error())
+
+			continue
+		}
+
+		assetIDs := make([]string, 0, len(transformedAssets))
+		for _, asset := range transformedAssets {
+			assetIDs = append(assetIDs, asset.AssetID)
+		}
+
+		profiling.ProfileAssets(server.Store, assetIDs)
+		dataQuality.CheckAssets(server.Store, assetIDs)
+	}
+}
+
 // GetProductDetails return the name of the products
 // @Summary Returns updated data product
 // @Description returns the name of the data product that can be transformed
@@ -527,3 +640,336 @@ func (server *Server) UpdateTransformations(ctx *gin.Context) {
 	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "transformation updated successfully")
 	logger.Info("UpdatePipelineConnectionOnAirByte endpoint returned successfully")
 }
+
+// DeleteDataProduct soft deletes the data product
+// @Summary soft deletes a data product
+// @Description soft deletes the data product, it can be restored until it is purged after the retention window
+// @Tags data-products
+// @Produce  json
+// @Param id path string true "Product ID"
+// @Success 200 {object} models.Response
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /data-products/{id}/ [delete].
+func (server *Server) DeleteDataProduct(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DeleteDataProduct endpoint called")
+
+	dataProductID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	err = server.Store.DeleteDataProduct(dataProductID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Data Product")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Data Product deleted successfully")
+	logger.Info("DeleteDataProduct endpoint returned successfully")
+}
+
+// RestoreDataProduct restores a soft deleted data product
+// @Summary restores a data product
+// @Description restores a soft deleted data product
+// @Tags data-products
+// @Produce  json
+// @Param id path string true "Product ID"
+// @Success 200 {object} models.Response
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /data-products/{id}/restore/ [post].
+func (server *Server) RestoreDataProduct(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("RestoreDataProduct endpoint called")
+
+	dataProductID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	err = server.Store.RestoreDataProduct(dataProductID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Data Product")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Data Product restored successfully")
+	logger.Info("RestoreDataProduct endpoint returned successfully")
+}
+
+// GetFreshnessSLA returns the freshness SLA of the data product
+// @Summary Get Freshness SLA
+// @Description Returns the freshness SLA of the data product with its status as of its latest evaluation
+// @Tags data-products
+// @Produce  json
+// @Param id path string true "Product ID"
+// @Success 200 {object} models.FreshnessSLAResponse
+// @Failure 400	{object} models.Response
+// @Failure 404	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /data-products/{id}/sla/ [get].
+func (server *Server) GetFreshnessSLA(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetFreshnessSLA endpoint called")
+
+	dataProductID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	sla, err := server.Store.GetFreshnessSLA(dataProductID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, "Data Product has no freshness SLA", nil)
+
+		return
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Freshness SLA")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", sla)
+	logger.Info("GetFreshnessSLA endpoint returned successfully")
+}
+
+// SaveFreshnessSLA declares the freshness SLA of the data product
+// @Summary Save Freshness SLA
+// @Description Declares how many minutes the data product may go without a successful sync of its pipelines and transformation, or changes the declared SLA
+// @Tags data-products
+// @Produce  json
+// @Param id path string true "Product ID"
+// @Param sla body models.FreshnessSLARequest true "Freshness SLA"
+// @Success 200 {object} models.FreshnessSLAResponse
+// @Failure 400	{object} models.Response
+// @Failure 403	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /data-products/{id}/sla/ [put].
+func (server *Server) SaveFreshnessSLA(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("SaveFreshnessSLA endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !server.canManageFreshnessSLA(ctx) {
+		return
+	}
+
+	dataProductID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var request models.FreshnessSLARequest
+	if err = ctx.ShouldBindJSON(&request); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	product, err := server.Store.GetDataProductInfo(dataProductID)
+	if err == nil && product.WorkspaceID != workspaceID {
+		err = errors.New("Product doesn't exists")
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Data Product")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	sla, err := server.Store.SaveFreshnessSLA(models.FreshnessSLA{
+		ProductID:           dataProductID,
+		MaxStalenessMinutes: request.MaxStalenessMinutes,
+		Status:              utils.FRESHNESS_SLA_STATUS_PENDING,
+		CreatedBy:           userID,
+		WorkspaceID:         workspaceID,
+		CreatedAt:           time.Now().UnixMilli(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Freshness SLA")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", sla)
+	logger.Info("SaveFreshnessSLA endpoint returned successfully")
+}
+
+// DeleteFreshnessSLA removes the freshness SLA of the data product
+// @Summary Delete Freshness SLA
+// @Description Removes the freshness SLA of the data product, its breach history is kept
+// @Tags data-products
+// @Produce  json
+// @Param id path string true "Product ID"
+// @Success 200 {object} models.Response
+// @Failure 400	{object} models.Response
+// @Failure 403	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /data-products/{id}/sla/ [delete].
+func (server *Server) DeleteFreshnessSLA(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DeleteFreshnessSLA endpoint called")
+
+	if !server.canManageFreshnessSLA(ctx) {
+		return
+	}
+
+	dataProductID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err = server.Store.DeleteFreshnessSLA(dataProductID); err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Freshness SLA")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Freshness SLA deleted successfully")
+	logger.Info("DeleteFreshnessSLA endpoint returned successfully")
+}
+
+// GetFreshnessSLAEvents returns the breach history of the data product
+// @Summary Get Freshness SLA Events
+// @Description Returns the breaches and recoveries of the freshness SLA of the data product, latest first
+// @Tags data-products
+// @Produce  json
+// @Param id path string true "Product ID"
+// @Success 200 {object} models.FreshnessSLAEventsResponse
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /data-products/{id}/sla/events/ [get].
+func (server *Server) GetFreshnessSLAEvents(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetFreshnessSLAEvents endpoint called")
+
+	dataProductID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	events, err := server.Store.GetFreshnessSLAEvents(dataProductID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Freshness SLA Events")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", events)
+	logger.Info("GetFreshnessSLAEvents endpoint returned successfully")
+}
+
+// EvaluateFreshnessSLAs evaluates the freshness SLAs of the data products
+// @Summary Evaluates the freshness SLAs
+// @Description Computes the staleness of every data product with a freshness SLA from the latest successful syncs of its pipelines and transformation, and emits an event when an SLA is breached or recovered
+// @Tags data-products/internal
+// @Produce  json
+// @Success 200 {object} models.FreshnessReportResponse
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /data-products/internal/sla/evaluate/ [post].
+func (server *Server) EvaluateFreshnessSLAs(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("EvaluateFreshnessSLAs internal endpoint called")
+
+	slas, err := server.Store.GetFreshnessSLAs()
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Freshness SLAs")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	report := models.FreshnessReport{
+		Evaluated: len(slas),
+		Breached:  make([]string, 0),
+		Recovered: make([]string, 0),
+		Failures:  make([]string, 0),
+	}
+
+	now := time.Now()
+
+	for _, sla := range slas {
+		evaluatedSLA, event, err := server.evaluateFreshnessSLA(sla, now)
+		if err == nil {
+			err = server.Store.UpdateFreshnessSLAStatus(evaluatedSLA)
+		}
+
+		if err == nil && event != nil {
+			err = server.emitFreshnessSLAEvent(*event)
+		}
+
+		if err != nil {
+			logger.Error(err.Error())
+			report.Failures = append(report.Failures, sla.ProductID.String())
+
+			continue
+		}
+
+		if event == nil {
+			continue
+		}
+
+		if event.Type == utils.FRESHNESS_SLA_EVENT_BREACHED {
+			report.Breached = append(report.Breached, event.ProductID)
+		} else {
+			report.Recovered = append(report.Recovered, event.ProductID)
+		}
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", report)
+	logger.Info("EvaluateFreshnessSLAs internal endpoint returned successfully")
+}
+
+func (server *Server) canManageFreshnessSLA(ctx *gin.Context) bool {
+	if utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		return true
+	}
+
+	errMsg := "Only editors can manage the freshness SLAs"
+	utils.GetLogger().Error(errMsg)
+	utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+	return false
+}
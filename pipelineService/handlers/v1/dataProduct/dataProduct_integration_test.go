@@ -3,16 +3,19 @@ package dataProduct_test
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	mock_airbyte "pipelineService/clients/airbyte/mocks"
 	"pipelineService/clients/authService"
 	mock_authservice "pipelineService/clients/authService/mocks"
 	"pipelineService/handlers/v1/test"
@@ -201,6 +204,7 @@ func TestGetDataProduct(t *testing.T) {
 			buildStubs: func(store *mockStore.MockStore) {
 				arg := mockDataProductView.ProductID
 				store.EXPECT().GetDataProduct(arg).Times(1).Return(mockDataProductView, nil)
+				store.EXPECT().GetProductQualityResults(arg).Times(1).Return([]models.QualityCheckResult{}, nil)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -212,6 +216,7 @@ func TestGetDataProduct(t *testing.T) {
 					Data: models.GetDataProductView{
 						DataProduct: mockDataProductView,
 						Owner:       mockUser.Payload.UserInfo,
+						Quality:     []models.QualityCheckResult{},
 					},
 				}
 				actual, e := json.Marshal(res)
@@ -533,6 +538,241 @@ func TestAddPipeline(t *testing.T) {
 }
 
 // createRandomUserDetails populates and return the UserDetails Model with random values.
+// TestSaveFreshnessSLA tests all the scenarios while declaring the freshness SLA of a Data Product.
+func TestSaveFreshnessSLA(t *testing.T) {
+	mockDataProduct := createRandomDataProduct()
+	mockSLA := createRandomFreshnessSLA(mockDataProduct.ProductID)
+
+	testCaseSuite := []struct {
+		testScenario  string
+		body          interface{}
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidStaleness",
+
+			body: models.FreshnessSLARequest{MaxStalenessMinutes: -5},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().SaveFreshnessSLA(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_OtherWorkspace",
+
+			body: models.FreshnessSLARequest{MaxStalenessMinutes: 360},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				otherProduct := mockDataProduct
+				otherProduct.WorkspaceID = 1
+
+				store.EXPECT().GetDataProductInfo(mockDataProduct.ProductID).Times(1).Return(otherProduct, nil)
+				store.EXPECT().SaveFreshnessSLA(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			body: models.FreshnessSLARequest{MaxStalenessMinutes: 360},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDataProductInfo(mockDataProduct.ProductID).Times(1).Return(mockDataProduct, nil)
+				store.EXPECT().SaveFreshnessSLA(gomock.Any()).Times(1).DoAndReturn(
+					func(sla models.FreshnessSLA) (models.FreshnessSLA, error) {
+						require.Equal(t, int64(360), sla.MaxStalenessMinutes)
+						require.Equal(t, utils.FRESHNESS_SLA_STATUS_PENDING, sla.Status)
+						require.Equal(t, 1122, sla.WorkspaceID)
+
+						return mockSLA, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockSLA,
+				}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.DATA_PRODUCT, store, nil, nil)
+			url := fmt.Sprintf("%sdata-products/%s/sla/", test.BaseURL, mockDataProduct.ProductID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPut, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestEvaluateFreshnessSLAs tests all the scenarios while evaluating the freshness SLAs of the Data Products.
+func TestEvaluateFreshnessSLAs(t *testing.T) {
+	productID, _ := uuid.NewV1()
+	mockSLA := createRandomFreshnessSLA(productID)
+	connectionID, _ := uuid.NewV1()
+	historyRequest := models.SyncHistoryRequest{ConfigTypes: []string{utils.SYNC}, ConfigId: connectionID.String()}
+
+	staleHistory := createSyncHistory(t, time.Now().Add(-8*time.Hour))
+	freshHistory := createSyncHistory(t, time.Now().Add(-time.Hour))
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		queryAirByte  func(querier *mock_airbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Breached",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetFreshnessSLAs().Times(1).Return([]models.FreshnessSLA{mockSLA}, nil)
+				store.EXPECT().GetProductAirbyteConnectionIDs(productID).Times(1).Return([]string{connectionID.String()}, nil)
+				store.EXPECT().UpdateFreshnessSLAStatus(gomock.Any()).Times(1).DoAndReturn(func(sla models.FreshnessSLA) error {
+					require.Equal(t, utils.FRESHNESS_SLA_STATUS_BREACHED, sla.Status)
+					require.GreaterOrEqual(t, sla.StalenessMinutes, int64(479))
+
+					return nil
+				})
+				store.EXPECT().CreateFreshnessSLAEvent(gomock.Any()).Times(1).DoAndReturn(func(event models.FreshnessSLAEvent) error {
+					require.Equal(t, utils.FRESHNESS_SLA_EVENT_BREACHED, event.Type)
+					require.Equal(t, productID.String(), event.ProductID)
+
+					return nil
+				})
+				store.EXPECT().CreateAuditLog(gomock.Any()).Times(1).DoAndReturn(func(auditLog models.AuditLog) error {
+					require.Equal(t, "FreshnessSLABreached", auditLog.Action)
+					require.Equal(t, productID.String(), auditLog.ResourceID)
+
+					return nil
+				})
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				querier.EXPECT().FetchSyncHistory(historyRequest).Times(1).Return(staleHistory, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.FreshnessReport{
+						Evaluated: 1,
+						Breached:  []string{productID.String()},
+						Recovered: []string{},
+						Failures:  []string{},
+					},
+				}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Met_NoEvent",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetFreshnessSLAs().Times(1).Return([]models.FreshnessSLA{mockSLA}, nil)
+				store.EXPECT().GetProductAirbyteConnectionIDs(productID).Times(1).Return([]string{connectionID.String()}, nil)
+				store.EXPECT().UpdateFreshnessSLAStatus(gomock.Any()).Times(1).Return(nil)
+				store.EXPECT().CreateFreshnessSLAEvent(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				querier.EXPECT().FetchSyncHistory(historyRequest).Times(1).Return(freshHistory, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			testScenario: "AirbyteFailure",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetFreshnessSLAs().Times(1).Return([]models.FreshnessSLA{mockSLA}, nil)
+				store.EXPECT().GetProductAirbyteConnectionIDs(productID).Times(1).Return([]string{connectionID.String()}, nil)
+				store.EXPECT().UpdateFreshnessSLAStatus(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				querier.EXPECT().FetchSyncHistory(historyRequest).Times(1).
+					Return(models.SyncHistoryResponse{}, errors.New("airbyte is unreachable"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.FreshnessReport{
+						Evaluated: 1,
+						Breached:  []string{},
+						Recovered: []string{},
+						Failures:  []string{productID.String()},
+					},
+				}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			querier := mock_airbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(querier)
+
+			server := test.NewTestServer(test.DATA_PRODUCT, store, querier, nil)
+			url := fmt.Sprintf("%sdata-products/internal/sla/evaluate/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
 func createRandomUserDetails(uID int, wsID int) models.UserDetails {
 	user := models.UserDetails{
 		Success: true,
@@ -649,6 +889,28 @@ func createRandomInputPipelines() models.InputProductsPipelines {
 }
 
 // TestMain runs the package level test in TestMode.
+func createRandomFreshnessSLA(productID uuid.UUID) models.FreshnessSLA {
+	return models.FreshnessSLA{
+		ProductID:           productID,
+		MaxStalenessMinutes: 360,
+		Status:              utils.FRESHNESS_SLA_STATUS_MET,
+		CreatedBy:           1122,
+		WorkspaceID:         1122,
+		CreatedAt:           time.Now().Add(-24 * time.Hour).UnixMilli(),
+	}
+}
+
+// createSyncHistory returns the history of a connection whose latest job failed after a job succeeded at the time.
+func createSyncHistory(t *testing.T, succeededAt time.Time) models.SyncHistoryResponse {
+	var history models.SyncHistoryResponse
+
+	document := fmt.Sprintf(`{"jobs": [{"job": {"status": "failed", "updatedAt": %d}}, {"job": {"status": "succeeded", "updatedAt": %d}}]}`,
+		time.Now().Unix(), succeededAt.Unix())
+	require.NoError(t, json.Unmarshal([]byte(document), &history))
+
+	return history
+}
+
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
 
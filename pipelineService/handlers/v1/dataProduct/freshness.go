@@ -0,0 +1,123 @@
+package dataProduct
+
+import (
+	"encoding/json"
+	"time"
+
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// lastSucceededSyncAt returns when the stalest of the airbyte connections last synced successfully in milliseconds,
+// 0 is returned when one of them never succeeded.
+func (server *Server) lastSucceededSyncAt(connectionIDs []string) (int64, error) {
+	var lastUpdatedAt int64
+
+	for i, connectionID := range connectionIDs {
+		syncHistory, err := server.Airbyte.FetchSyncHistory(models.SyncHistoryRequest{
+			ConfigTypes: []string{utils.SYNC},
+			ConfigId:    connectionID,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		var succeededAt int64
+
+		// airbyte lists the jobs latest first
+		for _, job := range syncHistory.Jobs {
+			if job.Job.Status == utils.AIRBYTE_JOB_STATUS_SUCCEEDED {
+				succeededAt = int64(job.Job.UpdatedAt) * 1000
+
+				break
+			}
+		}
+
+		if succeededAt == 0 {
+			return 0, nil
+		}
+
+		if i == 0 || succeededAt < lastUpdatedAt {
+			lastUpdatedAt = succeededAt
+		}
+	}
+
+	return lastUpdatedAt, nil
+}
+
+// evaluateFreshnessSLA computes the staleness of the data product and returns the event of the SLA when its status
+// changed to or from breached. A product which never synced is as stale as its SLA is old.
+func (server *Server) evaluateFreshnessSLA(sla models.FreshnessSLA, now time.Time) (models.FreshnessSLA, *models.FreshnessSLAEvent, error) {
+	connectionIDs, err := server.Store.GetProductAirbyteConnectionIDs(sla.ProductID)
+	if err != nil {
+		return sla, nil, err
+	}
+
+	lastUpdatedAt, err := server.lastSucceededSyncAt(connectionIDs)
+	if err != nil {
+		return sla, nil, err
+	}
+
+	since := lastUpdatedAt
+	if since == 0 {
+		since = sla.CreatedAt
+	}
+
+	previousStatus := sla.Status
+
+	sla.LastUpdatedAt = lastUpdatedAt
+	sla.StalenessMinutes = int64(now.Sub(time.UnixMilli(since)).Minutes())
+	sla.EvaluatedAt = now.UnixMilli()
+	sla.Status = utils.FRESHNESS_SLA_STATUS_MET
+
+	if sla.StalenessMinutes > sla.MaxStalenessMinutes {
+		sla.Status = utils.FRESHNESS_SLA_STATUS_BREACHED
+	}
+
+	var eventType string
+
+	switch {
+	case sla.Status == utils.FRESHNESS_SLA_STATUS_BREACHED && previousStatus != utils.FRESHNESS_SLA_STATUS_BREACHED:
+		eventType = utils.FRESHNESS_SLA_EVENT_BREACHED
+	case sla.Status == utils.FRESHNESS_SLA_STATUS_MET && previousStatus == utils.FRESHNESS_SLA_STATUS_BREACHED:
+		eventType = utils.FRESHNESS_SLA_EVENT_RECOVERED
+	default:
+		return sla, nil, nil
+	}
+
+	return sla, &models.FreshnessSLAEvent{
+		ProductID:           sla.ProductID.String(),
+		Type:                eventType,
+		MaxStalenessMinutes: sla.MaxStalenessMinutes,
+		StalenessMinutes:    sla.StalenessMinutes,
+		LastUpdatedAt:       sla.LastUpdatedAt,
+		WorkspaceID:         sla.WorkspaceID,
+		OccurredAt:          sla.EvaluatedAt,
+	}, nil
+}
+
+// emitFreshnessSLAEvent records the event in the breach history of the data product and in the audit log of the
+// workspace, where it's attributed to no user.
+func (server *Server) emitFreshnessSLAEvent(event models.FreshnessSLAEvent) error {
+	if err := server.Store.CreateFreshnessSLAEvent(event); err != nil {
+		return err
+	}
+
+	after, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	action := "FreshnessSLABreached"
+	if event.Type == utils.FRESHNESS_SLA_EVENT_RECOVERED {
+		action = "FreshnessSLARecovered"
+	}
+
+	return server.Store.CreateAuditLog(models.AuditLog{
+		WorkspaceID:  event.WorkspaceID,
+		Action:       action,
+		ResourceType: "data-products",
+		ResourceID:   event.ProductID,
+		After:        after,
+	})
+}
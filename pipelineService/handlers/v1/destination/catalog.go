@@ -0,0 +1,269 @@
+package destination
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"pipelineService/models/v1"
+	"pipelineService/services/connectorCatalog"
+	"pipelineService/utils"
+)
+
+// SetDestinationAvailability enables or disables a destination for the workspace
+// @Summary Enable or Disable Destination
+// @Description Enables or disables a destination of the catalog for the workspace, the disabled destinations can't be configured. Only admins can change the destinations of the workspace
+// @Tags destination
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Airbyte Destination Definition ID"
+// @Param availability body models.ConnectorAvailabilityRequest true "Availability"
+// @Success 200 {object} models.WorkspaceConnectorResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /destinations/definitions/{id}/ [put].
+func (server *Server) SetDestinationAvailability(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("SetDestinationAvailability endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.IsAdmin(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only admins can enable or disable the destinations of the workspace"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	definitionID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var availability models.ConnectorAvailabilityRequest
+	if err = ctx.ShouldBindJSON(&availability); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if _, err = server.Store.GetSupportedDestination(workspaceID, definitionID.String()); err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Supported Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	connector, err := server.Store.SaveWorkspaceConnector(models.WorkspaceConnector{
+		WorkspaceID:   workspaceID,
+		ConnectorType: utils.CONNECTOR_TYPE_DESTINATION,
+		DefinitionID:  definitionID.String(),
+		Enabled:       *availability.Enabled,
+		UpdatedBy:     userID,
+		UpdatedAt:     time.Now().UnixMilli(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Workspace Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", connector)
+	logger.Info("SetDestinationAvailability endpoint returned")
+}
+
+// ReconcileDestinationDefinitions syncs the supported destinations with the destination definitions of airbyte
+// @Summary Reconcile Destination Definitions
+// @Description Upserts the supported destinations with the docker image, version, release stage, icon and documentation of the destination definitions listed by airbyte. The destinations airbyte stopped listing are deactivated
+// @Tags destinations/internal
+// @Produce  json
+// @Success 200 {object} models.ConnectorCatalogReportResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /destinations/internal/definitions/reconcile/ [post].
+func (server *Server) ReconcileDestinationDefinitions(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ReconcileDestinationDefinitions internal endpoint called")
+
+	report, err := connectorCatalog.ReconcileDestinations(server.Store, server.Airbyte)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Supported Destinations")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", report)
+	logger.Info("ReconcileDestinationDefinitions internal endpoint returned successfully")
+}
+
+// RegisterCustomDestination registers an in-house destination connector for the workspace
+// @Summary Register Custom Destination
+// @Description Registers the docker image of an in-house destination connector as a custom destination definition of the workspace on airbyte and lists it in the supported destinations of the workspace. Only admins can register custom destinations
+// @Tags destination
+// @Accept  json
+// @Produce  json
+// @Param connector body models.CustomConnectorRequest true "Custom Destination"
+// @Success 201 {object} models.CustomDestinationResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /destinations/custom/ [post].
+func (server *Server) RegisterCustomDestination(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("RegisterCustomDestination endpoint called")
+
+	_, workspaceID, airbyteWorkspaceID := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.IsAdmin(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only admins can register custom destinations"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	var request models.CustomConnectorRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	destination, err := connectorCatalog.RegisterCustomDestination(server.Store, server.Airbyte, workspaceID, airbyteWorkspaceID,
+		request)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Custom Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", destination)
+	logger.Info("RegisterCustomDestination endpoint returned")
+}
+
+// UpgradeCustomDestination upgrades a custom destination of the workspace to a new version
+// @Summary Upgrade Custom Destination
+// @Description Upgrades a custom destination of the workspace to a new docker image tag. The configurations of the destinations of the workspace are checked against the specification of the new version and the upgrade is rolled back when one of them is incompatible. Only admins can upgrade custom destinations
+// @Tags destination
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Airbyte Destination Definition ID"
+// @Param upgrade body models.CustomConnectorUpgradeRequest true "Version"
+// @Success 200 {object} models.CustomConnectorUpgradeResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 409 {object} models.CustomConnectorUpgradeResponse
+// @Failure 500 {object} models.Response
+// @Router /destinations/custom/{id}/ [put].
+func (server *Server) UpgradeCustomDestination(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("UpgradeCustomDestination endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.IsAdmin(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only admins can upgrade custom destinations"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	definitionID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var request models.CustomConnectorUpgradeRequest
+	if err = ctx.ShouldBindJSON(&request); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	destination, err := server.Store.GetSupportedDestination(workspaceID, definitionID.String())
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Custom Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if !destination.Custom || destination.WorkspaceID != workspaceID {
+		errMsg := "Only the custom destinations of the workspace can be upgraded"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	upgrade, err := connectorCatalog.UpgradeCustomDestination(server.Store, server.Airbyte, workspaceID, destination,
+		request.DockerImageTag)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Custom Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if !upgrade.Upgraded {
+		errMsg := fmt.Sprintf("%d destinations of the workspace are incompatible with version %s",
+			len(upgrade.Incompatible), upgrade.Version)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, upgrade)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", upgrade)
+	logger.Info("UpgradeCustomDestination endpoint returned")
+}
+
+// connectorEnabled tells whether the workspace allows the destination definition, the response is written when it
+// doesn't.
+func (server *Server) connectorEnabled(ctx *gin.Context, definitionID string) bool {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	enabled, err := server.Store.IsConnectorEnabled(workspaceID, utils.CONNECTOR_TYPE_DESTINATION, definitionID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Workspace Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return false
+	}
+
+	if !enabled {
+		errMsg := fmt.Sprintf("destination definition %s is disabled for the workspace", definitionID)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return false
+	}
+
+	return true
+}
@@ -7,10 +7,12 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
 	"pipelineService/clients/airbyte"
 	"pipelineService/clients/authService"
 	"pipelineService/models/v1"
 	"pipelineService/services/db"
+	"pipelineService/services/destinationPool"
 	"pipelineService/utils"
 )
 
@@ -46,6 +48,15 @@ func (server *Server) ConfigureDestinationOnAirbyte(ctx *gin.Context) {
 		return
 	}
 
+	if !server.connectorEnabled(ctx, configureDestinationData.AirbyteDestinationDefinitionId) {
+		return
+	}
+
+	if !server.validateConfiguration(ctx, configureDestinationData.AirbyteDestinationDefinitionId,
+		configureDestinationData.ConnectionConfiguration) {
+		return
+	}
+
 	requestBody := map[string]interface{}{
 		"destinationDefinitionId": configureDestinationData.AirbyteDestinationDefinitionId,
 		"connectionConfiguration": configureDestinationData.ConnectionConfiguration,
@@ -104,9 +115,10 @@ func (server *Server) ConfigureDestinationOnAirbyte(ctx *gin.Context) {
 
 // GetSupportedDestinations return all the destinations supported by cdpaas
 // @Summary Get All Supported Destinations
-// @Description Return all the destinations supported by cdpaas
+// @Description Return all the destinations supported by cdpaas with the release stage and version of their airbyte definition. The destinations disabled for the workspace are only listed with include_disabled
 // @Tags destination
 // @Produce  json
+// @Param include_disabled query bool false "List the destinations disabled for the workspace"
 // @Success 200 {object} models.SupportedDestinationResponse
 // @Failure 400 {object} models.Response
 // @Failure 500 {object} models.Response
@@ -115,12 +127,28 @@ func (server *Server) GetSupportedDestinations(ctx *gin.Context) {
 	logger := utils.GetLogger()
 	logger.Info("GetSupportedDestinations endpoint called")
 
-	destinations, err := server.Store.GetSupportedDestinations()
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	destinations, err := server.Store.GetSupportedDestinations(workspaceID)
 	if err != nil {
 		logger.Error(err.Error())
 
 		statusCode, errMsg := utils.ParseDBError(err, "Supported Destination")
 		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if ctx.Query("include_disabled") != "true" {
+		enabledDestinations := make([]models.SupportedDestinations, 0, len(destinations))
+
+		for _, destination := range destinations {
+			if destination.Enabled {
+				enabledDestinations = append(enabledDestinations, destination)
+			}
+		}
+
+		destinations = enabledDestinations
 	}
 
 	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", destinations)
@@ -270,3 +298,224 @@ func (server *Server) GetDestinationSpecification(ctx *gin.Context) {
 
 	logger.Info("GetDestinationSpecification endpoint returned")
 }
+
+// UpdateDestination updates a configured destination
+// @Summary Update Destination
+// @Description Checks the new configuration against the destination, updates the destination connector on Airbyte and stores the changes in local database
+// @Tags destination
+// @Param id path string true "Destination ID"
+// @Param requestBody body models.EditDestinationConnectorRequest true "request body"
+// @Produce  json
+// @Success 200 {object} models.CreateDestinationConnectorResponseAPI
+// @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /destinations/{id}/ [put].
+func (server *Server) UpdateDestination(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("UpdateDestination endpoint called")
+
+	destinationID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var editDestinationData models.EditDestinationConnectorRequest
+	if err := ctx.ShouldBindJSON(&editDestinationData); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	destination, ok := server.getWorkspaceDestination(ctx, destinationID)
+	if !ok {
+		return
+	}
+
+	if editDestinationData.DestinationName != "" {
+		destination.DestinationName = editDestinationData.DestinationName
+	}
+
+	destination.ConfigurationDetails = editDestinationData.ConnectionConfiguration
+
+	if !server.validateConfiguration(ctx, destination.AirbyteDestDefinitionID, destination.ConfigurationDetails) {
+		return
+	}
+
+	requestBody := map[string]interface{}{
+		"destinationDefinitionId": destination.AirbyteDestDefinitionID,
+		"connectionConfiguration": destination.ConfigurationDetails,
+	}
+
+	err = server.Airbyte.CheckDestinationConnection(requestBody)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	editDestinationDataAirByte := models.EditDestinationConnectorRequestAirByte{
+		AirbyteDestinationID:    destination.AirbyteDestinationID,
+		ConnectionConfiguration: destination.ConfigurationDetails,
+		Name:                    destination.DestinationName,
+	}
+
+	_, err = server.Airbyte.EditDestinationConnectorOnAirByte(editDestinationDataAirByte)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	updatedDestination, err := server.Store.UpdateDestination(destination)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	// the previews reconnect with the new credentials
+	destinationPool.Invalidate(destinationID.String())
+
+	apiResponse := models.CreateDestinationConnectorResponseData{
+		DestinationID:   updatedDestination.DestinationID,
+		DestinationName: updatedDestination.DestinationName,
+		DestinationType: updatedDestination.DestinationType,
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", apiResponse)
+
+	logger.Info("UpdateDestination endpoint returned")
+}
+
+// DeleteDestination soft deletes a destination
+// @Summary Delete Destination
+// @Description Soft deletes a destination, the deletion is refused with the list of dependent resources if pipelines or data products are still using it
+// @Tags destination
+// @Produce  json
+// @Param id path string true "Destination ID"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 409 {object} models.ResourceDependenciesResponse
+// @Failure 500 {object} models.Response
+// @Router /destinations/{id}/ [delete].
+func (server *Server) DeleteDestination(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DeleteDestination endpoint called")
+
+	destinationID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if _, ok := server.getWorkspaceDestination(ctx, destinationID); !ok {
+		return
+	}
+
+	dependencies, err := server.Store.GetDestinationDependencies(destinationID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if len(dependencies) > 0 {
+		errMsg := "Destination is used by other resources"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, dependencies)
+
+		return
+	}
+
+	err = server.Store.DeleteDestination(destinationID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	destinationPool.Invalidate(destinationID.String())
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Destination deleted successfully")
+
+	logger.Info("DeleteDestination endpoint returned")
+}
+
+// RestoreDestination restores a soft deleted destination
+// @Summary Restore Destination
+// @Description Restores a soft deleted destination
+// @Tags destination
+// @Produce  json
+// @Param id path string true "Destination ID"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /destinations/{id}/restore/ [post].
+func (server *Server) RestoreDestination(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("RestoreDestination endpoint called")
+
+	destinationID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	err = server.Store.RestoreDestination(destinationID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Destination restored successfully")
+
+	logger.Info("RestoreDestination endpoint returned")
+}
+
+// getWorkspaceDestination returns the destination of the request, the destinations of the other workspaces are
+// reported missing so they can't be edited or deleted.
+func (server *Server) getWorkspaceDestination(ctx *gin.Context, destinationID uuid.UUID) (models.Destination, bool) {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	destination, err := server.Store.GetDestination(destinationID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && destination.WorkspaceID != workspaceID) {
+		errMsg := "Destination doesn't exist"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, errMsg, nil)
+
+		return destination, false
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Destination")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return destination, false
+	}
+
+	return destination, true
+}
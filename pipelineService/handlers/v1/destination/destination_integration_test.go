@@ -4,20 +4,18 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"gorm.io/datatypes" //nolint
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"strconv"
 	"testing"
 
-	// nolint
-	"gorm.io/datatypes" 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
 	mockairbyte "pipelineService/clients/airbyte/mocks"
 	"pipelineService/clients/authService"
 	mock_authservice "pipelineService/clients/authService/mocks"
@@ -56,6 +54,65 @@ func TestConfigureDestinationOnAirByte(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			testScenario: "BadRequest_InvalidConfiguration",
+
+			body: models.CreateDestinationConnectorRequestAPI{
+				CreateDestinationConnectorRequest: models.CreateDestinationConnectorRequest{
+					AirbyteDestinationDefinitionId: mockCreateDestinationConnectorRequest.AirbyteDestinationDefinitionId,
+					ConnectionConfiguration:        datatypes.JSON(`{"host": "db", "tunnel_method": {"tunnel_method": "SSH_KEY_AUTH"}}`),
+					Name:                           utils.RandomString(5),
+				},
+				DestinationType: mockDestinationType,
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				definitionID := mockCreateDestinationConnectorRequest.AirbyteDestinationDefinitionId
+				querier.EXPECT().GetDestinationDefinition(definitionID).Times(1).
+					Return(models.DestinationDefinition{DockerImageTag: utils.RandomString(6)}, nil)
+				querier.EXPECT().GetDestinationSpecification(definitionID).Times(1).
+					Return(createTunnelSpecification(), nil)
+				querier.EXPECT().CheckDestinationConnection(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateDestination(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "invalid connection configuration: tunnel_method.ssh_key is required",
+					Data:   []models.ConfigFieldError{{Field: "tunnel_method.ssh_key", Message: "is required"}}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Forbidden_DisabledDestination",
+
+			body: models.CreateDestinationConnectorRequestAPI{
+				CreateDestinationConnectorRequest: mockCreateDestinationConnectorRequest,
+				DestinationType:                   mockDestinationType,
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CheckDestinationConnection(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().IsConnectorEnabled(1122, utils.CONNECTOR_TYPE_DESTINATION,
+					mockCreateDestinationConnectorRequest.AirbyteDestinationDefinitionId).Times(1).Return(false, nil)
+				store.EXPECT().CreateDestination(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			testScenario: "Internal Server Error",
 
@@ -262,12 +319,14 @@ func TestConfigureDestinationOnAirByte(t *testing.T) {
 
 			store := mockStore.NewMockStore(ctrl)
 			testCase.buildStubs(store)
+			stubEnabledConnectors(store)
 
 			body, e := json.Marshal(testCase.body)
 			require.NoError(t, e)
 
 			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
 			testCase.queryAirByte(airByte)
+			stubConnectorSpecification(airByte)
 
 			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
 
@@ -300,7 +359,7 @@ func TestGetSupportedDestinations(t *testing.T) {
 			//},
 
 			buildStubs: func(store *mockStore.MockStore) {
-				store.EXPECT().GetSupportedDestinations().Times(1).Return([]models.SupportedDestinations{}, sql.ErrNoRows)
+				store.EXPECT().GetSupportedDestinations(1122).Times(1).Return([]models.SupportedDestinations{}, sql.ErrNoRows)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -315,7 +374,7 @@ func TestGetSupportedDestinations(t *testing.T) {
 			//},
 
 			buildStubs: func(store *mockStore.MockStore) {
-				store.EXPECT().GetSupportedDestinations().Times(1).Return(mockSupportedDestinations, nil)
+				store.EXPECT().GetSupportedDestinations(1122).Times(1).Return(mockSupportedDestinations, nil)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -562,6 +621,302 @@ func TestGetDestinationSummary(t *testing.T) {
 	}
 }
 
+// TestUpdateDestination tests all the scenarios while updating a destination.
+func TestUpdateDestination(t *testing.T) {
+	mockDestination := createRandomDestination()
+	mockDestinationID, _ := uuid.FromString(mockDestination.DestinationID)
+	mockRequest := models.EditDestinationConnectorRequest{
+		DestinationName:         utils.RandomString(5),
+		ConnectionConfiguration: datatypes.JSON(`{"host":"127.0.0.1"}`),
+	}
+	mockOtherWorkspaceDestination := mockDestination
+	mockOtherWorkspaceDestination.WorkspaceID = 2233
+	mockUpdatedDestination := mockDestination
+	mockUpdatedDestination.DestinationName = mockRequest.DestinationName
+	mockUpdatedDestination.ConfigurationDetails = mockRequest.ConnectionConfiguration
+
+	testCaseSuite := []struct {
+		testScenario  string
+		destinationID string
+		body          interface{}
+		buildStubs    func(store *mockStore.MockStore)
+		queryAirByte  func(airByte *mockairbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Bad Request Body",
+
+			destinationID: mockDestination.DestinationID,
+
+			body: map[string]interface{}{"destinationName": 1},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDestination(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(airByte *mockairbyte.MockAirByteQuerier) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Destination Of Another Workspace",
+
+			destinationID: mockDestination.DestinationID,
+
+			body: mockRequest,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDestination(mockDestinationID).Times(1).Return(mockOtherWorkspaceDestination, nil)
+				store.EXPECT().UpdateDestination(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(airByte *mockairbyte.MockAirByteQuerier) {
+				airByte.EXPECT().CheckDestinationConnection(gomock.Any()).Times(0)
+				airByte.EXPECT().EditDestinationConnectorOnAirByte(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Destination doesn't exist",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Check Connection Failed",
+
+			destinationID: mockDestination.DestinationID,
+
+			body: mockRequest,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDestination(mockDestinationID).Times(1).Return(mockDestination, nil)
+				store.EXPECT().UpdateDestination(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(airByte *mockairbyte.MockAirByteQuerier) {
+				airByte.EXPECT().CheckDestinationConnection(gomock.Any()).Times(1).
+					Return(errors.New("could not connect with provided configuration"))
+				airByte.EXPECT().EditDestinationConnectorOnAirByte(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "could not connect with provided configuration",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			destinationID: mockDestination.DestinationID,
+
+			body: mockRequest,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDestination(mockDestinationID).Times(1).Return(mockDestination, nil)
+				store.EXPECT().UpdateDestination(mockUpdatedDestination).Times(1).Return(mockUpdatedDestination, nil)
+			},
+
+			queryAirByte: func(airByte *mockairbyte.MockAirByteQuerier) {
+				airByte.EXPECT().CheckDestinationConnection(gomock.Any()).Times(1).Return(nil)
+				airByte.EXPECT().EditDestinationConnectorOnAirByte(models.EditDestinationConnectorRequestAirByte{
+					AirbyteDestinationID:    mockDestination.AirbyteDestinationID,
+					ConnectionConfiguration: mockRequest.ConnectionConfiguration,
+					Name:                    mockRequest.DestinationName,
+				}).Times(1).Return(models.CreateDestinationConnectorResponseAirbyte{}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.CreateDestinationConnectorResponseData{
+						DestinationID:   mockUpdatedDestination.DestinationID,
+						DestinationName: mockUpdatedDestination.DestinationName,
+						DestinationType: mockUpdatedDestination.DestinationType,
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+			stubConnectorSpecification(airByte)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.DESTINATION, store, airByte, authServiceClient)
+			url := fmt.Sprintf("%sdestinations/%s/", test.BaseURL, testCase.destinationID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPut, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestDeleteDestination tests all the scenarios while deleting a destination.
+func TestDeleteDestination(t *testing.T) {
+	mockDestination := createRandomDestination()
+	mockDestinationID, _ := uuid.FromString(mockDestination.DestinationID)
+	mockOtherWorkspaceDestination := mockDestination
+	mockOtherWorkspaceDestination.WorkspaceID = 2233
+	mockPipelineID, _ := uuid.NewV1()
+	mockDependencies := []models.ResourceDependency{
+		{
+			ResourceType: "pipeline",
+			ResourceID:   mockPipelineID.String(),
+			Name:         utils.RandomString(5),
+		},
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		destinationID string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Bad Destination ID",
+
+			destinationID: "not a uuid",
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Destination Of Another Workspace",
+
+			destinationID: mockDestinationID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDestination(mockDestinationID).Times(1).Return(mockOtherWorkspaceDestination, nil)
+				store.EXPECT().GetDestinationDependencies(gomock.Any()).Times(0)
+				store.EXPECT().DeleteDestination(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Destination doesn't exist",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Destination In Use",
+
+			destinationID: mockDestinationID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDestination(mockDestinationID).Times(1).Return(mockDestination, nil)
+				store.EXPECT().GetDestinationDependencies(mockDestinationID).Times(1).Return(mockDependencies, nil)
+				store.EXPECT().DeleteDestination(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Destination is used by other resources",
+					Data:   mockDependencies}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			destinationID: mockDestinationID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDestination(mockDestinationID).Times(1).Return(mockDestination, nil)
+				store.EXPECT().GetDestinationDependencies(mockDestinationID).Times(1).
+					Return([]models.ResourceDependency{}, nil)
+				store.EXPECT().DeleteDestination(mockDestinationID).Times(1).Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   "Destination deleted successfully"}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.DESTINATION, store, airByte, authServiceClient)
+			url := fmt.Sprintf("%sdestinations/%s/", test.BaseURL, testCase.destinationID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodDelete, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
 // TestGetDestinationSpecification tests all the scenarios while getting the destination Specifications.
 func TestGetDestinationSpecification(t *testing.T) {
 	mockDestinationSpecification := createRandomDestinationSpecification()
@@ -736,19 +1091,520 @@ func TestGetDestinationSpecification(t *testing.T) {
 	}
 }
 
-//createRandomDestinationSummary populates and return the DestinationSummary model with random values.
-func createRandomDestinationSummary() models.DestinationSummary {
-	ds := models.DestinationSummary{
-		DestinationName: utils.RandomString(5),
-		Owner:           1122,
-		CreatedAt:       utils.RandomInt(1, 10),
-	}
-
-	return ds
-}
+// TestValidateDestinationConfiguration tests all the scenarios while validating a destination configuration.
+func TestValidateDestinationConfiguration(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
 
-//createRandomDestinationDefinition populates and return the DestinationDefinition model with random values.
-func createRandomDestinationDefinition(ddID string, ddName string) models.DestinationDefinition {
+	testCaseSuite := []struct {
+		testScenario  string
+		body          models.ConfigValidationRequest
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_MissingDefinition",
+
+			body: models.ConfigValidationRequest{ConnectionConfiguration: map[string]interface{}{"host": "db"}},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetDestinationDefinition(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_AirbyteError",
+
+			body: models.ConfigValidationRequest{
+				DefinitionID:            definitionID.String(),
+				ConnectionConfiguration: map[string]interface{}{"host": "db"},
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetDestinationDefinition(definitionID.String()).Times(1).
+					Return(models.DestinationDefinition{}, errors.New("request to airbyte was not successful"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			body: models.ConfigValidationRequest{
+				DefinitionID: definitionID.String(),
+				ConnectionConfiguration: map[string]interface{}{
+					"host":          "db",
+					"tunnel_method": map[string]interface{}{"tunnel_method": "NO_TUNNEL"},
+				},
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetDestinationDefinition(definitionID.String()).Times(1).
+					Return(models.DestinationDefinition{DockerImageTag: "0.3.21"}, nil)
+				querier.EXPECT().GetDestinationSpecification(definitionID.String()).Times(1).
+					Return(createTunnelSpecification(), nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.ConfigValidation{
+						DefinitionID: definitionID.String(),
+						Version:      "0.3.21",
+						Valid:        true,
+						Errors:       []models.ConfigFieldError{},
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.DESTINATION, store, airByte, nil)
+			url := fmt.Sprintf("%sdestinations/validate/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestSetDestinationAvailability tests all the scenarios while enabling or disabling a destination for the workspace.
+func TestSetDestinationAvailability(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
+	enabled := true
+
+	testCaseSuite := []struct {
+		testScenario  string
+		role          string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Forbidden_Editor",
+
+			role: utils.USER_ROLE_EDITOR,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().SaveWorkspaceConnector(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnknownDestination",
+
+			role: utils.USER_ROLE_ADMIN,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedDestination(1122, definitionID.String()).Times(1).
+					Return(models.SupportedDestinations{}, sql.ErrNoRows)
+				store.EXPECT().SaveWorkspaceConnector(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			role: utils.USER_ROLE_ADMIN,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedDestination(1122, definitionID.String()).Times(1).
+					Return(createRandomSupportedDestination(), nil)
+				store.EXPECT().SaveWorkspaceConnector(gomock.Any()).Times(1).
+					DoAndReturn(func(connector models.WorkspaceConnector) (models.WorkspaceConnector, error) {
+						require.Equal(t, utils.CONNECTOR_TYPE_DESTINATION, connector.ConnectorType)
+						require.Equal(t, definitionID.String(), connector.DefinitionID)
+						require.True(t, connector.Enabled)
+
+						return connector, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+
+			body, e := json.Marshal(models.ConnectorAvailabilityRequest{Enabled: &enabled})
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.DESTINATION, store, airByte, nil)
+			withRole := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Header.Set("userRole", testCase.role)
+				server.ServeHTTP(w, r)
+			})
+
+			url := fmt.Sprintf("%sdestinations/definitions/%s/", test.BaseURL, definitionID)
+			expectedResp, err := test.MakeHttpRequest(withRole, http.MethodPut, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestReconcileDestinationDefinitions tests all the scenarios while syncing the supported destinations with airbyte.
+func TestReconcileDestinationDefinitions(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
+	definition := models.DestinationDefinition{
+		DestinationDefinitionID: definitionID.String(),
+		Name:                    "Postgres",
+		DockerRepository:        "airbyte/destination-postgres",
+		DockerImageTag:          "0.3.21",
+		ReleaseStage:            "beta",
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_AirbyteError",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetDestinationDefinitions().Times(1).
+					Return(models.DestinationDefinitions{}, errors.New("AirByte Server is Down"))
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().SyncSupportedDestinations(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetDestinationDefinitions().Times(1).Return(models.DestinationDefinitions{
+					DestinationDefinitions: []models.DestinationDefinition{definition},
+				}, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().SyncSupportedDestinations(gomock.Any()).Times(1).
+					DoAndReturn(func(destinations []models.SupportedDestinations) (int64, error) {
+						require.Len(t, destinations, 1)
+						require.Equal(t, definition.DestinationDefinitionID, destinations[0].AirbyteDefinitionID)
+						require.Equal(t, "postgres", destinations[0].Type)
+						require.Equal(t, definition.DockerImageTag, destinations[0].DockerImageTag)
+						require.Equal(t, definition.ReleaseStage, destinations[0].ReleaseStage)
+
+						return 0, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res struct {
+					Data models.ConnectorCatalogReport `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, 1, res.Data.Synced)
+				require.Equal(t, int64(0), res.Data.Deactivated)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			server := test.NewTestServer(test.DESTINATION, store, airByte, nil)
+			url := test.BaseURL + "destinations/internal/definitions/reconcile/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestRegisterCustomDestination tests all the scenarios while registering a custom destination.
+func TestRegisterCustomDestination(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
+	request := models.CustomConnectorRequest{
+		Name:             "Warehouse Loader",
+		DockerRepository: "registry.example.com/destination-warehouse",
+		DockerImageTag:   "1.0.0",
+		DocumentationURL: "https://wiki.example.com/connectors/warehouse",
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		role          string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Forbidden_Editor",
+
+			role: utils.USER_ROLE_EDITOR,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateCustomDestinationDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			role: utils.USER_ROLE_ADMIN,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateCustomDestinationDefinition(test.AirByteWorkspaceID, request).Times(1).
+					Return(models.DestinationDefinition{
+						DestinationDefinitionID: definitionID.String(),
+						Name:                    request.Name,
+						DockerRepository:        request.DockerRepository,
+						DockerImageTag:          request.DockerImageTag,
+						DocumentationURL:        request.DocumentationURL,
+					}, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateSupportedDestination(gomock.Any()).Times(1).
+					DoAndReturn(func(destination models.SupportedDestinations) (models.SupportedDestinations, error) {
+						require.Equal(t, definitionID.String(), destination.AirbyteDefinitionID)
+						require.Equal(t, "warehouse", destination.Type)
+						require.Equal(t, utils.AIRBYTE_RELEASE_STAGE_CUSTOM, destination.ReleaseStage)
+						require.True(t, destination.Custom)
+						require.Equal(t, 1122, destination.WorkspaceID)
+
+						return destination, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			body, e := json.Marshal(request)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.DESTINATION, store, airByte, nil)
+			withRole := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Header.Set("userRole", testCase.role)
+				server.ServeHTTP(w, r)
+			})
+
+			url := test.BaseURL + "destinations/custom/"
+			expectedResp, err := test.MakeHttpRequest(withRole, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestUpgradeCustomDestination tests all the scenarios while upgrading a custom destination.
+func TestUpgradeCustomDestination(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
+	customDestination := createRandomSupportedDestination()
+	customDestination.AirbyteDefinitionID = definitionID.String()
+	customDestination.DockerImageTag = "1.0.0"
+	customDestination.Custom = true
+	customDestination.WorkspaceID = 1122
+
+	incompatibleDestination := createRandomDestination()
+	incompatibleDestination.ConfigurationDetails = datatypes.JSON(`{"port":5432}`)
+
+	testCaseSuite := []struct {
+		testScenario  string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Conflict_IncompatibleDestination",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				gomock.InOrder(
+					querier.EXPECT().UpdateDestinationDefinition(definitionID.String(), "2.0.0").Times(1).
+						Return(models.DestinationDefinition{}, nil),
+					querier.EXPECT().UpdateDestinationDefinition(definitionID.String(), "1.0.0").Times(1).
+						Return(models.DestinationDefinition{}, nil),
+				)
+				querier.EXPECT().GetDestinationDefinition(definitionID.String()).Times(1).
+					Return(models.DestinationDefinition{DockerImageTag: utils.RandomString(6)}, nil)
+				querier.EXPECT().GetDestinationSpecification(definitionID.String()).Times(1).
+					Return(createTunnelSpecification(), nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedDestination(1122, definitionID.String()).Times(1).
+					Return(customDestination, nil)
+				store.EXPECT().GetDestinationsByDefinition(1122, definitionID.String()).Times(1).
+					Return([]models.Destination{incompatibleDestination}, nil)
+				store.EXPECT().UpdateSupportedDestinationVersion(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+
+				var res struct {
+					Data models.CustomConnectorUpgrade `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.False(t, res.Data.Upgraded)
+				require.Len(t, res.Data.Incompatible, 1)
+				require.Equal(t, incompatibleDestination.DestinationID, res.Data.Incompatible[0].ID)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateDestinationDefinition(definitionID.String(), "2.0.0").Times(1).
+					Return(models.DestinationDefinition{}, nil)
+				querier.EXPECT().GetDestinationDefinition(definitionID.String()).Times(1).
+					Return(models.DestinationDefinition{DockerImageTag: utils.RandomString(6)}, nil)
+				querier.EXPECT().GetDestinationSpecification(definitionID.String()).Times(1).
+					Return(createTunnelSpecification(), nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedDestination(1122, definitionID.String()).Times(1).
+					Return(customDestination, nil)
+				store.EXPECT().GetDestinationsByDefinition(1122, definitionID.String()).Times(1).
+					Return([]models.Destination{createRandomDestination()}, nil)
+				store.EXPECT().UpdateSupportedDestinationVersion(definitionID.String(), "2.0.0").Times(1).Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res struct {
+					Data models.CustomConnectorUpgrade `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.True(t, res.Data.Upgraded)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			body, e := json.Marshal(models.CustomConnectorUpgradeRequest{DockerImageTag: "2.0.0"})
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.DESTINATION, store, airByte, nil)
+			asAdmin := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Header.Set("userRole", utils.USER_ROLE_ADMIN)
+				server.ServeHTTP(w, r)
+			})
+
+			url := fmt.Sprintf("%sdestinations/custom/%s/", test.BaseURL, definitionID)
+			expectedResp, err := test.MakeHttpRequest(asAdmin, http.MethodPut, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+//createRandomDestinationSummary populates and return the DestinationSummary model with random values.
+func createRandomDestinationSummary() models.DestinationSummary {
+	ds := models.DestinationSummary{
+		DestinationName: utils.RandomString(5),
+		Owner:           1122,
+		CreatedAt:       utils.RandomInt(1, 10),
+	}
+
+	return ds
+}
+
+//createRandomDestinationDefinition populates and return the DestinationDefinition model with random values.
+func createRandomDestinationDefinition(ddID string, ddName string) models.DestinationDefinition {
 	dd := models.DestinationDefinition{
 		DestinationDefinitionID: ddID,
 		Name:                    ddName,
@@ -792,13 +1648,36 @@ func createRandomConfiguredDestination() models.ConfiguredDestination {
 	return d
 }
 
+//createRandomDestination populates and return the Destination model with random values.
+func createRandomDestination() models.Destination {
+	dID, _ := uuid.NewV1()
+	abID, _ := uuid.NewV1()
+	abDefID, _ := uuid.NewV1()
+	d := models.Destination{
+		DestinationID:           dID.String(),
+		DestinationName:         utils.RandomString(5),
+		AirbyteDestinationID:    abID.String(),
+		AirbyteDestDefinitionID: abDefID.String(),
+		DestinationType:         utils.RandomString(5),
+		ConfigurationDetails:    datatypes.JSON(`{"host":"localhost"}`),
+		Owner:                   1122,
+		WorkspaceID:             1122,
+	}
+
+	return d
+}
+
 //createRandomSupportedDestination populates and return the SupportedDestinations model with random values.
 func createRandomSupportedDestination() models.SupportedDestinations {
 	sdID, _ := uuid.NewV1()
 	sd := models.SupportedDestinations{
-		ID:   sdID,
-		Name: utils.RandomString(5),
-		Type: utils.RandomString(5),
+		ID:                  sdID,
+		Name:                utils.RandomString(5),
+		Type:                utils.RandomString(5),
+		AirbyteDefinitionID: sdID.String(),
+		DockerImageTag:      "0.3.21",
+		ReleaseStage:        "beta",
+		Enabled:             true,
 	}
 
 	return sd
@@ -808,13 +1687,49 @@ func createRandomSupportedDestination() models.SupportedDestinations {
 func createRandomDestinationConnectorRequest() models.CreateDestinationConnectorRequest {
 	scr := models.CreateDestinationConnectorRequest{
 		AirbyteDestinationDefinitionId: utils.RandomString(10),
-		ConnectionConfiguration:        datatypes.JSON("{Random Configuration}"),
+		ConnectionConfiguration:        datatypes.JSON(`{"host":"localhost"}`),
 		Name:                           utils.RandomString(5),
 	}
 
 	return scr
 }
 
+// stubEnabledConnectors lets the workspace of the tests configure any connector.
+func stubEnabledConnectors(store *mockStore.MockStore) {
+	store.EXPECT().IsConnectorEnabled(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(true, nil)
+}
+
+// stubConnectorSpecification lets the configurations of the tests pass the validation against the specification of
+// their connector.
+func stubConnectorSpecification(querier *mockairbyte.MockAirByteQuerier) {
+	querier.EXPECT().GetDestinationDefinition(gomock.Any()).AnyTimes().Return(models.DestinationDefinition{}, nil)
+	querier.EXPECT().GetDestinationSpecification(gomock.Any()).AnyTimes().Return(models.DestinationSpecification{}, nil)
+}
+
+// createTunnelSpecification returns a specification requiring a host and offering a choice of tunnel methods.
+func createTunnelSpecification() models.DestinationSpecification {
+	var connectionSpecification interface{}
+	_ = json.Unmarshal([]byte(`{
+		"type": "object",
+		"required": ["host"],
+		"properties": {
+			"host": {"type": "string"},
+			"tunnel_method": {
+				"type": "object",
+				"oneOf": [
+					{"required": ["tunnel_method"], "properties": {"tunnel_method": {"const": "NO_TUNNEL"}}},
+					{
+						"required": ["tunnel_method", "ssh_key"],
+						"properties": {"tunnel_method": {"const": "SSH_KEY_AUTH"}, "ssh_key": {"type": "string"}}
+					}
+				]
+			}
+		}
+	}`), &connectionSpecification)
+
+	return models.DestinationSpecification{ConnectionSpecification: connectionSpecification}
+}
+
 // TestMain runs the package level test in TestMode.
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
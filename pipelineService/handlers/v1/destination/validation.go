@@ -0,0 +1,69 @@
+package destination
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"pipelineService/models/v1"
+	"pipelineService/services/connectorSpec"
+	"pipelineService/utils"
+)
+
+// ValidateDestinationConfiguration validates a destination configuration without creating the destination
+// @Summary Validate Destination Configuration
+// @Description Dry run validating a destination configuration against the connection specification of its definition, the fields breaking it are reported one by one
+// @Tags destination
+// @Produce  json
+// @Param validation body models.ConfigValidationRequest true "Destination Definition and Configuration"
+// @Success 200 {object} models.ConfigValidationResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /destinations/validate/ [post].
+func (server *Server) ValidateDestinationConfiguration(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ValidateDestinationConfiguration endpoint called")
+
+	var request models.ConfigValidationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	validation, err := connectorSpec.ValidateDestinationConfiguration(server.Airbyte, request.DefinitionID,
+		request.ConnectionConfiguration)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", validation)
+	logger.Info("ValidateDestinationConfiguration endpoint returned")
+}
+
+// validateConfiguration rejects a configuration breaking the specification of the destination before it reaches Airbyte,
+// the response is written when it's rejected. When the specification can't be fetched the check of the connection on
+// Airbyte remains the only validation.
+func (server *Server) validateConfiguration(ctx *gin.Context, definitionID string, configuration interface{}) bool {
+	logger := utils.GetLogger()
+
+	validation, err := connectorSpec.ValidateDestinationConfiguration(server.Airbyte, definitionID, configuration)
+	if err != nil {
+		logger.Error(err.Error())
+
+		return true
+	}
+
+	if validation.Valid {
+		return true
+	}
+
+	validationErr := &connectorSpec.ValidationError{Errors: validation.Errors}
+	logger.Error(validationErr.Error())
+	utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, validationErr.Error(), validation.Errors)
+
+	return false
+}
@@ -0,0 +1,211 @@
+package lineage
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/services/lineage"
+	"pipelineService/utils"
+)
+
+type Server struct {
+	Store       db.Store
+	Router      *gin.Engine
+	RouterGroup *gin.RouterGroup
+}
+
+// GetLineage returns the lineage graph of a node
+// @Summary Get Lineage
+// @Description Returns the upstream and downstream lineage of a source, table or column of the workspace at table or column granularity. Nodes are identified as source:<sourceId>, table:<assetId> or column:<assetId>:<columnName>
+// @Tags lineage
+// @Produce  json
+// @Param node query string true "Node ID"
+// @Param direction query string false "upstream, downstream or both"
+// @Param depth query int false "Depth, 0 follows the lineage to its ends"
+// @Param granularity query string false "table or column"
+// @Success 200 {object} models.LineageGraphResponse
+// @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /lineage/ [get].
+func (server *Server) GetLineage(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetLineage endpoint called")
+
+	var lineageQuery models.LineageQuery
+	if err := ctx.ShouldBindQuery(&lineageQuery); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	_, lineageGraph, ok := server.traverse(ctx, lineageQuery)
+	if !ok {
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", lineageGraph)
+	logger.Info("GetLineage endpoint returned")
+}
+
+// GetOpenLineageEvents exports the lineage of a node as OpenLineage events
+// @Summary Export OpenLineage Events
+// @Description Exports the table lineage of a node as OpenLineage run events, one for every table written by a pipeline or the transformation of a data product. The written tables carry their schema and column lineage facets
+// @Tags lineage
+// @Produce  json
+// @Param node query string true "Node ID"
+// @Param direction query string false "upstream, downstream or both"
+// @Param depth query int false "Depth, 0 follows the lineage to its ends"
+// @Success 200 {object} models.OpenLineageEventsResponse
+// @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /lineage/openlineage/ [get].
+func (server *Server) GetOpenLineageEvents(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetOpenLineageEvents endpoint called")
+
+	var lineageQuery models.LineageQuery
+	if err := ctx.ShouldBindQuery(&lineageQuery); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	// the events are exchanged between jobs and datasets, the columns are described by the facets of the datasets
+	lineageQuery.Granularity = utils.LINEAGE_GRANULARITY_TABLE
+
+	graph, lineageGraph, ok := server.traverse(ctx, lineageQuery)
+	if !ok {
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", graph.OpenLineage(lineageGraph, time.Now()))
+	logger.Info("GetOpenLineageEvents endpoint returned")
+}
+
+// SaveDbtManifest uploads the dbt manifest of a data product
+// @Summary Save dbt Manifest
+// @Description Uploads the manifest.json compiled by the dbt project transforming the data product, the lineage of its transformed assets follows the dependencies of their models. A new upload replaces the manifest
+// @Tags lineage
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Product ID"
+// @Param manifest body object true "dbt manifest.json"
+// @Success 200 {object} models.DbtManifestResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /lineage/products/{id}/manifest/ [put].
+func (server *Server) SaveDbtManifest(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("SaveDbtManifest endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only editors can manage the dbt manifests"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	productID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	manifest, err := ctx.GetRawData()
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	dbtModels, err := lineage.DbtModels(manifest)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	product, err := server.Store.GetDataProductInfo(productID)
+	if err == nil && product.WorkspaceID != workspaceID {
+		err = errors.New("Product doesn't exists")
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Data Product")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	savedManifest, err := server.Store.SaveDbtManifest(models.DbtManifest{
+		ProductID:   productID,
+		Manifest:    manifest,
+		UploadedBy:  userID,
+		WorkspaceID: workspaceID,
+		UploadedAt:  time.Now().UnixMilli(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "dbt Manifest")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	savedManifest.Models = dbtModels
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", savedManifest)
+	logger.Info("SaveDbtManifest endpoint returned")
+}
+
+// traverse builds the lineage of the workspace and returns the lineage of the queried node, the response is written
+// when it fails.
+func (server *Server) traverse(ctx *gin.Context, lineageQuery models.LineageQuery) (*lineage.Graph, models.LineageGraph, bool) {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	graph, err := lineage.Load(server.Store, workspaceID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Lineage")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return nil, models.LineageGraph{}, false
+	}
+
+	lineageGraph, err := graph.Traverse(lineageQuery.Node, lineageQuery.Direction, lineageQuery.Depth,
+		lineageQuery.Granularity)
+	if err != nil {
+		logger.Error(err.Error())
+
+		statusCode := http.StatusBadRequest
+		if errors.Is(err, lineage.ErrNodeNotFound) {
+			statusCode = http.StatusNotFound
+		}
+
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, err.Error(), nil)
+
+		return nil, models.LineageGraph{}, false
+	}
+
+	return graph, lineageGraph, true
+}
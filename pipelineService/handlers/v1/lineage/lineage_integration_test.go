@@ -0,0 +1,470 @@
+package lineage_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"pipelineService/handlers/v1/test"
+	"pipelineService/models/v1"
+	mockStore "pipelineService/services/db/mocks"
+	"pipelineService/utils"
+)
+
+// lineageFixture is a source synced by a pipeline into the users and orders tables, a data product transforms them
+// into customers which its dbt manifest builds from users only.
+type lineageFixture struct {
+	sourceID         string
+	pipelineID       string
+	productID        uuid.UUID
+	users            models.LineageTable
+	orders           models.LineageTable
+	customers        models.LineageTable
+	sources          []models.LineageSource
+	tables           []models.LineageTable
+	productPipelines []models.ProductsPipelines
+	columns          []models.AssetColumn
+	manifests        []models.DbtManifest
+}
+
+// TestGetLineage tests all the scenarios while getting the lineage of a node.
+func TestGetLineage(t *testing.T) {
+	fixture := createLineageFixture()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_MissingNode",
+
+			query: map[string]string{},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetLineageSources(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_InvalidGranularity",
+
+			query: map[string]string{"node": "table:" + fixture.users.AssetID, "granularity": "row"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetLineageSources(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_DBError",
+
+			query: map[string]string{"node": "table:" + fixture.users.AssetID},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetLineageSources(1122).Times(1).Return(nil, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound",
+
+			query: map[string]string{"node": "table:" + utils.RandomString(8)},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				buildLineageStubs(store, fixture)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_SourceColumns",
+
+			query: map[string]string{"node": "source:" + fixture.sourceID, "granularity": "column"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				buildLineageStubs(store, fixture)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_UpstreamTables",
+
+			query: map[string]string{"node": "table:" + fixture.customers.AssetID, "direction": "upstream"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				buildLineageStubs(store, fixture)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				graph := decodeLineageGraph(t, recorder)
+				require.ElementsMatch(t, []string{
+					"source:" + fixture.sourceID,
+					"table:" + fixture.customers.AssetID,
+					"table:" + fixture.users.AssetID,
+				}, nodeIDs(graph))
+
+				// the dbt manifest builds customers from users, orders isn't upstream
+				require.Len(t, graph.Edges, 2)
+				for _, edge := range graph.Edges {
+					if edge.To == "table:"+fixture.customers.AssetID {
+						require.Equal(t, utils.LINEAGE_ORIGIN_DBT, edge.Origin)
+						require.Equal(t, fixture.productID.String(), edge.JobID)
+					}
+				}
+			},
+		},
+		{
+			testScenario: "Success_DownstreamColumns",
+
+			query: map[string]string{"node": "table:" + fixture.users.AssetID, "direction": "downstream", "granularity": "column"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				buildLineageStubs(store, fixture)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				graph := decodeLineageGraph(t, recorder)
+				require.Equal(t, []models.LineageEdge{{
+					From:   fmt.Sprintf("column:%s:email", fixture.users.AssetID),
+					To:     fmt.Sprintf("column:%s:email", fixture.customers.AssetID),
+					Origin: utils.LINEAGE_ORIGIN_DBT,
+					JobID:  fixture.productID.String(),
+				}}, graph.Edges)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.LINEAGE, store, nil, nil)
+			url := fmt.Sprintf("%slineage/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetOpenLineageEvents tests all the scenarios while exporting the lineage of a node as OpenLineage events.
+func TestGetOpenLineageEvents(t *testing.T) {
+	fixture := createLineageFixture()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "NotFound",
+
+			query: map[string]string{"node": "source:" + utils.RandomString(8)},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				buildLineageStubs(store, fixture)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			query: map[string]string{"node": "source:" + fixture.sourceID, "granularity": "column"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				buildLineageStubs(store, fixture)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res struct {
+					Data []models.OpenLineageRunEvent `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+
+				// the pipeline writes users and orders, the transformation of the product writes customers
+				require.Len(t, res.Data, 3)
+
+				transformation := res.Data[0]
+				require.Equal(t, "data-product."+fixture.productID.String(), transformation.Job.Name)
+				require.Equal(t, "COMPLETE", transformation.EventType)
+				require.Equal(t, "sales._airbyte_raw_shop_users", transformation.Inputs[0].Name)
+				require.Equal(t, "postgres://localhost:5432/warehouse", transformation.Inputs[0].Namespace)
+				require.Equal(t, "product.customers", transformation.Outputs[0].Name)
+				require.Contains(t, transformation.Outputs[0].Facets, "columnLineage")
+
+				require.Equal(t, "pipeline."+fixture.pipelineID, res.Data[1].Job.Name)
+				require.Equal(t, utils.LINEAGE_SOURCE_NAMESPACE, res.Data[1].Inputs[0].Namespace)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.LINEAGE, store, nil, nil)
+			url := fmt.Sprintf("%slineage/openlineage/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestSaveDbtManifest tests all the scenarios while uploading the dbt manifest of a data product.
+func TestSaveDbtManifest(t *testing.T) {
+	fixture := createLineageFixture()
+	mockProduct := models.DataProduct{ProductID: fixture.productID, WorkspaceID: 1122}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		productID     string
+		body          []byte
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_BadUUID",
+
+			productID: "notUuid",
+
+			body: fixture.manifests[0].Manifest,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().SaveDbtManifest(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_NoNodes",
+
+			productID: fixture.productID.String(),
+
+			body: []byte(`{"metadata": {}}`),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDataProductInfo(gomock.Any()).Times(0)
+				store.EXPECT().SaveDbtManifest(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnknownProduct",
+
+			productID: fixture.productID.String(),
+
+			body: fixture.manifests[0].Manifest,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDataProductInfo(fixture.productID).Times(1).
+					Return(models.DataProduct{}, gorm.ErrRecordNotFound)
+				store.EXPECT().SaveDbtManifest(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			productID: fixture.productID.String(),
+
+			body: fixture.manifests[0].Manifest,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDataProductInfo(fixture.productID).Times(1).Return(mockProduct, nil)
+				store.EXPECT().SaveDbtManifest(gomock.Any()).Times(1).DoAndReturn(
+					func(manifest models.DbtManifest) (models.DbtManifest, error) {
+						require.Equal(t, fixture.productID, manifest.ProductID)
+						require.Equal(t, 1122, manifest.UploadedBy)
+						require.JSONEq(t, string(fixture.manifests[0].Manifest), string(manifest.Manifest))
+
+						return manifest, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res struct {
+					Data models.DbtManifest `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, []string{"customers"}, res.Data.Models)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.LINEAGE, store, nil, nil)
+			url := fmt.Sprintf("%slineage/products/%s/manifest/", test.BaseURL, testCase.productID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPut, url, nil, testCase.body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+func buildLineageStubs(store *mockStore.MockStore, fixture lineageFixture) {
+	store.EXPECT().GetLineageSources(1122).Times(1).Return(fixture.sources, nil)
+	store.EXPECT().GetLineageTables(1122).Times(1).Return(fixture.tables, nil)
+	store.EXPECT().GetLineageProductPipelines(1122).Times(1).Return(fixture.productPipelines, nil)
+	store.EXPECT().GetLineageColumns(1122).Times(1).Return(fixture.columns, nil)
+	store.EXPECT().GetDbtManifests(1122).Times(1).Return(fixture.manifests, nil)
+}
+
+func decodeLineageGraph(t *testing.T, recorder *httptest.ResponseRecorder) models.LineageGraph {
+	var res struct {
+		Data models.LineageGraph `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+
+	return res.Data
+}
+
+func nodeIDs(graph models.LineageGraph) []string {
+	ids := make([]string, 0, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		ids = append(ids, node.ID)
+	}
+
+	return ids
+}
+
+func createLineageFixture() lineageFixture {
+	sourceID, _ := uuid.NewV1()
+	pipelineID, _ := uuid.NewV1()
+	productID, _ := uuid.NewV1()
+
+	newTable := func(name string, raw bool) models.LineageTable {
+		assetID, _ := uuid.NewV1()
+		table := models.LineageTable{
+			AssetID:         assetID.String(),
+			Name:            name,
+			Schema:          "product",
+			ParentID:        productID.String(),
+			Raw:             raw,
+			DestinationType: "Postgres",
+			Host:            "localhost",
+			Port:            "5432",
+			Database:        "warehouse",
+		}
+
+		if raw {
+			table.Prefix = "shop_"
+			table.Schema = "sales"
+			table.ParentID = pipelineID.String()
+		}
+
+		return table
+	}
+
+	users := newTable("users", true)
+	orders := newTable("orders", true)
+	customers := newTable("customers", false)
+
+	manifest := []byte(`{
+		"nodes": {
+			"model.shop.customers": {
+				"resource_type": "model",
+				"name": "customers",
+				"depends_on": {"nodes": ["source.shop.sales.users"]}
+			}
+		},
+		"sources": {
+			"source.shop.sales.users": {"resource_type": "source", "name": "users", "identifier": "shop_users"},
+			"source.shop.sales.orders": {"resource_type": "source", "name": "orders", "identifier": "shop_orders"}
+		}
+	}`)
+
+	return lineageFixture{
+		sourceID:         sourceID.String(),
+		pipelineID:       pipelineID.String(),
+		productID:        productID,
+		users:            users,
+		orders:           orders,
+		customers:        customers,
+		sources:          []models.LineageSource{{SourceID: sourceID.String(), SourceName: "shop", PipelineID: pipelineID.String()}},
+		tables:           []models.LineageTable{users, orders, customers},
+		productPipelines: []models.ProductsPipelines{{ProductID: productID, PipelineID: pipelineID.String()}},
+		columns: []models.AssetColumn{
+			{AssetID: users.AssetID, Name: "email", Type: "string"},
+			{AssetID: users.AssetID, Name: "name", Type: "string"},
+			{AssetID: orders.AssetID, Name: "email", Type: "string"},
+			{AssetID: customers.AssetID, Name: "email", Type: "string"},
+		},
+		manifests: []models.DbtManifest{{ProductID: productID, Manifest: manifest, WorkspaceID: 1122}},
+	}
+}
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+
+	os.Exit(m.Run())
+}
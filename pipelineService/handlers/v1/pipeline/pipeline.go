@@ -2,6 +2,8 @@ package pipeline
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"sync"
@@ -10,12 +12,15 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"go.uber.org/cadence/client"
+	"gorm.io/gorm"
 	"pipelineService/clients/airbyte"
 	"pipelineService/clients/authService"
 	"pipelineService/clients/cadenceClient"
 	"pipelineService/env"
 	"pipelineService/models/v1"
+	"pipelineService/services/dataQuality"
 	"pipelineService/services/db"
+	"pipelineService/services/profiling"
 	"pipelineService/utils"
 )
 
@@ -35,27 +40,13 @@ func (server *Server) getPipelinesData(pipeline models.PipelinesMetaData, pipeli
 
 	defer wg.Done()
 
-	requestBody := make(map[string]interface{})
-
-	var (
-		connectionMeta models.ConnectionMeta
-		authResponse   models.UserDetails
-	)
-
-	//parse the airbyte connectionID
-	if pipeline.AirbyteConnectionID != "" {
-		airbyteConnectionID, err := uuid.FromString(pipeline.AirbyteConnectionID)
-		if err != nil {
-			logger.Error(err.Error())
-			pipelineCh <- pipeline
-
-			return
+	// every destination has an airbyte connection of its own, the pipeline reports the status of the group
+	for i, destination := range pipeline.Destinations {
+		if destination.AirbyteConnectionID == "" {
+			continue
 		}
 
-		requestBody["connectionId"] = airbyteConnectionID
-		requestBody["withRefreshedCatalog"] = false
-
-		connectionMeta, err = server.Airbyte.GetConnectionDetails(requestBody)
+		connectionMeta, err := server.getConnectionMeta(destination.AirbyteConnectionID)
 		if err != nil {
 			logger.Error(err.Error())
 			pipelineCh <- pipeline
@@ -63,8 +54,12 @@ func (server *Server) getPipelinesData(pipeline models.PipelinesMetaData, pipeli
 			return
 		}
 
-		pipeline.AirbyteLastRun = connectionMeta.LatestSyncJobCreatedAt
-		pipeline.AirbyteStatus = connectionMeta.LatestSyncJobStatus
+		pipeline.Destinations[i].AirbyteLastRun = connectionMeta.LatestSyncJobCreatedAt
+		pipeline.Destinations[i].AirbyteStatus = connectionMeta.LatestSyncJobStatus
+	}
+
+	if len(pipeline.Destinations) > 0 {
+		pipeline.AirbyteStatus, pipeline.AirbyteLastRun = aggregateSyncStatus(pipeline.Destinations)
 	}
 
 	authResponse, err := server.AuthService.GetUserByID(pipeline.OwnerID)
@@ -245,25 +240,31 @@ func (server *Server) GetPipeline(ctx *gin.Context) {
 		return
 	}
 
-	var (
-		connectionMeta models.ConnectionMeta
-		authResponse   models.UserDetails
-	)
+	var authResponse models.UserDetails
 
-	//parse the airbyte connectionID
-	airbyteConnectionID, err := uuid.FromString(pipeline.Pipeline.AirbyteConnectionID)
-	if err != nil {
-		logger.Error(err.Error())
-		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+	destinations := pipeline.Pipeline.Destinations
+	for i := range destinations {
+		if destinations[i].AirbyteConnectionID == "" {
+			continue
+		}
 
-		return
+		connectionMeta, err := server.getConnectionMeta(destinations[i].AirbyteConnectionID)
+		if err != nil {
+			logger.Error(err.Error())
+			utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+			return
+		}
+
+		destinations[i].AirbyteLastRun = connectionMeta.LatestSyncJobCreatedAt
+		destinations[i].AirbyteStatus = connectionMeta.LatestSyncJobStatus
 	}
 
-	requestBody := make(map[string]interface{})
-	requestBody["connectionId"] = airbyteConnectionID
-	requestBody["withRefreshedCatalog"] = false
+	if len(destinations) > 0 {
+		pipeline.Pipeline.AirbyteStatus, pipeline.Pipeline.AirbyteLastRun = aggregateSyncStatus(destinations)
+	}
 
-	connectionMeta, err = server.Airbyte.GetConnectionDetails(requestBody)
+	authResponse, err = server.AuthService.GetUserByID(pipeline.Pipeline.Owner)
 	if err != nil {
 		logger.Error(err.Error())
 		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
@@ -271,25 +272,24 @@ func (server *Server) GetPipeline(ctx *gin.Context) {
 		return
 	}
 
-	pipeline.Pipeline.AirbyteLastRun = connectionMeta.LatestSyncJobCreatedAt
-	pipeline.Pipeline.AirbyteStatus = connectionMeta.LatestSyncJobStatus
+	pipeline.Owner = authResponse.Payload.UserInfo
 
-	authResponse, err = server.AuthService.GetUserByID(pipeline.Pipeline.Owner)
+	pipeline.Quality, err = server.Store.GetPipelineQualityResults(pipelineID)
 	if err != nil {
 		logger.Error(err.Error())
-		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+		statusCode, errMsg := utils.ParseDBError(err, "Quality Results")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
 
 		return
 	}
 
-	pipeline.Owner = authResponse.Payload.UserInfo
 	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", pipeline)
 	logger.Info("GetPipeline endpoint returned successfully")
 }
 
 // CreatePipelineConnection creates a pipeline connection on Airbyte
 // @Summary Create pipeline on airbyte
-// @Description Creates a pipeline on airbyte using the specified sources and destinations
+// @Description Creates a pipeline on airbyte using the specified source, the source is replicated to every destination over an airbyte connection of its own. The destinations are connected all together or not at all
 // @Tags pipelines
 // @Produce  json
 // @Param pipeline body models.CreatePipelineRequest true "Pipeline Info"
@@ -311,7 +311,17 @@ func (server *Server) CreatePipelineConnection(ctx *gin.Context) {
 		return
 	}
 
-	connectionInfo, err := server.Store.GetSourceAndDestinationAirbyteInfo(createPipelineRequest.SourceID, createPipelineRequest.DestinationID)
+	destinationIDs := createPipelineRequest.Destinations()
+	if len(destinationIDs) == 0 {
+		errMsg := "destinationId or destinationIds is required"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	connections, err := server.Store.AddPipelineDestinations(createPipelineRequest.SourceID,
+		createPipelineRequest.PipelineID, destinationIDs)
 	if err != nil {
 		logger.Error(err.Error())
 		statusCode, errMsg := utils.ParseDBError(err, "Source And Destination Info for Air Byte")
@@ -327,18 +337,57 @@ func (server *Server) CreatePipelineConnection(ctx *gin.Context) {
 		DecisionTaskStartToCloseTimeout: time.Minute,
 	}
 
-	err = server.CadenceClient.TriggerCreateConnectionWorkflow(ctx, workflowOptions, createPipelineRequest, connectionInfo, userID, workspaceID, airbyteWorkspaceID)
-	if err != nil {
-		logger.Error(err.Error())
-		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "Couldn't Trigger CreatePipelineConnection Workflow", nil)
+	// the same catalog is replicated to every destination, each workflow creates the airbyte connection of one of them
+	for _, connectionInfo := range connections {
+		connectionRequest := createPipelineRequest
+		connectionRequest.PipelineID = connectionInfo.PipelineID
+		connectionRequest.DestinationID = connectionInfo.DestinationID
+		connectionRequest.DestinationIDs = nil
 
-		return
+		err = server.CadenceClient.TriggerCreateConnectionWorkflow(ctx, workflowOptions, connectionRequest, connectionInfo, userID, workspaceID, airbyteWorkspaceID)
+		if err != nil {
+			logger.Error(err.Error())
+
+			// the destinations are connected all together or not at all
+			server.releasePipelineDestinations(connections)
+
+			utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "Couldn't Trigger CreatePipelineConnection Workflow", nil)
+
+			return
+		}
 	}
 
 	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", "connection created")
 	logger.Info("CreatePipelineConnection endpoint returned successfully")
 }
 
+// releasePipelineDestinations deletes the airbyte connections already created for the destinations and releases the
+// connections reserved for them, the failures are only logged since the request has already failed.
+func (server *Server) releasePipelineDestinations(connections []models.AirbyteSourceAndDestinations) {
+	logger := utils.GetLogger()
+
+	for _, connectionInfo := range connections {
+		connection, err := server.Store.GetConnection(connectionInfo.ConnectionID)
+		if err != nil {
+			logger.Error(err.Error())
+
+			continue
+		}
+
+		if connection.AirbyteConnectionID == "" {
+			continue
+		}
+
+		if err = server.Airbyte.DeleteConnection(connection.AirbyteConnectionID); err != nil {
+			logger.Error(err.Error())
+		}
+	}
+
+	if err := server.Store.ReleasePipelineDestinations(connections); err != nil {
+		logger.Error(err.Error())
+	}
+}
+
 // CreatePipelineConnectionOnAirbyte creates a pipeline connection on Airbyte
 // @Summary Create pipeline on airbyte
 // @Description Creates a pipeline on airbyte using the specified sources and destinations
@@ -368,7 +417,16 @@ func (server *Server) CreatePipelineConnectionOnAirbyte(ctx *gin.Context) {
 		return
 	}
 
-	airbyteInfo, err := server.Store.GetSourceAndDestinationAirbyteInfo(createPipelineRequest.SourceID, createPipelineRequest.DestinationID)
+	if createPipelineRequest.DestinationID == "" {
+		errMsg := "destinationId is required"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	airbyteInfo, err := server.Store.GetSourceAndDestinationAirbyteInfo(createPipelineRequest.SourceID,
+		createPipelineRequest.DestinationID, createPipelineRequest.PipelineID)
 	if err != nil {
 		logger.Error(err.Error())
 		statusCode, errMsg := utils.ParseDBError(err, "Source And Destination Info for Air Byte")
@@ -420,7 +478,7 @@ func (server *Server) CreatePipelineConnectionOnAirbyte(ctx *gin.Context) {
 
 // UpdatePipelineConnection updates a pipeline connection on AirByte
 // @Summary Updates Pipeline on AirByte
-// @Description Updates an pipeline on airByte
+// @Description Updates an pipeline on airByte, the schedule and the catalog are applied to the connection of every destination of the pipeline
 // @Tags pipelines
 // @Produce  json
 // @Param pipeline body models.UpdatePipelineAirByteRequest true "Pipeline Info"
@@ -453,6 +511,17 @@ func (server *Server) UpdatePipelineConnection(ctx *gin.Context) {
 		return
 	}
 
+	// the destinations of a pipeline replicate the same catalog on the same schedule, so the connection of every
+	// destination gets the update
+	connections, err := server.Store.GetPipelineConnections(uuid.FromStringOrNil(connection.PipelineID))
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connections")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
 	workflowOptions := client.StartWorkflowOptions{
 		//ID:                              wID.String(),
 		TaskList:                        env.Env.TaskListName,
@@ -463,12 +532,14 @@ func (server *Server) UpdatePipelineConnection(ctx *gin.Context) {
 	c, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 
-	err = server.CadenceClient.TriggerUpdateConnectionWorkflow(c, workflowOptions, updatePipelineRequest, connection, userID, workspaceID, airbyteWorkspaceID)
-	if err != nil {
-		logger.Error(err.Error())
-		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "Couldn't Trigger UpdatePipelineConnection Workflow", nil)
+	for _, pipelineConnection := range connections {
+		err = server.CadenceClient.TriggerUpdateConnectionWorkflow(c, workflowOptions, updatePipelineRequest, pipelineConnection, userID, workspaceID, airbyteWorkspaceID)
+		if err != nil {
+			logger.Error(err.Error())
+			utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "Couldn't Trigger UpdatePipelineConnection Workflow", nil)
 
-		return
+			return
+		}
 	}
 
 	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", "connection updated")
@@ -584,196 +655,132 @@ func (server *Server) RunManualSyncOnAirByte(ctx *gin.Context) {
 	logger.Info("RunManualSyncOnAirByte successfully returned")
 }
 
-func CreatePipelineAirbyteRequestModel(airbyteInfo models.AirbyteSourceAndDestinations,
-	createPipelineRequest models.CreatePipelineRequest) *models.CreatePipelineAirbyteRequest {
-	return &models.CreatePipelineAirbyteRequest{
-		DestinationId:       airbyteInfo.AirbyteDestinationID,
-		SourceId:            airbyteInfo.AirbyteSourceID,
-		NamespaceDefinition: utils.AIRBYTE_DEFAULT_NAMESPACE_DEFINITION,
-		NamespaceFormat:     airbyteInfo.PipelineName,
-		Prefix:              *createPipelineRequest.Prefix,
-		Status:              utils.AIRBYTE_DEFAULT_STATUS,
-		Schedule:            createPipelineRequest.Schedule,
-		SyncCatalog:         createPipelineRequest.SyncCatalog,
-		Operations:          createPipelineRequest.Operations,
-	}
-}
-
-// GetAllConnections returns all connections
-// @Summary Returns all connections
-// @Description Returns all existing connections
-// @Tags pipelines/internal
+// CancelPipelineSync triggers the cancel sync workflow
+// @Summary cancels the running sync of a pipeline
+// @Description Triggers the workflow which cancels the running sync job of the pipeline on airbyte
+// @Tags pipelines
 // @Produce  json
-// @Success 200 {array} models.Connection
+// @Param id path string true "Pipeline ID"
+// @Param operation body models.PipelineOperationRequest true "Operation confirmation"
+// @Success 200 {object} models.Response
 // @Failure 400	{object} models.Response
 // @Failure 500	{object} models.Response
-// @Router /pipelines/internal/connections/ [get].
-func (server *Server) GetAllConnections(ctx *gin.Context) {
+// @Router /pipelines/{id}/sync/cancel/ [post].
+func (server *Server) CancelPipelineSync(ctx *gin.Context) {
 	logger := utils.GetLogger()
-	logger.Info("GetAllConnections internal endpoint called")
+	logger.Info("CancelPipelineSync endpoint called")
 
-	connections, err := server.Store.GetAllConnections()
-	if err != nil {
-		logger.Error(err.Error())
-		statusCode, errMsg := utils.ParseDBError(err, "Connection")
-		ctx.JSON(statusCode, errMsg)
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
 
+	pipelineID, _, ok := parsePipelineOperationRequest(ctx)
+	if !ok {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, connections)
-	logger.Info("GetAllConnections internal endpoint successfully returned")
-}
-
-// FetchSyncHistoryFromAirByte Fetches the sync history from AirByte
-// @Summary Fetch Sync History From AirByte
-// @Description Fetch Sync History From AirByte for a specific source
-// @Tags pipelines
-// @Produce  json
-// @Param connection_id path string true "Connection ID"
-// @Success 200 {object} models.SyncHistoryResponse
-// @Failure 400 {object} models.Response
-// @Failure 500 {object} models.Response
-// @Router /pipelines/connections/{connection_id}/sync/history/ [get].
-func (server *Server) FetchSyncHistoryFromAirByte(ctx *gin.Context) {
-	logger := utils.GetLogger()
-	logger.Info("FetchSyncHistoryFromAirByte endpoint called")
-
-	airByteConnectionID := ctx.Param("connection_id")
-
-	requestBody := models.SyncHistoryRequest{
-		ConfigTypes: []string{
-			utils.SYNC,
-			utils.RESET_CONNECTION,
-		},
-		ConfigId: airByteConnectionID,
-	}
+	c, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
 
-	SyncHistoryResponse, err := server.Airbyte.FetchSyncHistory(requestBody)
+	err := server.CadenceClient.TriggerCancelSyncWorkflow(c, pipelineOperationWorkflowOptions(), pipelineID.String(), userID, workspaceID)
 	if err != nil {
 		logger.Error(err.Error())
-		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "Couldn't Trigger CancelSync Workflow", nil)
 
 		return
 	}
 
-	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", SyncHistoryResponse)
-
-	logger.Info("FetchSyncHistoryFromAirByte successfully returned")
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Sync Cancellation In-Progress")
+	logger.Info("CancelPipelineSync endpoint returned successfully")
 }
 
-// GetJobLogsFromAirByte Fetches the logs of a job from AirByte
-// @Summary Fetch Job logs From AirByte
-// @Description Fetch logs of a connection from AirByte as per the job
+// RetryPipelineSync triggers the retry sync workflow
+// @Summary retries the last failed sync of a pipeline
+// @Description Triggers the workflow which retries the last failed sync job of the pipeline on airbyte
 // @Tags pipelines
 // @Produce  json
-// @Param job_id path string true "Job ID"
-// @Success 200 {object} models.JobLogs
-// @Failure 400 {object} models.Response
-// @Failure 500 {object} models.Response
-// @Router /pipelines/connections/sync/logs/{job_id}/ [get].
-func (server *Server) GetJobLogsFromAirByte(ctx *gin.Context) {
+// @Param id path string true "Pipeline ID"
+// @Param operation body models.PipelineOperationRequest true "Operation confirmation"
+// @Success 200 {object} models.Response
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /pipelines/{id}/sync/retry/ [post].
+func (server *Server) RetryPipelineSync(ctx *gin.Context) {
 	logger := utils.GetLogger()
-	logger.Info("GetJobLogsFromAirByte endpoint called")
-
-	ID := ctx.Param("job_id")
-	jobID, _ := strconv.Atoi(ID)
+	logger.Info("RetryPipelineSync endpoint called")
 
-	JobLogs, err := server.Airbyte.GetJobLogs(jobID)
-	if err != nil {
-		logger.Error(err.Error())
-		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
 
+	pipelineID, _, ok := parsePipelineOperationRequest(ctx)
+	if !ok {
 		return
 	}
 
-	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", JobLogs)
-
-	logger.Info("GetJobLogsFromFromAirByte successfully returned")
-}
-
-// GetSourceSchemaFromAirByteConnection Fetches the Source Schema from AirByte
-// @Summary Fetch Source Schema from AirByte
-// @Description Fetch Source Schema of a connection from AirByte
-// @Tags pipelines
-// @Produce  json
-// @Param connection_id path string true "Connection ID"
-// @Success 200 {object} models.ConnectionSourceSchema
-// @Failure 400 {object} models.Response
-// @Failure 500 {object} models.Response
-// @Router /pipelines/connections/{connection_id}/schema/ [get].
-func (server *Server) GetSourceSchemaFromAirByteConnection(ctx *gin.Context) {
-	logger := utils.GetLogger()
-	logger.Info("GetSourceSchemaFromAirByteConnection endpoint called")
-
-	airByteConnectionID := ctx.Param("connection_id")
+	c, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
 
-	connSourceSchema, err := server.Airbyte.GetConnectionSchema(airByteConnectionID)
+	err := server.CadenceClient.TriggerRetrySyncWorkflow(c, pipelineOperationWorkflowOptions(), pipelineID.String(), userID, workspaceID)
 	if err != nil {
 		logger.Error(err.Error())
-		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "Couldn't Trigger RetrySync Workflow", nil)
 
 		return
 	}
 
-	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", connSourceSchema)
-
-	logger.Info("GetSourceSchemaFromAirByteConnection successfully returned")
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Sync Retry In-Progress")
+	logger.Info("RetryPipelineSync endpoint returned successfully")
 }
 
-// UpdateConnections update connections in Database(DB)
-// @Summary update all given connections
-// @Description updates all given connections in DB
-// @Tags pipelines/internal
+// ResetPipeline triggers the reset connection workflow
+// @Summary resets the data of a pipeline
+// @Description Triggers the workflow which resets the whole connection or only the selected streams on airbyte
+// @Tags pipelines
 // @Produce  json
-// @Param connections body []models.Connection true "Connection Instance"
+// @Param id path string true "Pipeline ID"
+// @Param operation body models.PipelineOperationRequest true "Operation confirmation and streams to reset"
 // @Success 200 {object} models.Response
 // @Failure 400	{object} models.Response
 // @Failure 500	{object} models.Response
-// @Router /pipelines/internal/connections/ [patch].
-func (server *Server) UpdateConnections(ctx *gin.Context) {
+// @Router /pipelines/{id}/reset/ [post].
+func (server *Server) ResetPipeline(ctx *gin.Context) {
 	logger := utils.GetLogger()
-	logger.Info("UpdateConnections internal endpoint called")
-
-	var connections []models.Connection
-	if err := ctx.ShouldBindJSON(&connections); err != nil {
-		logger.Error(err.Error())
+	logger.Info("ResetPipeline endpoint called")
 
-		msg := "missing connections payload"
-		utils.BuildResponse(ctx, http.StatusBadRequest, msg, utils.ERROR, nil)
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
 
+	pipelineID, operationRequest, ok := parsePipelineOperationRequest(ctx)
+	if !ok {
 		return
 	}
 
-	err := server.Store.UpdateConnections(connections)
+	c, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	err := server.CadenceClient.TriggerResetConnectionWorkflow(c, pipelineOperationWorkflowOptions(), pipelineID.String(),
+		operationRequest.Streams, userID, workspaceID)
 	if err != nil {
 		logger.Error(err.Error())
-		statusCode, errMsg := utils.ParseDBError(err, "Connection")
-		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "Couldn't Trigger ResetConnection Workflow", nil)
 
 		return
 	}
 
-	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", nil)
-
-	logger.Info("UpdateConnections internal endpoint successfully returned")
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Reset In-Progress")
+	logger.Info("ResetPipeline endpoint returned successfully")
 }
 
-// DeletePipeline deletes the pipelines
-// @Summary deletes a pipelines
-// @Description deletes a pipeline by ID
-// @Tags pipelines/internal
+// GetPipelineOperations returns the cancel, retry and reset operations of a pipeline
+// @Summary Returns the operations performed on a pipeline
+// @Description Returns the cancel, retry and reset operations performed on a pipeline, latest first
+// @Tags pipelines
 // @Produce  json
 // @Param id path string true "Pipeline ID"
-// @Success 204 {object} models.Response
+// @Success 200 {object} models.PipelineOperationsResponse
 // @Failure 400	{object} models.Response
 // @Failure 500	{object} models.Response
-// @Router /pipelines/internal/{id}/ [delete].
-func (server *Server) DeletePipeline(ctx *gin.Context) {
+// @Router /pipelines/{id}/operations/ [get].
+func (server *Server) GetPipelineOperations(ctx *gin.Context) {
 	logger := utils.GetLogger()
-	logger.Info("DeletePipeline internal endpoint called")
+	logger.Info("GetPipelineOperations endpoint called")
 
-	//Parse the pipelineID
 	pipelineID, err := uuid.FromString(ctx.Param("id"))
 	if err != nil {
 		logger.Error(err.Error())
@@ -782,54 +789,1076 @@ func (server *Server) DeletePipeline(ctx *gin.Context) {
 		return
 	}
 
-	err = server.Store.DeletePipeline(pipelineID)
+	operations, err := server.Store.GetPipelineOperations(pipelineID)
 	if err != nil {
 		logger.Error(err.Error())
-		statusCode, errMsg := utils.ParseDBError(err, "Pipeline")
+		statusCode, errMsg := utils.ParseDBError(err, "Pipeline Operations")
 		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
 
 		return
 	}
 
-	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Pipeline deleted successfully")
-	logger.Info("DeletePipeline internal endpoint successfully returned")
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", operations)
+	logger.Info("GetPipelineOperations endpoint returned successfully")
 }
 
-// TriggerDeletePipeline triggers the deletion workflow
-// @Summary pipeline deletion workflow is triggered
-// @Description pipeline deletion workflow on temporal is triggered
-// @Tags pipelines
+// CancelPipelineSyncOnAirByte cancels the running sync jobs of a pipeline on AirByte
+// @Summary cancels the running sync jobs on airbyte
+// @Description Cancels the running sync job of every connection of the pipeline, updates the pipeline_status and records an operation for each of them
+// @Tags pipelines/internal
 // @Produce  json
 // @Param id path string true "Pipeline ID"
-// @Success 200 {object} models.Response
+// @Success 200 {array} models.ManualConnectionSyncResponse
 // @Failure 400	{object} models.Response
 // @Failure 500	{object} models.Response
-// @Router /pipelines/{id}/ [delete].
-func (server *Server) TriggerDeletePipeline(ctx *gin.Context) {
+// @Router /pipelines/internal/{id}/sync/cancel/ [post].
+func (server *Server) CancelPipelineSyncOnAirByte(ctx *gin.Context) {
 	logger := utils.GetLogger()
-	logger.Info("TriggerDeletePipeline endpoint called")
-
-	workflowOptions := client.StartWorkflowOptions{
-		//ID:                              wID.String(),
-		TaskList:                        env.Env.TaskListName,
-		ExecutionStartToCloseTimeout:    time.Minute,
-		DecisionTaskStartToCloseTimeout: time.Minute,
-	}
+	logger.Info("CancelPipelineSyncOnAirByte internal endpoint called")
 
-	c, cancel := context.WithTimeout(context.Background(), 20*time.Second)
-	defer cancel()
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
 
-	err := server.CadenceClient.TriggerDeletePipelineWorkflow(c, workflowOptions, ctx.Param("id"))
+	pipelineID, err := uuid.FromString(ctx.Param("id"))
 	if err != nil {
 		logger.Error(err.Error())
-		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "Couldn't Trigger DeletePipeline Workflow", nil)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
 
 		return
 	}
 
-	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Pipeline Deletion In-Progress")
-	logger.Info("TriggerDeletePipeline successfully returned")
-}
+	connections, ok := server.getPipelineConnections(ctx, pipelineID)
+	if !ok {
+		return
+	}
+
+	cancelJobResponses := make([]models.ManualConnectionSyncResponse, 0, len(connections))
+	operations := make([]models.PipelineOperation, 0, len(connections))
+
+	// every destination of the pipeline has its own connection, the running job of each of them is cancelled
+	for _, connection := range connections {
+		var syncHistory models.SyncHistoryResponse
+
+		syncHistory, err = server.Airbyte.FetchSyncHistory(models.SyncHistoryRequest{
+			ConfigTypes: []string{utils.SYNC, utils.RESET_CONNECTION},
+			ConfigId:    connection.AirbyteConnectionID,
+		})
+		if err != nil {
+			break
+		}
+
+		runningJobID := 0
+
+		for _, job := range syncHistory.Jobs {
+			if job.Job.Status == utils.AIRBYTE_JOB_STATUS_RUNNING || job.Job.Status == utils.AIRBYTE_JOB_STATUS_PENDING {
+				runningJobID = job.Job.ID
+
+				break
+			}
+		}
+
+		if runningJobID == 0 {
+			continue
+		}
+
+		var cancelJobResponse models.ManualConnectionSyncResponse
+
+		cancelJobResponse, err = server.Airbyte.CancelJob(runningJobID)
+		if err != nil {
+			break
+		}
+
+		cancelJobResponses = append(cancelJobResponses, cancelJobResponse)
+		operations = append(operations, models.PipelineOperation{
+			PipelineID:   pipelineID.String(),
+			ConnectionID: connection.ConnectionID,
+			Operation:    utils.PIPELINE_OPERATION_CANCEL,
+			JobID:        cancelJobResponse.Job.ID,
+			JobStatus:    cancelJobResponse.Job.Status,
+			Owner:        userID,
+			WorkspaceID:  workspaceID,
+		})
+	}
+
+	if !server.finishPipelineOperations(ctx, pipelineID, utils.PIPELINE_STATUS_SYNC_CANCELLED, operations, err,
+		"no running sync found for the pipeline") {
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", cancelJobResponses)
+	logger.Info("CancelPipelineSyncOnAirByte internal endpoint successfully returned")
+}
+
+// RetryPipelineSyncOnAirByte retries the last failed sync jobs of a pipeline on AirByte
+// @Summary retries the last failed sync jobs on airbyte
+// @Description Re-runs the sync of every connection of the pipeline whose latest sync job failed, updates the pipeline_status and records an operation for each of them
+// @Tags pipelines/internal
+// @Produce  json
+// @Param id path string true "Pipeline ID"
+// @Success 200 {array} models.ManualConnectionSyncResponse
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /pipelines/internal/{id}/sync/retry/ [post].
+func (server *Server) RetryPipelineSyncOnAirByte(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("RetryPipelineSyncOnAirByte internal endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	pipelineID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	connections, ok := server.getPipelineConnections(ctx, pipelineID)
+	if !ok {
+		return
+	}
+
+	retrySyncResponses := make([]models.ManualConnectionSyncResponse, 0, len(connections))
+	operations := make([]models.PipelineOperation, 0, len(connections))
+
+	for _, connection := range connections {
+		var syncHistory models.SyncHistoryResponse
+
+		syncHistory, err = server.Airbyte.FetchSyncHistory(models.SyncHistoryRequest{
+			ConfigTypes: []string{utils.SYNC},
+			ConfigId:    connection.AirbyteConnectionID,
+		})
+		if err != nil {
+			break
+		}
+
+		// airbyte lists the jobs latest first, only the connections whose latest job failed are retried
+		if len(syncHistory.Jobs) == 0 || syncHistory.Jobs[0].Job.Status != utils.AIRBYTE_JOB_STATUS_FAILED {
+			continue
+		}
+
+		requestBody := make(map[string]interface{})
+		requestBody["connectionId"] = connection.AirbyteConnectionID
+
+		var retrySyncResponse models.ManualConnectionSyncResponse
+
+		retrySyncResponse, err = server.Airbyte.SyncConnectionManually(requestBody)
+		if err != nil {
+			break
+		}
+
+		retrySyncResponses = append(retrySyncResponses, retrySyncResponse)
+		operations = append(operations, models.PipelineOperation{
+			PipelineID:   pipelineID.String(),
+			ConnectionID: connection.ConnectionID,
+			Operation:    utils.PIPELINE_OPERATION_RETRY,
+			JobID:        retrySyncResponse.Job.ID,
+			JobStatus:    retrySyncResponse.Job.Status,
+			Owner:        userID,
+			WorkspaceID:  workspaceID,
+		})
+	}
+
+	if !server.finishPipelineOperations(ctx, pipelineID, utils.PIPELINE_STATUS_SYNC_RETRYING, operations, err,
+		"no failed sync found for the pipeline") {
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", retrySyncResponses)
+	logger.Info("RetryPipelineSyncOnAirByte internal endpoint successfully returned")
+}
+
+// ResetPipelineOnAirByte resets the connections of a pipeline on AirByte
+// @Summary resets the connections on airbyte
+// @Description Resets all the streams of every connection of the pipeline, or only the given ones, updates the pipeline_status and records an operation for each of them
+// @Tags pipelines/internal
+// @Produce  json
+// @Param id path string true "Pipeline ID"
+// @Param operation body models.PipelineOperationRequest true "Streams to reset"
+// @Success 200 {array} models.ManualConnectionSyncResponse
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /pipelines/internal/{id}/reset/ [post].
+func (server *Server) ResetPipelineOnAirByte(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ResetPipelineOnAirByte internal endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	pipelineID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var operationRequest models.PipelineOperationRequest
+	if err = ctx.ShouldBindJSON(&operationRequest); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	connections, ok := server.getPipelineConnections(ctx, pipelineID)
+	if !ok {
+		return
+	}
+
+	var streams []string
+
+	for _, stream := range operationRequest.Streams {
+		if stream.Namespace != "" {
+			streams = append(streams, stream.Namespace+"."+stream.Name)
+		} else {
+			streams = append(streams, stream.Name)
+		}
+	}
+
+	resetResponses := make([]models.ManualConnectionSyncResponse, 0, len(connections))
+	operations := make([]models.PipelineOperation, 0, len(connections))
+
+	for _, connection := range connections {
+		var resetResponse models.ManualConnectionSyncResponse
+
+		if len(operationRequest.Streams) == 0 {
+			resetResponse, err = server.Airbyte.ResetConnection(connection.AirbyteConnectionID)
+		} else {
+			resetResponse, err = server.Airbyte.ResetConnectionStreams(models.ResetConnectionStreamsRequest{
+				ConnectionId: connection.AirbyteConnectionID,
+				Streams:      operationRequest.Streams,
+			})
+		}
+
+		if err != nil {
+			break
+		}
+
+		resetResponses = append(resetResponses, resetResponse)
+		operations = append(operations, models.PipelineOperation{
+			PipelineID:   pipelineID.String(),
+			ConnectionID: connection.ConnectionID,
+			Operation:    utils.PIPELINE_OPERATION_RESET,
+			JobID:        resetResponse.Job.ID,
+			JobStatus:    resetResponse.Job.Status,
+			Streams:      streams,
+			Owner:        userID,
+			WorkspaceID:  workspaceID,
+		})
+	}
+
+	if !server.finishPipelineOperations(ctx, pipelineID, utils.PIPELINE_STATUS_RESET_IN_PROGRESS, operations, err, "") {
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", resetResponses)
+	logger.Info("ResetPipelineOnAirByte internal endpoint successfully returned")
+}
+
+// getPipelineConnections returns the airbyte connections of the pipeline, a pipeline without any can't be operated.
+func (server *Server) getPipelineConnections(ctx *gin.Context, pipelineID uuid.UUID) ([]models.Connection, bool) {
+	logger := utils.GetLogger()
+
+	connections, err := server.Store.GetPipelineConnections(pipelineID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Pipeline")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return nil, false
+	}
+
+	if len(connections) == 0 {
+		errMsg := "pipeline has no connection on airbyte"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return nil, false
+	}
+
+	return connections, true
+}
+
+// finishPipelineOperations records the operations started on the connections of the pipeline, including the ones
+// started before another connection failed, then reports the failure or the absence of any operation.
+func (server *Server) finishPipelineOperations(ctx *gin.Context, pipelineID uuid.UUID, pipelineStatus string,
+	operations []models.PipelineOperation, err error, noOperationMsg string) bool {
+	logger := utils.GetLogger()
+
+	if len(operations) > 0 && !server.recordPipelineOperations(ctx, pipelineID, pipelineStatus, operations...) {
+		return false
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return false
+	}
+
+	if len(operations) == 0 {
+		logger.Error(noOperationMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, noOperationMsg, nil)
+
+		return false
+	}
+
+	return true
+}
+
+// parsePipelineOperationRequest parses the pipeline ID and the operation request,
+// the operations cannot be undone so the request has to be explicitly confirmed.
+func parsePipelineOperationRequest(ctx *gin.Context) (uuid.UUID, models.PipelineOperationRequest, bool) {
+	logger := utils.GetLogger()
+
+	var operationRequest models.PipelineOperationRequest
+
+	pipelineID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return pipelineID, operationRequest, false
+	}
+
+	if err = ctx.ShouldBindJSON(&operationRequest); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return pipelineID, operationRequest, false
+	}
+
+	if !operationRequest.Confirm {
+		errMsg := "operation is not confirmed"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return pipelineID, operationRequest, false
+	}
+
+	return pipelineID, operationRequest, true
+}
+
+// recordPipelineOperations updates the pipeline_status and adds the operations to the pipeline's audit trail.
+func (server *Server) recordPipelineOperations(ctx *gin.Context, pipelineID uuid.UUID, pipelineStatus string,
+	operations ...models.PipelineOperation) bool {
+	logger := utils.GetLogger()
+
+	if err := server.Store.UpdatePipelineStatus(pipelineID, pipelineStatus); err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Pipeline")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return false
+	}
+
+	for _, operation := range operations {
+		if _, err := server.Store.CreatePipelineOperation(operation); err != nil {
+			logger.Error(err.Error())
+			statusCode, errMsg := utils.ParseDBError(err, "Pipeline Operation")
+			utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+func pipelineOperationWorkflowOptions() client.StartWorkflowOptions {
+	return client.StartWorkflowOptions{
+		TaskList:                        env.Env.TaskListName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+}
+
+func (server *Server) getConnectionMeta(airbyteConnectionID string) (models.ConnectionMeta, error) {
+	connectionID, err := uuid.FromString(airbyteConnectionID)
+	if err != nil {
+		return models.ConnectionMeta{}, err
+	}
+
+	requestBody := make(map[string]interface{})
+	requestBody["connectionId"] = connectionID
+	requestBody["withRefreshedCatalog"] = false
+
+	return server.Airbyte.GetConnectionDetails(requestBody)
+}
+
+// syncStatusSeverity ranks the statuses of the latest syncs, a status airbyte adds later ranks below the known ones.
+var syncStatusSeverity = map[string]int{
+	utils.AIRBYTE_JOB_STATUS_SUCCEEDED: 1,
+	utils.AIRBYTE_JOB_STATUS_PENDING:   2,
+	utils.AIRBYTE_JOB_STATUS_RUNNING:   3,
+	utils.AIRBYTE_JOB_STATUS_CANCELLED: 4,
+	utils.AIRBYTE_JOB_STATUS_FAILED:    5,
+}
+
+// aggregateSyncStatus returns the most severe status of the latest syncs of the destinations and the latest of
+// their runs, a pipeline has failed as soon as one of its destinations failed.
+func aggregateSyncStatus(destinations []models.PipelineDestination) (string, int) {
+	status, lastRun := "", 0
+
+	for _, destination := range destinations {
+		if destination.AirbyteStatus != "" &&
+			(status == "" || syncStatusSeverity[destination.AirbyteStatus] > syncStatusSeverity[status]) {
+			status = destination.AirbyteStatus
+		}
+
+		if destination.AirbyteLastRun > lastRun {
+			lastRun = destination.AirbyteLastRun
+		}
+	}
+
+	return status, lastRun
+}
+
+func CreatePipelineAirbyteRequestModel(airbyteInfo models.AirbyteSourceAndDestinations,
+	createPipelineRequest models.CreatePipelineRequest) *models.CreatePipelineAirbyteRequest {
+	return &models.CreatePipelineAirbyteRequest{
+		DestinationId:       airbyteInfo.AirbyteDestinationID,
+		SourceId:            airbyteInfo.AirbyteSourceID,
+		NamespaceDefinition: utils.AIRBYTE_DEFAULT_NAMESPACE_DEFINITION,
+		NamespaceFormat:     airbyteInfo.PipelineName,
+		Prefix:              *createPipelineRequest.Prefix,
+		Status:              utils.AIRBYTE_DEFAULT_STATUS,
+		Schedule:            createPipelineRequest.Schedule,
+		SyncCatalog:         createPipelineRequest.SyncCatalog,
+		Operations:          createPipelineRequest.Operations,
+	}
+}
+
+// GetAllConnections returns all connections
+// @Summary Returns all connections
+// @Description Returns all existing connections
+// @Tags pipelines/internal
+// @Produce  json
+// @Success 200 {array} models.Connection
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /pipelines/internal/connections/ [get].
+func (server *Server) GetAllConnections(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetAllConnections internal endpoint called")
+
+	connections, err := server.Store.GetAllConnections()
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connection")
+		ctx.JSON(statusCode, errMsg)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, connections)
+	logger.Info("GetAllConnections internal endpoint successfully returned")
+}
+
+// FetchSyncHistoryFromAirByte Fetches the sync history from AirByte
+// @Summary Fetch Sync History From AirByte
+// @Description Fetch Sync History From AirByte for a specific source
+// @Tags pipelines
+// @Produce  json
+// @Param connection_id path string true "Connection ID"
+// @Success 200 {object} models.SyncHistoryResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /pipelines/connections/{connection_id}/sync/history/ [get].
+func (server *Server) FetchSyncHistoryFromAirByte(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("FetchSyncHistoryFromAirByte endpoint called")
+
+	airByteConnectionID := ctx.Param("connection_id")
+
+	requestBody := models.SyncHistoryRequest{
+		ConfigTypes: []string{
+			utils.SYNC,
+			utils.RESET_CONNECTION,
+		},
+		ConfigId: airByteConnectionID,
+	}
+
+	SyncHistoryResponse, err := server.Airbyte.FetchSyncHistory(requestBody)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", SyncHistoryResponse)
+
+	logger.Info("FetchSyncHistoryFromAirByte successfully returned")
+}
+
+// GetJobLogsFromAirByte Fetches the logs of a job from AirByte
+// @Summary Fetch Job logs From AirByte
+// @Description Fetch logs of a connection from AirByte as per the job
+// @Tags pipelines
+// @Produce  json
+// @Param job_id path string true "Job ID"
+// @Success 200 {object} models.JobLogs
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /pipelines/connections/sync/logs/{job_id}/ [get].
+func (server *Server) GetJobLogsFromAirByte(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetJobLogsFromAirByte endpoint called")
+
+	ID := ctx.Param("job_id")
+	jobID, _ := strconv.Atoi(ID)
+
+	JobLogs, err := server.Airbyte.GetJobLogs(jobID)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", JobLogs)
+
+	logger.Info("GetJobLogsFromFromAirByte successfully returned")
+}
+
+// GetSourceSchemaFromAirByteConnection Fetches the Source Schema from AirByte
+// @Summary Fetch Source Schema from AirByte
+// @Description Fetch Source Schema of a connection from AirByte
+// @Tags pipelines
+// @Produce  json
+// @Param connection_id path string true "Connection ID"
+// @Success 200 {object} models.ConnectionSourceSchema
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /pipelines/connections/{connection_id}/schema/ [get].
+func (server *Server) GetSourceSchemaFromAirByteConnection(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetSourceSchemaFromAirByteConnection endpoint called")
+
+	airByteConnectionID := ctx.Param("connection_id")
+
+	connSourceSchema, err := server.Airbyte.GetConnectionSchema(airByteConnectionID)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", connSourceSchema)
+
+	logger.Info("GetSourceSchemaFromAirByteConnection successfully returned")
+}
+
+// UpdateConnections update connections in Database(DB)
+// @Summary update all given connections
+// @Description updates all given connections in DB
+// @Tags pipelines/internal
+// @Produce  json
+// @Param connections body []models.Connection true "Connection Instance"
+// @Success 200 {object} models.Response
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /pipelines/internal/connections/ [patch].
+func (server *Server) UpdateConnections(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("UpdateConnections internal endpoint called")
+
+	var connections []models.Connection
+	if err := ctx.ShouldBindJSON(&connections); err != nil {
+		logger.Error(err.Error())
+
+		msg := "missing connections payload"
+		utils.BuildResponse(ctx, http.StatusBadRequest, msg, utils.ERROR, nil)
+
+		return
+	}
+
+	err := server.Store.UpdateConnections(connections)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connection")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", nil)
+
+	logger.Info("UpdateConnections internal endpoint successfully returned")
+}
+
+// DeletePipeline purges the pipeline
+// @Summary purges a pipeline
+// @Description permanently deletes a pipeline by ID, it is called by the deletion workflow once the airbyte resources are torn down
+// @Tags pipelines/internal
+// @Produce  json
+// @Param id path string true "Pipeline ID"
+// @Success 204 {object} models.Response
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /pipelines/internal/{id}/ [delete].
+func (server *Server) DeletePipeline(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DeletePipeline internal endpoint called")
+
+	//Parse the pipelineID
+	pipelineID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	err = server.Store.PurgePipeline(pipelineID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Pipeline")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Pipeline deleted successfully")
+	logger.Info("DeletePipeline internal endpoint successfully returned")
+}
+
+// SoftDeletePipeline soft deletes the pipeline
+// @Summary soft deletes a pipeline
+// @Description soft deletes a pipeline along with its source and deactivates its airbyte connections, the status they had is recorded and it can be restored until it is purged after the retention window
+// @Tags pipelines
+// @Produce  json
+// @Param id path string true "Pipeline ID"
+// @Success 200 {object} models.Response
+// @Failure 400	{object} models.Response
+// @Failure 404	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /pipelines/{id}/ [delete].
+func (server *Server) SoftDeletePipeline(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("SoftDeletePipeline endpoint called")
+
+	pipelineID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	pipeline, ok := server.getWorkspacePipeline(ctx, pipelineID)
+	if !ok {
+		return
+	}
+
+	// the connections of a deleted pipeline are already deactivated, deactivating them again would lose the status
+	// recorded for the restore
+	if pipeline.DeletedAt.Valid {
+		errMsg := "Pipeline doesn't exist"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	connections, err := server.Store.GetPipelineConnections(pipelineID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connections")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	inactiveConnections := make([]models.Connection, len(connections))
+	for i, connection := range connections {
+		inactiveConnections[i] = connection
+		inactiveConnections[i].AirbyteStatus = utils.AIRBYTE_INACTIVE_STATUS
+	}
+
+	// the connections are deactivated first so a deleted pipeline doesn't keep syncing on its schedule
+	previousConnections, err := server.setConnectionsStatus(inactiveConnections)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, "Couldn't deactivate the connections of the pipeline", nil)
+
+		return
+	}
+
+	err = server.Store.DeletePipeline(pipelineID, previousConnections)
+	if err != nil {
+		logger.Error(err.Error())
+
+		if _, err := server.setConnectionsStatus(previousConnections); err != nil {
+			logger.Error(err.Error())
+		}
+
+		statusCode, errMsg := utils.ParseDBError(err, "Pipeline")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Pipeline deleted successfully")
+	logger.Info("SoftDeletePipeline endpoint successfully returned")
+}
+
+// RestorePipeline restores a soft deleted pipeline
+// @Summary restores a pipeline
+// @Description restores a soft deleted pipeline along with its source and sets its airbyte connections back to the status they had before the deletion, a pipeline writing to a deleted destination can't be restored before the destination
+// @Tags pipelines
+// @Produce  json
+// @Param id path string true "Pipeline ID"
+// @Success 200 {object} models.Response
+// @Failure 400	{object} models.Response
+// @Failure 404	{object} models.Response
+// @Failure 409	{object} models.ResourceDependenciesResponse
+// @Failure 500	{object} models.Response
+// @Router /pipelines/{id}/restore/ [post].
+func (server *Server) RestorePipeline(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("RestorePipeline endpoint called")
+
+	pipelineID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	pipeline, ok := server.getWorkspacePipeline(ctx, pipelineID)
+	if !ok {
+		return
+	}
+
+	if !pipeline.DeletedAt.Valid {
+		errMsg := "Pipeline isn't deleted"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	destinations, err := server.Store.GetPipelineDeletedDestinations(pipelineID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Destinations")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if len(destinations) > 0 {
+		errMsg := "Pipeline writes to a deleted destination, restore the destination first"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, destinations)
+
+		return
+	}
+
+	connections, err := server.Store.GetPipelineConnections(pipelineID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connections")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	// the connections are set back to the status recorded when the pipeline was deleted, the ones which were inactive
+	// before the deletion stay inactive
+	for i := range connections {
+		if connections[i].AirbyteStatus != utils.AIRBYTE_INACTIVE_STATUS {
+			connections[i].AirbyteStatus = utils.AIRBYTE_DEFAULT_STATUS
+		}
+	}
+
+	previousConnections, err := server.setConnectionsStatus(connections)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, "Couldn't reactivate the connections of the pipeline", nil)
+
+		return
+	}
+
+	err = server.Store.RestorePipeline(pipelineID)
+	if err != nil {
+		logger.Error(err.Error())
+
+		if _, err := server.setConnectionsStatus(previousConnections); err != nil {
+			logger.Error(err.Error())
+		}
+
+		statusCode, errMsg := utils.ParseDBError(err, "Pipeline")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Pipeline restored successfully")
+	logger.Info("RestorePipeline endpoint successfully returned")
+}
+
+// getWorkspacePipeline returns the pipeline of the request whether it is soft deleted or not, the pipelines of the other
+// workspaces are reported missing.
+func (server *Server) getWorkspacePipeline(ctx *gin.Context, pipelineID uuid.UUID) (models.Pipeline, bool) {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	pipeline, err := server.Store.GetPipelineIncludingDeleted(pipelineID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && pipeline.WorkspaceID != workspaceID) {
+		errMsg := "Pipeline doesn't exist"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, errMsg, nil)
+
+		return pipeline, false
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Pipeline")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return pipeline, false
+	}
+
+	return pipeline, true
+}
+
+// setConnectionsStatus sets every airbyte connection to the status it is given, the rest of their configuration is
+// sent back as is. It returns the connections with the status they had, the ones already updated are set back to it
+// when one of them fails.
+func (server *Server) setConnectionsStatus(connections []models.Connection) ([]models.Connection, error) {
+	previousConnections := make([]models.Connection, 0, len(connections))
+
+	for _, connection := range connections {
+		previousStatus, err := server.setConnectionStatus(connection.AirbyteConnectionID, connection.AirbyteStatus)
+		if err != nil {
+			for _, previousConnection := range previousConnections {
+				_, revertErr := server.setConnectionStatus(previousConnection.AirbyteConnectionID, previousConnection.AirbyteStatus)
+				if revertErr != nil {
+					utils.GetLogger().Error(revertErr.Error())
+				}
+			}
+
+			return nil, err
+		}
+
+		previousConnection := connection
+		previousConnection.AirbyteStatus = previousStatus
+		previousConnections = append(previousConnections, previousConnection)
+	}
+
+	return previousConnections, nil
+}
+
+// setConnectionStatus sets the status of the airbyte connection and returns the one it had.
+func (server *Server) setConnectionStatus(connectionID string, status string) (string, error) {
+	connectionSchema, err := server.Airbyte.GetConnectionSchema(connectionID)
+	if err != nil {
+		return "", err
+	}
+
+	if connectionSchema.Status == status {
+		return status, nil
+	}
+
+	updatePipelineRequest := models.UpdatePipelineAirByteRequest{
+		ConnectionId: connectionID,
+		Prefix:       &connectionSchema.Prefix,
+		SyncCatalog:  connectionSchema.SyncCatalog,
+		Status:       status,
+	}
+
+	if connectionSchema.Schedule.Units != 0 {
+		updatePipelineRequest.Schedule = &connectionSchema.Schedule
+	}
+
+	if _, err = server.Airbyte.UpdateConnection(updatePipelineRequest); err != nil {
+		return "", err
+	}
+
+	return connectionSchema.Status, nil
+}
+
+// PurgeDeletedResources purges the resources soft deleted before the retention window
+// @Summary purges the soft deleted resources
+// @Description Triggers the deletion workflow of the pipelines and removes the sources, destinations and data products which were soft deleted before the retention window. The connectors are deleted on airbyte before their rows, the resources which couldn't be purged are reported as failures and retried on the next run
+// @Tags pipelines/internal
+// @Produce  json
+// @Success 200 {object} models.PurgeReportResponse
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /pipelines/internal/purge/ [post].
+func (server *Server) PurgeDeletedResources(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("PurgeDeletedResources internal endpoint called")
+
+	deletedBefore := time.Now().AddDate(0, 0, -env.Env.SoftDeleteRetentionDays)
+	report := models.PurgeReport{
+		Pipelines:    make([]string, 0),
+		Sources:      make([]string, 0),
+		Destinations: make([]string, 0),
+		DataProducts: make([]string, 0),
+		Failures:     make([]models.PurgeFailure, 0),
+	}
+
+	fail := func(resourceType string, resourceID string, err error) {
+		logger.Error(err.Error())
+		report.Failures = append(report.Failures, models.PurgeFailure{ResourceType: resourceType, ResourceID: resourceID, Error: err.Error()})
+	}
+
+	pipelines, err := server.Store.GetPurgeablePipelines(deletedBefore)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Pipelines")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		TaskList:                        env.Env.TaskListName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	// the deletion workflow tears down the airbyte connection and source, then purges the pipeline
+	for _, pipeline := range pipelines {
+		err = server.CadenceClient.TriggerDeletePipelineWorkflow(c, workflowOptions, pipeline.PipelineID.String())
+		if err != nil {
+			fail("pipeline", pipeline.PipelineID.String(), err)
+
+			continue
+		}
+
+		report.Pipelines = append(report.Pipelines, pipeline.PipelineID.String())
+	}
+
+	sources, err := server.Store.GetPurgeableSources(deletedBefore)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Sources")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	// the rows are kept until the connectors are deleted on airbyte, a failed source is retried on the next run
+	for _, source := range sources {
+		// a source still read by a pipeline which is not purged yet is left for the next run
+		var dependencies []models.ResourceDependency
+
+		dependencies, err = server.Store.GetSourceDependencies(source.SourceID)
+		if err != nil {
+			fail("source", source.SourceID, err)
+
+			continue
+		}
+
+		if len(dependencies) > 0 {
+			fail("source", source.SourceID, fmt.Errorf("source is still used by %d resources", len(dependencies)))
+
+			continue
+		}
+
+		if source.AirbyteSourceID != "" {
+			err = server.Airbyte.DeleteSourceConnectorOnAirByte(source.AirbyteSourceID)
+			if err != nil {
+				fail("source", source.SourceID, err)
+
+				continue
+			}
+		}
+
+		err = server.Store.PurgeSource(source.SourceID)
+		if err != nil {
+			fail("source", source.SourceID, err)
+
+			continue
+		}
+
+		report.Sources = append(report.Sources, source.SourceID)
+	}
+
+	dataProducts, err := server.Store.GetPurgeableDataProducts(deletedBefore)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Data Products")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	// the data products are purged before the destinations they were writing to
+	for _, dataProduct := range dataProducts {
+		err = server.Store.PurgeDataProduct(dataProduct.ProductID)
+		if err != nil {
+			fail("data-product", dataProduct.ProductID.String(), err)
+
+			continue
+		}
+
+		report.DataProducts = append(report.DataProducts, dataProduct.ProductID.String())
+	}
+
+	destinations, err := server.Store.GetPurgeableDestinations(deletedBefore)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Destinations")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	for _, destination := range destinations {
+		destinationID, _ := uuid.FromString(destination.DestinationID)
+
+		// a destination still referenced by a pipeline or a data product which is not purged yet is left for the next run
+		var dependencies []models.ResourceDependency
+
+		dependencies, err = server.Store.GetDestinationDependencies(destinationID)
+		if err != nil {
+			fail("destination", destination.DestinationID, err)
+
+			continue
+		}
+
+		if len(dependencies) > 0 {
+			fail("destination", destination.DestinationID, fmt.Errorf("destination is still used by %d resources", len(dependencies)))
+
+			continue
+		}
+
+		if destination.AirbyteDestinationID != "" {
+			err = server.Airbyte.DeleteDestinationConnectorOnAirByte(destination.AirbyteDestinationID)
+			if err != nil {
+				fail("destination", destination.DestinationID, err)
+
+				continue
+			}
+		}
+
+		err = server.Store.PurgeDestination(destinationID)
+		if err != nil {
+			fail("destination", destination.DestinationID, err)
+
+			continue
+		}
+
+		report.Destinations = append(report.Destinations, destination.DestinationID)
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", report)
+	logger.Info("PurgeDeletedResources internal endpoint successfully returned")
+}
 
 // GetPipelineSourceAndConnectionID returns a source and connection ID
 // @Summary Returns a source and connection ID
@@ -969,7 +1998,7 @@ func (server *Server) CreatePipelineAssets(ctx *gin.Context) {
 		return
 	}
 
-	err := server.Store.CreatePipelineAssets(pipelineAssets)
+	createdAssets, err := server.Store.CreatePipelineAssets(pipelineAssets)
 	if err != nil {
 		logger.Error(err.Error())
 		statusCode, errMsg := utils.ParseDBError(err, "Pipeline Assets")
@@ -978,11 +2007,78 @@ func (server *Server) CreatePipelineAssets(ctx *gin.Context) {
 		return
 	}
 
+	// the data dictionary is refreshed on a best effort basis, the assets are already created
+	if err = server.syncAssetColumns(createdAssets); err != nil {
+		logger.Error(err.Error())
+	}
+
+	// the assets are created after a successful sync, they are profiled and their quality rules are checked in the
+	// background since it scans them
+	assetIDs := make([]string, 0, len(createdAssets))
+	for _, asset := range createdAssets {
+		assetIDs = append(assetIDs, asset.AssetID)
+	}
+
+	go func() {
+		profiling.ProfileAssets(server.Store, assetIDs)
+		dataQuality.CheckAssets(server.Store, assetIDs)
+	}()
+
 	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", nil)
 
 	logger.Info("CreatePipelineAssets internal endpoint successfully returned")
 }
 
+// syncAssetColumns populates the data dictionary of the raw assets from the JSON schema of the synced streams.
+func (server *Server) syncAssetColumns(pipelineAssets []models.PipelineAssets) error {
+	if len(pipelineAssets) == 0 {
+		return nil
+	}
+
+	pipelineID, err := uuid.FromString(pipelineAssets[0].PipelineID)
+	if err != nil {
+		return err
+	}
+
+	connections, err := server.Store.GetPipelineConnections(pipelineID)
+	if err != nil {
+		return err
+	}
+
+	// the destinations of the pipeline can sync different streams of the source, the catalogs of all of them are read
+	streamSchemas := make(map[string]interface{})
+
+	for _, connection := range connections {
+		connectionSchema, err := server.Airbyte.GetConnectionSchema(connection.AirbyteConnectionID)
+		if err != nil {
+			return err
+		}
+
+		for _, stream := range connectionSchema.SyncCatalog.Streams {
+			streamSchemas[stream.Stream.Name] = stream.Stream.JsonSchema
+		}
+	}
+
+	columns := make([]models.AssetColumn, 0)
+
+	for _, asset := range pipelineAssets {
+		for position, field := range utils.JSONSchemaFields(streamSchemas[asset.Name]) {
+			columns = append(columns, models.AssetColumn{
+				AssetID:     asset.AssetID,
+				ParentID:    asset.PipelineID,
+				AssetName:   asset.Name,
+				Name:        field.Name,
+				Type:        field.Type,
+				Nullable:    field.Nullable,
+				Position:    position + 1,
+				WorkspaceID: asset.WorkspaceID,
+			})
+		}
+	}
+
+	return server.Store.SyncAssetColumns(pipelineAssets[0].PipelineID, columns)
+}
+
 // DeletePipelineSchema deletes the pipeline schema and related assets
 // @Summary deletes the pipeline schema and related assets
 // @Description deletes the pipeline schema and related assets
@@ -9,12 +9,14 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 	mock_airbyte "pipelineService/clients/airbyte/mocks"
 	"pipelineService/clients/authService"
 	mock_authservice "pipelineService/clients/authService/mocks"
@@ -268,6 +270,32 @@ func TestCreatePipelineConnectionOnAirByte(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			testScenario: "BadRequest_NoDestination",
+
+			body: models.CreatePipelineRequest{
+				SourceID:    mockCreatePipelineReq.SourceID,
+				PipelineID:  mockCreatePipelineReq.PipelineID,
+				SyncCatalog: mockCreatePipelineReq.SyncCatalog,
+				Prefix:      mockCreatePipelineReq.Prefix,
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {},
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "destinationId or destinationIds is required",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
 		{
 			testScenario: "InternalServerError_BadAirByteInfo",
 
@@ -276,7 +304,7 @@ func TestCreatePipelineConnectionOnAirByte(t *testing.T) {
 			buildStubs: func(store *mockStore.MockStore) {
 				arg0 := mockCreatePipelineReq.SourceID
 				arg1 := mockCreatePipelineReq.DestinationID
-				store.EXPECT().GetSourceAndDestinationAirbyteInfo(arg0, arg1).Times(1).Return(models.AirbyteSourceAndDestinations{}, sql.ErrNoRows)
+				store.EXPECT().AddPipelineDestinations(arg0, mockCreatePipelineReq.PipelineID, []string{arg1}).Times(1).Return(nil, sql.ErrNoRows)
 			},
 
 			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {},
@@ -301,14 +329,14 @@ func TestCreatePipelineConnectionOnAirByte(t *testing.T) {
 			buildStubs: func(store *mockStore.MockStore) {
 				arg0 := mockCreatePipelineReq.SourceID
 				arg1 := mockCreatePipelineReq.DestinationID
-				store.EXPECT().GetSourceAndDestinationAirbyteInfo(arg0, arg1).Times(1).
-					Return(models.AirbyteSourceAndDestinations{
+				store.EXPECT().AddPipelineDestinations(arg0, mockCreatePipelineReq.PipelineID, []string{arg1}).Times(1).
+					Return([]models.AirbyteSourceAndDestinations{{
 						ConnectionID:         mockConnectionID.String(),
 						SourceID:             mockCreatePipelineReq.SourceID,
 						DestinationID:        mockCreatePipelineReq.DestinationID,
 						AirbyteSourceID:      mockAirByteSourceID.String(),
 						AirbyteDestinationID: mockAirByteDestID.String(),
-					}, nil)
+					}}, nil)
 			},
 
 			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
@@ -349,14 +377,14 @@ func TestCreatePipelineConnectionOnAirByte(t *testing.T) {
 			buildStubs: func(store *mockStore.MockStore) {
 				arg0 := mockCreatePipelineReq.SourceID
 				arg1 := mockCreatePipelineReq.DestinationID
-				store.EXPECT().GetSourceAndDestinationAirbyteInfo(arg0, arg1).Times(1).
-					Return(models.AirbyteSourceAndDestinations{
+				store.EXPECT().AddPipelineDestinations(arg0, mockCreatePipelineReq.PipelineID, []string{arg1}).Times(1).
+					Return([]models.AirbyteSourceAndDestinations{{
 						ConnectionID:         mockConnectionID.String(),
 						SourceID:             mockCreatePipelineReq.SourceID,
 						DestinationID:        mockCreatePipelineReq.DestinationID,
 						AirbyteSourceID:      mockAirByteSourceID.String(),
 						AirbyteDestinationID: mockAirByteDestID.String(),
-					}, nil)
+					}}, nil)
 
 				arg2 := models.Connection{
 					ConnectionID:          mockConnectionID.String(),
@@ -414,14 +442,14 @@ func TestCreatePipelineConnectionOnAirByte(t *testing.T) {
 			buildStubs: func(store *mockStore.MockStore) {
 				arg0 := mockCreatePipelineReq.SourceID
 				arg1 := mockCreatePipelineReq.DestinationID
-				store.EXPECT().GetSourceAndDestinationAirbyteInfo(arg0, arg1).Times(1).
-					Return(models.AirbyteSourceAndDestinations{
+				store.EXPECT().AddPipelineDestinations(arg0, mockCreatePipelineReq.PipelineID, []string{arg1}).Times(1).
+					Return([]models.AirbyteSourceAndDestinations{{
 						ConnectionID:         mockConnectionID.String(),
 						SourceID:             mockCreatePipelineReq.SourceID,
 						DestinationID:        mockCreatePipelineReq.DestinationID,
 						AirbyteSourceID:      mockAirByteSourceID.String(),
 						AirbyteDestinationID: mockAirByteDestID.String(),
-					}, nil)
+					}}, nil)
 
 				arg2 := models.Connection{
 					ConnectionID:          mockConnectionID.String(),
@@ -533,46 +561,882 @@ func TestUpdatePipelineConnectionOnAirByte(t *testing.T) {
 
 			body: mockUpdatePipelineReq,
 
-			buildStubs: func(store *mockStore.MockStore) {
-				store.EXPECT().GetConnection(mockConnectionID.String()).Times(1).Return(models.Connection{}, sql.ErrConnDone)
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetConnection(mockConnectionID.String()).Times(1).Return(models.Connection{}, sql.ErrConnDone)
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Something went wrong",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "InternalServerError_BadAirByte",
+
+			connectionID: mockConnectionID.String(),
+
+			body: mockUpdatePipelineReq,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetConnection(mockConnectionID.String()).Times(1).Return(mockConnection, nil)
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateConnection(mockUpdatePipelineReq).Times(1).
+					Return(models.CreatePipelineAirbyteResponse{}, errors.New("Bad AirByte response"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Bad AirByte response",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "InternalServerError",
+
+			connectionID: mockConnectionID.String(),
+
+			body: mockUpdatePipelineReq,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetConnection(mockConnectionID.String()).Times(1).Return(mockConnection, nil)
+
+				arg := models.Connection{
+					ConnectionID:          mockConnectionID.String(),
+					AirbyteFrequencyUnits: mockUpdatePipelineReq.Schedule.Units,
+					AirbyteTimeUnit:       mockUpdatePipelineReq.Schedule.TimeUnit,
+				}
+				store.EXPECT().UpdateConnectionSchedule(arg).Times(1).Return(sql.ErrConnDone)
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateConnection(mockUpdatePipelineReq).Times(1).
+					Return(models.CreatePipelineAirbyteResponse{
+						Schedule: mockUpdatePipelineReq.Schedule,
+					}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Something went wrong",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			connectionID: mockConnectionID.String(),
+
+			body: mockUpdatePipelineReq,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetConnection(mockConnectionID.String()).Times(1).Return(mockConnection, nil)
+
+				arg := models.Connection{
+					ConnectionID:          mockConnectionID.String(),
+					AirbyteFrequencyUnits: mockUpdatePipelineReq.Schedule.Units,
+					AirbyteTimeUnit:       mockUpdatePipelineReq.Schedule.TimeUnit,
+				}
+				store.EXPECT().UpdateConnectionSchedule(arg).Times(1).Return(nil)
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateConnection(mockUpdatePipelineReq).Times(1).
+					Return(models.CreatePipelineAirbyteResponse{Schedule: mockUpdatePipelineReq.Schedule}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   "pipeline updated successfully"}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			querier := mock_airbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(querier)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.PIPELINE, store, querier, authServiceClient)
+			url := fmt.Sprintf("%spipelines/connections/%s/", test.BaseURL, testCase.connectionID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPut, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+func TestRunManualSyncOnAirByte(t *testing.T) {
+	mockManualConnectionSyncResponse := createRandomManualConnectionSyncResponse()
+	cid, _ := uuid.NewV1()
+	mockConnectionID := cid.String()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		productID     string
+		connectionID  string
+		queryAirByte  func(querier *mock_airbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest",
+
+			connectionID: "",
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				arg := make(map[string]interface{})
+				arg["connectionId"] = ""
+
+				querier.EXPECT().SyncConnectionManually(arg).Times(1).Return(models.ManualConnectionSyncResponse{}, errors.New("bad connection ID"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: errors.New("bad connection ID").Error(),
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			connectionID: mockConnectionID,
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				arg := make(map[string]interface{})
+				arg["connectionId"] = mockConnectionID
+
+				querier.EXPECT().SyncConnectionManually(arg).Times(1).
+					Return(mockManualConnectionSyncResponse, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockManualConnectionSyncResponse}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			querier := mock_airbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(querier)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.PIPELINE, nil, querier, authServiceClient)
+			url := fmt.Sprintf("%spipelines/connections/%s/sync/", test.BaseURL, testCase.connectionID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestCancelPipelineSyncOnAirByte tests all the scenarios while cancelling the running sync of a pipeline.
+func TestCancelPipelineSyncOnAirByte(t *testing.T) {
+	pID, _ := uuid.NewV1()
+	mockPipelineID := pID.String()
+	mockConnections := createRandomPipelineConnections(mockPipelineID, 2)
+	mockCancelJobResponse := createRandomManualConnectionSyncResponse()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		pipelineID    string
+		buildStubs    func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_NoRunningSync",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections, nil)
+				querier.EXPECT().FetchSyncHistory(gomock.Any()).Times(2).
+					Return(createSyncHistoryResponse(t, utils.AIRBYTE_JOB_STATUS_FAILED), nil)
+				querier.EXPECT().CancelJob(gomock.Any()).Times(0)
+				store.EXPECT().CreatePipelineOperation(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "no running sync found for the pipeline",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "BadRequest_NoConnection",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return([]models.Connection{}, nil)
+				querier.EXPECT().FetchSyncHistory(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections[:1], nil)
+				querier.EXPECT().FetchSyncHistory(models.SyncHistoryRequest{
+					ConfigTypes: []string{utils.SYNC, utils.RESET_CONNECTION},
+					ConfigId:    mockConnections[0].AirbyteConnectionID,
+				}).Times(1).Return(createSyncHistoryResponse(t, utils.AIRBYTE_JOB_STATUS_RUNNING), nil)
+				querier.EXPECT().CancelJob(1).Times(1).Return(mockCancelJobResponse, nil)
+				store.EXPECT().UpdatePipelineStatus(pID, utils.PIPELINE_STATUS_SYNC_CANCELLED).Times(1).Return(nil)
+				store.EXPECT().CreatePipelineOperation(models.PipelineOperation{
+					PipelineID:   mockPipelineID,
+					ConnectionID: mockConnections[0].ConnectionID,
+					Operation:    utils.PIPELINE_OPERATION_CANCEL,
+					JobID:        mockCancelJobResponse.Job.ID,
+					JobStatus:    mockCancelJobResponse.Job.Status,
+					Owner:        1122,
+					WorkspaceID:  1122,
+				}).Times(1).Return(models.PipelineOperation{}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   []models.ManualConnectionSyncResponse{mockCancelJobResponse}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_TwoDestinations",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections, nil)
+				for _, connection := range mockConnections {
+					querier.EXPECT().FetchSyncHistory(models.SyncHistoryRequest{
+						ConfigTypes: []string{utils.SYNC, utils.RESET_CONNECTION},
+						ConfigId:    connection.AirbyteConnectionID,
+					}).Times(1).Return(createSyncHistoryResponse(t, utils.AIRBYTE_JOB_STATUS_RUNNING), nil)
+					store.EXPECT().CreatePipelineOperation(models.PipelineOperation{
+						PipelineID:   mockPipelineID,
+						ConnectionID: connection.ConnectionID,
+						Operation:    utils.PIPELINE_OPERATION_CANCEL,
+						JobID:        mockCancelJobResponse.Job.ID,
+						JobStatus:    mockCancelJobResponse.Job.Status,
+						Owner:        1122,
+						WorkspaceID:  1122,
+					}).Times(1).Return(models.PipelineOperation{}, nil)
+				}
+				querier.EXPECT().CancelJob(1).Times(2).Return(mockCancelJobResponse, nil)
+				store.EXPECT().UpdatePipelineStatus(pID, utils.PIPELINE_STATUS_SYNC_CANCELLED).Times(1).Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   []models.ManualConnectionSyncResponse{mockCancelJobResponse, mockCancelJobResponse}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "BadRequest_SecondDestinationFailed",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections, nil)
+				querier.EXPECT().FetchSyncHistory(gomock.Any()).Times(2).
+					Return(createSyncHistoryResponse(t, utils.AIRBYTE_JOB_STATUS_RUNNING), nil)
+				gomock.InOrder(
+					querier.EXPECT().CancelJob(1).Times(1).Return(mockCancelJobResponse, nil),
+					querier.EXPECT().CancelJob(1).Times(1).
+						Return(models.ManualConnectionSyncResponse{}, errors.New("job cancellation failed")),
+				)
+				// the cancellation which went through is still recorded
+				store.EXPECT().UpdatePipelineStatus(pID, utils.PIPELINE_STATUS_SYNC_CANCELLED).Times(1).Return(nil)
+				store.EXPECT().CreatePipelineOperation(models.PipelineOperation{
+					PipelineID:   mockPipelineID,
+					ConnectionID: mockConnections[0].ConnectionID,
+					Operation:    utils.PIPELINE_OPERATION_CANCEL,
+					JobID:        mockCancelJobResponse.Job.ID,
+					JobStatus:    mockCancelJobResponse.Job.Status,
+					Owner:        1122,
+					WorkspaceID:  1122,
+				}).Times(1).Return(models.PipelineOperation{}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "job cancellation failed",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			querier := mock_airbyte.NewMockAirByteQuerier(ctrl)
+			testCase.buildStubs(store, querier)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.PIPELINE, store, querier, authServiceClient)
+			url := fmt.Sprintf("%spipelines/internal/%s/sync/cancel/", test.BaseURL, testCase.pipelineID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestRetryPipelineSyncOnAirByte tests all the scenarios while retrying the failed sync of a pipeline.
+func TestRetryPipelineSyncOnAirByte(t *testing.T) {
+	pID, _ := uuid.NewV1()
+	mockPipelineID := pID.String()
+	mockConnections := createRandomPipelineConnections(mockPipelineID, 2)
+	mockRetrySyncResponse := createRandomManualConnectionSyncResponse()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		pipelineID    string
+		buildStubs    func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_LastSyncNotFailed",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections[:1], nil)
+				querier.EXPECT().FetchSyncHistory(gomock.Any()).Times(1).
+					Return(createSyncHistoryResponse(t, utils.AIRBYTE_JOB_STATUS_RUNNING), nil)
+				querier.EXPECT().SyncConnectionManually(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "no failed sync found for the pipeline",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "BadRequest_UpdatePipelineStatus",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections[:1], nil)
+				querier.EXPECT().FetchSyncHistory(gomock.Any()).Times(1).
+					Return(createSyncHistoryResponse(t, utils.AIRBYTE_JOB_STATUS_FAILED), nil)
+				querier.EXPECT().SyncConnectionManually(gomock.Any()).Times(1).Return(mockRetrySyncResponse, nil)
+				store.EXPECT().UpdatePipelineStatus(pID, utils.PIPELINE_STATUS_SYNC_RETRYING).Times(1).Return(sql.ErrConnDone)
+				store.EXPECT().CreatePipelineOperation(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Something went wrong",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				arg := make(map[string]interface{})
+				arg["connectionId"] = mockConnections[0].AirbyteConnectionID
+
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections[:1], nil)
+				querier.EXPECT().FetchSyncHistory(models.SyncHistoryRequest{
+					ConfigTypes: []string{utils.SYNC},
+					ConfigId:    mockConnections[0].AirbyteConnectionID,
+				}).Times(1).Return(createSyncHistoryResponse(t, utils.AIRBYTE_JOB_STATUS_FAILED), nil)
+				querier.EXPECT().SyncConnectionManually(arg).Times(1).Return(mockRetrySyncResponse, nil)
+				store.EXPECT().UpdatePipelineStatus(pID, utils.PIPELINE_STATUS_SYNC_RETRYING).Times(1).Return(nil)
+				store.EXPECT().CreatePipelineOperation(gomock.Any()).Times(1).Return(models.PipelineOperation{}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   []models.ManualConnectionSyncResponse{mockRetrySyncResponse}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_TwoDestinations_OnlyFailedRetried",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				arg := make(map[string]interface{})
+				arg["connectionId"] = mockConnections[1].AirbyteConnectionID
+
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections, nil)
+				querier.EXPECT().FetchSyncHistory(models.SyncHistoryRequest{
+					ConfigTypes: []string{utils.SYNC},
+					ConfigId:    mockConnections[0].AirbyteConnectionID,
+				}).Times(1).Return(createSyncHistoryResponse(t, utils.AIRBYTE_JOB_STATUS_SUCCEEDED), nil)
+				querier.EXPECT().FetchSyncHistory(models.SyncHistoryRequest{
+					ConfigTypes: []string{utils.SYNC},
+					ConfigId:    mockConnections[1].AirbyteConnectionID,
+				}).Times(1).Return(createSyncHistoryResponse(t, utils.AIRBYTE_JOB_STATUS_FAILED), nil)
+				querier.EXPECT().SyncConnectionManually(arg).Times(1).Return(mockRetrySyncResponse, nil)
+				store.EXPECT().UpdatePipelineStatus(pID, utils.PIPELINE_STATUS_SYNC_RETRYING).Times(1).Return(nil)
+				store.EXPECT().CreatePipelineOperation(models.PipelineOperation{
+					PipelineID:   mockPipelineID,
+					ConnectionID: mockConnections[1].ConnectionID,
+					Operation:    utils.PIPELINE_OPERATION_RETRY,
+					JobID:        mockRetrySyncResponse.Job.ID,
+					JobStatus:    mockRetrySyncResponse.Job.Status,
+					Owner:        1122,
+					WorkspaceID:  1122,
+				}).Times(1).Return(models.PipelineOperation{}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   []models.ManualConnectionSyncResponse{mockRetrySyncResponse}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			querier := mock_airbyte.NewMockAirByteQuerier(ctrl)
+			testCase.buildStubs(store, querier)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.PIPELINE, store, querier, authServiceClient)
+			url := fmt.Sprintf("%spipelines/internal/%s/sync/retry/", test.BaseURL, testCase.pipelineID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestResetPipelineOnAirByte tests all the scenarios while resetting the connections of a pipeline.
+func TestResetPipelineOnAirByte(t *testing.T) {
+	pID, _ := uuid.NewV1()
+	mockPipelineID := pID.String()
+	mockConnections := createRandomPipelineConnections(mockPipelineID, 2)
+	mockResetResponse := createRandomManualConnectionSyncResponse()
+	mockStreams := []models.StreamDescriptor{{Name: "users", Namespace: "public"}}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		pipelineID    string
+		body          models.PipelineOperationRequest
+		buildStubs    func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidPipelineID",
+
+			pipelineID: "invalid",
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_WholeConnection",
+
+			pipelineID: mockPipelineID,
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections[:1], nil)
+				querier.EXPECT().ResetConnection(mockConnections[0].AirbyteConnectionID).Times(1).Return(mockResetResponse, nil)
+				querier.EXPECT().ResetConnectionStreams(gomock.Any()).Times(0)
+				store.EXPECT().UpdatePipelineStatus(pID, utils.PIPELINE_STATUS_RESET_IN_PROGRESS).Times(1).Return(nil)
+				store.EXPECT().CreatePipelineOperation(gomock.Any()).Times(1).Return(models.PipelineOperation{}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   []models.ManualConnectionSyncResponse{mockResetResponse}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_SelectedStreams",
+
+			pipelineID: mockPipelineID,
+
+			body: models.PipelineOperationRequest{Confirm: true, Streams: mockStreams},
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections[:1], nil)
+				querier.EXPECT().ResetConnection(gomock.Any()).Times(0)
+				querier.EXPECT().ResetConnectionStreams(models.ResetConnectionStreamsRequest{
+					ConnectionId: mockConnections[0].AirbyteConnectionID,
+					Streams:      mockStreams,
+				}).Times(1).Return(mockResetResponse, nil)
+				store.EXPECT().UpdatePipelineStatus(pID, utils.PIPELINE_STATUS_RESET_IN_PROGRESS).Times(1).Return(nil)
+				store.EXPECT().CreatePipelineOperation(models.PipelineOperation{
+					PipelineID:   mockPipelineID,
+					ConnectionID: mockConnections[0].ConnectionID,
+					Operation:    utils.PIPELINE_OPERATION_RESET,
+					JobID:        mockResetResponse.Job.ID,
+					JobStatus:    mockResetResponse.Job.Status,
+					Streams:      []string{"public.users"},
+					Owner:        1122,
+					WorkspaceID:  1122,
+				}).Times(1).Return(models.PipelineOperation{}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_TwoDestinations",
+
+			pipelineID: mockPipelineID,
+
+			body: models.PipelineOperationRequest{Confirm: true, Streams: mockStreams},
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(mockConnections, nil)
+				for _, connection := range mockConnections {
+					querier.EXPECT().ResetConnectionStreams(models.ResetConnectionStreamsRequest{
+						ConnectionId: connection.AirbyteConnectionID,
+						Streams:      mockStreams,
+					}).Times(1).Return(mockResetResponse, nil)
+					store.EXPECT().CreatePipelineOperation(models.PipelineOperation{
+						PipelineID:   mockPipelineID,
+						ConnectionID: connection.ConnectionID,
+						Operation:    utils.PIPELINE_OPERATION_RESET,
+						JobID:        mockResetResponse.Job.ID,
+						JobStatus:    mockResetResponse.Job.Status,
+						Streams:      []string{"public.users"},
+						Owner:        1122,
+						WorkspaceID:  1122,
+					}).Times(1).Return(models.PipelineOperation{}, nil)
+				}
+				store.EXPECT().UpdatePipelineStatus(pID, utils.PIPELINE_STATUS_RESET_IN_PROGRESS).Times(1).Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   []models.ManualConnectionSyncResponse{mockResetResponse, mockResetResponse}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			querier := mock_airbyte.NewMockAirByteQuerier(ctrl)
+			testCase.buildStubs(store, querier)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			body, err := json.Marshal(testCase.body)
+			require.NoError(t, err)
+
+			server := test.NewTestServer(test.PIPELINE, store, querier, authServiceClient)
+			url := fmt.Sprintf("%spipelines/internal/%s/reset/", test.BaseURL, testCase.pipelineID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestResetPipelineNotConfirmed tests that the reset is refused when the request is not confirmed.
+func TestResetPipelineNotConfirmed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pID, _ := uuid.NewV1()
+
+	httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+	authServiceClient := authService.NewClient(httpMockClient)
+
+	body, err := json.Marshal(models.PipelineOperationRequest{Confirm: false})
+	require.NoError(t, err)
+
+	server := test.NewTestServer(test.PIPELINE, nil, nil, authServiceClient)
+	url := fmt.Sprintf("%spipelines/%s/reset/", test.BaseURL, pID.String())
+	recorder, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	res := models.Response{
+		Status: utils.ERROR,
+		Errors: "operation is not confirmed",
+		Data:   nil}
+	actual, e := json.Marshal(res)
+	require.NoError(t, e)
+	test.ReqResBodyMatcher(t, recorder.Body, actual)
+}
+
+// TestSoftDeletePipeline tests all the scenarios while soft deleting and restoring a pipeline.
+func TestSoftDeletePipeline(t *testing.T) {
+	pID, _ := uuid.NewV1()
+	dID, _ := uuid.NewV1()
+	pipeline := models.Pipeline{PipelineID: pID, Name: "pipeline-1", WorkspaceID: 1122}
+	deletedPipeline := pipeline
+	deletedPipeline.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	otherWorkspacePipeline := pipeline
+	otherWorkspacePipeline.WorkspaceID = 2233
+	connectionIDs := []string{utils.RandomString(10), utils.RandomString(10)}
+	connections := []models.Connection{
+		{ConnectionID: utils.RandomString(10), AirbyteConnectionID: connectionIDs[0]},
+		{ConnectionID: utils.RandomString(10), AirbyteConnectionID: connectionIDs[1]},
+	}
+	// the first connection was syncing and the second one was paused before the pipeline was deleted
+	recordedConnections := []models.Connection{connections[0], connections[1]}
+	recordedConnections[0].AirbyteStatus = utils.AIRBYTE_DEFAULT_STATUS
+	recordedConnections[1].AirbyteStatus = utils.AIRBYTE_INACTIVE_STATUS
+
+	// expectStatus expects the status of the connection to be read and set from the given status, the connections
+	// which already have the status aren't updated.
+	expectStatus := func(querier *mock_airbyte.MockAirByteQuerier, connectionID string, from string, to string) {
+		querier.EXPECT().GetConnectionSchema(connectionID).Times(1).
+			Return(models.ConnectionSourceSchema{ConnectionId: connectionID, Status: from}, nil)
+
+		if from == to {
+			return
+		}
+
+		querier.EXPECT().UpdateConnection(gomock.Any()).Times(1).
+			DoAndReturn(func(request models.UpdatePipelineAirByteRequest) (models.CreatePipelineAirbyteResponse, error) {
+				require.Equal(t, connectionID, request.ConnectionId)
+				require.Equal(t, to, request.Status)
+
+				return models.CreatePipelineAirbyteResponse{}, nil
+			})
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		method        string
+		url           string
+		buildStubs    func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_Delete",
+
+			method: http.MethodDelete,
+
+			url: fmt.Sprintf("%spipelines/%s/", test.BaseURL, pID.String()),
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(pipeline, nil)
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return([]models.Connection{}, nil)
+				store.EXPECT().DeletePipeline(pID, []models.Connection{}).Times(1).
+					Return(errors.New("Pipeline doesn't exists"))
 			},
 
-			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {},
-
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
-
-				res := models.Response{
-					Status: utils.ERROR,
-					Errors: "Something went wrong",
-					Data:   nil}
-				actual, e := json.Marshal(res)
-				require.NoError(t, e)
-				test.ReqResBodyMatcher(t, recorder.Body, actual)
 			},
 		},
 		{
-			testScenario: "InternalServerError_BadAirByte",
+			testScenario: "NotFound_Delete_OtherWorkspace",
 
-			connectionID: mockConnectionID.String(),
+			method: http.MethodDelete,
 
-			body: mockUpdatePipelineReq,
+			url: fmt.Sprintf("%spipelines/%s/", test.BaseURL, pID.String()),
 
-			buildStubs: func(store *mockStore.MockStore) {
-				store.EXPECT().GetConnection(mockConnectionID.String()).Times(1).Return(mockConnection, nil)
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(otherWorkspacePipeline, nil)
+				store.EXPECT().GetPipelineConnections(gomock.Any()).Times(0)
+				querier.EXPECT().UpdateConnection(gomock.Any()).Times(0)
+				store.EXPECT().DeletePipeline(gomock.Any(), gomock.Any()).Times(0)
 			},
 
-			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
-				querier.EXPECT().UpdateConnection(mockUpdatePipelineReq).Times(1).
-					Return(models.CreatePipelineAirbyteResponse{}, errors.New("Bad AirByte response"))
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound_Delete_AlreadyDeleted",
+
+			method: http.MethodDelete,
+
+			url: fmt.Sprintf("%spipelines/%s/", test.BaseURL, pID.String()),
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(deletedPipeline, nil)
+				store.EXPECT().GetPipelineConnections(gomock.Any()).Times(0)
+				querier.EXPECT().GetConnectionSchema(gomock.Any()).Times(0)
+				querier.EXPECT().UpdateConnection(gomock.Any()).Times(0)
+				store.EXPECT().DeletePipeline(gomock.Any(), gomock.Any()).Times(0)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
 
 				res := models.Response{
 					Status: utils.ERROR,
-					Errors: "Bad AirByte response",
+					Errors: "Pipeline doesn't exist",
 					Data:   nil}
 				actual, e := json.Marshal(res)
 				require.NoError(t, e)
@@ -580,63 +1444,69 @@ func TestUpdatePipelineConnectionOnAirByte(t *testing.T) {
 			},
 		},
 		{
-			testScenario: "InternalServerError",
-
-			connectionID: mockConnectionID.String(),
+			testScenario: "Success_Delete",
 
-			body: mockUpdatePipelineReq,
-
-			buildStubs: func(store *mockStore.MockStore) {
-				store.EXPECT().GetConnection(mockConnectionID.String()).Times(1).Return(mockConnection, nil)
+			method: http.MethodDelete,
 
-				arg := models.Connection{
-					ConnectionID:          mockConnectionID.String(),
-					AirbyteFrequencyUnits: mockUpdatePipelineReq.Schedule.Units,
-					AirbyteTimeUnit:       mockUpdatePipelineReq.Schedule.TimeUnit,
-				}
-				store.EXPECT().UpdateConnectionSchedule(arg).Times(1).Return(sql.ErrConnDone)
-			},
+			url: fmt.Sprintf("%spipelines/%s/", test.BaseURL, pID.String()),
 
-			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
-				querier.EXPECT().UpdateConnection(mockUpdatePipelineReq).Times(1).
-					Return(models.CreatePipelineAirbyteResponse{
-						Schedule: mockUpdatePipelineReq.Schedule,
-					}, nil)
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(pipeline, nil)
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(connections, nil)
+				expectStatus(querier, connectionIDs[0], utils.AIRBYTE_DEFAULT_STATUS, utils.AIRBYTE_INACTIVE_STATUS)
+				expectStatus(querier, connectionIDs[1], utils.AIRBYTE_INACTIVE_STATUS, utils.AIRBYTE_INACTIVE_STATUS)
+				store.EXPECT().DeletePipeline(pID, recordedConnections).Times(1).Return(nil)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
 
 				res := models.Response{
-					Status: utils.ERROR,
-					Errors: "Something went wrong",
-					Data:   nil}
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   "Pipeline deleted successfully"}
 				actual, e := json.Marshal(res)
 				require.NoError(t, e)
 				test.ReqResBodyMatcher(t, recorder.Body, actual)
 			},
 		},
 		{
-			testScenario: "Success",
+			testScenario: "InternalServerError_Delete_DeactivationFailed",
 
-			connectionID: mockConnectionID.String(),
+			method: http.MethodDelete,
 
-			body: mockUpdatePipelineReq,
+			url: fmt.Sprintf("%spipelines/%s/", test.BaseURL, pID.String()),
 
-			buildStubs: func(store *mockStore.MockStore) {
-				store.EXPECT().GetConnection(mockConnectionID.String()).Times(1).Return(mockConnection, nil)
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(pipeline, nil)
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(connections, nil)
+				expectStatus(querier, connectionIDs[0], utils.AIRBYTE_DEFAULT_STATUS, utils.AIRBYTE_INACTIVE_STATUS)
+				querier.EXPECT().GetConnectionSchema(connectionIDs[1]).Times(1).
+					Return(models.ConnectionSourceSchema{}, errors.New("airbyte is unavailable"))
+				// the connection deactivated before the failure is set back to active
+				expectStatus(querier, connectionIDs[0], utils.AIRBYTE_INACTIVE_STATUS, utils.AIRBYTE_DEFAULT_STATUS)
+				store.EXPECT().DeletePipeline(gomock.Any(), gomock.Any()).Times(0)
+			},
 
-				arg := models.Connection{
-					ConnectionID:          mockConnectionID.String(),
-					AirbyteFrequencyUnits: mockUpdatePipelineReq.Schedule.Units,
-					AirbyteTimeUnit:       mockUpdatePipelineReq.Schedule.TimeUnit,
-				}
-				store.EXPECT().UpdateConnectionSchedule(arg).Times(1).Return(nil)
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
+		},
+		{
+			testScenario: "Success_Restore",
 
-			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
-				querier.EXPECT().UpdateConnection(mockUpdatePipelineReq).Times(1).
-					Return(models.CreatePipelineAirbyteResponse{Schedule: mockUpdatePipelineReq.Schedule}, nil)
+			method: http.MethodPost,
+
+			url: fmt.Sprintf("%spipelines/%s/restore/", test.BaseURL, pID.String()),
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(deletedPipeline, nil)
+				store.EXPECT().GetPipelineDeletedDestinations(pID).Times(1).Return([]models.ResourceDependency{}, nil)
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(recordedConnections, nil)
+				// the connection which was paused before the deletion isn't reactivated
+				expectStatus(querier, connectionIDs[0], utils.AIRBYTE_INACTIVE_STATUS, utils.AIRBYTE_DEFAULT_STATUS)
+				expectStatus(querier, connectionIDs[1], utils.AIRBYTE_INACTIVE_STATUS, utils.AIRBYTE_INACTIVE_STATUS)
+				store.EXPECT().RestorePipeline(pID).Times(1).Return(nil)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -645,102 +1515,121 @@ func TestUpdatePipelineConnectionOnAirByte(t *testing.T) {
 				res := models.Response{
 					Status: utils.SUCCESS,
 					Errors: "",
-					Data:   "pipeline updated successfully"}
+					Data:   "Pipeline restored successfully"}
 				actual, e := json.Marshal(res)
 				require.NoError(t, e)
 				test.ReqResBodyMatcher(t, recorder.Body, actual)
 			},
 		},
-	}
+		{
+			testScenario: "Conflict_Restore_NotDeleted",
 
-	for i := range testCaseSuite {
-		testCase := testCaseSuite[i]
+			method: http.MethodPost,
 
-		t.Run(testCase.testScenario, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+			url: fmt.Sprintf("%spipelines/%s/restore/", test.BaseURL, pID.String()),
 
-			store := mockStore.NewMockStore(ctrl)
-			testCase.buildStubs(store)
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(pipeline, nil)
+				store.EXPECT().GetPipelineConnections(gomock.Any()).Times(0)
+				querier.EXPECT().GetConnectionSchema(gomock.Any()).Times(0)
+				querier.EXPECT().UpdateConnection(gomock.Any()).Times(0)
+				store.EXPECT().RestorePipeline(gomock.Any()).Times(0)
+			},
 
-			querier := mock_airbyte.NewMockAirByteQuerier(ctrl)
-			testCase.queryAirByte(querier)
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
 
-			body, e := json.Marshal(testCase.body)
-			require.NoError(t, e)
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Pipeline isn't deleted",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "NotFound_Restore",
 
-			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+			method: http.MethodPost,
 
-			authServiceClient := authService.NewClient(httpMockClient)
+			url: fmt.Sprintf("%spipelines/%s/restore/", test.BaseURL, pID.String()),
 
-			server := test.NewTestServer(test.PIPELINE, store, querier, authServiceClient)
-			url := fmt.Sprintf("%spipelines/connections/%s/", test.BaseURL, testCase.connectionID)
-			expectedResp, err := test.MakeHttpRequest(server, http.MethodPut, url, nil, body)
-			require.NoError(t, err)
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(models.Pipeline{}, gorm.ErrRecordNotFound)
+				store.EXPECT().RestorePipeline(gomock.Any()).Times(0)
+			},
 
-			testCase.checkResponse(expectedResp)
-		})
-	}
-}
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_Restore_StoreFailed",
 
-func TestRunManualSyncOnAirByte(t *testing.T) {
-	mockManualConnectionSyncResponse := createRandomManualConnectionSyncResponse()
-	cid, _ := uuid.NewV1()
-	mockConnectionID := cid.String()
+			method: http.MethodPost,
 
-	testCaseSuite := []struct {
-		testScenario  string
-		productID     string
-		connectionID  string
-		queryAirByte  func(querier *mock_airbyte.MockAirByteQuerier)
-		checkResponse func(recorder *httptest.ResponseRecorder)
-	}{
+			url: fmt.Sprintf("%spipelines/%s/restore/", test.BaseURL, pID.String()),
+
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(deletedPipeline, nil)
+				store.EXPECT().GetPipelineDeletedDestinations(pID).Times(1).Return([]models.ResourceDependency{}, nil)
+				store.EXPECT().GetPipelineConnections(pID).Times(1).Return(recordedConnections, nil)
+				expectStatus(querier, connectionIDs[0], utils.AIRBYTE_INACTIVE_STATUS, utils.AIRBYTE_DEFAULT_STATUS)
+				expectStatus(querier, connectionIDs[1], utils.AIRBYTE_INACTIVE_STATUS, utils.AIRBYTE_INACTIVE_STATUS)
+				store.EXPECT().RestorePipeline(pID).Times(1).Return(errors.New("Deleted pipeline doesn't exists"))
+				// the connections are set back to the status they had before the restore
+				expectStatus(querier, connectionIDs[0], utils.AIRBYTE_DEFAULT_STATUS, utils.AIRBYTE_INACTIVE_STATUS)
+				expectStatus(querier, connectionIDs[1], utils.AIRBYTE_INACTIVE_STATUS, utils.AIRBYTE_INACTIVE_STATUS)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
-			testScenario: "BadRequest",
+			testScenario: "Conflict_Restore_DestinationDeleted",
 
-			connectionID: "",
+			method: http.MethodPost,
 
-			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
-				arg := make(map[string]interface{})
-				arg["connectionId"] = ""
+			url: fmt.Sprintf("%spipelines/%s/restore/", test.BaseURL, pID.String()),
 
-				querier.EXPECT().SyncConnectionManually(arg).Times(1).Return(models.ManualConnectionSyncResponse{}, errors.New("bad connection ID"))
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().GetPipelineIncludingDeleted(pID).Times(1).Return(deletedPipeline, nil)
+				store.EXPECT().GetPipelineDeletedDestinations(pID).Times(1).Return([]models.ResourceDependency{
+					{ResourceType: "destination", ResourceID: dID.String(), Name: "warehouse"},
+				}, nil)
+				store.EXPECT().GetPipelineConnections(gomock.Any()).Times(0)
+				querier.EXPECT().UpdateConnection(gomock.Any()).Times(0)
+				store.EXPECT().RestorePipeline(gomock.Any()).Times(0)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusConflict, recorder.Code)
 
-				res := models.Response{
+				res := models.ResourceDependenciesResponse{
 					Status: utils.ERROR,
-					Errors: errors.New("bad connection ID").Error(),
-					Data:   nil}
+					Errors: "Pipeline writes to a deleted destination, restore the destination first",
+					Data:   []models.ResourceDependency{{ResourceType: "destination", ResourceID: dID.String(), Name: "warehouse"}}}
 				actual, e := json.Marshal(res)
 				require.NoError(t, e)
 				test.ReqResBodyMatcher(t, recorder.Body, actual)
 			},
 		},
 		{
-			testScenario: "Success",
+			testScenario: "Success_Purge",
 
-			connectionID: mockConnectionID,
+			method: http.MethodDelete,
 
-			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
-				arg := make(map[string]interface{})
-				arg["connectionId"] = mockConnectionID
+			url: fmt.Sprintf("%spipelines/internal/%s/", test.BaseURL, pID.String()),
 
-				querier.EXPECT().SyncConnectionManually(arg).Times(1).
-					Return(mockManualConnectionSyncResponse, nil)
+			buildStubs: func(store *mockStore.MockStore, querier *mock_airbyte.MockAirByteQuerier) {
+				store.EXPECT().DeletePipeline(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PurgePipeline(pID).Times(1).Return(nil)
 			},
+
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-
-				res := models.Response{
-					Status: utils.SUCCESS,
-					Errors: "",
-					Data:   mockManualConnectionSyncResponse}
-				actual, e := json.Marshal(res)
-				require.NoError(t, e)
-				test.ReqResBodyMatcher(t, recorder.Body, actual)
 			},
 		},
 	}
@@ -752,16 +1641,16 @@ func TestRunManualSyncOnAirByte(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			store := mockStore.NewMockStore(ctrl)
 			querier := mock_airbyte.NewMockAirByteQuerier(ctrl)
-			testCase.queryAirByte(querier)
+			testCase.buildStubs(store, querier)
 
 			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
 
 			authServiceClient := authService.NewClient(httpMockClient)
 
-			server := test.NewTestServer(test.PIPELINE, nil, querier, authServiceClient)
-			url := fmt.Sprintf("%spipelines/connections/%s/sync/", test.BaseURL, testCase.connectionID)
-			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			server := test.NewTestServer(test.PIPELINE, store, querier, authServiceClient)
+			expectedResp, err := test.MakeHttpRequest(server, testCase.method, testCase.url, nil, nil)
 			require.NoError(t, err)
 
 			testCase.checkResponse(expectedResp)
@@ -769,6 +1658,99 @@ func TestRunManualSyncOnAirByte(t *testing.T) {
 	}
 }
 
+// TestPurgeDeletedResources tests that the sources, destinations and data products deleted before the retention window
+// are purged once their connectors are deleted on airbyte, and the ones which couldn't be purged are reported.
+func TestPurgeDeletedResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dID, _ := uuid.NewV1()
+	inUseID, _ := uuid.NewV1()
+	failedDestinationID, _ := uuid.NewV1()
+	productID, _ := uuid.NewV1()
+	sourceID, _ := uuid.NewV1()
+	inUseSourceID, _ := uuid.NewV1()
+	failedSourceID, _ := uuid.NewV1()
+	mockAirByteDestinationID := utils.RandomString(10)
+	mockFailedAirByteDestinationID := utils.RandomString(10)
+	mockAirByteSourceID := utils.RandomString(10)
+	mockFailedAirByteSourceID := utils.RandomString(10)
+
+	store := mockStore.NewMockStore(ctrl)
+	querier := mock_airbyte.NewMockAirByteQuerier(ctrl)
+
+	store.EXPECT().GetPurgeablePipelines(gomock.Any()).Times(1).Return([]models.Pipeline{}, nil)
+	store.EXPECT().GetPurgeableSources(gomock.Any()).Times(1).Return([]models.Source{
+		{SourceID: sourceID.String(), AirbyteSourceID: mockAirByteSourceID},
+		{SourceID: inUseSourceID.String(), AirbyteSourceID: utils.RandomString(10)},
+		{SourceID: failedSourceID.String(), AirbyteSourceID: mockFailedAirByteSourceID},
+	}, nil)
+	store.EXPECT().GetSourceDependencies(sourceID.String()).Times(1).Return([]models.ResourceDependency{}, nil)
+	// the pipeline reading from the source is soft deleted but not purged yet
+	store.EXPECT().GetSourceDependencies(inUseSourceID.String()).Times(1).
+		Return([]models.ResourceDependency{{ResourceType: "pipeline", ResourceID: utils.RandomString(10), Deleted: true}}, nil)
+	store.EXPECT().GetSourceDependencies(failedSourceID.String()).Times(1).Return([]models.ResourceDependency{}, nil)
+	gomock.InOrder(
+		querier.EXPECT().DeleteSourceConnectorOnAirByte(mockAirByteSourceID).Times(1).Return(nil),
+		store.EXPECT().PurgeSource(sourceID.String()).Times(1).Return(nil),
+	)
+	querier.EXPECT().DeleteSourceConnectorOnAirByte(mockFailedAirByteSourceID).Times(1).
+		Return(errors.New("source deletion failed"))
+	store.EXPECT().PurgeSource(inUseSourceID.String()).Times(0)
+	store.EXPECT().PurgeSource(failedSourceID.String()).Times(0)
+
+	store.EXPECT().GetPurgeableDestinations(gomock.Any()).Times(1).Return([]models.Destination{
+		{DestinationID: dID.String(), AirbyteDestinationID: mockAirByteDestinationID},
+		{DestinationID: inUseID.String()},
+		{DestinationID: failedDestinationID.String(), AirbyteDestinationID: mockFailedAirByteDestinationID},
+	}, nil)
+	store.EXPECT().GetDestinationDependencies(dID).Times(1).Return([]models.ResourceDependency{}, nil)
+	store.EXPECT().GetDestinationDependencies(inUseID).Times(1).
+		Return([]models.ResourceDependency{{ResourceType: "pipeline", ResourceID: utils.RandomString(10)}}, nil)
+	store.EXPECT().GetDestinationDependencies(failedDestinationID).Times(1).Return([]models.ResourceDependency{}, nil)
+	gomock.InOrder(
+		querier.EXPECT().DeleteDestinationConnectorOnAirByte(mockAirByteDestinationID).Times(1).Return(nil),
+		store.EXPECT().PurgeDestination(dID).Times(1).Return(nil),
+	)
+	querier.EXPECT().DeleteDestinationConnectorOnAirByte(mockFailedAirByteDestinationID).Times(1).
+		Return(errors.New("destination deletion failed"))
+	store.EXPECT().PurgeDestination(inUseID).Times(0)
+	store.EXPECT().PurgeDestination(failedDestinationID).Times(0)
+
+	store.EXPECT().GetPurgeableDataProducts(gomock.Any()).Times(1).
+		Return([]models.DataProduct{{ProductID: productID}}, nil)
+	store.EXPECT().PurgeDataProduct(productID).Times(1).Return(nil)
+
+	httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+	authServiceClient := authService.NewClient(httpMockClient)
+
+	server := test.NewTestServer(test.PIPELINE, store, querier, authServiceClient)
+	url := fmt.Sprintf("%spipelines/internal/purge/", test.BaseURL)
+	recorder, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	res := models.Response{
+		Status: utils.SUCCESS,
+		Errors: "",
+		Data: models.PurgeReport{
+			Pipelines:    []string{},
+			Sources:      []string{sourceID.String()},
+			Destinations: []string{dID.String()},
+			DataProducts: []string{productID.String()},
+			Failures: []models.PurgeFailure{
+				{ResourceType: "source", ResourceID: inUseSourceID.String(), Error: "source is still used by 1 resources"},
+				{ResourceType: "source", ResourceID: failedSourceID.String(), Error: "source deletion failed"},
+				{ResourceType: "destination", ResourceID: inUseID.String(), Error: "destination is still used by 1 resources"},
+				{ResourceType: "destination", ResourceID: failedDestinationID.String(), Error: "destination deletion failed"},
+			},
+		}}
+	actual, e := json.Marshal(res)
+	require.NoError(t, e)
+	test.ReqResBodyMatcher(t, recorder.Body, actual)
+}
+
 // TestGetAllPipelines tests all the scenarios while getting all the pipelines of the specific product.
 func TestGetAllPipelines(t *testing.T) {
 	mockPipeline := createRandomPipeline()
@@ -883,6 +1865,17 @@ func TestGetPipeline(t *testing.T) {
 	mockPipelineView := createRandomPipelineView(abConnectionID.String())
 	mockUser := test.CreateRandomUserDetails(1, 1122)
 	mockConnectionMeta := createRandomConnectionMeta()
+	mockQualityResults := []models.QualityCheckResult{{
+		RunID:      uuid.Must(uuid.NewV4()).String(),
+		RuleID:     uuid.Must(uuid.NewV4()).String(),
+		AssetID:    uuid.Must(uuid.NewV4()).String(),
+		Column:     "email",
+		Check:      utils.QUALITY_CHECK_NOT_NULL,
+		Severity:   utils.QUALITY_SEVERITY_ERROR,
+		RowCount:   10,
+		FailedRows: 2,
+		Observed:   "2 of 10 rows failed",
+	}}
 
 	testCaseSuite := []struct {
 		testScenario   string
@@ -991,6 +1984,7 @@ func TestGetPipeline(t *testing.T) {
 			buildStubs: func(store *mockStore.MockStore) {
 				arg := mockPipelineView.PipelineID
 				store.EXPECT().GetPipeline(arg).Times(1).Return(mockPipelineView, nil)
+				store.EXPECT().GetPipelineQualityResults(arg).Times(1).Return(mockQualityResults, nil)
 			},
 
 			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
@@ -1012,6 +2006,7 @@ func TestGetPipeline(t *testing.T) {
 					Data: models.GetPipelineDetails{
 						Pipeline: mockPipelineView,
 						Owner:    mockUser.Payload.UserInfo,
+						Quality:  mockQualityResults,
 					}}
 				actual, e := json.Marshal(res)
 				require.NoError(t, e)
@@ -1047,6 +2042,116 @@ func TestGetPipeline(t *testing.T) {
 	}
 }
 
+// TestGetFanOutPipeline tests the status of a pipeline replicating its source to several destinations.
+func TestGetFanOutPipeline(t *testing.T) {
+	warehouseConnectionID, _ := uuid.NewV1()
+	archiveConnectionID, _ := uuid.NewV1()
+	mockPipelineView := createRandomPipelineView(warehouseConnectionID.String())
+	mockPipelineView.Destinations = append(mockPipelineView.Destinations,
+		createRandomPipelineDestination(archiveConnectionID.String()),
+		createRandomPipelineDestination(""))
+	mockUser := test.CreateRandomUserDetails(1, 1122)
+
+	testCaseSuite := []struct {
+		testScenario   string
+		getUserDetails func(client *mock_authservice.MockHttpClient)
+		buildStubs     func(store *mockStore.MockStore)
+		queryAirByte   func(querier *mock_airbyte.MockAirByteQuerier)
+		checkResponse  func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "FailedGetConnectionDetails",
+
+			getUserDetails: func(client *mock_authservice.MockHttpClient) {},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetPipeline(mockPipelineView.PipelineID).Times(1).Return(mockPipelineView, nil)
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetConnectionDetails(gomock.Any()).Times(1).
+					Return(models.ConnectionMeta{}, errors.New("connection not found"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			getUserDetails: func(client *mock_authservice.MockHttpClient) {
+				test.MockGetUserByID(client, 1, 1122)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetPipeline(mockPipelineView.PipelineID).Times(1).Return(mockPipelineView, nil)
+				store.EXPECT().GetPipelineQualityResults(mockPipelineView.PipelineID).Times(1).
+					Return([]models.QualityCheckResult{}, nil)
+			},
+
+			queryAirByte: func(querier *mock_airbyte.MockAirByteQuerier) {
+				warehouse := map[string]interface{}{"connectionId": warehouseConnectionID, "withRefreshedCatalog": false}
+				querier.EXPECT().GetConnectionDetails(warehouse).Times(1).Return(models.ConnectionMeta{
+					LatestSyncJobCreatedAt: 1645517210,
+					LatestSyncJobStatus:    utils.AIRBYTE_JOB_STATUS_SUCCEEDED,
+				}, nil)
+
+				archive := map[string]interface{}{"connectionId": archiveConnectionID, "withRefreshedCatalog": false}
+				querier.EXPECT().GetConnectionDetails(archive).Times(1).Return(models.ConnectionMeta{
+					LatestSyncJobCreatedAt: 1645517100,
+					LatestSyncJobStatus:    utils.AIRBYTE_JOB_STATUS_FAILED,
+				}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res struct {
+					Data models.GetPipelineDetails `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+
+				// a failed destination fails the pipeline, the last run is the latest of the destinations
+				require.Equal(t, utils.AIRBYTE_JOB_STATUS_FAILED, res.Data.Pipeline.AirbyteStatus)
+				require.Equal(t, 1645517210, res.Data.Pipeline.AirbyteLastRun)
+				require.Len(t, res.Data.Pipeline.Destinations, 3)
+				require.Equal(t, utils.AIRBYTE_JOB_STATUS_SUCCEEDED, res.Data.Pipeline.Destinations[0].AirbyteStatus)
+				require.Equal(t, utils.AIRBYTE_JOB_STATUS_FAILED, res.Data.Pipeline.Destinations[1].AirbyteStatus)
+				require.Empty(t, res.Data.Pipeline.Destinations[2].AirbyteStatus)
+				require.EqualValues(t, mockUser.Payload.UserInfo.ID, res.Data.Owner.(map[string]interface{})["id"])
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airbyteQuerier := mock_airbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airbyteQuerier)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+			testCase.getUserDetails(httpMockClient)
+
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.PIPELINE, store, airbyteQuerier, authServiceClient)
+			url := fmt.Sprintf("%spipelines/%s/", test.BaseURL, mockPipelineView.PipelineID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
 // TestGetSourceSchemaFromAirByteConnection tests all the scenarios while getting the Schema of the specific connection.
 func TestGetSourceSchemaFromAirByteConnection(t *testing.T) {
 	cid, _ := uuid.NewV1()
@@ -1324,6 +2429,30 @@ func createRandomManualConnectionSyncResponse() models.ManualConnectionSyncRespo
 	return r
 }
 
+// createRandomPipelineConnections returns the given number of connections of the pipeline with random IDs, one for
+// each destination of the pipeline.
+func createRandomPipelineConnections(pipelineID string, count int) []models.Connection {
+	connections := make([]models.Connection, 0, count)
+
+	for i := 0; i < count; i++ {
+		cID, _ := uuid.NewV1()
+		connection := createRandomConnection(cID.String())
+		connection.PipelineID = pipelineID
+		connections = append(connections, connection)
+	}
+
+	return connections
+}
+
+// createSyncHistoryResponse returns a SyncHistoryResponse having a single job with the given status.
+func createSyncHistoryResponse(t *testing.T, status string) models.SyncHistoryResponse {
+	syncHistory := models.SyncHistoryResponse{}
+	err := json.Unmarshal([]byte(fmt.Sprintf(`{"jobs":[{"job":{"id":1,"status":"%s"}}]}`, status)), &syncHistory)
+	require.NoError(t, err)
+
+	return syncHistory
+}
+
 //createRandomPipelineReq populates and return the CreatePipelineRequest Model with random values.
 func createRandomPipelineReq() models.CreatePipelineRequest {
 	sID, _ := uuid.NewV1()
@@ -1419,6 +2548,7 @@ func createRandomPipelineMeta(AbConnecID string) models.PipelinesMetaData {
 		AirbyteStatus:       "",
 		AirbyteLastRun:      0,
 		AirbyteConnectionID: AbConnecID,
+		Destinations:        []models.PipelineDestination{createRandomPipelineDestination(AbConnecID)},
 		Owner:               "{user:admin}",
 	}
 
@@ -1445,12 +2575,27 @@ func createRandomPipelineView(abConnID string) models.PipelineView {
 		AirbyteStatus:       "",
 		AirbyteLastRun:      0,
 		AirbyteConnectionID: abConnID,
+		Destinations:        []models.PipelineDestination{createRandomPipelineDestination(abConnID)},
 		Owner:               1,
 	}
 
 	return p
 }
 
+//createRandomPipelineDestination populates and return the PipelineDestination Model with random values.
+func createRandomPipelineDestination(abConnID string) models.PipelineDestination {
+	destinationID, _ := uuid.NewV1()
+	connectionID, _ := uuid.NewV1()
+
+	return models.PipelineDestination{
+		DestinationID:       destinationID.String(),
+		DestinationName:     utils.RandomString(5),
+		DestinationType:     "postgres",
+		ConnectionID:        connectionID.String(),
+		AirbyteConnectionID: abConnID,
+	}
+}
+
 //createRandomPipeline populates and return the Pipeline Model with random values.
 func createRandomPipeline() models.Pipeline {
 	pID, _ := uuid.NewV1()
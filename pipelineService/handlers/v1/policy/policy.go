@@ -0,0 +1,312 @@
+package policy
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+type Server struct {
+	Store       db.Store
+	Router      *gin.Engine
+	RouterGroup *gin.RouterGroup
+}
+
+// GetMaskingPolicies returns the masking policies of the workspace
+// @Summary Get Masking Policies
+// @Description Returns the masking policies applied on the previewed columns by their classification
+// @Tags policies
+// @Produce  json
+// @Success 200 {object} models.MaskingPoliciesResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /policies/masking/ [get].
+func (server *Server) GetMaskingPolicies(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetMaskingPolicies endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	policies, err := server.Store.GetMaskingPolicies(workspaceID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Masking Policies")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", policies)
+	logger.Info("GetMaskingPolicies endpoint returned")
+}
+
+// CreateMaskingPolicy creates a masking policy
+// @Summary Create Masking Policy
+// @Description Creates a policy that hashes, redacts, partially masks or nulls the previewed values of the columns with the classification, for all the roles but the exempt ones
+// @Tags policies
+// @Produce  json
+// @Param policy body models.MaskingPolicy true "Masking Policy"
+// @Success 201 {object} models.MaskingPolicyResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /policies/masking/ [post].
+func (server *Server) CreateMaskingPolicy(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("CreateMaskingPolicy endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !server.canManagePolicies(ctx) {
+		return
+	}
+
+	var policy models.MaskingPolicy
+	if err := ctx.ShouldBindJSON(&policy); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	policy.PolicyID = uuid.Nil
+	policy.CreatedBy = userID
+	policy.WorkspaceID = workspaceID
+	policy.CreatedAt = 0
+
+	createdPolicy, err := server.Store.CreateMaskingPolicy(policy)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Masking Policy")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", createdPolicy)
+	logger.Info("CreateMaskingPolicy endpoint returned")
+}
+
+// DeleteMaskingPolicy deletes a masking policy
+// @Summary Delete Masking Policy
+// @Description Deletes a masking policy of the workspace
+// @Tags policies
+// @Produce  json
+// @Param id path string true "Policy ID"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /policies/masking/{id}/ [delete].
+func (server *Server) DeleteMaskingPolicy(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DeleteMaskingPolicy endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !server.canManagePolicies(ctx) {
+		return
+	}
+
+	policyID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err = server.Store.DeleteMaskingPolicy(policyID, workspaceID); err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Masking Policy")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Masking policy deleted successfully")
+	logger.Info("DeleteMaskingPolicy endpoint returned")
+}
+
+// GetRowFilters returns the row filters of a data product
+// @Summary Get Row Filters
+// @Description Returns the row filters enforced on the previews of the assets of a data product
+// @Tags policies
+// @Produce  json
+// @Param productId query string true "Product ID"
+// @Success 200 {object} models.RowFiltersResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /policies/row-filters/ [get].
+func (server *Server) GetRowFilters(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetRowFilters endpoint called")
+
+	var filterQuery models.RowFilterQuery
+	if err := ctx.ShouldBindQuery(&filterQuery); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	productID, _ := uuid.FromString(filterQuery.ProductID)
+
+	filters, err := server.Store.GetRowFilters(productID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Row Filters")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", filters)
+	logger.Info("GetRowFilters endpoint returned")
+}
+
+// CreateRowFilter creates a row filter on a data product
+// @Summary Create Row Filter
+// @Description Creates a condition the previewed rows of the assets of a data product must match, for all the roles but the exempt ones
+// @Tags policies
+// @Produce  json
+// @Param filter body models.RowFilter true "Row Filter"
+// @Success 201 {object} models.RowFilterResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /policies/row-filters/ [post].
+func (server *Server) CreateRowFilter(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("CreateRowFilter endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !server.canManagePolicies(ctx) {
+		return
+	}
+
+	var filter models.RowFilter
+	if err := ctx.ShouldBindJSON(&filter); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err := validateRowFilter(filter); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	productID, _ := uuid.FromString(filter.ProductID)
+
+	product, err := server.Store.GetDataProductInfo(productID)
+	if err == nil && product.WorkspaceID != workspaceID {
+		err = errors.New("Product doesn't exists")
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Data Product")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	filter.FilterID = uuid.Nil
+	filter.CreatedBy = userID
+	filter.WorkspaceID = workspaceID
+	filter.CreatedAt = 0
+
+	createdFilter, err := server.Store.CreateRowFilter(filter)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Row Filter")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", createdFilter)
+	logger.Info("CreateRowFilter endpoint returned")
+}
+
+// DeleteRowFilter deletes a row filter
+// @Summary Delete Row Filter
+// @Description Deletes a row filter of a data product
+// @Tags policies
+// @Produce  json
+// @Param id path string true "Filter ID"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /policies/row-filters/{id}/ [delete].
+func (server *Server) DeleteRowFilter(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DeleteRowFilter endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !server.canManagePolicies(ctx) {
+		return
+	}
+
+	filterID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err = server.Store.DeleteRowFilter(filterID, workspaceID); err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Row Filter")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Row filter deleted successfully")
+	logger.Info("DeleteRowFilter endpoint returned")
+}
+
+func (server *Server) canManagePolicies(ctx *gin.Context) bool {
+	if utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		return true
+	}
+
+	errMsg := "Only editors can manage the policies"
+	utils.GetLogger().Error(errMsg)
+	utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+	return false
+}
+
+func validateRowFilter(filter models.RowFilter) error {
+	switch filter.Operator {
+	case utils.ROW_FILTER_IS_NULL, utils.ROW_FILTER_NOT_NULL:
+		if len(filter.Values) != 0 {
+			return errors.New("values are not allowed with " + filter.Operator)
+		}
+	case utils.ROW_FILTER_IN, utils.ROW_FILTER_NOT_IN:
+		if len(filter.Values) == 0 {
+			return errors.New("values are required with " + filter.Operator)
+		}
+	default:
+		if len(filter.Values) != 1 {
+			return errors.New("a single value is required with " + filter.Operator)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,312 @@
+package policy_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"pipelineService/handlers/v1/test"
+	"pipelineService/models/v1"
+	mockStore "pipelineService/services/db/mocks"
+	"pipelineService/utils"
+)
+
+// TestCreateMaskingPolicy tests all the scenarios while creating a masking policy.
+func TestCreateMaskingPolicy(t *testing.T) {
+	mockPolicy := createRandomMaskingPolicy()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		body          interface{}
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidMethod",
+
+			body: models.MaskingPolicy{Classification: utils.CLASSIFICATION_EMAIL, Method: "encrypt"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateMaskingPolicy(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_DBError",
+
+			body: models.MaskingPolicy{Classification: mockPolicy.Classification, Method: mockPolicy.Method},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateMaskingPolicy(gomock.Any()).Times(1).Return(models.MaskingPolicy{}, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			body: models.MaskingPolicy{Classification: mockPolicy.Classification, Method: mockPolicy.Method,
+				ExemptRoles: mockPolicy.ExemptRoles, WorkspaceID: 1},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateMaskingPolicy(gomock.Any()).Times(1).DoAndReturn(
+					func(policy models.MaskingPolicy) (models.MaskingPolicy, error) {
+						require.Equal(t, mockPolicy.Method, policy.Method)
+						require.Equal(t, 1122, policy.CreatedBy)
+						require.Equal(t, 1122, policy.WorkspaceID)
+
+						return mockPolicy, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockPolicy}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.POLICY, store, nil, nil)
+			url := fmt.Sprintf("%spolicies/masking/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestCreateRowFilter tests all the scenarios while creating a row filter on a data product.
+func TestCreateRowFilter(t *testing.T) {
+	mockFilter := createRandomRowFilter()
+	productID, _ := uuid.FromString(mockFilter.ProductID)
+
+	testCaseSuite := []struct {
+		testScenario  string
+		body          interface{}
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidOperator",
+
+			body: models.RowFilter{ProductID: mockFilter.ProductID, Column: "region", Operator: "like",
+				Values: pq.StringArray{"EU%"}},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateRowFilter(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_MultipleValues",
+
+			body: models.RowFilter{ProductID: mockFilter.ProductID, Column: "region", Operator: utils.ROW_FILTER_EQ,
+				Values: pq.StringArray{"EU", "UK"}},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateRowFilter(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_ProductOfOtherWorkspace",
+
+			body: models.RowFilter{ProductID: mockFilter.ProductID, Column: mockFilter.Column,
+				Operator: mockFilter.Operator, Values: mockFilter.Values},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDataProductInfo(productID).Times(1).Return(models.DataProduct{WorkspaceID: 1}, nil)
+				store.EXPECT().CreateRowFilter(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			body: models.RowFilter{ProductID: mockFilter.ProductID, Column: mockFilter.Column,
+				Operator: mockFilter.Operator, Values: mockFilter.Values},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDataProductInfo(productID).Times(1).Return(models.DataProduct{WorkspaceID: 1122}, nil)
+				store.EXPECT().CreateRowFilter(gomock.Any()).Times(1).DoAndReturn(
+					func(filter models.RowFilter) (models.RowFilter, error) {
+						require.Equal(t, mockFilter.Column, filter.Column)
+						require.Equal(t, 1122, filter.CreatedBy)
+
+						return mockFilter, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.POLICY, store, nil, nil)
+			url := fmt.Sprintf("%spolicies/row-filters/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetRowFilters tests all the scenarios while getting the row filters of a data product.
+func TestGetRowFilters(t *testing.T) {
+	mockFilters := []models.RowFilter{createRandomRowFilter()}
+	productID, _ := uuid.FromString(mockFilters[0].ProductID)
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_MissingProduct",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetRowFilters(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			query: map[string]string{"productId": productID.String()},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetRowFilters(productID).Times(1).Return(mockFilters, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockFilters}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.POLICY, store, nil, nil)
+			url := fmt.Sprintf("%spolicies/row-filters/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+func createRandomMaskingPolicy() models.MaskingPolicy {
+	policyID, _ := uuid.NewV1()
+
+	return models.MaskingPolicy{
+		PolicyID:       policyID,
+		Classification: utils.CLASSIFICATION_EMAIL,
+		Method:         utils.MASKING_METHOD_PARTIAL,
+		ExemptRoles:    pq.StringArray{utils.USER_ROLE_ADMIN},
+		CreatedBy:      1122,
+		WorkspaceID:    1122,
+		CreatedAt:      utils.RandomInt(1, 1000),
+	}
+}
+
+func createRandomRowFilter() models.RowFilter {
+	filterID, _ := uuid.NewV1()
+	productID, _ := uuid.NewV1()
+
+	return models.RowFilter{
+		FilterID:    filterID,
+		ProductID:   productID.String(),
+		Column:      "region",
+		Operator:    utils.ROW_FILTER_IN,
+		Values:      pq.StringArray{"EU", "UK"},
+		CreatedBy:   1122,
+		WorkspaceID: 1122,
+		CreatedAt:   utils.RandomInt(1, 1000),
+	}
+}
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+
+	os.Exit(m.Run())
+}
@@ -0,0 +1,312 @@
+package quality
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+	"pipelineService/services/dataQuality"
+	"pipelineService/services/db"
+	"pipelineService/services/destinationPool"
+	"pipelineService/utils"
+)
+
+type Server struct {
+	Store       db.Store
+	Router      *gin.Engine
+	RouterGroup *gin.RouterGroup
+}
+
+// GetQualityRules returns the quality rules of an asset
+// @Summary Get Quality Rules
+// @Description Returns the expectations checked on the rows of an asset after every sync or transformation
+// @Tags quality
+// @Produce  json
+// @Param assetId query string true "Asset ID"
+// @Success 200 {object} models.QualityRulesResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /quality/rules/ [get].
+func (server *Server) GetQualityRules(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetQualityRules endpoint called")
+
+	var ruleQuery models.QualityRuleQuery
+	if err := ctx.ShouldBindQuery(&ruleQuery); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	assetID, _ := uuid.FromString(ruleQuery.AssetID)
+
+	rules, err := server.Store.GetQualityRules(assetID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Quality Rules")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", rules)
+	logger.Info("GetQualityRules endpoint returned")
+}
+
+// CreateQualityRule creates a quality rule on an asset
+// @Summary Create Quality Rule
+// @Description Creates a not null, unique, accepted values, range, regex, row count delta or freshness expectation on an asset. A failed rule of error severity degrades the data products of the asset
+// @Tags quality
+// @Produce  json
+// @Param rule body models.QualityRule true "Quality Rule"
+// @Success 201 {object} models.QualityRuleResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /quality/rules/ [post].
+func (server *Server) CreateQualityRule(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("CreateQualityRule endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !server.canManageQuality(ctx) {
+		return
+	}
+
+	var rule models.QualityRule
+	if err := ctx.ShouldBindJSON(&rule); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err := validateQualityRule(rule); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	assetID, _ := uuid.FromString(rule.AssetID)
+
+	identity, err := server.Store.GetAssetIdentity(assetID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, "Asset not found", nil)
+
+		return
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Asset")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	columns, err := server.Store.GetAssetColumns(assetID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Asset Columns")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if err = checkRuleColumn(rule, columns); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if rule.Severity == "" {
+		rule.Severity = utils.QUALITY_SEVERITY_WARNING
+	}
+
+	rule.RuleID = uuid.Nil
+	rule.ParentID = identity.ParentID
+	rule.AssetName = identity.AssetName
+	rule.CreatedBy = userID
+	rule.WorkspaceID = workspaceID
+	rule.CreatedAt = 0
+
+	createdRule, err := server.Store.CreateQualityRule(rule)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Quality Rule")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", createdRule)
+	logger.Info("CreateQualityRule endpoint returned")
+}
+
+// DeleteQualityRule deletes a quality rule
+// @Summary Delete Quality Rule
+// @Description Deletes a quality rule of the workspace, its results are kept
+// @Tags quality
+// @Produce  json
+// @Param id path string true "Rule ID"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /quality/rules/{id}/ [delete].
+func (server *Server) DeleteQualityRule(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DeleteQualityRule endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !server.canManageQuality(ctx) {
+		return
+	}
+
+	ruleID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err = server.Store.DeleteQualityRule(ruleID, workspaceID); err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Quality Rule")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Quality rule deleted successfully")
+	logger.Info("DeleteQualityRule endpoint returned")
+}
+
+// GetQualityResults returns the results of the quality rules of an asset
+// @Summary Get Quality Results
+// @Description Returns the latest results of the quality rules of an asset over its runs, newest first
+// @Tags quality
+// @Produce  json
+// @Param assetId query string true "Asset ID"
+// @Param ruleId query string false "Rule ID"
+// @Param runId query string false "Run ID"
+// @Success 200 {object} models.QualityCheckResultsResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /quality/results/ [get].
+func (server *Server) GetQualityResults(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetQualityResults endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	var filter models.QualityResultFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	results, err := server.Store.GetQualityResults(workspaceID, filter)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Quality Results")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", results)
+	logger.Info("GetQualityResults endpoint returned")
+}
+
+// CheckAssetQuality checks the quality rules of an asset
+// @Summary Check Asset Quality
+// @Description Checks the quality rules of an asset on its destination in a new run, outside of its syncs and transformations
+// @Tags quality
+// @Produce  json
+// @Param id path string true "Asset ID"
+// @Success 201 {object} models.QualityCheckResultsResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /quality/assets/{id}/check/ [post].
+func (server *Server) CheckAssetQuality(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("CheckAssetQuality endpoint called")
+
+	if !server.canManageQuality(ctx) {
+		return
+	}
+
+	assetID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	runID, err := uuid.NewV4()
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	results, err := dataQuality.CheckAsset(server.Store, runID, assetID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, "Asset not found", nil)
+
+		return
+	}
+
+	if errors.Is(err, destinationPool.ErrUnsupportedDestination) {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Quality Results")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if results == nil {
+		results = make([]models.QualityCheckResult, 0)
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", results)
+	logger.Info("CheckAssetQuality endpoint returned")
+}
+
+func (server *Server) canManageQuality(ctx *gin.Context) bool {
+	if utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		return true
+	}
+
+	errMsg := "Only editors can manage the quality rules"
+	utils.GetLogger().Error(errMsg)
+	utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+	return false
+}
@@ -0,0 +1,403 @@
+package quality_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"pipelineService/handlers/v1/test"
+	"pipelineService/models/v1"
+	mockStore "pipelineService/services/db/mocks"
+	"pipelineService/utils"
+)
+
+// TestCreateQualityRule tests all the scenarios while creating a quality rule on an asset.
+func TestCreateQualityRule(t *testing.T) {
+	mockRule := createRandomQualityRule()
+	assetID, _ := uuid.FromString(mockRule.AssetID)
+	mockColumns := []models.AssetColumn{{Name: "email", Type: "string"}}
+	mockIdentity := models.AssetIdentity{ParentID: uuid.Must(uuid.NewV1()).String(), AssetName: "users"}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		body          interface{}
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidCheck",
+
+			body: models.QualityRule{AssetID: mockRule.AssetID, Column: "email", Check: "not_empty"},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateQualityRule(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_MissingPattern",
+
+			body: models.QualityRule{AssetID: mockRule.AssetID, Column: "email", Check: utils.QUALITY_CHECK_REGEX},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateQualityRule(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_MissingMaxAge",
+
+			body: models.QualityRule{AssetID: mockRule.AssetID, Check: utils.QUALITY_CHECK_FRESHNESS},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateQualityRule(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound_Asset",
+
+			body: models.QualityRule{AssetID: mockRule.AssetID, Column: "email", Check: utils.QUALITY_CHECK_NOT_NULL},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetIdentity(assetID).Times(1).Return(models.AssetIdentity{}, gorm.ErrRecordNotFound)
+				store.EXPECT().CreateQualityRule(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound_UncatalogedColumn",
+
+			body: models.QualityRule{AssetID: mockRule.AssetID, Column: "phone", Check: utils.QUALITY_CHECK_NOT_NULL},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetIdentity(assetID).Times(1).Return(mockIdentity, nil)
+				store.EXPECT().GetAssetColumns(assetID).Times(1).Return(mockColumns, nil)
+				store.EXPECT().CreateQualityRule(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			body: models.QualityRule{AssetID: mockRule.AssetID, Column: "email", Check: utils.QUALITY_CHECK_NOT_NULL},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetAssetIdentity(assetID).Times(1).Return(mockIdentity, nil)
+				store.EXPECT().GetAssetColumns(assetID).Times(1).Return(mockColumns, nil)
+				store.EXPECT().CreateQualityRule(gomock.Any()).Times(1).DoAndReturn(
+					func(rule models.QualityRule) (models.QualityRule, error) {
+						require.Equal(t, mockIdentity.ParentID, rule.ParentID)
+						require.Equal(t, mockIdentity.AssetName, rule.AssetName)
+						require.Equal(t, utils.QUALITY_SEVERITY_WARNING, rule.Severity)
+						require.Equal(t, 1122, rule.CreatedBy)
+						require.Equal(t, 1122, rule.WorkspaceID)
+
+						return mockRule, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockRule}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.QUALITY, store, nil, nil)
+			url := fmt.Sprintf("%squality/rules/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetQualityResults tests all the scenarios while getting the results of the quality rules of an asset.
+func TestGetQualityResults(t *testing.T) {
+	mockResult := createRandomQualityResult()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_MissingAssetID",
+
+			query: map[string]string{},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetQualityResults(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_DBError",
+
+			query: map[string]string{"assetId": mockResult.AssetID},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetQualityResults(1122, gomock.Any()).Times(1).
+					Return([]models.QualityCheckResult{}, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			query: map[string]string{"assetId": mockResult.AssetID, "runId": mockResult.RunID},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				filter := models.QualityResultFilter{AssetID: mockResult.AssetID, RunID: mockResult.RunID}
+				store.EXPECT().GetQualityResults(1122, filter).Times(1).
+					Return([]models.QualityCheckResult{mockResult}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   []models.QualityCheckResult{mockResult}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.QUALITY, store, nil, nil)
+			url := fmt.Sprintf("%squality/results/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestCheckAssetQuality tests all the scenarios while checking the quality rules of an asset on demand.
+func TestCheckAssetQuality(t *testing.T) {
+	mockRule := createRandomQualityRule()
+	assetID, _ := uuid.FromString(mockRule.AssetID)
+	// the asset recreated by a later sync of the pipeline of the rule
+	resyncedAssetID, _ := uuid.NewV1()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		assetID       string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_BadUUID",
+
+			assetID: "notUuid",
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound",
+
+			assetID: mockRule.AssetID,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetQualityRules(assetID).Times(1).Return([]models.QualityRule{mockRule}, nil)
+				store.EXPECT().GetAssetTable(assetID).Times(1).Return(models.AssetTable{}, gorm.ErrRecordNotFound)
+				store.EXPECT().SaveQualityResults(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnsupportedDestination",
+
+			assetID: mockRule.AssetID,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetQualityRules(assetID).Times(1).Return([]models.QualityRule{mockRule}, nil)
+				store.EXPECT().GetAssetTable(assetID).Times(1).
+					Return(models.AssetTable{AssetName: "users", DestinationType: "mysql"}, nil)
+				store.EXPECT().GetAssetColumns(assetID).Times(1).Return(nil, nil)
+				store.EXPECT().CheckQualityRule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().SaveQualityResults(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "ResyncedAsset_RulesChecked",
+
+			assetID: resyncedAssetID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetQualityRules(resyncedAssetID).Times(1).Return([]models.QualityRule{mockRule}, nil)
+				// the rule of the previous asset is checked on the table of the recreated one
+				store.EXPECT().GetAssetTable(resyncedAssetID).Times(1).Return(models.AssetTable{}, gorm.ErrRecordNotFound)
+				store.EXPECT().GetAssetTable(assetID).Times(0)
+				store.EXPECT().SaveQualityResults(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_NoRules",
+
+			assetID: mockRule.AssetID,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetQualityRules(assetID).Times(1).Return([]models.QualityRule{}, nil)
+				store.EXPECT().GetAssetTable(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   []models.QualityCheckResult{}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.QUALITY, store, nil, nil)
+			url := fmt.Sprintf("%squality/assets/%s/check/", test.BaseURL, testCase.assetID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+func createRandomQualityRule() models.QualityRule {
+	ruleID, _ := uuid.NewV1()
+	assetID, _ := uuid.NewV1()
+
+	return models.QualityRule{
+		RuleID:      ruleID,
+		AssetID:     assetID.String(),
+		Column:      "email",
+		Check:       utils.QUALITY_CHECK_NOT_NULL,
+		Severity:    utils.QUALITY_SEVERITY_WARNING,
+		CreatedBy:   1122,
+		WorkspaceID: 1122,
+		CreatedAt:   utils.RandomInt(1, 1000),
+	}
+}
+
+func createRandomQualityResult() models.QualityCheckResult {
+	resultID, _ := uuid.NewV1()
+	runID, _ := uuid.NewV1()
+	ruleID, _ := uuid.NewV1()
+	assetID, _ := uuid.NewV1()
+
+	return models.QualityCheckResult{
+		ResultID:    resultID,
+		RunID:       runID.String(),
+		RuleID:      ruleID.String(),
+		AssetID:     assetID.String(),
+		Column:      "email",
+		Check:       utils.QUALITY_CHECK_UNIQUE,
+		Severity:    utils.QUALITY_SEVERITY_ERROR,
+		RowCount:    100,
+		FailedRows:  3,
+		Observed:    "3 of 100 rows failed",
+		WorkspaceID: 1122,
+		CheckedAt:   utils.RandomInt(1, 1000),
+	}
+}
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+
+	os.Exit(m.Run())
+}
@@ -0,0 +1,74 @@
+package quality
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// validateQualityRule checks that the rule has the parameters of its check, the column is required by all the
+// checks but the row count delta and the freshness which defaults to the emission time of the rows.
+func validateQualityRule(rule models.QualityRule) error {
+	switch rule.Check {
+	case utils.QUALITY_CHECK_ROW_COUNT_DELTA:
+		if rule.MaxDeltaPercent == nil || *rule.MaxDeltaPercent < 0 {
+			return errors.New("maxDeltaPercent is required with " + rule.Check)
+		}
+
+		return nil
+	case utils.QUALITY_CHECK_FRESHNESS:
+		if rule.MaxAgeMinutes <= 0 {
+			return errors.New("maxAgeMinutes is required with " + rule.Check)
+		}
+
+		return nil
+	}
+
+	if rule.Column == "" {
+		return errors.New("column is required with " + rule.Check)
+	}
+
+	switch rule.Check {
+	case utils.QUALITY_CHECK_ACCEPTED_VALUES:
+		if len(rule.Values) == 0 {
+			return errors.New("values are required with " + rule.Check)
+		}
+	case utils.QUALITY_CHECK_RANGE:
+		if rule.Min == nil && rule.Max == nil {
+			return errors.New("min or max is required with " + rule.Check)
+		}
+
+		if rule.Min != nil && rule.Max != nil && *rule.Min > *rule.Max {
+			return errors.New("min can't be greater than max")
+		}
+	case utils.QUALITY_CHECK_REGEX:
+		if rule.Pattern == "" {
+			return errors.New("pattern is required with " + rule.Check)
+		}
+
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRuleColumn checks that the column of the rule is cataloged on the asset, the emission time of the rows isn't
+// cataloged but can always be checked.
+func checkRuleColumn(rule models.QualityRule, columns []models.AssetColumn) error {
+	if rule.Column == "" || rule.Column == utils.AIRBYTE_EMITTED_AT_COLUMN {
+		return nil
+	}
+
+	for _, column := range columns {
+		if column.Name == rule.Column {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("column %s isn't cataloged on the asset", rule.Column)
+}
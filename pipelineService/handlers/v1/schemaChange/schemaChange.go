@@ -0,0 +1,358 @@
+package schemaChange
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/cadence/client"
+	"pipelineService/clients/airbyte"
+	"pipelineService/clients/cadenceClient"
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/services/schemaDrift"
+	"pipelineService/utils"
+)
+
+type Server struct {
+	Store         db.Store
+	Router        *gin.Engine
+	RouterGroup   *gin.RouterGroup
+	Airbyte       airbyte.AirByteQuerier
+	CadenceClient cadenceClient.CadStore
+}
+
+// GetSchemaChanges returns the schema changes detected in the workspace
+// @Summary Get Schema Changes
+// @Description Returns the schema changes detected on the sources of the pipelines, latest first
+// @Tags schema-changes
+// @Produce  json
+// @Param pipelineId query string false "Pipeline ID"
+// @Param status query string false "Status i.e. pending, approved or rejected"
+// @Success 200 {object} models.SchemaChangesResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /schema-changes/ [get].
+func (server *Server) GetSchemaChanges(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetSchemaChanges endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	var filter models.SchemaChangeFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	schemaChanges, err := server.Store.GetSchemaChanges(workspaceID, filter)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Schema Changes")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", schemaChanges)
+
+	logger.Info("GetSchemaChanges endpoint returned")
+}
+
+// ApproveSchemaChange approves a pending schema change
+// @Summary Approve Schema Change
+// @Description Approves a pending schema change and triggers the update connection workflow to apply the discovered catalog on Airbyte to the connection of every destination of the pipeline, the same change pending on the other connections is approved with it
+// @Tags schema-changes
+// @Produce  json
+// @Param id path string true "Schema Change ID"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 409 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /schema-changes/{id}/approve/ [post].
+func (server *Server) ApproveSchemaChange(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ApproveSchemaChange endpoint called")
+
+	userID, workspaceID, airbyteWorkspaceID := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	schemaChange, ok := server.getPendingSchemaChange(ctx)
+	if !ok {
+		return
+	}
+
+	var proposedCatalog models.SyncCatalog
+	if err := json.Unmarshal(schemaChange.ProposedCatalog, &proposedCatalog); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	// the destinations of the pipeline replicate the same catalog, the approved catalog is applied to the connection
+	// of every destination
+	connections, err := server.Store.GetPipelineConnections(uuid.FromStringOrNil(schemaChange.PipelineID))
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connections")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	// the requests are built before any workflow is triggered, a connection which can't be read fails the approval
+	// without updating the others
+	updatePipelineRequests := make([]models.UpdatePipelineAirByteRequest, len(connections))
+
+	for i, connection := range connections {
+		connectionSchema, err := server.Airbyte.GetConnectionSchema(connection.AirbyteConnectionID)
+		if err != nil {
+			logger.Error(err.Error())
+			utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+			return
+		}
+
+		updatePipelineRequests[i] = models.UpdatePipelineAirByteRequest{
+			Prefix:      &connectionSchema.Prefix,
+			Status:      connectionSchema.Status,
+			SyncCatalog: proposedCatalog,
+		}
+
+		if connectionSchema.Schedule.Units != 0 {
+			updatePipelineRequests[i].Schedule = &connectionSchema.Schedule
+		}
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		TaskList:                        env.Env.TaskListName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	for i, connection := range connections {
+		err = server.CadenceClient.TriggerUpdateConnectionWorkflow(c, workflowOptions, updatePipelineRequests[i], connection, userID, workspaceID, airbyteWorkspaceID)
+		if err != nil {
+			logger.Error(err.Error())
+			utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, "Couldn't Trigger UpdatePipelineConnection Workflow", nil)
+
+			return
+		}
+	}
+
+	server.reviewSchemaChange(ctx, schemaChange, utils.SCHEMA_CHANGE_STATUS_APPROVED)
+
+	logger.Info("ApproveSchemaChange endpoint returned")
+}
+
+// RejectSchemaChange rejects a pending schema change
+// @Summary Reject Schema Change
+// @Description Rejects a pending schema change, the connection keeps its current catalog
+// @Tags schema-changes
+// @Produce  json
+// @Param id path string true "Schema Change ID"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 409 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /schema-changes/{id}/reject/ [post].
+func (server *Server) RejectSchemaChange(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("RejectSchemaChange endpoint called")
+
+	schemaChange, ok := server.getPendingSchemaChange(ctx)
+	if !ok {
+		return
+	}
+
+	server.reviewSchemaChange(ctx, schemaChange, utils.SCHEMA_CHANGE_STATUS_REJECTED)
+
+	logger.Info("RejectSchemaChange endpoint returned")
+}
+
+// DetectSchemaDrift rediscovers the schema of the sources and compares it with the catalog of the connections
+// @Summary Detects schema drift
+// @Description Rediscovers the schema of the source of every pipeline and raises a pending change when streams or columns are added, removed or their types are changed. A drift identical to a rejected change isn't raised again
+// @Tags schema-changes/internal
+// @Produce  json
+// @Success 200 {object} models.SchemaDriftReportResponse
+// @Failure 400	{object} models.Response
+// @Failure 500	{object} models.Response
+// @Router /schema-changes/internal/detect/ [post].
+func (server *Server) DetectSchemaDrift(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DetectSchemaDrift internal endpoint called")
+
+	connections, err := server.Store.GetDriftCheckConnections()
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connections")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	report := models.SchemaDriftReport{
+		Checked:  len(connections),
+		Drifted:  make([]string, 0),
+		Failures: make([]string, 0),
+	}
+
+	// sources are shared by pipelines, so each one is discovered once per run
+	discoveredCatalogs := make(map[string]models.SyncCatalog)
+
+	for _, connection := range connections {
+		discovered, ok := discoveredCatalogs[connection.AirbyteSourceID]
+		if !ok {
+			sourceSchema, err := server.Airbyte.RefreshSourceSchema(connection.AirbyteSourceID)
+			if err == nil {
+				discovered, err = schemaDrift.ToSyncCatalog(sourceSchema)
+			}
+
+			if err != nil {
+				logger.Error(err.Error())
+				report.Failures = append(report.Failures, connection.PipelineID)
+
+				continue
+			}
+
+			discoveredCatalogs[connection.AirbyteSourceID] = discovered
+		}
+
+		connectionSchema, err := server.Airbyte.GetConnectionSchema(connection.AirbyteConnectionID)
+		if err != nil {
+			logger.Error(err.Error())
+			report.Failures = append(report.Failures, connection.PipelineID)
+
+			continue
+		}
+
+		changes, proposedCatalog := schemaDrift.DiffCatalogs(connectionSchema.SyncCatalog, discovered)
+		if len(changes) == 0 {
+			continue
+		}
+
+		// a drift identical to a rejected change is kept as is on the connection
+		fingerprint := schemaDrift.Fingerprint(changes)
+
+		rejected, err := server.Store.IsSchemaChangeRejected(connection.ConnectionID, fingerprint)
+		if err != nil {
+			logger.Error(err.Error())
+			report.Failures = append(report.Failures, connection.PipelineID)
+
+			continue
+		}
+
+		if rejected {
+			continue
+		}
+
+		changesJSON, _ := json.Marshal(changes)
+		proposedCatalogJSON, _ := json.Marshal(proposedCatalog)
+
+		_, err = server.Store.SaveSchemaChange(models.SchemaChange{
+			PipelineID:      connection.PipelineID,
+			ConnectionID:    connection.ConnectionID,
+			Changes:         changesJSON,
+			ProposedCatalog: proposedCatalogJSON,
+			Fingerprint:     fingerprint,
+			WorkspaceID:     connection.WorkspaceID,
+		})
+		if err != nil {
+			logger.Error(err.Error())
+			report.Failures = append(report.Failures, connection.PipelineID)
+
+			continue
+		}
+
+		report.Drifted = append(report.Drifted, connection.PipelineID)
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", report)
+	logger.Info("DetectSchemaDrift internal endpoint successfully returned")
+}
+
+// getPendingSchemaChange checks that the caller can review changes and returns the pending change of the request from
+// the workspace of the caller.
+func (server *Server) getPendingSchemaChange(ctx *gin.Context) (models.SchemaChange, bool) {
+	logger := utils.GetLogger()
+
+	if !utils.CanEditResources(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only editors can review schema changes"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return models.SchemaChange{}, false
+	}
+
+	changeID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return models.SchemaChange{}, false
+	}
+
+	schemaChange, err := server.Store.GetSchemaChange(changeID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Schema Change")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return schemaChange, false
+	}
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	// the changes of the other workspaces are reported as missing
+	if schemaChange.WorkspaceID != workspaceID {
+		errMsg := "Schema change doesn't exists"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, errMsg, nil)
+
+		return schemaChange, false
+	}
+
+	if schemaChange.Status != utils.SCHEMA_CHANGE_STATUS_PENDING {
+		errMsg := "Schema change is already " + schemaChange.Status
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, nil)
+
+		return schemaChange, false
+	}
+
+	return schemaChange, true
+}
+
+func (server *Server) reviewSchemaChange(ctx *gin.Context, schemaChange models.SchemaChange, status string) {
+	logger := utils.GetLogger()
+
+	userID, _, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	schemaChange.Status = status
+	schemaChange.ReviewedBy = userID
+	schemaChange.ReviewedAt = time.Now().UnixMilli()
+
+	if err := server.Store.ReviewSchemaChange(schemaChange); err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Schema Change")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Schema change "+status+" successfully")
+}
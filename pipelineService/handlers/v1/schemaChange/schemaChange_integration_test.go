@@ -0,0 +1,539 @@
+package schemaChange_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	mockairbyte "pipelineService/clients/airbyte/mocks"
+	"pipelineService/handlers/v1/test"
+	"pipelineService/models/v1"
+	mockStore "pipelineService/services/db/mocks"
+	"pipelineService/utils"
+)
+
+// TestDetectSchemaDrift tests all the scenarios while detecting the schema drift of the pipelines.
+func TestDetectSchemaDrift(t *testing.T) {
+	mockConnections := []models.DriftCheckConnection{createRandomDriftCheckConnection(), createRandomDriftCheckConnection()}
+	// both pipelines share the same source
+	mockConnections[1].AirbyteSourceID = mockConnections[0].AirbyteSourceID
+
+	configuredProperties := map[string]interface{}{
+		"id":    map[string]interface{}{"type": "integer"},
+		"name":  map[string]interface{}{"type": []interface{}{"null", "string"}},
+		"email": map[string]interface{}{"type": "string"},
+	}
+	discoveredProperties := map[string]interface{}{
+		"id":         map[string]interface{}{"type": "string"},
+		"name":       map[string]interface{}{"type": []interface{}{"string", "null"}},
+		"created_at": map[string]interface{}{"type": "string", "format": "date-time"},
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_DBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDriftCheckConnections().Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().SaveSchemaChange(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().RefreshSourceSchema(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_NoDrift",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDriftCheckConnections().Times(1).Return(mockConnections, nil)
+				store.EXPECT().SaveSchemaChange(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().RefreshSourceSchema(mockConnections[0].AirbyteSourceID).Times(1).
+					Return(createSourceSchema(t, configuredProperties), nil)
+				querier.EXPECT().GetConnectionSchema(gomock.Any()).Times(2).
+					Return(createConnectionSchema(configuredProperties), nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				report := decodeDriftReport(t, recorder)
+				require.Equal(t, 2, report.Checked)
+				require.Empty(t, report.Drifted)
+				require.Empty(t, report.Failures)
+			},
+		},
+		{
+			testScenario: "Success_Drifted",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDriftCheckConnections().Times(1).Return(mockConnections[:1], nil)
+				store.EXPECT().IsSchemaChangeRejected(mockConnections[0].ConnectionID, gomock.Any()).Times(1).Return(false, nil)
+				store.EXPECT().SaveSchemaChange(gomock.Any()).Times(1).DoAndReturn(
+					func(schemaChange models.SchemaChange) (models.SchemaChange, error) {
+						require.Equal(t, mockConnections[0].ConnectionID, schemaChange.ConnectionID)
+						require.Len(t, schemaChange.Fingerprint, 64)
+
+						var changes []models.SchemaFieldChange
+						require.NoError(t, json.Unmarshal(schemaChange.Changes, &changes))
+						require.Equal(t, []models.SchemaFieldChange{
+							{Stream: "users", Field: "created_at", ChangeType: utils.SCHEMA_CHANGE_FIELD_ADDED, NewType: "string(date-time)"},
+							{Stream: "users", Field: "id", ChangeType: utils.SCHEMA_CHANGE_TYPE_CHANGED, OldType: "integer", NewType: "string"},
+							{Stream: "users", Field: "email", ChangeType: utils.SCHEMA_CHANGE_FIELD_REMOVED, OldType: "string"},
+						}, changes)
+
+						var proposedCatalog models.SyncCatalog
+						require.NoError(t, json.Unmarshal(schemaChange.ProposedCatalog, &proposedCatalog))
+						require.Len(t, proposedCatalog.Streams, 1)
+						require.True(t, proposedCatalog.Streams[0].Config.Selected)
+
+						return schemaChange, nil
+					})
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().RefreshSourceSchema(mockConnections[0].AirbyteSourceID).Times(1).
+					Return(createSourceSchema(t, discoveredProperties), nil)
+				querier.EXPECT().GetConnectionSchema(mockConnections[0].AirbyteConnectionID).Times(1).
+					Return(createConnectionSchema(configuredProperties), nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				report := decodeDriftReport(t, recorder)
+				require.Equal(t, []string{mockConnections[0].PipelineID}, report.Drifted)
+				require.Empty(t, report.Failures)
+			},
+		},
+		{
+			testScenario: "Success_RejectedDriftSkipped",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDriftCheckConnections().Times(1).Return(mockConnections[:1], nil)
+				store.EXPECT().IsSchemaChangeRejected(mockConnections[0].ConnectionID, gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().SaveSchemaChange(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().RefreshSourceSchema(mockConnections[0].AirbyteSourceID).Times(1).
+					Return(createSourceSchema(t, discoveredProperties), nil)
+				querier.EXPECT().GetConnectionSchema(mockConnections[0].AirbyteConnectionID).Times(1).
+					Return(createConnectionSchema(configuredProperties), nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				report := decodeDriftReport(t, recorder)
+				require.Empty(t, report.Drifted)
+				require.Empty(t, report.Failures)
+			},
+		},
+		{
+			testScenario: "Success_DiscoverFailed",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetDriftCheckConnections().Times(1).Return(mockConnections[:1], nil)
+				store.EXPECT().SaveSchemaChange(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().RefreshSourceSchema(gomock.Any()).Times(1).
+					Return(models.SourceSchema{}, errors.New("discover failed"))
+				querier.EXPECT().GetConnectionSchema(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				report := decodeDriftReport(t, recorder)
+				require.Empty(t, report.Drifted)
+				require.Equal(t, []string{mockConnections[0].PipelineID}, report.Failures)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			server := test.NewTestServer(test.SCHEMA_CHANGE, store, airByte, nil)
+			url := fmt.Sprintf("%sschema-changes/internal/detect/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetSchemaChanges tests all the scenarios while getting the schema changes of a workspace.
+func TestGetSchemaChanges(t *testing.T) {
+	mockSchemaChanges := []models.SchemaChange{createRandomSchemaChange(), createRandomSchemaChange()}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         map[string]string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_DBError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSchemaChanges(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			query: map[string]string{"status": utils.SCHEMA_CHANGE_STATUS_PENDING},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				filter := models.SchemaChangeFilter{Status: utils.SCHEMA_CHANGE_STATUS_PENDING}
+
+				store.EXPECT().GetSchemaChanges(1122, filter).Times(1).Return(mockSchemaChanges, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockSchemaChanges}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.SCHEMA_CHANGE, store, nil, nil)
+			url := fmt.Sprintf("%sschema-changes/", test.BaseURL)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, testCase.query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestApproveSchemaChange tests the scenarios while approving a schema change that fail before the workflow is triggered.
+func TestApproveSchemaChange(t *testing.T) {
+	mockSchemaChange := createRandomSchemaChange()
+	mockSchemaChange.ProposedCatalog = []byte(`{"streams":[]}`)
+	mockConnections := []models.Connection{
+		{ConnectionID: mockSchemaChange.ConnectionID, PipelineID: mockSchemaChange.PipelineID, AirbyteConnectionID: utils.RandomString(10)},
+		{ConnectionID: utils.RandomString(10), PipelineID: mockSchemaChange.PipelineID, AirbyteConnectionID: utils.RandomString(10)},
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		changeID      string
+		buildStubs    func(store *mockStore.MockStore)
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_InvalidID",
+
+			changeID: "invalid",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSchemaChange(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound_OtherWorkspace",
+
+			changeID: mockSchemaChange.ChangeID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				otherChange := mockSchemaChange
+				otherChange.WorkspaceID = 1
+
+				store.EXPECT().GetSchemaChange(mockSchemaChange.ChangeID).Times(1).Return(otherChange, nil)
+				store.EXPECT().GetPipelineConnections(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Conflict_AlreadyReviewed",
+
+			changeID: mockSchemaChange.ChangeID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				reviewed := mockSchemaChange
+				reviewed.Status = utils.SCHEMA_CHANGE_STATUS_REJECTED
+
+				store.EXPECT().GetSchemaChange(mockSchemaChange.ChangeID).Times(1).Return(reviewed, nil)
+				store.EXPECT().GetPipelineConnections(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			testScenario: "InternalServerError_BadAirByteCall",
+
+			changeID: mockSchemaChange.ChangeID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSchemaChange(mockSchemaChange.ChangeID).Times(1).Return(mockSchemaChange, nil)
+				store.EXPECT().GetPipelineConnections(uuid.FromStringOrNil(mockSchemaChange.PipelineID)).Times(1).
+					Return(mockConnections, nil)
+				store.EXPECT().ReviewSchemaChange(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				// the connection of the second destination can't be read, no workflow is triggered for the first one
+				querier.EXPECT().GetConnectionSchema(mockConnections[0].AirbyteConnectionID).Times(1).
+					Return(createConnectionSchema(map[string]interface{}{"id": map[string]interface{}{"type": "integer"}}), nil)
+				querier.EXPECT().GetConnectionSchema(mockConnections[1].AirbyteConnectionID).Times(1).
+					Return(models.ConnectionSourceSchema{}, errors.New("airbyte error"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			server := test.NewTestServer(test.SCHEMA_CHANGE, store, airByte, nil)
+			url := fmt.Sprintf("%sschema-changes/%s/approve/", test.BaseURL, testCase.changeID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestRejectSchemaChange tests all the scenarios while rejecting a schema change.
+func TestRejectSchemaChange(t *testing.T) {
+	mockSchemaChange := createRandomSchemaChange()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "NotFound",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSchemaChange(mockSchemaChange.ChangeID).Times(1).Return(models.SchemaChange{}, sql.ErrNoRows)
+				store.EXPECT().ReviewSchemaChange(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "NotFound_OtherWorkspace",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				otherChange := mockSchemaChange
+				otherChange.WorkspaceID = 1
+
+				store.EXPECT().GetSchemaChange(mockSchemaChange.ChangeID).Times(1).Return(otherChange, nil)
+				store.EXPECT().ReviewSchemaChange(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Conflict_AlreadyReviewed",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				reviewed := mockSchemaChange
+				reviewed.Status = utils.SCHEMA_CHANGE_STATUS_APPROVED
+
+				store.EXPECT().GetSchemaChange(mockSchemaChange.ChangeID).Times(1).Return(reviewed, nil)
+				store.EXPECT().ReviewSchemaChange(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSchemaChange(mockSchemaChange.ChangeID).Times(1).Return(mockSchemaChange, nil)
+				store.EXPECT().ReviewSchemaChange(gomock.Any()).Times(1).DoAndReturn(
+					func(schemaChange models.SchemaChange) error {
+						require.Equal(t, utils.SCHEMA_CHANGE_STATUS_REJECTED, schemaChange.Status)
+						require.Equal(t, 1122, schemaChange.ReviewedBy)
+						require.NotZero(t, schemaChange.ReviewedAt)
+
+						return nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			server := test.NewTestServer(test.SCHEMA_CHANGE, store, nil, nil)
+			url := fmt.Sprintf("%sschema-changes/%s/reject/", test.BaseURL, mockSchemaChange.ChangeID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+func createRandomDriftCheckConnection() models.DriftCheckConnection {
+	cID, _ := uuid.NewV1()
+	pID, _ := uuid.NewV1()
+
+	return models.DriftCheckConnection{
+		ConnectionID:        cID.String(),
+		PipelineID:          pID.String(),
+		AirbyteConnectionID: utils.RandomString(10),
+		AirbyteSourceID:     utils.RandomString(10),
+		WorkspaceID:         1122,
+	}
+}
+
+func createRandomSchemaChange() models.SchemaChange {
+	changeID, _ := uuid.NewV1()
+	cID, _ := uuid.NewV1()
+	pID, _ := uuid.NewV1()
+
+	return models.SchemaChange{
+		ChangeID:     changeID,
+		PipelineID:   pID.String(),
+		ConnectionID: cID.String(),
+		Status:       utils.SCHEMA_CHANGE_STATUS_PENDING,
+		Changes:      []byte(`[{"stream":"users","field":"email","changeType":"field_added","newType":"string"}]`),
+		WorkspaceID:  1122,
+		CreatedAt:    utils.RandomInt(1, 1000),
+	}
+}
+
+func createConnectionSchema(properties map[string]interface{}) models.ConnectionSourceSchema {
+	return models.ConnectionSourceSchema{
+		SyncCatalog: models.SyncCatalog{Streams: []models.Streams{{
+			Stream: models.Stream{
+				Name:       "users",
+				JsonSchema: map[string]interface{}{"type": "object", "properties": properties},
+			},
+			Config: models.Config{SyncMode: "full_refresh", Selected: true},
+		}}},
+	}
+}
+
+func createSourceSchema(t *testing.T, properties map[string]interface{}) models.SourceSchema {
+	var sourceSchema models.SourceSchema
+
+	catalog, err := json.Marshal(map[string]interface{}{"catalog": createConnectionSchema(properties).SyncCatalog})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(catalog, &sourceSchema))
+
+	return sourceSchema
+}
+
+func decodeDriftReport(t *testing.T, recorder *httptest.ResponseRecorder) models.SchemaDriftReport {
+	var res models.SchemaDriftReportResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+
+	return res.Data
+}
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+
+	os.Exit(m.Run())
+}
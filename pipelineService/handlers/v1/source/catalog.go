@@ -0,0 +1,269 @@
+package source
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"pipelineService/models/v1"
+	"pipelineService/services/connectorCatalog"
+	"pipelineService/utils"
+)
+
+// SetSourceAvailability enables or disables a source for the workspace
+// @Summary Enable or Disable Source
+// @Description Enables or disables a source of the catalog for the workspace, the disabled sources can't be configured. Only admins can change the sources of the workspace
+// @Tags source
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Airbyte Source Definition ID"
+// @Param availability body models.ConnectorAvailabilityRequest true "Availability"
+// @Success 200 {object} models.WorkspaceConnectorResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/definitions/{id}/ [put].
+func (server *Server) SetSourceAvailability(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("SetSourceAvailability endpoint called")
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.IsAdmin(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only admins can enable or disable the sources of the workspace"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	definitionID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var availability models.ConnectorAvailabilityRequest
+	if err = ctx.ShouldBindJSON(&availability); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if _, err = server.Store.GetSupportedSource(workspaceID, definitionID.String()); err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Supported Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	connector, err := server.Store.SaveWorkspaceConnector(models.WorkspaceConnector{
+		WorkspaceID:   workspaceID,
+		ConnectorType: utils.CONNECTOR_TYPE_SOURCE,
+		DefinitionID:  definitionID.String(),
+		Enabled:       *availability.Enabled,
+		UpdatedBy:     userID,
+		UpdatedAt:     time.Now().UnixMilli(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Workspace Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", connector)
+	logger.Info("SetSourceAvailability endpoint returned")
+}
+
+// ReconcileSourceDefinitions syncs the supported sources with the source definitions of airbyte
+// @Summary Reconcile Source Definitions
+// @Description Upserts the supported sources with the docker image, version, release stage, icon and documentation of the source definitions listed by airbyte. The sources airbyte stopped listing are deactivated
+// @Tags sources/internal
+// @Produce  json
+// @Success 200 {object} models.ConnectorCatalogReportResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/internal/definitions/reconcile/ [post].
+func (server *Server) ReconcileSourceDefinitions(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ReconcileSourceDefinitions internal endpoint called")
+
+	report, err := connectorCatalog.ReconcileSources(server.Store, server.Airbyte)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Supported Sources")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", report)
+	logger.Info("ReconcileSourceDefinitions internal endpoint returned successfully")
+}
+
+// RegisterCustomSource registers an in-house source connector for the workspace
+// @Summary Register Custom Source
+// @Description Registers the docker image of an in-house source connector as a custom source definition of the workspace on airbyte and lists it in the supported sources of the workspace. Only admins can register custom sources
+// @Tags source
+// @Accept  json
+// @Produce  json
+// @Param connector body models.CustomConnectorRequest true "Custom Source"
+// @Success 201 {object} models.CustomSourceResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/custom/ [post].
+func (server *Server) RegisterCustomSource(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("RegisterCustomSource endpoint called")
+
+	_, workspaceID, airbyteWorkspaceID := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.IsAdmin(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only admins can register custom sources"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	var request models.CustomConnectorRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	source, err := connectorCatalog.RegisterCustomSource(server.Store, server.Airbyte, workspaceID, airbyteWorkspaceID,
+		request)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Custom Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", source)
+	logger.Info("RegisterCustomSource endpoint returned")
+}
+
+// UpgradeCustomSource upgrades a custom source of the workspace to a new version
+// @Summary Upgrade Custom Source
+// @Description Upgrades a custom source of the workspace to a new docker image tag. The configurations of the sources of the workspace are checked against the specification of the new version and the upgrade is rolled back when one of them is incompatible. Only admins can upgrade custom sources
+// @Tags source
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Airbyte Source Definition ID"
+// @Param upgrade body models.CustomConnectorUpgradeRequest true "Version"
+// @Success 200 {object} models.CustomConnectorUpgradeResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 409 {object} models.CustomConnectorUpgradeResponse
+// @Failure 500 {object} models.Response
+// @Router /sources/custom/{id}/ [put].
+func (server *Server) UpgradeCustomSource(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("UpgradeCustomSource endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !utils.IsAdmin(utils.GetUserRoleFromContext(ctx)) {
+		errMsg := "Only admins can upgrade custom sources"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	definitionID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var request models.CustomConnectorUpgradeRequest
+	if err = ctx.ShouldBindJSON(&request); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	source, err := server.Store.GetSupportedSource(workspaceID, definitionID.String())
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Custom Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if !source.Custom || source.WorkspaceID != workspaceID {
+		errMsg := "Only the custom sources of the workspace can be upgraded"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	upgrade, err := connectorCatalog.UpgradeCustomSource(server.Store, server.Airbyte, workspaceID, source,
+		request.DockerImageTag)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Custom Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if !upgrade.Upgraded {
+		errMsg := fmt.Sprintf("%d sources of the workspace are incompatible with version %s",
+			len(upgrade.Incompatible), upgrade.Version)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, upgrade)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", upgrade)
+	logger.Info("UpgradeCustomSource endpoint returned")
+}
+
+// connectorEnabled tells whether the workspace allows the source definition, the response is written when it
+// doesn't.
+func (server *Server) connectorEnabled(ctx *gin.Context, definitionID string) bool {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	enabled, err := server.Store.IsConnectorEnabled(workspaceID, utils.CONNECTOR_TYPE_SOURCE, definitionID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Workspace Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return false
+	}
+
+	if !enabled {
+		errMsg := fmt.Sprintf("source definition %s is disabled for the workspace", definitionID)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusForbidden, utils.ERROR, errMsg, nil)
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,447 @@
+package source
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/services/fileSource"
+	"pipelineService/utils"
+)
+
+const (
+	// fileUploadFormOverhead is the room left in the body of an upload for the other fields and the multipart framing.
+	fileUploadFormOverhead = 1 << 20
+	// fileUploadFormMemory is the part of the form kept in memory while it's parsed, the rest is spooled to disk.
+	fileUploadFormMemory = 32 << 20
+)
+
+// fileUpload is a file of the request which was previewed.
+type fileUpload struct {
+	file    multipart.File
+	header  *multipart.FileHeader
+	format  string
+	preview models.FilePreview
+}
+
+// UploadFileSource creates a source from an uploaded file
+// @Summary Upload File Source
+// @Description Stores a CSV, JSON Lines, Excel (xlsx) or Parquet file in the object store of the service and creates the airbyte file source reading it. The schema inferred from the first rows of the file is returned as its preview.
+// @Tags source
+// @Accept multipart/form-data
+// @Produce  json
+// @Param file formData file true "File"
+// @Param name formData string true "Source Name"
+// @Success 201 {object} models.FileSourceUploadResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 413 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/files/ [post].
+func (server *Server) UploadFileSource(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("UploadFileSource endpoint called")
+
+	userID, workspaceID, airbyteWorkspaceID := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	if !parseFileUploadForm(ctx) {
+		return
+	}
+
+	name := ctx.PostForm("name")
+	if name == "" {
+		errMsg := "the name of the source is required"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if !server.connectorEnabled(ctx, utils.AIRBYTE_CSV_SOURCE_DEFINITION_ID) {
+		return
+	}
+
+	upload, ok := server.readFileUpload(ctx)
+	if !ok {
+		return
+	}
+
+	defer upload.file.Close()
+
+	objectStore := fileSource.NewObjectStore()
+
+	fileRecord, ok := server.storeFileUpload(ctx, objectStore, upload)
+	if !ok {
+		return
+	}
+
+	source, err := server.createSource(models.CreateSourceConnectorRequest{
+		AirbyteSourceDefinitionId: utils.AIRBYTE_CSV_SOURCE_DEFINITION_ID,
+		ConnectionConfiguration: fileSource.Configure(objectStore, nil, fileSource.DatasetName(name), upload.format,
+			fileRecord.ObjectKey),
+		Name: name,
+	}, userID, workspaceID, airbyteWorkspaceID)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	insertedSource, err := server.Store.CreateSource(source)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	fileRecord.SourceID = insertedSource.SourceID
+	fileRecord.Version = 1
+
+	fileRecord, err = server.Store.CreateFileUpload(fileRecord)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "File Upload")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "",
+		newFileSourceUpload(insertedSource, fileRecord, upload.preview))
+	logger.Info("UploadFileSource endpoint returned")
+}
+
+// ReuploadFileSource uploads a new version of the file of a file source
+// @Summary Re-upload File Source
+// @Description Stores the file as the next version of the file source and points the airbyte source at it, the previous versions are kept. With sync the pipelines reading from the source are synced with the new version, the pipelines whose sync couldn't be triggered are listed as failed.
+// @Tags source
+// @Accept multipart/form-data
+// @Produce  json
+// @Param id path string true "Source ID"
+// @Param file formData file true "File"
+// @Param sync query bool false "Sync the pipelines of the source"
+// @Success 201 {object} models.FileSourceUploadResponse
+// @Failure 400 {object} models.Response
+// @Failure 413 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/{id}/files/ [post].
+func (server *Server) ReuploadFileSource(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("ReuploadFileSource endpoint called")
+
+	source, latest, ok := server.getFileSource(ctx)
+	if !ok {
+		return
+	}
+
+	if !parseFileUploadForm(ctx) {
+		return
+	}
+
+	upload, ok := server.readFileUpload(ctx)
+	if !ok {
+		return
+	}
+
+	defer upload.file.Close()
+
+	objectStore := fileSource.NewObjectStore()
+
+	fileRecord, ok := server.storeFileUpload(ctx, objectStore, upload)
+	if !ok {
+		return
+	}
+
+	configuredSource, err := server.Airbyte.GetConfiguredSource(source.AirbyteSourceID)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	configuration := fileSource.Configure(objectStore, configuredSource.ConnectionConfiguration,
+		fileSource.DatasetName(source.SourceName), upload.format, fileRecord.ObjectKey)
+
+	err = server.Airbyte.CheckSourceConnection(map[string]interface{}{
+		"sourceDefinitionId":      source.AirbyteSourceDefinitionID,
+		"connectionConfiguration": configuration,
+	})
+	if err == nil {
+		_, err = server.Airbyte.EditSourceConnectorOnAirByte(models.EditSourceConnectorRequestAirByte{
+			AirByteSourceID:         source.AirbyteSourceID,
+			ConnectionConfiguration: configuration,
+			Name:                    source.SourceName,
+		})
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	fileRecord.SourceID = source.SourceID
+	fileRecord.Version = latest.Version + 1
+
+	fileRecord, err = server.Store.CreateFileUpload(fileRecord)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "File Upload")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	sourceUpload := newFileSourceUpload(source, fileRecord, upload.preview)
+
+	if ctx.Query("sync") == "true" {
+		connections, err := server.Store.GetSourceConnections(source.SourceID)
+		if err != nil {
+			logger.Error(err.Error())
+			statusCode, errMsg := utils.ParseDBError(err, "Connection")
+			utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+			return
+		}
+
+		for _, connection := range connections {
+			if connection.AirbyteConnectionID == "" {
+				continue
+			}
+
+			_, err = server.Airbyte.SyncConnectionManually(map[string]interface{}{
+				"connectionId": connection.AirbyteConnectionID,
+			})
+			if err != nil {
+				logger.Error(err.Error())
+				sourceUpload.FailedPipelines = append(sourceUpload.FailedPipelines, connection.PipelineID)
+
+				continue
+			}
+
+			sourceUpload.SyncedPipelines = append(sourceUpload.SyncedPipelines, connection.PipelineID)
+		}
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", sourceUpload)
+	logger.Info("ReuploadFileSource endpoint returned")
+}
+
+// GetFileUploads returns the versions of the file of a file source
+// @Summary Get File Source Versions
+// @Description Returns the uploaded versions of the file of a file source with their preview, the latest first
+// @Tags source
+// @Produce  json
+// @Param id path string true "Source ID"
+// @Success 200 {object} models.FileUploadsResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/{id}/files/ [get].
+func (server *Server) GetFileUploads(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetFileUploads endpoint called")
+
+	source, _, ok := server.getFileSource(ctx)
+	if !ok {
+		return
+	}
+
+	uploads, err := server.Store.GetFileUploads(source.SourceID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "File Upload")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", uploads)
+	logger.Info("GetFileUploads endpoint returned")
+}
+
+// getFileSource returns the source of the request and its latest upload, a source which wasn't created from an
+// uploaded file has no upload.
+func (server *Server) getFileSource(ctx *gin.Context) (models.Source, models.FileUpload, bool) {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	sourceID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return models.Source{}, models.FileUpload{}, false
+	}
+
+	source, err := server.Store.GetSource(sourceID.String())
+	if err == nil && source.WorkspaceID != workspaceID {
+		err = fmt.Errorf("source %s doesn't belong to workspace %d", sourceID, workspaceID)
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return source, models.FileUpload{}, false
+	}
+
+	latest, err := server.Store.GetLatestFileUpload(source.SourceID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "File Upload")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return source, latest, false
+	}
+
+	return source, latest, true
+}
+
+// parseFileUploadForm parses the multipart form of an upload with its body capped at the max size of the files, an
+// oversized body is rejected while it's read instead of after it's spooled to disk.
+func parseFileUploadForm(ctx *gin.Context) bool {
+	logger := utils.GetLogger()
+
+	maxSize := env.Env.FileUploadMaxSizeMB << 20
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxSize+fileUploadFormOverhead)
+
+	err := ctx.Request.ParseMultipartForm(fileUploadFormMemory)
+	if err == nil {
+		return true
+	}
+
+	logger.Error(err.Error())
+
+	// the error of the capped body isn't exported before go 1.19
+	if strings.Contains(err.Error(), "request body too large") {
+		errMsg := fmt.Sprintf("the file is larger than %d MB", env.Env.FileUploadMaxSizeMB)
+		utils.BuildResponse(ctx, http.StatusRequestEntityTooLarge, utils.ERROR, errMsg, nil)
+
+		return false
+	}
+
+	utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+	return false
+}
+
+// readFileUpload opens and previews the file of the request, the response is written when the file can't be used.
+// The caller closes the file.
+func (server *Server) readFileUpload(ctx *gin.Context) (fileUpload, bool) {
+	logger := utils.GetLogger()
+
+	upload := fileUpload{}
+
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return upload, false
+	}
+
+	if header.Size > env.Env.FileUploadMaxSizeMB<<20 {
+		errMsg := fmt.Sprintf("the file is larger than %d MB", env.Env.FileUploadMaxSizeMB)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusRequestEntityTooLarge, utils.ERROR, errMsg, nil)
+
+		return upload, false
+	}
+
+	upload.header = header
+
+	upload.format, err = fileSource.DetectFormat(header.Filename)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return upload, false
+	}
+
+	upload.file, err = header.Open()
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return upload, false
+	}
+
+	upload.preview, err = fileSource.Preview(upload.format, upload.file, header.Size)
+	if err != nil {
+		upload.file.Close()
+
+		errMsg := fmt.Sprintf("couldn't read %s: %s", header.Filename, err.Error())
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return upload, false
+	}
+
+	return upload, true
+}
+
+// storeFileUpload stores the file under a new key and returns the upload to record, the response is written when the
+// file can't be stored.
+func (server *Server) storeFileUpload(ctx *gin.Context, objectStore fileSource.ObjectStore,
+	upload fileUpload) (models.FileUpload, bool) {
+	logger := utils.GetLogger()
+
+	userID, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	uploadID, err := uuid.NewV4()
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return models.FileUpload{}, false
+	}
+
+	fileRecord := models.FileUpload{
+		UploadID:    uploadID,
+		FileName:    upload.header.Filename,
+		Format:      upload.format,
+		Size:        upload.header.Size,
+		ObjectKey:   fileSource.ObjectKey(workspaceID, uploadID, upload.header.Filename),
+		WorkspaceID: workspaceID,
+		CreatedBy:   userID,
+	}
+
+	fileRecord.Preview, _ = json.Marshal(upload.preview)
+
+	fileRecord.Checksum, err = fileSource.Store(objectStore, fileRecord.ObjectKey, upload.file, upload.header.Size)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return fileRecord, false
+	}
+
+	return fileRecord, true
+}
+
+func newFileSourceUpload(source models.Source, fileRecord models.FileUpload,
+	preview models.FilePreview) models.FileSourceUpload {
+	return models.FileSourceUpload{
+		SourceID:        source.SourceID,
+		SourceName:      source.SourceName,
+		UploadID:        fileRecord.UploadID,
+		Version:         fileRecord.Version,
+		Format:          fileRecord.Format,
+		Preview:         preview,
+		SyncedPipelines: make([]string, 0),
+		FailedPipelines: make([]string, 0),
+	}
+}
@@ -0,0 +1,386 @@
+package source
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/services/sourceOAuth"
+	"pipelineService/utils"
+)
+
+// StartSourceOAuth starts the OAuth consent of a source
+// @Summary Start Source OAuth
+// @Description Returns the consent url of the OAuth provider of the source definition, the provider redirects the consent to the callback of the sources. The redirect url must be on one of the allowed frontend origins. Giving a source authorizes that source again once the consent completes, otherwise the flow is given to the source configuration with oauthFlowId.
+// @Tags source
+// @Produce  json
+// @Param consent body models.OAuthConsentRequest true "Source Definition and Redirect URL"
+// @Success 201 {object} models.OAuthConsentResponse
+// @Failure 400 {object} models.Response
+// @Failure 403 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/oauth/consent/ [post].
+func (server *Server) StartSourceOAuth(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("StartSourceOAuth endpoint called")
+
+	userID, workspaceID, airbyteWorkspaceID := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	var request models.OAuthConsentRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if !sourceOAuth.AllowedRedirect(request.RedirectURL) {
+		errMsg := fmt.Sprintf("redirect url %s isn't on an allowed origin", request.RedirectURL)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	flow := models.OAuthFlow{
+		SourceDefinitionID: request.SourceDefinitionID,
+		RedirectURL:        request.RedirectURL,
+		Status:             utils.OAUTH_FLOW_STATUS_PENDING,
+		WorkspaceID:        workspaceID,
+		AirbyteWorkspaceID: airbyteWorkspaceID,
+		CreatedBy:          userID,
+	}
+
+	if request.SourceID != "" {
+		source, err := server.Store.GetSource(request.SourceID)
+		if err == nil && source.WorkspaceID != workspaceID {
+			err = fmt.Errorf("source %s doesn't belong to workspace %d", request.SourceID, workspaceID)
+		}
+
+		if err == nil && source.AirbyteSourceDefinitionID != request.SourceDefinitionID {
+			err = fmt.Errorf("source %s isn't configured from definition %s", request.SourceID,
+				request.SourceDefinitionID)
+		}
+
+		if err != nil {
+			logger.Error(err.Error())
+			statusCode, errMsg := utils.ParseDBError(err, "Source")
+			utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+			return
+		}
+
+		flow.SourceID = &source.SourceID
+	}
+
+	if !server.connectorEnabled(ctx, request.SourceDefinitionID) {
+		return
+	}
+
+	spec, err := server.Airbyte.GetSourceSpecification(request.SourceDefinitionID)
+	if err == nil && !sourceOAuth.SupportsOAuth(spec) {
+		err = fmt.Errorf("source definition %s doesn't support OAuth", request.SourceDefinitionID)
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	consentURL, err := server.Airbyte.GetSourceConsentURL(models.SourceOAuthRequestAirbyte{
+		SourceDefinitionID:      request.SourceDefinitionID,
+		WorkspaceID:             airbyteWorkspaceID,
+		RedirectURL:             env.Env.OAuthRedirectURL,
+		OAuthInputConfiguration: request.OAuthInputConfiguration,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	flow.State, err = sourceOAuth.ConsentState(consentURL)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	flow.OAuthInputConfiguration, _ = json.Marshal(request.OAuthInputConfiguration)
+
+	flow, err = server.Store.CreateOAuthFlow(flow)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "OAuth Flow")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", models.OAuthConsent{
+		FlowID:     flow.FlowID,
+		ConsentURL: consentURL,
+	})
+	logger.Info("StartSourceOAuth endpoint returned")
+}
+
+// CompleteSourceOAuth handles the redirect of the OAuth provider
+// @Summary Complete Source OAuth
+// @Description Completes the OAuth flow the state of the provider was issued for and redirects to the redirect url of the flow with its flowId and status. The tokens are never part of the redirect.
+// @Tags source
+// @Produce  json
+// @Param state query string true "OAuth State"
+// @Success 302
+// @Failure 400 {object} models.Response
+// @Failure 409 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/oauth/callback/ [get].
+func (server *Server) CompleteSourceOAuth(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("CompleteSourceOAuth endpoint called")
+
+	state := ctx.Query("state")
+	if state == "" {
+		err := errors.New("the callback has no state")
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	flow, err := server.Store.GetOAuthFlowByState(state)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "OAuth Flow")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if flow.Status != utils.OAUTH_FLOW_STATUS_PENDING {
+		errMsg := fmt.Sprintf("OAuth flow is %s", flow.Status)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	// the flows started before the origins were checked are refused instead of redirected
+	if !sourceOAuth.AllowedRedirect(flow.RedirectURL) {
+		errMsg := fmt.Sprintf("redirect url %s isn't on an allowed origin", flow.RedirectURL)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	queryParams := make(map[string]interface{})
+	for key := range ctx.Request.URL.Query() {
+		queryParams[key] = ctx.Query(key)
+	}
+
+	flow, err = sourceOAuth.Complete(server.Store, server.Airbyte, flow, queryParams)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "OAuth Flow")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	redirectURL, err := url.Parse(flow.RedirectURL)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	query := redirectURL.Query()
+	query.Set("flowId", flow.FlowID.String())
+	query.Set("status", flow.Status)
+	redirectURL.RawQuery = query.Encode()
+
+	ctx.Redirect(http.StatusFound, redirectURL.String())
+	logger.Info("CompleteSourceOAuth endpoint returned")
+}
+
+// GetSourceOAuthFlow returns an OAuth flow
+// @Summary Get Source OAuth Flow
+// @Description Returns the status of an OAuth flow of the workspace and the error of a failed one
+// @Tags source
+// @Produce  json
+// @Param id path string true "OAuth Flow ID"
+// @Success 200 {object} models.OAuthFlowResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/oauth/{id}/ [get].
+func (server *Server) GetSourceOAuthFlow(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetSourceOAuthFlow endpoint called")
+
+	flowID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	flow, ok := server.getOAuthFlow(ctx, flowID)
+	if !ok {
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", flow)
+	logger.Info("GetSourceOAuthFlow endpoint returned")
+}
+
+// GetOAuthTokenFailures returns the sources whose tokens can't be refreshed
+// @Summary Get OAuth Token Failures
+// @Description Returns the open token refresh failures of the sources of the workspace, a failure is resolved once its source connects again or is authorized through a new OAuth flow
+// @Tags source
+// @Produce  json
+// @Success 200 {object} models.OAuthTokenFailuresResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/oauth/failures/ [get].
+func (server *Server) GetOAuthTokenFailures(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetOAuthTokenFailures endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	failures, err := server.Store.GetOAuthTokenFailures(workspaceID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "OAuth Token Failures")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", failures)
+	logger.Info("GetOAuthTokenFailures endpoint returned")
+}
+
+// CheckOAuthTokens checks the tokens of the sources authorized through OAuth
+// @Summary Check OAuth Tokens
+// @Description Internal endpoint checking the connection of every source authorized through OAuth, the sources failing the check are recorded as token refresh failures and the passing ones are resolved
+// @Tags source
+// @Produce  json
+// @Success 200 {object} models.OAuthTokenCheckReportResponse
+// @Failure 500 {object} models.Response
+// @Router /sources/internal/oauth/check/ [post].
+func (server *Server) CheckOAuthTokens(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("CheckOAuthTokens endpoint called")
+
+	report, err := sourceOAuth.CheckTokens(server.Store, server.Airbyte)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", report)
+	logger.Info("CheckOAuthTokens endpoint returned")
+}
+
+// injectOAuthTokens writes the tokens of the completed flow into the configuration of the source to create, the
+// response is written when the flow can't be used.
+func (server *Server) injectOAuthTokens(ctx *gin.Context,
+	configureSourceData *models.CreateSourceConnectorRequestAPI) (models.OAuthFlow, bool) {
+	logger := utils.GetLogger()
+
+	flowID, err := uuid.FromString(configureSourceData.OAuthFlowID)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return models.OAuthFlow{}, false
+	}
+
+	flow, ok := server.getOAuthFlow(ctx, flowID)
+	if !ok {
+		return flow, false
+	}
+
+	if flow.Status != utils.OAUTH_FLOW_STATUS_COMPLETED {
+		errMsg := fmt.Sprintf("OAuth flow is %s", flow.Status)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, nil)
+
+		return flow, false
+	}
+
+	if flow.SourceDefinitionID != configureSourceData.AirbyteSourceDefinitionId {
+		errMsg := fmt.Sprintf("OAuth flow %s was started for source definition %s", flowID, flow.SourceDefinitionID)
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, errMsg, nil)
+
+		return flow, false
+	}
+
+	spec, err := server.Airbyte.GetSourceSpecification(flow.SourceDefinitionID)
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
+
+		return flow, false
+	}
+
+	output := make(map[string]interface{})
+	_ = json.Unmarshal(flow.Output, &output)
+
+	configureSourceData.ConnectionConfiguration = sourceOAuth.InjectTokens(spec,
+		configureSourceData.ConnectionConfiguration, output)
+
+	return flow, true
+}
+
+// consumeOAuthFlow hands the flow over to the created source and drops its tokens, the source is authorized even when
+// recording it fails.
+func (server *Server) consumeOAuthFlow(flow models.OAuthFlow, sourceID string) {
+	logger := utils.GetLogger()
+
+	flow.Status = utils.OAUTH_FLOW_STATUS_CONSUMED
+	flow.SourceID = &sourceID
+	flow.Output = nil
+
+	if err := server.Store.UpdateOAuthFlow(flow, utils.OAUTH_FLOW_STATUS_COMPLETED); err != nil {
+		logger.Error(err.Error())
+	}
+}
+
+func (server *Server) getOAuthFlow(ctx *gin.Context, flowID uuid.UUID) (models.OAuthFlow, bool) {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	flow, err := server.Store.GetOAuthFlow(flowID)
+	if err == nil && flow.WorkspaceID != workspaceID {
+		err = fmt.Errorf("OAuth flow %s doesn't belong to workspace %d", flowID, workspaceID)
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "OAuth Flow")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return flow, false
+	}
+
+	return flow, true
+}
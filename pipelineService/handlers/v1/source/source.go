@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
 	"pipelineService/clients/airbyte"
 	"pipelineService/clients/authService"
 	"pipelineService/models/v1"
@@ -23,8 +24,8 @@ type Server struct {
 }
 
 // ConfigureSourceOnAirbyte configures and creates a source connector on Airbyte
-// @Summary Create Source on Airbyte and store related information in local database. A connection is also created with the source in the database.
-// @Description Configures and creates a source connector on Airbyte
+// @Summary Create Source on Airbyte and store related information in local database. When a pipeline is given a connection is also created with the source in the database.
+// @Description Configures and creates a workspace source connector on Airbyte, which can be shared by multiple pipelines
 // @Tags source
 // @Produce  json
 // @Param configureSourceData body models.CreateSourceConnectorRequestAPI true "Source Details"
@@ -46,24 +47,28 @@ func (server *Server) ConfigureSourceOnAirbyte(ctx *gin.Context) {
 		return
 	}
 
-	var pipelineConnection models.PipelineConnection
+	if !server.connectorEnabled(ctx, configureSourceData.AirbyteSourceDefinitionId) {
+		return
+	}
 
-	pipelineConnection, err := server.Store.GetPipelineConnection(configureSourceData.Pipeline)
-	if err != nil {
-		logger.Error(err.Error())
-		statusCode, errMsg := utils.ParseDBError(err, "Source and Connection creation")
-		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+	var oauthFlow models.OAuthFlow
+
+	if configureSourceData.OAuthFlowID != "" {
+		var ok bool
+		if oauthFlow, ok = server.injectOAuthTokens(ctx, &configureSourceData); !ok {
+			return
+		}
+	}
 
+	if !server.validateConfiguration(ctx, configureSourceData.AirbyteSourceDefinitionId,
+		configureSourceData.ConnectionConfiguration) {
 		return
 	}
 
-	if pipelineConnection == (models.PipelineConnection{}) {
-		requestBody := map[string]interface{}{
-			"sourceDefinitionId":      configureSourceData.AirbyteSourceDefinitionId,
-			"connectionConfiguration": configureSourceData.ConnectionConfiguration,
-		}
+	var pipelineConnection models.PipelineConnection
 
-		err = server.Airbyte.CheckSourceConnection(requestBody)
+	if configureSourceData.Pipeline == "" {
+		source, err := server.createSource(configureSourceData.CreateSourceConnectorRequest, userID, workspaceID, airbyteWorkspaceID)
 		if err != nil {
 			logger.Error(err.Error())
 			utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
@@ -71,12 +76,39 @@ func (server *Server) ConfigureSourceOnAirbyte(ctx *gin.Context) {
 			return
 		}
 
-		airbyteRequest := models.CreateSourceConnectorRequestAirbyte{
-			WorkspaceId:                  airbyteWorkspaceID,
-			CreateSourceConnectorRequest: configureSourceData.CreateSourceConnectorRequest,
+		insertedSource, err := server.Store.CreateSource(source)
+		if err != nil {
+			logger.Error(err.Error())
+			statusCode, errMsg := utils.ParseDBError(err, "Source")
+			utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+			return
+		}
+
+		if configureSourceData.OAuthFlowID != "" {
+			server.consumeOAuthFlow(oauthFlow, insertedSource.SourceID)
 		}
 
-		createSourceResponse, err := server.Airbyte.CreateSourceConnectorOnAirByte(airbyteRequest)
+		pipelineConnection.SourceID = insertedSource.SourceID
+		pipelineConnection.SourceName = insertedSource.SourceName
+
+		utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", pipelineConnection)
+		logger.Info("ConfigureSourceOnAirbyte endpoint returned")
+
+		return
+	}
+
+	pipelineConnection, err := server.Store.GetPipelineConnection(configureSourceData.Pipeline)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Source and Connection creation")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if pipelineConnection == (models.PipelineConnection{}) {
+		createdSource, err := server.createSource(configureSourceData.CreateSourceConnectorRequest, userID, workspaceID, airbyteWorkspaceID)
 		if err != nil {
 			logger.Error(err.Error())
 			utils.BuildResponse(ctx, http.StatusInternalServerError, utils.ERROR, err.Error(), nil)
@@ -84,13 +116,6 @@ func (server *Server) ConfigureSourceOnAirbyte(ctx *gin.Context) {
 			return
 		}
 
-		createdSource := models.Source{
-			SourceName:                createSourceResponse.SourceName,
-			AirbyteSourceID:           createSourceResponse.AirbyteSourceId,
-			AirbyteSourceDefinitionID: createSourceResponse.AirbyteSourceDefinitionId,
-			Owner:                     userID,
-			WorkspaceID:               workspaceID,
-		}
 		createdConnection := models.Connection{
 			PipelineID: configureSourceData.Pipeline,
 		}
@@ -104,6 +129,10 @@ func (server *Server) ConfigureSourceOnAirbyte(ctx *gin.Context) {
 			return
 		}
 
+		if configureSourceData.OAuthFlowID != "" {
+			server.consumeOAuthFlow(oauthFlow, source.SourceID)
+		}
+
 		pipelineConnection.SourceID = source.SourceID
 		pipelineConnection.SourceName = source.SourceName
 		pipelineConnection.ConnectionID = connection.ConnectionID
@@ -114,15 +143,158 @@ func (server *Server) ConfigureSourceOnAirbyte(ctx *gin.Context) {
 	logger.Info("ConfigureSourceOnAirbyte endpoint returned")
 }
 
+// createSource checks the source configuration and creates the source connector on Airbyte.
+func (server *Server) createSource(sourceData models.CreateSourceConnectorRequest,
+	userID int, workspaceID int, airbyteWorkspaceID string) (models.Source, error) {
+	requestBody := map[string]interface{}{
+		"sourceDefinitionId":      sourceData.AirbyteSourceDefinitionId,
+		"connectionConfiguration": sourceData.ConnectionConfiguration,
+	}
+
+	err := server.Airbyte.CheckSourceConnection(requestBody)
+	if err != nil {
+		return models.Source{}, err
+	}
+
+	airbyteRequest := models.CreateSourceConnectorRequestAirbyte{
+		WorkspaceId:                  airbyteWorkspaceID,
+		CreateSourceConnectorRequest: sourceData,
+	}
+
+	createSourceResponse, err := server.Airbyte.CreateSourceConnectorOnAirByte(airbyteRequest)
+	if err != nil {
+		return models.Source{}, err
+	}
+
+	source := models.Source{
+		SourceName:                createSourceResponse.SourceName,
+		AirbyteSourceID:           createSourceResponse.AirbyteSourceId,
+		AirbyteSourceDefinitionID: createSourceResponse.AirbyteSourceDefinitionId,
+		Owner:                     userID,
+		WorkspaceID:               workspaceID,
+	}
+
+	return source, nil
+}
+
+// GetConfiguredSources return all the sources that are configured in the workspace
+// @Summary Get All Configured Sources
+// @Description Return all the sources configured in the workspace along with the number of pipelines using them
+// @Tags source
+// @Produce  json
+// @Success 200 {object} models.WorkspaceSourcesResponse
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/configured/ [get].
+func (server *Server) GetConfiguredSources(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("GetConfiguredSources endpoint called")
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	sources, err := server.Store.GetWorkspaceSources(workspaceID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Configured Sources")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", sources)
+
+	logger.Info("GetConfiguredSources endpoint returned")
+}
+
+// AttachSourceToPipeline attaches an existing source to a pipeline
+// @Summary Attach Source to Pipeline
+// @Description Creates the connection of the pipeline with an already configured source, so the source is reused instead of configured again
+// @Tags source
+// @Produce  json
+// @Param id path string true "Source ID"
+// @Param requestBody body models.AttachSourceRequest true "request body"
+// @Success 201 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 409 {object} models.Response
+// @Failure 500 {object} models.Response
+// @Router /sources/{id}/pipelines/ [post].
+func (server *Server) AttachSourceToPipeline(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("AttachSourceToPipeline endpoint called")
+
+	sourceID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	var attachSourceData models.AttachSourceRequest
+	if err := ctx.ShouldBindJSON(&attachSourceData); err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	source, ok := server.getWorkspaceSource(ctx, sourceID)
+	if !ok {
+		return
+	}
+
+	pipelineConnection, err := server.Store.GetPipelineConnection(attachSourceData.PipelineID)
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connection")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if pipelineConnection != (models.PipelineConnection{}) {
+		errMsg := "Pipeline already has a source"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	connection, err := server.Store.AttachSourceToPipeline(models.Connection{
+		PipelineID: attachSourceData.PipelineID,
+		SourceID:   source.SourceID,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Connection")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	pipelineConnection = models.PipelineConnection{
+		ConnectionID: connection.ConnectionID,
+		PipelineID:   connection.PipelineID,
+		SourceID:     source.SourceID,
+		SourceName:   source.SourceName,
+	}
+
+	utils.BuildResponse(ctx, http.StatusCreated, utils.SUCCESS, "", pipelineConnection)
+
+	logger.Info("AttachSourceToPipeline endpoint returned")
+}
+
 // EditSourceOnAirByte Edits a source connector on AirByte
 // @Summary Edit Source on AirByte
-// @Description Edit a source connector on AirByte
+// @Description Edit a source connector on AirByte, the change applies to every pipeline reading from the source
 // @Tags source
 // @Produce  json
 // @Param id path string true "Source ID"
 // @Param EditSourceData body models.EditSourceConnectorRequest true "Source Details"
 // @Success 200 {object} models.Response
 // @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
 // @Failure 500 {object} models.Response
 // @Router /sources/{id}/ [put].
 func (server *Server) EditSourceOnAirByte(ctx *gin.Context) {
@@ -137,14 +309,8 @@ func (server *Server) EditSourceOnAirByte(ctx *gin.Context) {
 		return
 	}
 
-	var source models.Source
-
-	source, err = server.Store.GetSource(sourceID.String())
-	if err != nil {
-		logger.Error(err.Error())
-		statusCode, errMsg := utils.ParseDBError(err, "Source")
-		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
-
+	source, ok := server.getWorkspaceSource(ctx, sourceID)
+	if !ok {
 		return
 	}
 
@@ -156,6 +322,10 @@ func (server *Server) EditSourceOnAirByte(ctx *gin.Context) {
 		return
 	}
 
+	if !server.validateConfiguration(ctx, source.AirbyteSourceDefinitionID, editSourceData.ConnectionConfiguration) {
+		return
+	}
+
 	requestBody := map[string]interface{}{
 		"sourceDefinitionId":      source.AirbyteSourceDefinitionID,
 		"connectionConfiguration": editSourceData.ConnectionConfiguration,
@@ -188,11 +358,71 @@ func (server *Server) EditSourceOnAirByte(ctx *gin.Context) {
 	logger.Info("GetSupportedSources endpoint returned")
 }
 
+// DeleteSource soft deletes a source
+// @Summary Delete Source
+// @Description Soft deletes a source, the deletion is refused with the list of dependent resources if a pipeline is still syncing from it
+// @Tags source
+// @Produce  json
+// @Param id path string true "Source ID"
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 404 {object} models.Response
+// @Failure 409 {object} models.ResourceDependenciesResponse
+// @Failure 500 {object} models.Response
+// @Router /sources/{id}/ [delete].
+func (server *Server) DeleteSource(ctx *gin.Context) {
+	logger := utils.GetLogger()
+	logger.Info("DeleteSource endpoint called")
+
+	sourceID, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		logger.Error(err.Error())
+		utils.BuildResponse(ctx, http.StatusBadRequest, utils.ERROR, err.Error(), nil)
+
+		return
+	}
+
+	if _, ok := server.getWorkspaceSource(ctx, sourceID); !ok {
+		return
+	}
+
+	dependencies, err := server.Store.GetSourceDependencies(sourceID.String())
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	if len(dependencies) > 0 {
+		errMsg := "Source is used by other resources"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusConflict, utils.ERROR, errMsg, dependencies)
+
+		return
+	}
+
+	err = server.Store.DeleteSource(sourceID.String())
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return
+	}
+
+	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", "Source deleted successfully")
+
+	logger.Info("DeleteSource endpoint returned")
+}
+
 // GetSupportedSources return all the sources supported by cdpaas
 // @Summary Get All Supported Sources
-// @Description Return all the sources supported by cdpaas
+// @Description Return all the sources supported by cdpaas with the release stage and version of their airbyte definition. The sources disabled for the workspace are only listed with include_disabled
 // @Tags source
 // @Produce  json
+// @Param include_disabled query bool false "List the sources disabled for the workspace"
 // @Success 200 {object} models.SupportedSourcesResponse
 // @Failure 400 {object} models.Response
 // @Failure 500 {object} models.Response
@@ -201,7 +431,9 @@ func (server *Server) GetSupportedSources(ctx *gin.Context) {
 	logger := utils.GetLogger()
 	logger.Info("GetSupportedSources endpoint called")
 
-	sources, err := server.Store.GetSupportedSources()
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	sources, err := server.Store.GetSupportedSources(workspaceID)
 	if err != nil {
 		logger.Error(err.Error())
 		statusCode, errMsg := utils.ParseDBError(err, "Supported Sources")
@@ -210,6 +442,18 @@ func (server *Server) GetSupportedSources(ctx *gin.Context) {
 		return
 	}
 
+	if ctx.Query("include_disabled") != "true" {
+		enabledSources := make([]models.SupportedSources, 0, len(sources))
+
+		for _, source := range sources {
+			if source.Enabled {
+				enabledSources = append(enabledSources, source)
+			}
+		}
+
+		sources = enabledSources
+	}
+
 	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", sources)
 
 	logger.Info("GetSupportedSources endpoint returned")
@@ -407,3 +651,30 @@ func (server *Server) GetConnectionSummary(ctx *gin.Context) {
 	utils.BuildResponse(ctx, http.StatusOK, utils.SUCCESS, "", connectionSummaryResponse)
 	logger.Info("GetConnectionSummary successfully returned")
 }
+
+// getWorkspaceSource returns the source of the request, the sources of the other workspaces are reported missing so
+// their credentials can't be reused, edited or deleted.
+func (server *Server) getWorkspaceSource(ctx *gin.Context, sourceID uuid.UUID) (models.Source, bool) {
+	logger := utils.GetLogger()
+
+	_, workspaceID, _ := utils.GetUserAndWorkspaceIDFromContext(ctx)
+
+	source, err := server.Store.GetSource(sourceID.String())
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && source.WorkspaceID != workspaceID) {
+		errMsg := "Source doesn't exist"
+		logger.Error(errMsg)
+		utils.BuildResponse(ctx, http.StatusNotFound, utils.ERROR, errMsg, nil)
+
+		return source, false
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		statusCode, errMsg := utils.ParseDBError(err, "Source")
+		utils.BuildResponse(ctx, statusCode, utils.ERROR, errMsg, nil)
+
+		return source, false
+	}
+
+	return source, true
+}
@@ -1,12 +1,18 @@
 package source_test
 
 import (
+	"archive/zip"
+	"bytes"
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -14,6 +20,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 	mockairbyte "pipelineService/clients/airbyte/mocks"
 	"pipelineService/clients/authService"
 	mock_authservice "pipelineService/clients/authService/mocks"
@@ -62,6 +69,62 @@ func TestConfigureSourceOnAirByte(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			testScenario: "BadRequest_InvalidConfiguration",
+
+			body: models.CreateSourceConnectorRequestAPI{
+				CreateSourceConnectorRequest: models.CreateSourceConnectorRequest{
+					AirbyteSourceDefinitionId: mockSourceDefID.String(),
+					ConnectionConfiguration:   map[string]interface{}{"port": "5432"},
+					Name:                      utils.RandomString(5),
+				},
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateSource(gomock.Any()).Times(0)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinition(mockSourceDefID.String()).Times(1).
+					Return(models.SourceDefinition{DockerImageTag: utils.RandomString(6)}, nil)
+				querier.EXPECT().GetSourceSpecification(mockSourceDefID.String()).Times(1).
+					Return(createConnectorSpecification(), nil)
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "invalid connection configuration: host is required; port must be of type integer",
+					Data: []models.ConfigFieldError{
+						{Field: "host", Message: "is required"},
+						{Field: "port", Message: "must be of type integer"},
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Forbidden_DisabledSource",
+
+			body: mockSourceConnectorReq,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().IsConnectorEnabled(1122, utils.CONNECTOR_TYPE_SOURCE, mockSourceDefID.String()).Times(1).
+					Return(false, nil)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			testScenario: "InternalServerError",
 
@@ -146,7 +209,6 @@ func TestConfigureSourceOnAirByte(t *testing.T) {
 						SourceName:                arg0.SourceName,
 						AirbyteSourceID:           arg0.AirbyteSourceID,
 						AirbyteSourceDefinitionID: arg0.AirbyteSourceDefinitionID,
-						ConnectionID:              mockSourceDefID.String(),
 						Owner:                     1122,
 						WorkspaceID:               1122,
 					}, models.Connection{
@@ -206,7 +268,6 @@ func TestConfigureSourceOnAirByte(t *testing.T) {
 						SourceName:                arg0.SourceName,
 						AirbyteSourceID:           arg0.AirbyteSourceID,
 						AirbyteSourceDefinitionID: arg0.AirbyteSourceDefinitionID,
-						ConnectionID:              mockSourceDefID.String(),
 						Owner:                     1122,
 						WorkspaceID:               1122,
 					}, models.Connection{
@@ -241,6 +302,55 @@ func TestConfigureSourceOnAirByte(t *testing.T) {
 					PipelineID:   mockSourceConnectorReq.Pipeline,
 				}
 
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockAPIRes}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_WorkspaceSource",
+
+			body: models.CreateSourceConnectorRequestAPI{
+				CreateSourceConnectorRequest: mockSourceConnectorReq.CreateSourceConnectorRequest,
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				arg0 := models.Source{
+					SourceName:                mockSourceConnectorRes.SourceName,
+					AirbyteSourceID:           mockSourceConnectorRes.AirbyteSourceId,
+					AirbyteSourceDefinitionID: mockSourceConnectorRes.AirbyteSourceDefinitionId,
+					Owner:                     1122,
+					WorkspaceID:               1122,
+				}
+
+				store.EXPECT().GetPipelineConnection(gomock.Any()).Times(0)
+				store.EXPECT().CreateSource(arg0).Times(1).
+					Return(models.Source{
+						SourceID:                  mockSourceDefID.String(),
+						SourceName:                arg0.SourceName,
+						AirbyteSourceID:           arg0.AirbyteSourceID,
+						AirbyteSourceDefinitionID: arg0.AirbyteSourceDefinitionID,
+						Owner:                     1122,
+						WorkspaceID:               1122,
+					}, nil)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(1).Return(nil)
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(1).Return(mockSourceConnectorRes, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				mockAPIRes := models.CreateSourceConnectorResposneData{
+					SourceID:   mockSourceDefID.String(),
+					SourceName: mockSourceConnectorRes.SourceName,
+				}
+
 				res := models.Response{
 					Status: utils.SUCCESS,
 					Errors: "",
@@ -261,12 +371,14 @@ func TestConfigureSourceOnAirByte(t *testing.T) {
 
 			store := mockStore.NewMockStore(ctrl)
 			testCase.buildStubs(store)
+			stubEnabledConnectors(store)
 
 			body, e := json.Marshal(testCase.body)
 			require.NoError(t, e)
 
 			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
 			testCase.queryAirByte(airByte)
+			stubConnectorSpecification(airByte)
 
 			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
 
@@ -451,6 +563,9 @@ func TestGetConnectionSummary(t *testing.T) {
 func TestEditSourceOnAirByte(t *testing.T) {
 	mockSourceID, _ := uuid.NewV1()
 	mockSource := createRandomSource(mockSourceID.String())
+	mockSource.WorkspaceID = 1122
+	mockOtherWorkspaceSource := createRandomSource(mockSourceID.String())
+	mockOtherWorkspaceSource.WorkspaceID = 2233
 	mockEditConnectorReq := models.EditSourceConnectorRequest{ConnectionConfiguration: "{port:5432}"}
 	testCaseSuite := []struct {
 		testScenario  string
@@ -501,6 +616,34 @@ func TestEditSourceOnAirByte(t *testing.T) {
 				test.ReqResBodyMatcher(t, recorder.Body, actual)
 			},
 		},
+		{
+			testScenario: "Source Of Another Workspace",
+
+			sourceID: mockSourceID.String(),
+
+			body: mockEditConnectorReq,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(0)
+				querier.EXPECT().EditSourceConnectorOnAirByte(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(mockSourceID.String()).Times(1).Return(mockOtherWorkspaceSource, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Source doesn't exist",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
 		{
 			testScenario: "Bad Request",
 
@@ -648,6 +791,7 @@ func TestEditSourceOnAirByte(t *testing.T) {
 
 			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
 			testCase.queryAirByte(airByte)
+			stubConnectorSpecification(airByte)
 
 			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
 
@@ -663,6 +807,151 @@ func TestEditSourceOnAirByte(t *testing.T) {
 	}
 }
 
+// TestDeleteSource tests all the scenarios while deleting a source.
+func TestDeleteSource(t *testing.T) {
+	mockSourceID, _ := uuid.NewV1()
+	mockSource := createRandomSource(mockSourceID.String())
+	mockSource.WorkspaceID = 1122
+	mockOtherWorkspaceSource := createRandomSource(mockSourceID.String())
+	mockOtherWorkspaceSource.WorkspaceID = 2233
+	mockPipelineID, _ := uuid.NewV1()
+	mockDependencies := []models.ResourceDependency{
+		{
+			ResourceType: "pipeline",
+			ResourceID:   mockPipelineID.String(),
+			Name:         utils.RandomString(5),
+		},
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		sourceID      string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Bad Source ID",
+
+			sourceID: "not a uuid",
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Source Not Found",
+
+			sourceID: mockSourceID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(mockSourceID.String()).Times(1).Return(models.Source{}, gorm.ErrRecordNotFound)
+				store.EXPECT().GetSourceDependencies(gomock.Any()).Times(0)
+				store.EXPECT().DeleteSource(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Source Of Another Workspace",
+
+			sourceID: mockSourceID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(mockSourceID.String()).Times(1).Return(mockOtherWorkspaceSource, nil)
+				store.EXPECT().GetSourceDependencies(gomock.Any()).Times(0)
+				store.EXPECT().DeleteSource(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Source doesn't exist",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Source In Use",
+
+			sourceID: mockSourceID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(mockSourceID.String()).Times(1).Return(mockSource, nil)
+				store.EXPECT().GetSourceDependencies(mockSourceID.String()).Times(1).Return(mockDependencies, nil)
+				store.EXPECT().DeleteSource(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Source is used by other resources",
+					Data:   mockDependencies}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			sourceID: mockSourceID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(mockSourceID.String()).Times(1).Return(mockSource, nil)
+				store.EXPECT().GetSourceDependencies(mockSourceID.String()).Times(1).
+					Return([]models.ResourceDependency{}, nil)
+				store.EXPECT().DeleteSource(mockSourceID.String()).Times(1).Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   "Source deleted successfully"}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, authServiceClient)
+			url := fmt.Sprintf("%ssources/%s/", test.BaseURL, testCase.sourceID)
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodDelete, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
 // TestGetConfiguredSource tests all the scenarios while getting the configured sources on airByte.
 func TestGetConfiguredSource(t *testing.T) {
 	mockSourceID, _ := uuid.NewV1()
@@ -783,12 +1072,12 @@ func TestGetConfiguredSource(t *testing.T) {
 	}
 }
 
-// TestGetSupportedSources tests all the scenarios while getting the supported sources.
-func TestGetSupportedSources(t *testing.T) {
-	mockSupportedSources := []models.SupportedSources{
-		createRandomSupportedSource(),
-		createRandomSupportedSource(),
-		createRandomSupportedSource()}
+// TestGetConfiguredSources tests all the scenarios while getting the sources configured in the workspace.
+func TestGetConfiguredSources(t *testing.T) {
+	mockSources := []models.WorkspaceSource{
+		createRandomWorkspaceSource(),
+		createRandomWorkspaceSource(),
+	}
 
 	testCaseSuite := []struct {
 		testScenario  string
@@ -799,7 +1088,7 @@ func TestGetSupportedSources(t *testing.T) {
 			testScenario: "InternalServerError",
 
 			buildStubs: func(store *mockStore.MockStore) {
-				store.EXPECT().GetSupportedSources().Times(1).Return([]models.SupportedSources{}, sql.ErrNoRows)
+				store.EXPECT().GetWorkspaceSources(1122).Times(1).Return([]models.WorkspaceSource{}, sql.ErrConnDone)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -810,7 +1099,7 @@ func TestGetSupportedSources(t *testing.T) {
 			testScenario: "Success",
 
 			buildStubs: func(store *mockStore.MockStore) {
-				store.EXPECT().GetSupportedSources().Times(1).Return(mockSupportedSources, nil)
+				store.EXPECT().GetWorkspaceSources(1122).Times(1).Return(mockSources, nil)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -819,7 +1108,7 @@ func TestGetSupportedSources(t *testing.T) {
 				res := models.Response{
 					Status: utils.SUCCESS,
 					Errors: "",
-					Data:   mockSupportedSources}
+					Data:   mockSources}
 				actual, e := json.Marshal(res)
 				require.NoError(t, e)
 				test.ReqResBodyMatcher(t, recorder.Body, actual)
@@ -840,11 +1129,10 @@ func TestGetSupportedSources(t *testing.T) {
 			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
 
 			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
-
 			authServiceClient := authService.NewClient(httpMockClient)
 
 			server := test.NewTestServer(test.SOURCE, store, airByte, authServiceClient)
-			url := test.BaseURL + "sources/"
+			url := test.BaseURL + "sources/configured/"
 			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
 			require.NoError(t, err)
 
@@ -853,82 +1141,1721 @@ func TestGetSupportedSources(t *testing.T) {
 	}
 }
 
-// TestGetSourceSpecifications tests all the scenarios while getting the source specifications.
-func TestGetSourceSpecifications(t *testing.T) {
-	mockSourceSpecification := createRandomSourceSpecification()
-	name := "randomDefinition"
-	SourceDefinitions := []models.SourceDefinition{createRandomSourceDefinition(name), createRandomSourceDefinition("random")}
-	mockSourceDefinitions := models.SourceDefinitions{SourceDefinitions: SourceDefinitions}
+// TestAttachSourceToPipeline tests all the scenarios while attaching an existing source to a pipeline.
+func TestAttachSourceToPipeline(t *testing.T) {
+	mockSourceID, _ := uuid.NewV1()
+	mockPipelineID, _ := uuid.NewV1()
+	mockConnectionID, _ := uuid.NewV1()
+	mockSource := createRandomSource(mockSourceID.String())
+	mockSource.WorkspaceID = 1122
+	mockRequest := models.AttachSourceRequest{PipelineID: mockPipelineID.String()}
 
 	testCaseSuite := []struct {
 		testScenario  string
-		query         string
-		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		body          interface{}
 		buildStubs    func(store *mockStore.MockStore)
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
 		{
-			testScenario: "BadGetSourceDefinitionsCall",
+			testScenario: "BadRequest",
 
-			query: mockSourceDefinitions.SourceDefinitions[0].Name,
+			body: map[string]interface{}{},
 
-			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
-				querier.EXPECT().GetSourceDefinitions().Times(1).Return(models.SourceDefinitions{}, errors.New("Bad Request"))
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(gomock.Any()).Times(0)
 			},
 
-			buildStubs: func(store *mockStore.MockStore) {},
-
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			testScenario: "BadGetSourceSpecificationsCall",
-
-			query: mockSourceDefinitions.SourceDefinitions[0].Name,
+			testScenario: "NotFound_OtherWorkspace",
 
-			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
-				querier.EXPECT().GetSourceDefinitions().Times(1).Return(mockSourceDefinitions, nil)
+			body: mockRequest,
 
-				arg := mockSourceDefinitions.SourceDefinitions[0].SourceDefinitionID
+			buildStubs: func(store *mockStore.MockStore) {
+				otherSource := mockSource
+				otherSource.WorkspaceID = 2233
 
-				querier.EXPECT().GetSourceSpecification(arg).Times(1).Return(models.SourceSpecification{}, errors.New("Bad Request"))
+				store.EXPECT().GetSource(mockSourceID.String()).Times(1).Return(otherSource, nil)
+				store.EXPECT().GetPipelineConnection(gomock.Any()).Times(0)
+				store.EXPECT().AttachSourceToPipeline(gomock.Any()).Times(0)
 			},
 
-			buildStubs: func(store *mockStore.MockStore) {},
-
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
 		{
-			testScenario: "Success",
-
-			query: mockSourceDefinitions.SourceDefinitions[0].Name,
+			testScenario: "NotFound_Source",
 
-			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
-				querier.EXPECT().GetSourceDefinitions().Times(1).Return(mockSourceDefinitions, nil)
-
-				arg := mockSourceDefinitions.SourceDefinitions[0].SourceDefinitionID
+			body: mockRequest,
 
-				querier.EXPECT().GetSourceSpecification(arg).Times(1).Return(mockSourceSpecification, nil)
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(mockSourceID.String()).Times(1).Return(models.Source{}, gorm.ErrRecordNotFound)
+				store.EXPECT().AttachSourceToPipeline(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Pipeline Already Has A Source",
+
+			body: mockRequest,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(mockSourceID.String()).Times(1).Return(mockSource, nil)
+				store.EXPECT().GetPipelineConnection(mockPipelineID.String()).Times(1).
+					Return(models.PipelineConnection{
+						ConnectionID: mockConnectionID.String(),
+						PipelineID:   mockPipelineID.String(),
+						SourceID:     utils.RandomString(5),
+						SourceName:   utils.RandomString(5),
+					}, nil)
+				store.EXPECT().AttachSourceToPipeline(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "Pipeline already has a source",
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			body: mockRequest,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(mockSourceID.String()).Times(1).Return(mockSource, nil)
+				store.EXPECT().GetPipelineConnection(mockPipelineID.String()).Times(1).
+					Return(models.PipelineConnection{}, nil)
+				store.EXPECT().AttachSourceToPipeline(models.Connection{
+					PipelineID: mockPipelineID.String(),
+					SourceID:   mockSourceID.String(),
+				}).Times(1).Return(models.Connection{
+					ConnectionID: mockConnectionID.String(),
+					PipelineID:   mockPipelineID.String(),
+					SourceID:     mockSourceID.String(),
+				}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.PipelineConnection{
+						ConnectionID: mockConnectionID.String(),
+						PipelineID:   mockPipelineID.String(),
+						SourceID:     mockSourceID.String(),
+						SourceName:   mockSource.SourceName,
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, authServiceClient)
+			url := fmt.Sprintf("%ssources/%s/pipelines/", test.BaseURL, mockSourceID.String())
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetSupportedSources tests all the scenarios while getting the supported sources.
+func TestGetSupportedSources(t *testing.T) {
+	mockSupportedSources := []models.SupportedSources{
+		createRandomSupportedSource(),
+		createRandomSupportedSource(),
+		createRandomSupportedSource()}
+	disabledSource := createRandomSupportedSource()
+	disabledSource.Enabled = false
+
+	testCaseSuite := []struct {
+		testScenario  string
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "InternalServerError",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSources(1122).Times(1).Return([]models.SupportedSources{}, sql.ErrNoRows)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSources(1122).Times(1).Return(mockSupportedSources, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockSupportedSources}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success_HidesDisabledSources",
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSources(1122).Times(1).
+					Return(append([]models.SupportedSources{disabledSource}, mockSupportedSources...), nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockSupportedSources}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, authServiceClient)
+			url := test.BaseURL + "sources/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestGetSourceSpecifications tests all the scenarios while getting the source specifications.
+func TestGetSourceSpecifications(t *testing.T) {
+	mockSourceSpecification := createRandomSourceSpecification()
+	name := "randomDefinition"
+	SourceDefinitions := []models.SourceDefinition{createRandomSourceDefinition(name), createRandomSourceDefinition("random")}
+	mockSourceDefinitions := models.SourceDefinitions{SourceDefinitions: SourceDefinitions}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadGetSourceDefinitionsCall",
+
+			query: mockSourceDefinitions.SourceDefinitions[0].Name,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinitions().Times(1).Return(models.SourceDefinitions{}, errors.New("Bad Request"))
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadGetSourceSpecificationsCall",
+
+			query: mockSourceDefinitions.SourceDefinitions[0].Name,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinitions().Times(1).Return(mockSourceDefinitions, nil)
+
+				arg := mockSourceDefinitions.SourceDefinitions[0].SourceDefinitionID
+
+				querier.EXPECT().GetSourceSpecification(arg).Times(1).Return(models.SourceSpecification{}, errors.New("Bad Request"))
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			query: mockSourceDefinitions.SourceDefinitions[0].Name,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinitions().Times(1).Return(mockSourceDefinitions, nil)
+
+				arg := mockSourceDefinitions.SourceDefinitions[0].SourceDefinitionID
+
+				querier.EXPECT().GetSourceSpecification(arg).Times(1).Return(mockSourceSpecification, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   mockSourceSpecification}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, authServiceClient)
+
+			query := make(map[string]string)
+			query["source"] = testCase.query
+
+			url := test.BaseURL + "sources/specification/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestDiscoverSourceSchema tests all the scenarios while discovering the source schema.
+func TestDiscoverSourceSchema(t *testing.T) {
+	randomSourceID := "randomSourceID"
+	mockRandomSource := createRandomSource(randomSourceID)
+	testCaseSuite := []struct {
+		testScenario  string
+		query         string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Couldn't get discovered source schema",
+
+			query: randomSourceID,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				arg := randomSourceID
+				store.EXPECT().GetSource(arg).Times(1).Return(models.Source{}, sql.ErrNoRows)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Bad Air Byte Response",
+
+			query: randomSourceID,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				arg := randomSourceID
+				store.EXPECT().GetSource(arg).Times(1).Return(mockRandomSource, nil)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				arg := mockRandomSource.AirbyteSourceID
+				querier.EXPECT().DiscoverSourceSchema(arg).Times(1).Return(models.SourceSchema{}, errors.New("AirByte Server is Down"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: errors.New("couldn't get discover source schema").Error(),
+					Data:   nil}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			query: randomSourceID,
+
+			buildStubs: func(store *mockStore.MockStore) {
+				arg := randomSourceID
+				store.EXPECT().GetSource(arg).Times(1).Return(mockRandomSource, nil)
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				arg := mockRandomSource.AirbyteSourceID
+				querier.EXPECT().DiscoverSourceSchema(arg).Times(1).Return(models.SourceSchema{}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data:   models.SourceSchema{}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+
+			authServiceClient := authService.NewClient(httpMockClient)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, authServiceClient)
+
+			query := make(map[string]string)
+			query["source_id"] = testCase.query
+
+			url := test.BaseURL + "sources/discover/schema/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestValidateSourceConfiguration tests all the scenarios while validating a source configuration.
+func TestValidateSourceConfiguration(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
+
+	testCaseSuite := []struct {
+		testScenario  string
+		body          models.ConfigValidationRequest
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_MissingConfiguration",
+
+			body: models.ConfigValidationRequest{DefinitionID: definitionID.String()},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinition(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_AirbyteError",
+
+			body: models.ConfigValidationRequest{
+				DefinitionID:            definitionID.String(),
+				ConnectionConfiguration: map[string]interface{}{"host": "db"},
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinition(definitionID.String()).Times(1).
+					Return(models.SourceDefinition{}, errors.New("request to airbyte was not successful"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_InvalidConfiguration",
+
+			body: models.ConfigValidationRequest{
+				DefinitionID:            definitionID.String(),
+				ConnectionConfiguration: map[string]interface{}{"host": "db", "port": 70000},
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinition(definitionID.String()).Times(1).
+					Return(models.SourceDefinition{DockerImageTag: "0.4.4"}, nil)
+				querier.EXPECT().GetSourceSpecification(definitionID.String()).Times(1).
+					Return(createConnectorSpecification(), nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := models.Response{
+					Status: utils.SUCCESS,
+					Errors: "",
+					Data: models.ConfigValidation{
+						DefinitionID: definitionID.String(),
+						Version:      "0.4.4",
+						Valid:        false,
+						Errors: []models.ConfigFieldError{
+							{Field: "port", Message: "must be less than or equal to 65536"},
+						},
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			url := test.BaseURL + "sources/validate/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestSetSourceAvailability tests all the scenarios while enabling or disabling a source for the workspace.
+func TestSetSourceAvailability(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
+	mockSupportedSource := createRandomSupportedSource()
+	enabled := false
+
+	testCaseSuite := []struct {
+		testScenario  string
+		role          string
+		definitionID  string
+		body          models.ConnectorAvailabilityRequest
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Forbidden_Editor",
+
+			role:         utils.USER_ROLE_EDITOR,
+			definitionID: definitionID.String(),
+			body:         models.ConnectorAvailabilityRequest{Enabled: &enabled},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().SaveWorkspaceConnector(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_InvalidDefinitionID",
+
+			role:         utils.USER_ROLE_ADMIN,
+			definitionID: "not-a-uuid",
+			body:         models.ConnectorAvailabilityRequest{Enabled: &enabled},
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_MissingEnabled",
+
+			role:         utils.USER_ROLE_ADMIN,
+			definitionID: definitionID.String(),
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_UnknownSource",
+
+			role:         utils.USER_ROLE_ADMIN,
+			definitionID: definitionID.String(),
+			body:         models.ConnectorAvailabilityRequest{Enabled: &enabled},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(1122, definitionID.String()).Times(1).
+					Return(models.SupportedSources{}, sql.ErrNoRows)
+				store.EXPECT().SaveWorkspaceConnector(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			role:         utils.USER_ROLE_ADMIN,
+			definitionID: definitionID.String(),
+			body:         models.ConnectorAvailabilityRequest{Enabled: &enabled},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(1122, definitionID.String()).Times(1).
+					Return(mockSupportedSource, nil)
+				store.EXPECT().SaveWorkspaceConnector(gomock.Any()).Times(1).
+					DoAndReturn(func(connector models.WorkspaceConnector) (models.WorkspaceConnector, error) {
+						require.Equal(t, 1122, connector.WorkspaceID)
+						require.Equal(t, utils.CONNECTOR_TYPE_SOURCE, connector.ConnectorType)
+						require.Equal(t, definitionID.String(), connector.DefinitionID)
+						require.False(t, connector.Enabled)
+						require.Equal(t, 1122, connector.UpdatedBy)
+
+						return connector, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res struct {
+					Data models.WorkspaceConnector `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, definitionID.String(), res.Data.DefinitionID)
+				require.False(t, res.Data.Enabled)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			withRole := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Header.Set("userRole", testCase.role)
+				server.ServeHTTP(w, r)
+			})
+
+			url := fmt.Sprintf("%ssources/definitions/%s/", test.BaseURL, testCase.definitionID)
+			expectedResp, err := test.MakeHttpRequest(withRole, http.MethodPut, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestReconcileSourceDefinitions tests all the scenarios while syncing the supported sources with airbyte.
+func TestReconcileSourceDefinitions(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
+	definition := models.SourceDefinition{
+		SourceDefinitionID: definitionID.String(),
+		Name:               "Postgres",
+		DockerRepository:   "airbyte/source-postgres",
+		DockerImageTag:     "0.4.4",
+		DocumentationURL:   "https://docs.airbyte.io/integrations/sources/postgres",
+		Icon:               "<svg></svg>",
+		SourceType:         "database",
+		ReleaseStage:       "generally_available",
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_AirbyteError",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinitions().Times(1).
+					Return(models.SourceDefinitions{}, errors.New("AirByte Server is Down"))
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().SyncSupportedSources(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_NoDefinitions",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinitions().Times(1).Return(models.SourceDefinitions{}, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().SyncSupportedSources(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				customDefinition := createRandomSourceDefinition("Billing API")
+				customDefinition.ReleaseStage = utils.AIRBYTE_RELEASE_STAGE_CUSTOM
+
+				querier.EXPECT().GetSourceDefinitions().Times(1).Return(models.SourceDefinitions{
+					SourceDefinitions: []models.SourceDefinition{definition, customDefinition},
+				}, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().SyncSupportedSources(gomock.Any()).Times(1).
+					DoAndReturn(func(sources []models.SupportedSources) (int64, error) {
+						require.Len(t, sources, 1)
+						require.Equal(t, definitionID, sources[0].ID)
+						require.Equal(t, definition.SourceDefinitionID, sources[0].AirbyteDefinitionID)
+						require.Equal(t, definition.Name, sources[0].Name)
+						require.Equal(t, definition.SourceType, sources[0].Type)
+						require.Equal(t, definition.DockerRepository, sources[0].DockerRepository)
+						require.Equal(t, definition.DockerImageTag, sources[0].DockerImageTag)
+						require.Equal(t, definition.ReleaseStage, sources[0].ReleaseStage)
+						require.Equal(t, definition.DocumentationURL, sources[0].DocumentationURL)
+						require.Equal(t, definition.Icon, sources[0].Icon)
+						require.True(t, *sources[0].IsActive)
+
+						return 2, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res struct {
+					Data models.ConnectorCatalogReport `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, 1, res.Data.Synced)
+				require.Equal(t, int64(2), res.Data.Deactivated)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			url := test.BaseURL + "sources/internal/definitions/reconcile/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestRegisterCustomSource tests all the scenarios while registering a custom source.
+func TestRegisterCustomSource(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
+	request := models.CustomConnectorRequest{
+		Name:             "Billing API",
+		DockerRepository: "registry.example.com/source-billing",
+		DockerImageTag:   "1.0.0",
+		DocumentationURL: "https://wiki.example.com/connectors/billing",
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		role          string
+		body          models.CustomConnectorRequest
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Forbidden_Editor",
+
+			role: utils.USER_ROLE_EDITOR,
+			body: request,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateCustomSourceDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_MissingImage",
+
+			role: utils.USER_ROLE_ADMIN,
+			body: models.CustomConnectorRequest{Name: request.Name, DocumentationURL: request.DocumentationURL},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateCustomSourceDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			role: utils.USER_ROLE_ADMIN,
+			body: request,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateCustomSourceDefinition(test.AirByteWorkspaceID, request).Times(1).
+					Return(models.SourceDefinition{
+						SourceDefinitionID: definitionID.String(),
+						Name:               request.Name,
+						DockerRepository:   request.DockerRepository,
+						DockerImageTag:     request.DockerImageTag,
+						DocumentationURL:   request.DocumentationURL,
+					}, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateSupportedSource(gomock.Any()).Times(1).
+					DoAndReturn(func(source models.SupportedSources) (models.SupportedSources, error) {
+						require.Equal(t, definitionID, source.ID)
+						require.Equal(t, definitionID.String(), source.AirbyteDefinitionID)
+						require.Equal(t, request.DockerRepository, source.DockerRepository)
+						require.Equal(t, request.DockerImageTag, source.DockerImageTag)
+						require.Equal(t, utils.AIRBYTE_RELEASE_STAGE_CUSTOM, source.ReleaseStage)
+						require.True(t, source.Custom)
+						require.Equal(t, 1122, source.WorkspaceID)
+
+						return source, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res struct {
+					Data models.SupportedSources `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, definitionID.String(), res.Data.AirbyteDefinitionID)
+				require.True(t, res.Data.Custom)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			withRole := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Header.Set("userRole", testCase.role)
+				server.ServeHTTP(w, r)
+			})
+
+			url := test.BaseURL + "sources/custom/"
+			expectedResp, err := test.MakeHttpRequest(withRole, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestUpgradeCustomSource tests all the scenarios while upgrading a custom source.
+func TestUpgradeCustomSource(t *testing.T) {
+	definitionID, _ := uuid.NewV1()
+	customSource := createRandomSupportedSource()
+	customSource.AirbyteDefinitionID = definitionID.String()
+	customSource.DockerImageTag = "1.0.0"
+	customSource.Custom = true
+	customSource.WorkspaceID = 1122
+	configuredSource := createRandomSource(utils.RandomString(8))
+
+	testCaseSuite := []struct {
+		testScenario  string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_NotCustom",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateSourceDefinition(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(1122, definitionID.String()).Times(1).
+					Return(createRandomSupportedSource(), nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Conflict_IncompatibleSource",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				gomock.InOrder(
+					querier.EXPECT().UpdateSourceDefinition(definitionID.String(), "2.0.0").Times(1).
+						Return(models.SourceDefinition{}, nil),
+					querier.EXPECT().UpdateSourceDefinition(definitionID.String(), "1.0.0").Times(1).
+						Return(models.SourceDefinition{}, nil),
+				)
+				querier.EXPECT().GetConfiguredSource(configuredSource.AirbyteSourceID).Times(1).
+					Return(models.ConfiguredSource{ConnectionConfiguration: map[string]interface{}{"host": "db"}}, nil)
+				querier.EXPECT().GetSourceDefinition(definitionID.String()).Times(1).
+					Return(models.SourceDefinition{DockerImageTag: utils.RandomString(6)}, nil)
+				querier.EXPECT().GetSourceSpecification(definitionID.String()).Times(1).
+					Return(createConnectorSpecification(), nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(1122, definitionID.String()).Times(1).Return(customSource, nil)
+				store.EXPECT().GetSourcesByDefinition(1122, definitionID.String()).Times(1).
+					Return([]models.Source{configuredSource}, nil)
+				store.EXPECT().UpdateSupportedSourceVersion(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+
+				res := models.Response{
+					Status: utils.ERROR,
+					Errors: "1 sources of the workspace are incompatible with version 2.0.0",
+					Data: models.CustomConnectorUpgrade{
+						DefinitionID:    definitionID.String(),
+						PreviousVersion: "1.0.0",
+						Version:         "2.0.0",
+						Upgraded:        false,
+						Incompatible: []models.IncompatibleConnector{{
+							ID:     configuredSource.SourceID,
+							Name:   configuredSource.SourceName,
+							Errors: []models.ConfigFieldError{{Field: "port", Message: "is required"}},
+						}},
+					}}
+				actual, e := json.Marshal(res)
+				require.NoError(t, e)
+				test.ReqResBodyMatcher(t, recorder.Body, actual)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().UpdateSourceDefinition(definitionID.String(), "2.0.0").Times(1).
+					Return(models.SourceDefinition{}, nil)
+				querier.EXPECT().GetConfiguredSource(configuredSource.AirbyteSourceID).Times(1).
+					Return(models.ConfiguredSource{
+						ConnectionConfiguration: map[string]interface{}{"host": "db", "port": 5432},
+					}, nil)
+				querier.EXPECT().GetSourceDefinition(definitionID.String()).Times(1).
+					Return(models.SourceDefinition{DockerImageTag: utils.RandomString(6)}, nil)
+				querier.EXPECT().GetSourceSpecification(definitionID.String()).Times(1).
+					Return(createConnectorSpecification(), nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSupportedSource(1122, definitionID.String()).Times(1).Return(customSource, nil)
+				store.EXPECT().GetSourcesByDefinition(1122, definitionID.String()).Times(1).
+					Return([]models.Source{configuredSource}, nil)
+				store.EXPECT().UpdateSupportedSourceVersion(definitionID.String(), "2.0.0").Times(1).Return(nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res struct {
+					Data models.CustomConnectorUpgrade `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.True(t, res.Data.Upgraded)
+				require.Equal(t, "2.0.0", res.Data.Version)
+				require.Empty(t, res.Data.Incompatible)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			body, e := json.Marshal(models.CustomConnectorUpgradeRequest{DockerImageTag: "2.0.0"})
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			asAdmin := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Header.Set("userRole", utils.USER_ROLE_ADMIN)
+				server.ServeHTTP(w, r)
+			})
+
+			url := fmt.Sprintf("%ssources/custom/%s/", test.BaseURL, definitionID)
+			expectedResp, err := test.MakeHttpRequest(asAdmin, http.MethodPut, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+//createRandomConnectionSummary populates and return the ConnectionSummary model with random values.
+// TestStartSourceOAuth tests all the scenarios while starting the OAuth consent of a source.
+func TestStartSourceOAuth(t *testing.T) {
+	allowedOrigins := env.Env.OAuthAllowedOrigins
+	env.Env.OAuthAllowedOrigins = []string{"https://app.example.com/"}
+
+	defer func() {
+		env.Env.OAuthAllowedOrigins = allowedOrigins
+	}()
+
+	definitionID, _ := uuid.NewV1()
+	request := models.OAuthConsentRequest{
+		SourceDefinitionID: definitionID.String(),
+		RedirectURL:        "https://app.example.com/sources/oauth",
+		OAuthInputConfiguration: map[string]interface{}{
+			"spreadsheet_id": "1yXz",
+		},
+	}
+	consentURL := "https://accounts.google.com/o/oauth2/v2/auth?client_id=client&state=b4d2c1"
+
+	testCaseSuite := []struct {
+		testScenario  string
+		body          models.OAuthConsentRequest
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_RedirectURLOnOtherOrigin",
+
+			body: models.OAuthConsentRequest{
+				SourceDefinitionID: definitionID.String(),
+				RedirectURL:        "https://app.example.com.attacker.io/sources/oauth",
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceConsentURL(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateOAuthFlow(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_MissingRedirectURL",
+
+			body: models.OAuthConsentRequest{SourceDefinitionID: definitionID.String()},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceConsentURL(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().CreateOAuthFlow(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_NoOAuth",
+
+			body: request,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceSpecification(definitionID.String()).Times(1).
+					Return(createConnectorSpecification(), nil)
+				querier.EXPECT().GetSourceConsentURL(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				stubEnabledConnectors(store)
+				store.EXPECT().CreateOAuthFlow(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_SourceOfOtherDefinition",
+
+			body: models.OAuthConsentRequest{
+				SourceDefinitionID: definitionID.String(),
+				SourceID:           "a152379e-01a1-11ec-82d6-a312edcd9c7b",
+				RedirectURL:        request.RedirectURL,
+			},
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceConsentURL(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				source := createRandomSource("a152379e-01a1-11ec-82d6-a312edcd9c7b")
+				source.WorkspaceID = 1122
+				store.EXPECT().GetSource("a152379e-01a1-11ec-82d6-a312edcd9c7b").Times(1).Return(source, nil)
+				store.EXPECT().CreateOAuthFlow(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			body: request,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceSpecification(definitionID.String()).Times(1).
+					Return(createOAuthSpecification(), nil)
+				querier.EXPECT().GetSourceConsentURL(models.SourceOAuthRequestAirbyte{
+					SourceDefinitionID:      definitionID.String(),
+					WorkspaceID:             test.AirByteWorkspaceID,
+					RedirectURL:             env.Env.OAuthRedirectURL,
+					OAuthInputConfiguration: request.OAuthInputConfiguration,
+				}).Times(1).Return(consentURL, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				stubEnabledConnectors(store)
+				store.EXPECT().CreateOAuthFlow(gomock.Any()).Times(1).
+					DoAndReturn(func(flow models.OAuthFlow) (models.OAuthFlow, error) {
+						require.Equal(t, "b4d2c1", flow.State)
+						require.Equal(t, utils.OAUTH_FLOW_STATUS_PENDING, flow.Status)
+						require.Equal(t, request.RedirectURL, flow.RedirectURL)
+						require.Equal(t, test.AirByteWorkspaceID, flow.AirbyteWorkspaceID)
+						require.Equal(t, 1122, flow.WorkspaceID)
+						require.Nil(t, flow.SourceID)
+						require.JSONEq(t, `{"spreadsheet_id": "1yXz"}`, string(flow.OAuthInputConfiguration))
+
+						flow.FlowID, _ = uuid.NewV1()
+
+						return flow, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res struct {
+					Data models.OAuthConsent `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, consentURL, res.Data.ConsentURL)
+				require.NotEqual(t, uuid.Nil, res.Data.FlowID)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			body, e := json.Marshal(testCase.body)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			url := test.BaseURL + "sources/oauth/consent/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestCompleteSourceOAuth tests all the scenarios while handling the callback of an OAuth provider.
+func TestCompleteSourceOAuth(t *testing.T) {
+	allowedOrigins := env.Env.OAuthAllowedOrigins
+	env.Env.OAuthAllowedOrigins = []string{"https://app.example.com"}
+
+	defer func() {
+		env.Env.OAuthAllowedOrigins = allowedOrigins
+	}()
+
+	flowID, _ := uuid.NewV1()
+	definitionID, _ := uuid.NewV1()
+	sourceID := "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+	tokens := map[string]interface{}{"access_token": "ya29.a0", "refresh_token": "1//0g"}
+
+	pendingFlow := func() models.OAuthFlow {
+		return models.OAuthFlow{
+			FlowID:                  flowID,
+			State:                   "b4d2c1",
+			SourceDefinitionID:      definitionID.String(),
+			RedirectURL:             "https://app.example.com/sources/oauth?tab=sources",
+			Status:                  utils.OAUTH_FLOW_STATUS_PENDING,
+			OAuthInputConfiguration: []byte(`{}`),
+			WorkspaceID:             1122,
+			AirbyteWorkspaceID:      test.AirByteWorkspaceID,
+		}
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "NotFound_UnknownState",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CompleteSourceOAuth(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetOAuthFlowByState("b4d2c1").Times(1).Return(models.OAuthFlow{}, sql.ErrNoRows)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Conflict_Completed",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CompleteSourceOAuth(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				flow := pendingFlow()
+				flow.Status = utils.OAUTH_FLOW_STATUS_COMPLETED
+				store.EXPECT().GetOAuthFlowByState("b4d2c1").Times(1).Return(flow, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_RedirectURLOnOtherOrigin",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CompleteSourceOAuth(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				flow := pendingFlow()
+				flow.RedirectURL = "https://attacker.io/sources/oauth"
+				store.EXPECT().GetOAuthFlowByState("b4d2c1").Times(1).Return(flow, nil)
+				store.EXPECT().UpdateOAuthFlow(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Empty(t, recorder.Header().Get("Location"))
+			},
+		},
+		{
+			testScenario: "Failed_ConsentRefused",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CompleteSourceOAuth(gomock.Any()).Times(1).
+					Return(nil, errors.New("access_denied"))
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetOAuthFlowByState("b4d2c1").Times(1).Return(pendingFlow(), nil)
+				store.EXPECT().UpdateOAuthFlow(gomock.Any(), utils.OAUTH_FLOW_STATUS_PENDING).Times(1).
+					DoAndReturn(func(flow models.OAuthFlow, fromStatus string) error {
+						require.Equal(t, utils.OAUTH_FLOW_STATUS_FAILED, flow.Status)
+						require.Equal(t, "access_denied", flow.Error)
+						require.Empty(t, flow.Output)
+
+						return nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusFound, recorder.Code)
+				require.Equal(t, "https://app.example.com/sources/oauth?flowId="+flowID.String()+
+					"&status=failed&tab=sources", recorder.Header().Get("Location"))
+			},
+		},
+		{
+			testScenario: "Success_NewSource",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CompleteSourceOAuth(models.SourceOAuthRequestAirbyte{
+					SourceDefinitionID:      definitionID.String(),
+					WorkspaceID:             test.AirByteWorkspaceID,
+					RedirectURL:             env.Env.OAuthRedirectURL,
+					QueryParams:             map[string]interface{}{"code": "4/0Ad", "state": "b4d2c1"},
+					OAuthInputConfiguration: map[string]interface{}{},
+				}).Times(1).Return(tokens, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetOAuthFlowByState("b4d2c1").Times(1).Return(pendingFlow(), nil)
+				store.EXPECT().UpdateOAuthFlow(gomock.Any(), utils.OAUTH_FLOW_STATUS_PENDING).Times(1).
+					DoAndReturn(func(flow models.OAuthFlow, fromStatus string) error {
+						require.Equal(t, utils.OAUTH_FLOW_STATUS_COMPLETED, flow.Status)
+						require.JSONEq(t, `{"access_token": "ya29.a0", "refresh_token": "1//0g"}`, string(flow.Output))
+						require.NotZero(t, flow.CompletedAt)
+
+						return nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusFound, recorder.Code)
+				require.Equal(t, "https://app.example.com/sources/oauth?flowId="+flowID.String()+
+					"&status=completed&tab=sources", recorder.Header().Get("Location"))
+				require.NotContains(t, recorder.Header().Get("Location"), "ya29")
+			},
+		},
+		{
+			testScenario: "Success_ReauthorizedSource",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				configuredSource := createRandomConfiguredSource(sourceID)
+				configuredSource.ConnectionConfiguration = map[string]interface{}{
+					"spreadsheet_id": "1yXz",
+					"credentials":    map[string]interface{}{"auth_type": "Client", "refresh_token": "expired"},
+				}
+
+				querier.EXPECT().CompleteSourceOAuth(gomock.Any()).Times(1).Return(tokens, nil)
+				querier.EXPECT().GetConfiguredSource(gomock.Any()).Times(1).Return(configuredSource, nil)
+				querier.EXPECT().GetSourceSpecification(definitionID.String()).Times(1).
+					Return(createOAuthSpecification(), nil)
+				querier.EXPECT().EditSourceConnectorOnAirByte(gomock.Any()).Times(1).
+					DoAndReturn(func(request models.EditSourceConnectorRequestAirByte) (
+						models.CreateSourceConnectorResponseAirbyte, error) {
+						configuration, _ := json.Marshal(request.ConnectionConfiguration)
+						require.JSONEq(t, `{
+							"spreadsheet_id": "1yXz",
+							"credentials": {
+								"auth_type": "Client",
+								"access_token": "ya29.a0",
+								"refresh_token": "1//0g"
+							}
+						}`, string(configuration))
+
+						return models.CreateSourceConnectorResponseAirbyte{}, nil
+					})
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				flow := pendingFlow()
+				flow.SourceID = &sourceID
+
+				source := createRandomSource(sourceID)
+				source.AirbyteSourceDefinitionID = definitionID.String()
+
+				store.EXPECT().GetOAuthFlowByState("b4d2c1").Times(1).Return(flow, nil)
+				store.EXPECT().GetSource(sourceID).Times(1).Return(source, nil)
+				store.EXPECT().ResolveOAuthTokenFailures(sourceID).Times(1).Return(nil)
+				store.EXPECT().UpdateOAuthFlow(gomock.Any(), utils.OAUTH_FLOW_STATUS_PENDING).Times(1).
+					DoAndReturn(func(flow models.OAuthFlow, fromStatus string) error {
+						require.Equal(t, utils.OAUTH_FLOW_STATUS_CONSUMED, flow.Status)
+						require.Empty(t, flow.Output)
+
+						return nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusFound, recorder.Code)
+				require.Contains(t, recorder.Header().Get("Location"), "status=consumed")
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			url := test.BaseURL + "sources/oauth/callback/"
+			query := map[string]string{"code": "4/0Ad", "state": "b4d2c1"}
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, query, nil)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestConfigureSourceWithOAuthFlow tests all the scenarios while configuring a source with the tokens of an OAuth
+// flow.
+func TestConfigureSourceWithOAuthFlow(t *testing.T) {
+	flowID, _ := uuid.NewV1()
+	definitionID, _ := uuid.NewV1()
+	sourceID := "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+
+	request := createRandomSourceConnectorRequestAPI(definitionID.String())
+	request.Pipeline = ""
+	request.OAuthFlowID = flowID.String()
+	request.ConnectionConfiguration = map[string]interface{}{"spreadsheet_id": "1yXz"}
+
+	completedFlow := func() models.OAuthFlow {
+		return models.OAuthFlow{
+			FlowID:             flowID,
+			SourceDefinitionID: definitionID.String(),
+			Status:             utils.OAUTH_FLOW_STATUS_COMPLETED,
+			Output:             []byte(`{"access_token": "ya29.a0", "refresh_token": "1//0g"}`),
+			WorkspaceID:        1122,
+		}
+	}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "Conflict_FlowPending",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				flow := completedFlow()
+				flow.Status = utils.OAUTH_FLOW_STATUS_PENDING
+
+				stubEnabledConnectors(store)
+				store.EXPECT().GetOAuthFlow(flowID).Times(1).Return(flow, nil)
+				store.EXPECT().CreateSource(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			testScenario: "BadRequest_FlowOfOtherWorkspace",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				flow := completedFlow()
+				flow.WorkspaceID = 7
+
+				stubEnabledConnectors(store)
+				store.EXPECT().GetOAuthFlow(flowID).Times(1).Return(flow, nil)
+				store.EXPECT().CreateSource(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetSourceDefinition(gomock.Any()).AnyTimes().Return(models.SourceDefinition{}, nil)
+				querier.EXPECT().GetSourceSpecification(definitionID.String()).AnyTimes().
+					Return(createOAuthSpecification(), nil)
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(1).
+					DoAndReturn(func(requestBody map[string]interface{}) error {
+						configuration, _ := json.Marshal(requestBody["connectionConfiguration"])
+						require.JSONEq(t, `{
+							"spreadsheet_id": "1yXz",
+							"credentials": {
+								"auth_type": "Client",
+								"access_token": "ya29.a0",
+								"refresh_token": "1//0g"
+							}
+						}`, string(configuration))
+
+						return nil
+					})
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(1).
+					Return(models.CreateSourceConnectorResponseAirbyte{}, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				stubEnabledConnectors(store)
+				store.EXPECT().GetOAuthFlow(flowID).Times(1).Return(completedFlow(), nil)
+				store.EXPECT().CreateSource(gomock.Any()).Times(1).Return(createRandomSource(sourceID), nil)
+				store.EXPECT().UpdateOAuthFlow(gomock.Any(), utils.OAUTH_FLOW_STATUS_COMPLETED).Times(1).
+					DoAndReturn(func(flow models.OAuthFlow, fromStatus string) error {
+						require.Equal(t, utils.OAUTH_FLOW_STATUS_CONSUMED, flow.Status)
+						require.Equal(t, sourceID, *flow.SourceID)
+						require.Empty(t, flow.Output)
+
+						return nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			body, e := json.Marshal(request)
+			require.NoError(t, e)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			url := test.BaseURL + "sources/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, body)
+			require.NoError(t, err)
+
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
+
+// TestCheckOAuthTokens tests the check of the tokens of the sources authorized through OAuth.
+func TestCheckOAuthTokens(t *testing.T) {
+	healthySource := models.OAuthSource{SourceID: "a152379e-01a1-11ec-82d6-a312edcd9c7b", AirbyteSourceID: "healthy",
+		WorkspaceID: 1122}
+	revokedSource := models.OAuthSource{SourceID: "b152379e-01a1-11ec-82d6-a312edcd9c7b", AirbyteSourceID: "revoked",
+		WorkspaceID: 1122}
+
+	testCaseSuite := []struct {
+		testScenario  string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "InternalServerError_Airbyte",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetConfiguredSource("healthy").Times(1).
+					Return(models.ConfiguredSource{}, errors.New("airbyte is unreachable"))
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetOAuthSources().Times(1).Return([]models.OAuthSource{healthySource}, nil)
+				store.EXPECT().SaveOAuthTokenFailure(gomock.Any()).Times(0)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().GetConfiguredSource("healthy").Times(1).
+					Return(models.ConfiguredSource{ConnectionConfiguration: "healthy"}, nil)
+				querier.EXPECT().GetConfiguredSource("revoked").Times(1).
+					Return(models.ConfiguredSource{ConnectionConfiguration: "revoked"}, nil)
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(2).
+					DoAndReturn(func(requestBody map[string]interface{}) error {
+						if requestBody["connectionConfiguration"] == "revoked" {
+							return errors.New("invalid_grant: Token has been expired or revoked.")
+						}
+
+						return nil
+					})
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetOAuthSources().Times(1).
+					Return([]models.OAuthSource{healthySource, revokedSource}, nil)
+				store.EXPECT().ResolveOAuthTokenFailures(healthySource.SourceID).Times(1).Return(nil)
+				store.EXPECT().SaveOAuthTokenFailure(gomock.Any()).Times(1).
+					DoAndReturn(func(failure models.OAuthTokenFailure) (models.OAuthTokenFailure, error) {
+						require.Equal(t, revokedSource.SourceID, failure.SourceID)
+						require.Equal(t, "invalid_grant: Token has been expired or revoked.", failure.Error)
+						require.Equal(t, 1122, failure.WorkspaceID)
+						require.NotZero(t, failure.DetectedAt)
+
+						return failure, nil
+					})
 			},
 
-			buildStubs: func(store *mockStore.MockStore) {},
-
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 
-				res := models.Response{
-					Status: utils.SUCCESS,
-					Errors: "",
-					Data:   mockSourceSpecification}
-				actual, e := json.Marshal(res)
-				require.NoError(t, e)
-				test.ReqResBodyMatcher(t, recorder.Body, actual)
+				var res struct {
+					Data models.OAuthTokenCheckReport `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, 2, res.Data.Checked)
+				require.Equal(t, []string{revokedSource.SourceID}, res.Data.Failed)
 			},
 		},
 	}
+
 	for i := range testCaseSuite {
 		testCase := testCaseSuite[i]
 
@@ -942,17 +2869,9 @@ func TestGetSourceSpecifications(t *testing.T) {
 			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
 			testCase.queryAirByte(airByte)
 
-			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
-
-			authServiceClient := authService.NewClient(httpMockClient)
-
-			server := test.NewTestServer(test.SOURCE, store, airByte, authServiceClient)
-
-			query := make(map[string]string)
-			query["source"] = testCase.query
-
-			url := test.BaseURL + "sources/specification/"
-			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, query, nil)
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			url := test.BaseURL + "sources/internal/oauth/check/"
+			expectedResp, err := test.MakeHttpRequest(server, http.MethodPost, url, nil, nil)
 			require.NoError(t, err)
 
 			testCase.checkResponse(expectedResp)
@@ -960,84 +2879,251 @@ func TestGetSourceSpecifications(t *testing.T) {
 	}
 }
 
-// TestDiscoverSourceSchema tests all the scenarios while discovering the source schema.
-func TestDiscoverSourceSchema(t *testing.T) {
-	randomSourceID := "randomSourceID"
-	mockRandomSource := createRandomSource(randomSourceID)
+// TestUploadFileSource tests all the scenarios while creating a source from an uploaded file.
+func TestUploadFileSource(t *testing.T) {
+	uploadDirectory := t.TempDir()
+
+	fileStore, directory, maxSizeMB := env.Env.FileStore, env.Env.FileUploadDirectory, env.Env.FileUploadMaxSizeMB
+	env.Env.FileStore, env.Env.FileUploadDirectory, env.Env.FileUploadMaxSizeMB = utils.FILE_STORE_LOCAL, uploadDirectory, 1
+
+	defer func() {
+		env.Env.FileStore, env.Env.FileUploadDirectory, env.Env.FileUploadMaxSizeMB = fileStore, directory, maxSizeMB
+	}()
+
+	sourceID := "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+	orders := []byte("order_id,amount,paid,note\n1,10.5,true,first\n2,7,false,\n")
+
+	var parquetFile bytes.Buffer
+	parquetWriter, _ := utils.NewParquetWriter(&parquetFile, []string{"order_id", "note"})
+	_ = parquetWriter.WriteRow([]*string{nil, nil})
+	_ = parquetWriter.Close()
+
 	testCaseSuite := []struct {
 		testScenario  string
-		query         string
+		name          string
+		fileName      string
+		content       []byte
 		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
 		buildStubs    func(store *mockStore.MockStore)
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
 		{
-			testScenario: "Couldn't get discovered source schema",
+			testScenario: "BadRequest_MissingName",
 
-			query: randomSourceID,
+			fileName: "orders.csv",
+			content:  orders,
 
-			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {},
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "RequestEntityTooLarge_BodyOverLimit",
+
+			name:     "orders",
+			fileName: "orders.csv",
+			content:  bytes.Repeat([]byte("1,10.5,true,first\n"), 3<<20/18),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(0)
+			},
 
 			buildStubs: func(store *mockStore.MockStore) {
-				arg := randomSourceID
-				store.EXPECT().GetSource(arg).Times(1).Return(models.Source{}, sql.ErrNoRows)
+				store.EXPECT().CreateFileUpload(gomock.Any()).Times(0)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
 			},
 		},
 		{
-			testScenario: "Bad Air Byte Response",
+			testScenario: "BadRequest_UnsupportedFile",
 
-			query: randomSourceID,
+			name:     "orders",
+			fileName: "orders.txt",
+			content:  orders,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(0)
+			},
 
 			buildStubs: func(store *mockStore.MockStore) {
-				arg := randomSourceID
-				store.EXPECT().GetSource(arg).Times(1).Return(mockRandomSource, nil)
+				stubEnabledConnectors(store)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
+		},
+		{
+			testScenario: "BadRequest_MalformedJSONLines",
+
+			name:     "orders",
+			fileName: "orders.jsonl",
+			content:  []byte("{\"order_id\": 1}\n[1, 2]\n"),
 
 			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
-				arg := mockRandomSource.AirbyteSourceID
-				querier.EXPECT().DiscoverSourceSchema(arg).Times(1).Return(models.SourceSchema{}, errors.New("AirByte Server is Down"))
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				stubEnabledConnectors(store)
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
-				res := models.Response{
-					Status: utils.ERROR,
-					Errors: errors.New("couldn't get discover source schema").Error(),
-					Data:   nil}
-				actual, e := json.Marshal(res)
-				require.NoError(t, e)
-				test.ReqResBodyMatcher(t, recorder.Body, actual)
 			},
 		},
 		{
-			testScenario: "Success",
+			testScenario: "Success_CSV",
 
-			query: randomSourceID,
+			name:     "Orders 2024",
+			fileName: "Orders 2024.csv",
+			content:  orders,
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(1).Return(nil)
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(1).
+					DoAndReturn(func(request models.CreateSourceConnectorRequestAirbyte) (
+						models.CreateSourceConnectorResponseAirbyte, error) {
+						configuration, _ := request.ConnectionConfiguration.(map[string]interface{})
+						require.Equal(t, utils.AIRBYTE_CSV_SOURCE_DEFINITION_ID, request.AirbyteSourceDefinitionId)
+						require.Equal(t, "orders_2024", configuration["dataset_name"])
+						require.Equal(t, utils.FILE_FORMAT_CSV, configuration["format"])
+						require.Equal(t, map[string]interface{}{"storage": "local"}, configuration["provider"])
+						require.Regexp(t, `^/local/uploads/1122/[0-9a-f-]{36}/orders_2024\.csv$`, configuration["url"])
+
+						stored, err := os.ReadFile(filepath.Join(uploadDirectory,
+							strings.TrimPrefix(configuration["url"].(string), "/local/")))
+						require.NoError(t, err)
+						require.Equal(t, orders, stored)
+
+						return models.CreateSourceConnectorResponseAirbyte{SourceName: request.Name}, nil
+					})
+			},
 
 			buildStubs: func(store *mockStore.MockStore) {
-				arg := randomSourceID
-				store.EXPECT().GetSource(arg).Times(1).Return(mockRandomSource, nil)
+				stubEnabledConnectors(store)
+				store.EXPECT().CreateSource(gomock.Any()).Times(1).
+					DoAndReturn(func(source models.Source) (models.Source, error) {
+						source.SourceID = sourceID
+
+						return source, nil
+					})
+				store.EXPECT().CreateFileUpload(gomock.Any()).Times(1).
+					DoAndReturn(func(upload models.FileUpload) (models.FileUpload, error) {
+						require.Equal(t, sourceID, upload.SourceID)
+						require.Equal(t, 1, upload.Version)
+						require.Equal(t, "Orders 2024.csv", upload.FileName)
+						require.Equal(t, int64(len(orders)), upload.Size)
+						require.Len(t, upload.Checksum, 64)
+
+						return upload, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res struct {
+					Data models.FileSourceUpload `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, sourceID, res.Data.SourceID)
+				require.Equal(t, []models.FileColumn{
+					{Name: "order_id", Type: "integer"},
+					{Name: "amount", Type: "number"},
+					{Name: "paid", Type: "boolean"},
+					{Name: "note", Type: "string"},
+				}, res.Data.Preview.Columns)
+				require.Len(t, res.Data.Preview.Rows, 2)
 			},
+		},
+		{
+			testScenario: "Success_Parquet",
+
+			name:     "orders",
+			fileName: "orders.parquet",
+			content:  parquetFile.Bytes(),
 
 			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
-				arg := mockRandomSource.AirbyteSourceID
-				querier.EXPECT().DiscoverSourceSchema(arg).Times(1).Return(models.SourceSchema{}, nil)
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(1).Return(nil)
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(1).
+					Return(models.CreateSourceConnectorResponseAirbyte{}, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				stubEnabledConnectors(store)
+				store.EXPECT().CreateSource(gomock.Any()).Times(1).Return(createRandomSource(sourceID), nil)
+				store.EXPECT().CreateFileUpload(gomock.Any()).Times(1).
+					DoAndReturn(func(upload models.FileUpload) (models.FileUpload, error) {
+						return upload, nil
+					})
 			},
 
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, http.StatusCreated, recorder.Code)
 
-				res := models.Response{
-					Status: utils.SUCCESS,
-					Errors: "",
-					Data:   models.SourceSchema{}}
-				actual, e := json.Marshal(res)
-				require.NoError(t, e)
-				test.ReqResBodyMatcher(t, recorder.Body, actual)
+				var res struct {
+					Data models.FileSourceUpload `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, utils.FILE_FORMAT_PARQUET, res.Data.Format)
+				require.Equal(t, []models.FileColumn{
+					{Name: "order_id", Type: "string"},
+					{Name: "note", Type: "string"},
+				}, res.Data.Preview.Columns)
+				require.Equal(t, int64(1), *res.Data.Preview.NumRows)
+			},
+		},
+		{
+			testScenario: "Success_Excel",
+
+			name:     "orders",
+			fileName: "orders.xlsx",
+			content: createWorkbook(t, `<sst><si><t>order_id</t></si><si><t>customer</t></si><si><t>acme</t></si></sst>`,
+				`<worksheet><sheetData>
+					<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+					<row r="2"><c r="A2"><v>7</v></c><c r="B2" t="s"><v>2</v></c></row>
+					<row r="3"><c r="B3" t="inlineStr"><is><t>globex</t></is></c></row>
+				</sheetData></worksheet>`),
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(1).Return(nil)
+				querier.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(1).
+					Return(models.CreateSourceConnectorResponseAirbyte{}, nil)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				stubEnabledConnectors(store)
+				store.EXPECT().CreateSource(gomock.Any()).Times(1).Return(createRandomSource(sourceID), nil)
+				store.EXPECT().CreateFileUpload(gomock.Any()).Times(1).
+					DoAndReturn(func(upload models.FileUpload) (models.FileUpload, error) {
+						return upload, nil
+					})
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res struct {
+					Data models.FileSourceUpload `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, []models.FileColumn{
+					{Name: "order_id", Type: "integer"},
+					{Name: "customer", Type: "string"},
+				}, res.Data.Preview.Columns)
+				require.Equal(t, []map[string]interface{}{
+					{"order_id": "7", "customer": "acme"},
+					{"order_id": "", "customer": "globex"},
+				}, res.Data.Preview.Rows)
 			},
 		},
 	}
@@ -1055,17 +3141,213 @@ func TestDiscoverSourceSchema(t *testing.T) {
 			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
 			testCase.queryAirByte(airByte)
 
-			httpMockClient := mock_authservice.NewMockHttpClient(ctrl)
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			url := test.BaseURL + "sources/files/"
+			expectedResp, err := makeUploadRequest(server, url, map[string]string{"name": testCase.name},
+				testCase.fileName, testCase.content)
+			require.NoError(t, err)
 
-			authServiceClient := authService.NewClient(httpMockClient)
+			testCase.checkResponse(expectedResp)
+		})
+	}
+}
 
-			server := test.NewTestServer(test.SOURCE, store, airByte, authServiceClient)
+// TestUploadFileSourceToS3 tests that an uploaded file is stored in an S3 compatible object store.
+func TestUploadFileSourceToS3(t *testing.T) {
+	content := []byte("{\"order_id\": 1, \"tags\": [\"new\"]}\n{\"order_id\": 2.5, \"paid\": true}\n")
+
+	var storedKey string
+
+	var storedContent []byte
+
+	objectStore := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Regexp(t, `^AWS4-HMAC-SHA256 Credential=access/\d{8}/eu-west-1/s3/aws4_request, `+
+			`SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=[0-9a-f]{64}$`, r.Header.Get("Authorization"))
+
+		storedKey = r.URL.Path
+		storedContent, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer objectStore.Close()
+
+	previousEnv := *env.Env
+	env.Env.FileStore = utils.FILE_STORE_S3
+	env.Env.FileStoreS3Endpoint = objectStore.URL
+	env.Env.FileStoreS3Bucket = "uploads"
+	env.Env.FileStoreS3Region = "eu-west-1"
+	env.Env.FileStoreS3AccessKeyID = "access"
+	env.Env.FileStoreS3SecretKey = "secret"
+
+	defer func() {
+		*env.Env = previousEnv
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockStore.NewMockStore(ctrl)
+	stubEnabledConnectors(store)
+	store.EXPECT().CreateSource(gomock.Any()).Times(1).
+		Return(createRandomSource("a152379e-01a1-11ec-82d6-a312edcd9c7b"), nil)
+	store.EXPECT().CreateFileUpload(gomock.Any()).Times(1).
+		DoAndReturn(func(upload models.FileUpload) (models.FileUpload, error) {
+			return upload, nil
+		})
 
-			query := make(map[string]string)
-			query["source_id"] = testCase.query
+	airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+	airByte.EXPECT().CheckSourceConnection(gomock.Any()).Times(1).Return(nil)
+	airByte.EXPECT().CreateSourceConnectorOnAirByte(gomock.Any()).Times(1).
+		DoAndReturn(func(request models.CreateSourceConnectorRequestAirbyte) (
+			models.CreateSourceConnectorResponseAirbyte, error) {
+			configuration, _ := request.ConnectionConfiguration.(map[string]interface{})
+			require.Equal(t, "s3://uploads"+strings.TrimPrefix(storedKey, "/uploads"), configuration["url"])
+			require.Equal(t, map[string]interface{}{
+				"storage":               "S3",
+				"aws_access_key_id":     "access",
+				"aws_secret_access_key": "secret",
+			}, configuration["provider"])
+
+			return models.CreateSourceConnectorResponseAirbyte{}, nil
+		})
 
-			url := test.BaseURL + "sources/discover/schema/"
-			expectedResp, err := test.MakeHttpRequest(server, http.MethodGet, url, query, nil)
+	server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+	url := test.BaseURL + "sources/files/"
+	recorder, err := makeUploadRequest(server, url, map[string]string{"name": "orders"}, "orders.jsonl", content)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	require.Equal(t, content, storedContent)
+
+	var res struct {
+		Data models.FileSourceUpload `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	require.Equal(t, []models.FileColumn{
+		{Name: "order_id", Type: "number"},
+		{Name: "tags", Type: "array"},
+		{Name: "paid", Type: "boolean"},
+	}, res.Data.Preview.Columns)
+}
+
+// TestReuploadFileSource tests all the scenarios while uploading a new version of the file of a source.
+func TestReuploadFileSource(t *testing.T) {
+	fileStore, directory := env.Env.FileStore, env.Env.FileUploadDirectory
+	env.Env.FileStore, env.Env.FileUploadDirectory = utils.FILE_STORE_LOCAL, t.TempDir()
+
+	defer func() {
+		env.Env.FileStore, env.Env.FileUploadDirectory = fileStore, directory
+	}()
+
+	sourceID := "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+	source := createRandomSource(sourceID)
+	source.WorkspaceID = 1122
+	source.SourceName = "orders"
+
+	testCaseSuite := []struct {
+		testScenario  string
+		query         string
+		queryAirByte  func(querier *mockairbyte.MockAirByteQuerier)
+		buildStubs    func(store *mockStore.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			testScenario: "BadRequest_NotFileSource",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				querier.EXPECT().EditSourceConnectorOnAirByte(gomock.Any()).Times(0)
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(sourceID).Times(1).Return(source, nil)
+				store.EXPECT().GetLatestFileUpload(sourceID).Times(1).
+					Return(models.FileUpload{}, errors.New("record not found"))
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			testScenario: "Success_Sync",
+
+			query: "?sync=true",
+
+			queryAirByte: func(querier *mockairbyte.MockAirByteQuerier) {
+				configuredSource := createRandomConfiguredSource(sourceID)
+				configuredSource.ConnectionConfiguration = map[string]interface{}{
+					"dataset_name":   "orders_v1",
+					"reader_options": "{\"sep\": \";\"}",
+					"format":         utils.FILE_FORMAT_CSV,
+					"url":            "/local/uploads/1122/previous/orders.csv",
+				}
+
+				querier.EXPECT().GetConfiguredSource(source.AirbyteSourceID).Times(1).Return(configuredSource, nil)
+				querier.EXPECT().CheckSourceConnection(gomock.Any()).Times(1).Return(nil)
+				querier.EXPECT().EditSourceConnectorOnAirByte(gomock.Any()).Times(1).
+					DoAndReturn(func(request models.EditSourceConnectorRequestAirByte) (
+						models.CreateSourceConnectorResponseAirbyte, error) {
+						configuration, _ := request.ConnectionConfiguration.(map[string]interface{})
+						require.Equal(t, "orders_v1", configuration["dataset_name"])
+						require.Equal(t, "{\"sep\": \";\"}", configuration["reader_options"])
+						require.Equal(t, utils.FILE_FORMAT_JSONL, configuration["format"])
+						require.Regexp(t, `^/local/uploads/1122/[0-9a-f-]{36}/orders\.jsonl$`, configuration["url"])
+
+						return models.CreateSourceConnectorResponseAirbyte{}, nil
+					})
+				querier.EXPECT().SyncConnectionManually(map[string]interface{}{"connectionId": "synced"}).Times(1).
+					Return(models.ManualConnectionSyncResponse{}, nil)
+				querier.EXPECT().SyncConnectionManually(map[string]interface{}{"connectionId": "running"}).Times(1).
+					Return(models.ManualConnectionSyncResponse{}, errors.New("a sync is already running"))
+			},
+
+			buildStubs: func(store *mockStore.MockStore) {
+				store.EXPECT().GetSource(sourceID).Times(1).Return(source, nil)
+				store.EXPECT().GetLatestFileUpload(sourceID).Times(1).
+					Return(models.FileUpload{SourceID: sourceID, Version: 2}, nil)
+				store.EXPECT().CreateFileUpload(gomock.Any()).Times(1).
+					DoAndReturn(func(upload models.FileUpload) (models.FileUpload, error) {
+						require.Equal(t, 3, upload.Version)
+						require.Equal(t, utils.FILE_FORMAT_JSONL, upload.Format)
+
+						return upload, nil
+					})
+				store.EXPECT().GetSourceConnections(sourceID).Times(1).Return([]models.Connection{
+					{PipelineID: "pipeline-1", AirbyteConnectionID: "synced"},
+					{PipelineID: "pipeline-2", AirbyteConnectionID: "running"},
+					{PipelineID: "pipeline-3"},
+				}, nil)
+			},
+
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res struct {
+					Data models.FileSourceUpload `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, 3, res.Data.Version)
+				require.Equal(t, []string{"pipeline-1"}, res.Data.SyncedPipelines)
+				require.Equal(t, []string{"pipeline-2"}, res.Data.FailedPipelines)
+			},
+		},
+	}
+
+	for i := range testCaseSuite {
+		testCase := testCaseSuite[i]
+
+		t.Run(testCase.testScenario, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockStore.NewMockStore(ctrl)
+			testCase.buildStubs(store)
+
+			airByte := mockairbyte.NewMockAirByteQuerier(ctrl)
+			testCase.queryAirByte(airByte)
+
+			server := test.NewTestServer(test.SOURCE, store, airByte, nil)
+			url := test.BaseURL + "sources/" + sourceID + "/files/" + testCase.query
+			expectedResp, err := makeUploadRequest(server, url, nil, "orders.jsonl", []byte("{\"order_id\": 3}\n"))
 			require.NoError(t, err)
 
 			testCase.checkResponse(expectedResp)
@@ -1073,7 +3355,6 @@ func TestDiscoverSourceSchema(t *testing.T) {
 	}
 }
 
-//createRandomConnectionSummary populates and return the ConnectionSummary model with random values.
 func createRandomConnectionSummary() models.ConnectionSummary {
 	abID, _ := uuid.NewV1()
 
@@ -1120,9 +3401,13 @@ func createRandomSourceConnectorRequestAPI(sourceDefID string) models.CreateSour
 func createRandomSupportedSource() models.SupportedSources {
 	sID, _ := uuid.NewV1()
 	Ss := models.SupportedSources{
-		ID:   sID,
-		Name: utils.RandomString(5),
-		Type: utils.RandomString(5),
+		ID:                  sID,
+		Name:                utils.RandomString(5),
+		Type:                utils.RandomString(5),
+		AirbyteDefinitionID: sID.String(),
+		DockerImageTag:      "0.4.4",
+		ReleaseStage:        "generally_available",
+		Enabled:             true,
 	}
 
 	return Ss
@@ -1135,12 +3420,27 @@ func createRandomSource(sid string) models.Source {
 		SourceName:                utils.RandomString(5),
 		AirbyteSourceID:           utils.RandomString(5),
 		AirbyteSourceDefinitionID: utils.RandomString(5),
-		ConnectionID:              utils.RandomString(5),
 	}
 
 	return s
 }
 
+// createRandomWorkspaceSource populates and return the WorkspaceSource with Random values.
+func createRandomWorkspaceSource() models.WorkspaceSource {
+	sID, _ := uuid.NewV1()
+	abID, _ := uuid.NewV1()
+	ws := models.WorkspaceSource{
+		SourceID:                  sID.String(),
+		SourceName:                utils.RandomString(5),
+		AirbyteSourceID:           abID.String(),
+		AirbyteSourceDefinitionID: abID.String(),
+		Owner:                     1122,
+		Pipelines:                 2,
+	}
+
+	return ws
+}
+
 // createRandomConfiguredSource populates and return the ConfiguredSource with Random values.
 func createRandomConfiguredSource(sid string) models.ConfiguredSource {
 	cs := models.ConfiguredSource{
@@ -1183,6 +3483,116 @@ func createRandomSourceSpecification() models.SourceSpecification {
 	return Ss
 }
 
+// stubEnabledConnectors lets the workspace of the tests configure any connector.
+func stubEnabledConnectors(store *mockStore.MockStore) {
+	store.EXPECT().IsConnectorEnabled(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(true, nil)
+}
+
+// stubConnectorSpecification lets the configurations of the tests pass the validation against the specification of
+// their connector.
+func stubConnectorSpecification(querier *mockairbyte.MockAirByteQuerier) {
+	querier.EXPECT().GetSourceDefinition(gomock.Any()).AnyTimes().Return(models.SourceDefinition{}, nil)
+	querier.EXPECT().GetSourceSpecification(gomock.Any()).AnyTimes().Return(models.SourceSpecification{}, nil)
+}
+
+// createConnectorSpecification returns a specification requiring the host and port of a database.
+func createConnectorSpecification() models.SourceSpecification {
+	var connectionSpecification interface{}
+	_ = json.Unmarshal([]byte(`{
+		"type": "object",
+		"required": ["host", "port"],
+		"properties": {
+			"host": {"type": "string"},
+			"port": {"type": "integer", "minimum": 0, "maximum": 65536}
+		}
+	}`), &connectionSpecification)
+
+	return models.SourceSpecification{ConnectionSpecification: connectionSpecification}
+}
+
+// createOAuthSpecification returns a specification whose advanced auth puts the tokens under the credentials.
+func createOAuthSpecification() models.SourceSpecification {
+	var advancedAuth interface{}
+	_ = json.Unmarshal([]byte(`{
+		"authFlowType": "oauth2.0",
+		"predicateKey": ["credentials", "auth_type"],
+		"predicateValue": "Client",
+		"oauthConfigSpecification": {
+			"completeOAuthOutputSpecification": {
+				"type": "object",
+				"properties": {
+					"access_token": {"type": "string", "path_in_connector_config": ["credentials", "access_token"]},
+					"refresh_token": {"type": "string", "path_in_connector_config": ["credentials", "refresh_token"]}
+				}
+			}
+		}
+	}`), &advancedAuth)
+
+	return models.SourceSpecification{AdvancedAuth: advancedAuth}
+}
+
+// makeUploadRequest posts the file and the fields to the server as a multipart form.
+func makeUploadRequest(handler http.Handler, url string, fields map[string]string, fileName string,
+	content []byte) (*httptest.ResponseRecorder, error) {
+	var body bytes.Buffer
+
+	form := multipart.NewWriter(&body)
+
+	for name, value := range fields {
+		if err := form.WriteField(name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := form.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = file.Write(content); err != nil {
+		return nil, err
+	}
+
+	if err = form.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	test.MockAddAuthorization(req)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	return recorder, nil
+}
+
+// createWorkbook returns a xlsx workbook with the shared strings and a single worksheet.
+func createWorkbook(t *testing.T, sharedStrings string, worksheet string) []byte {
+	var workbook bytes.Buffer
+
+	archive := zip.NewWriter(&workbook)
+
+	for name, content := range map[string]string{
+		"xl/sharedStrings.xml":     sharedStrings,
+		"xl/worksheets/sheet1.xml": worksheet,
+	} {
+		file, err := archive.Create(name)
+		require.NoError(t, err)
+
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, archive.Close())
+
+	return workbook.Bytes()
+}
+
 // TestMain runs the package level test in TestMode.
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
@@ -16,6 +16,7 @@ const SessionIdValue = "6jgxlgfdxrm8z4vnf9evqpqhj6bi14as"
 const UserID = "1122"
 const WorkspaceID = "1122"
 const AirByteWorkspaceID = "a152379e-01a1-11ec-82d6-a312edcd9c7b"
+const UserRole = "editor"
 
 func MockAddAuthorization(request *http.Request) {
 	cookie := http.Cookie{
@@ -28,6 +29,7 @@ func MockAddAuthorization(request *http.Request) {
 	request.Header.Set("userID", UserID)
 	request.Header.Set("workspaceID", WorkspaceID)
 	request.Header.Set("airbyteWorkspaceID", AirByteWorkspaceID)
+	request.Header.Set("userRole", UserRole)
 }
 
 func MockGetUserByID(client *mock_authservice.MockHttpClient, userID int, workspaceID int) {
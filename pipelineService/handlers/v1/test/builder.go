@@ -4,10 +4,18 @@ import (
 	"github.com/gin-gonic/gin"
 	mock_airbyte "pipelineService/clients/airbyte/mocks"
 	"pipelineService/clients/authService"
+	"pipelineService/controllers/v1/assets"
+	"pipelineService/controllers/v1/audit"
+	"pipelineService/controllers/v1/classification"
+	"pipelineService/controllers/v1/connectorUpgrade"
 	"pipelineService/controllers/v1/dataProduct"
 	"pipelineService/controllers/v1/destination"
 	"pipelineService/controllers/v1/health"
+	"pipelineService/controllers/v1/lineage"
 	"pipelineService/controllers/v1/pipeline"
+	"pipelineService/controllers/v1/policy"
+	"pipelineService/controllers/v1/quality"
+	"pipelineService/controllers/v1/schemaChange"
 	"pipelineService/controllers/v1/source"
 	mock_store "pipelineService/services/db/mocks"
 )
@@ -17,17 +25,28 @@ const BaseURL = "/pipeline-service/api/v1/"
 type PackageName string
 
 const (
-	HEALTH       PackageName = "health"
-	DATA_PRODUCT PackageName = "dataProduct"
-	SOURCE       PackageName = "source"
-	PIPELINE     PackageName = "pipeline"
-	DESTINATION  PackageName = "destination"
+	HEALTH         PackageName = "health"
+	DATA_PRODUCT   PackageName = "dataProduct"
+	SOURCE         PackageName = "source"
+	PIPELINE       PackageName = "pipeline"
+	DESTINATION    PackageName = "destination"
+	AUDIT          PackageName = "audit"
+	SCHEMA_CHANGE  PackageName = "schemaChange"
+	ASSETS         PackageName = "assets"
+	CLASSIFICATION PackageName = "classification"
+	POLICY         PackageName = "policy"
+	QUALITY        PackageName = "quality"
+	LINEAGE        PackageName = "lineage"
+
+	CONNECTOR_UPGRADE PackageName = "connectorUpgrade"
 )
 
 // NewTestServer returns a router.
 func NewTestServer(packageName PackageName, mockStore *mock_store.MockStore,
 	mockAirByteClient *mock_airbyte.MockAirByteQuerier, AuthServiceClient authService.AuthServiceClient) *gin.Engine {
 	router := gin.New()
+	router.Use(mockValidateSession)
+
 	pipelineServiceGrp := router.Group(BaseURL)
 
 	switch packageName {
@@ -54,8 +73,56 @@ func NewTestServer(packageName PackageName, mockStore *mock_store.MockStore,
 	case DESTINATION:
 		destination.CreateNewServer(mockStore, mockAirByteClient, AuthServiceClient, router, pipelineServiceGrp)
 
+		return router
+
+	case AUDIT:
+		audit.RegisterMiddleware(mockStore, pipelineServiceGrp)
+		audit.CreateNewServer(mockStore, router, pipelineServiceGrp)
+
+		return router
+
+	case SCHEMA_CHANGE:
+		schemaChange.CreateNewServer(mockStore, mockAirByteClient, router, pipelineServiceGrp, nil)
+
+		return router
+
+	case ASSETS:
+		assets.CreateNewServer(mockStore, mockAirByteClient, AuthServiceClient, router, pipelineServiceGrp)
+
+		return router
+
+	case CLASSIFICATION:
+		classification.CreateNewServer(mockStore, router, pipelineServiceGrp)
+
+		return router
+
+	case POLICY:
+		policy.CreateNewServer(mockStore, router, pipelineServiceGrp)
+
+		return router
+
+	case QUALITY:
+		quality.CreateNewServer(mockStore, router, pipelineServiceGrp)
+
+		return router
+
+	case LINEAGE:
+		lineage.CreateNewServer(mockStore, router, pipelineServiceGrp)
+
+		return router
+
+	case CONNECTOR_UPGRADE:
+		connectorUpgrade.CreateNewServer(mockStore, mockAirByteClient, router, pipelineServiceGrp)
+
 		return router
 	}
 
 	return nil
 }
+
+// mockValidateSession sets the role the auth service returns for the session of the request, the tests pass it in
+// the userRole and isSuperAdmin headers of the request.
+func mockValidateSession(ctx *gin.Context) {
+	ctx.Set("userRole", ctx.GetHeader("userRole"))
+	ctx.Set("isSuperAdmin", ctx.GetHeader("isSuperAdmin") == "true")
+}
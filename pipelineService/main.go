@@ -13,11 +13,18 @@ import (
 	"pipelineService/clients/authService"
 	cadenceclient "pipelineService/clients/cadenceClient"
 	"pipelineService/controllers/v1/assets"
+	"pipelineService/controllers/v1/audit"
 	"pipelineService/controllers/v1/authWorkflow"
+	"pipelineService/controllers/v1/classification"
+	"pipelineService/controllers/v1/connectorUpgrade"
 	"pipelineService/controllers/v1/dataProduct"
 	"pipelineService/controllers/v1/destination"
 	"pipelineService/controllers/v1/health"
+	"pipelineService/controllers/v1/lineage"
 	"pipelineService/controllers/v1/pipeline"
+	"pipelineService/controllers/v1/policy"
+	"pipelineService/controllers/v1/quality"
+	"pipelineService/controllers/v1/schemaChange"
 	"pipelineService/controllers/v1/source"
 	"pipelineService/controllers/v1/workspace"
 	"pipelineService/docs"
@@ -43,6 +50,14 @@ func main() {
 	database := db.GetConnection()
 	dbStore := db.NewStore(database)
 
+	if err = dbStore.BackfillConnectionSources(); err != nil {
+		logger.Error(err.Error())
+	}
+
+	if err = dbStore.BackfillQualityRuleAssets(); err != nil {
+		logger.Error(err.Error())
+	}
+
 	router := gin.New()
 
 	//router.Use(cors.Default())
@@ -64,6 +79,9 @@ func main() {
 	authServiceClient := authService.NewClient(httpClient)
 
 	pipelineServiceGrp := router.Group("pipeline-service/api/v1")
+	// the session is validated first, the role it carries is the one the handlers and the audit log rely on
+	pipelineServiceGrp.Use(authService.SessionMiddleware(authServiceClient))
+	audit.RegisterMiddleware(dbStore, pipelineServiceGrp)
 
 	authWorkflow.CreateNewServer(router, pipelineServiceGrp, cadStore)
 	health.CreateNewServer(dbStore, router, pipelineServiceGrp)
@@ -73,6 +91,13 @@ func main() {
 	destination.CreateNewServer(dbStore, airByteClient, authServiceClient, router, pipelineServiceGrp)
 	workspace.CreateNewServer(dbStore, airByteClient, authServiceClient, router, pipelineServiceGrp)
 	assets.CreateNewServer(dbStore, airByteClient, authServiceClient, router, pipelineServiceGrp)
+	classification.CreateNewServer(dbStore, router, pipelineServiceGrp)
+	policy.CreateNewServer(dbStore, router, pipelineServiceGrp)
+	quality.CreateNewServer(dbStore, router, pipelineServiceGrp)
+	lineage.CreateNewServer(dbStore, router, pipelineServiceGrp)
+	schemaChange.CreateNewServer(dbStore, airByteClient, router, pipelineServiceGrp, cadStore)
+	connectorUpgrade.CreateNewServer(dbStore, airByteClient, router, pipelineServiceGrp)
+	audit.CreateNewServer(dbStore, router, pipelineServiceGrp)
 
 	// register swagger documentation endpoint
 	pipelineServiceGrp.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
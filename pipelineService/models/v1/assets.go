@@ -1,16 +1,23 @@
 package models
 
-import "gorm.io/datatypes"
+import (
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+)
 
 type PipelineAssets struct {
-	AssetID     string         `gorm:"type:uuid;primaryKey;default:(-)" json:"assetID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
-	SchemaID    string         `gorm:"column:pipeline_schemas_id" json:"pipelineSchemaID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
-	PipelineID  string         `gorm:"column:pipeline_id" json:"pipelineID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
-	Name        string         `gorm:"column:name" json:"name" example:"schema"`
-	IsEnabled   bool           `gorm:"column:is_enabled" json:"isEnabled" example:"false"`
-	Columns     datatypes.JSON `json:"columns" gorm:"column:columns;type:json" example:"{}"`
-	Owner       int            `json:"owner" gorm:"type:int" example:"1"`
-	WorkspaceID int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	AssetID    string `gorm:"type:uuid;primaryKey;default:(-)" json:"assetID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	SchemaID   string `gorm:"column:pipeline_schemas_id" json:"pipelineSchemaID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	PipelineID string `gorm:"column:pipeline_id" json:"pipelineID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	// DestinationID is the destination holding the asset, the assets of a pipeline with a single destination have none
+	DestinationID *string        `gorm:"column:destination_id;type:uuid" json:"destinationId,omitempty" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Name          string         `gorm:"column:name" json:"name" example:"schema"`
+	IsEnabled     bool           `gorm:"column:is_enabled" json:"isEnabled" example:"false"`
+	Columns       datatypes.JSON `json:"columns" gorm:"column:columns;type:json" example:"{}"`
+	Owner         int            `json:"owner" gorm:"type:int" example:"1"`
+	WorkspaceID   int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	Profile       *AssetProfile  `json:"profile,omitempty" gorm:"-"`
 }
 
 type AssetDetails struct {
@@ -22,6 +29,11 @@ type AssetDetails struct {
 	DbName     string `json:"database" gorm:"column:database;type:string;size:50"`
 	UserName   string `json:"username" gorm:"column:username;type:string;size:50"`
 	Password   string `json:"password" gorm:"column:password;type:string;size:50"`
+	// the type of the destination picks how the asset is read, the configuration holds the connection and SSL
+	// settings of the destination
+	DestinationID   string         `json:"destinationId" gorm:"column:destination_id"`
+	DestinationType string         `json:"destinationType" gorm:"column:destination_type"`
+	Configuration   datatypes.JSON `json:"-" gorm:"column:configuration_details"`
 }
 
 type PipelineAssetsResponse struct {
@@ -38,6 +50,7 @@ type ProductAssets struct {
 	Columns     datatypes.JSON `json:"columns" gorm:"column:columns;type:json" example:"{}"`
 	Owner       int            `json:"owner" gorm:"type:int" example:"1"`
 	WorkspaceID int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	Profile     *AssetProfile  `json:"profile,omitempty" gorm:"-"`
 }
 
 type TransformedAssetsResponse struct {
@@ -51,9 +64,28 @@ type TransformedAssetDetails struct {
 	AssetName                string         `gorm:"column:asset_name" json:"assetName" example:"asset"`
 	ProductID                string         `gorm:"column:product_id" json:"productID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
 	ProductName              string         `gorm:"column:product_name" json:"productName" example:"product"`
+	DestinationID            string         `gorm:"column:destination_id" json:"destinationID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
 	DestinationConfiguration datatypes.JSON `gorm:"column:destination_configuration" json:"destinationConfiguration" example:"{}"`
 }
 
+// AssetTable is the table of a raw or transformed asset and the connection to the destination holding it.
+type AssetTable struct {
+	AssetID   string `gorm:"column:asset_id"`
+	AssetName string `gorm:"column:asset_name"`
+	Schema    string `gorm:"column:schema_name"`
+	Table     string `gorm:"column:table_name"`
+	Host      string `gorm:"column:host"`
+	Port      string `gorm:"column:port"`
+	Database  string `gorm:"column:database"`
+	UserName  string `gorm:"column:username"`
+	Password  string `gorm:"column:password"`
+	Raw       bool   `gorm:"-"`
+
+	DestinationID   string         `gorm:"column:destination_id"`
+	DestinationType string         `gorm:"column:destination_type"`
+	Configuration   datatypes.JSON `gorm:"column:configuration_details"`
+}
+
 type ProductAssetDetails struct {
 	Schema      string   `json:"schemaName"`
 	ProductID   string   `json:"productID"`
@@ -61,3 +93,155 @@ type ProductAssetDetails struct {
 	WorkspaceID int      `json:"workspaceId"`
 	Table       []string `json:"tableNames" gorm:"column:table_name" example:""`
 }
+
+// AssetColumn is an entry of the data dictionary. Columns are identified by the pipeline or product of the asset,
+// the asset name and the column name so the documentation outlives the assets being recreated on every sync.
+type AssetColumn struct {
+	ColumnID        uuid.UUID      `json:"columnId" gorm:"column:column_id; type:uuid;primaryKey;default:(-)" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AssetID         string         `json:"assetId" gorm:"column:asset_id; type:uuid" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ParentID        string         `json:"parentId" gorm:"column:parent_id; type:uuid; uniqueIndex:idx_asset_column" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AssetName       string         `json:"assetName" gorm:"column:asset_name; uniqueIndex:idx_asset_column" example:"users"`
+	Name            string         `json:"name" gorm:"column:name; uniqueIndex:idx_asset_column" example:"email"`
+	Type            string         `json:"type" gorm:"column:type" example:"string"`
+	Nullable        bool           `json:"nullable" gorm:"column:nullable" example:"true"`
+	Position        int            `json:"position" gorm:"column:position" example:"1"`
+	Description     string         `json:"description" gorm:"column:description" example:"Email address of the user"`
+	Tags            pq.StringArray `json:"tags" gorm:"column:tags; type:varchar[]" example:"[contact, pii]"`
+	Classifications pq.StringArray `json:"classifications" gorm:"column:classifications; type:varchar[]" example:"[email]"`
+	// ClassificationsPinned marks the labels set by a user, the automatic classification doesn't overwrite them
+	ClassificationsPinned bool  `json:"classificationsPinned" gorm:"column:classifications_pinned" example:"false"`
+	DocumentedBy          int   `json:"documentedBy" gorm:"column:documented_by; type:int" example:"1"`
+	DocumentedAt          int64 `json:"documentedAt" gorm:"column:documented_at" example:"1660000000000"`
+	WorkspaceID           int   `json:"workspaceId" gorm:"type:int" example:"1"`
+}
+
+// AssetIdentity identifies an asset by its pipeline or product and its name, unlike its ID it outlives the syncs
+// recreating the asset.
+type AssetIdentity struct {
+	ParentID  string `gorm:"column:parent_id"`
+	AssetName string `gorm:"column:asset_name"`
+}
+
+type AssetColumnsResponse struct {
+	Status string        `json:"status" example:"success"`
+	Errors string        `json:"errors" example:""`
+	Data   []AssetColumn `json:"data"`
+}
+
+type EditAssetColumnRequest struct {
+	Description string   `json:"description" example:"Email address of the user"`
+	Tags        []string `json:"tags" example:"contact,pii"`
+}
+
+type DataDictionaryRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=markdown csv"`
+}
+
+type TableColumn struct {
+	TableName  string `gorm:"column:table_name"`
+	ColumnName string `gorm:"column:column_name"`
+	DataType   string `gorm:"column:data_type"`
+	IsNullable string `gorm:"column:is_nullable"`
+	Position   int    `gorm:"column:ordinal_position"`
+}
+
+// PreviewRequest pages through the rows of an asset. Filters are written as column:operator:value, the values of the
+// in and not_in operators are comma separated. The order column is prefixed with - to sort in descending order.
+type PreviewRequest struct {
+	Columns string   `form:"columns" example:"id,email"`
+	Filters []string `form:"filter" example:"age:gte:18"`
+	OrderBy string   `form:"orderBy" example:"-created_at"`
+	Limit   int      `form:"limit" binding:"omitempty,min=1" example:"10"`
+	Offset  int      `form:"offset" binding:"omitempty,min=0" example:"0"`
+	Cursor  string   `form:"cursor" example:""`
+}
+
+type PreviewFilter struct {
+	Column   string
+	Operator string
+	Values   []string
+}
+
+type PreviewCursor struct {
+	Value string `json:"v"`
+	Type  string `json:"t"`
+}
+
+type PreviewQuery struct {
+	Columns    []string
+	Filters    []PreviewFilter
+	OrderBy    string
+	Descending bool
+	Limit      int
+	Offset     int
+	After      *PreviewCursor
+	// RawTypes are the types of the columns of raw tables, their values are read from the JSON data column as text
+	RawTypes map[string]string
+}
+
+type PreviewColumn struct {
+	Name string `json:"name" example:"email"`
+	Type string `json:"type" example:"varchar"`
+}
+
+type PreviewResult struct {
+	Columns    []PreviewColumn          `json:"columns"`
+	Rows       []map[string]interface{} `json:"rows"`
+	NextCursor string                   `json:"nextCursor,omitempty" example:""`
+}
+
+type PreviewResponse struct {
+	Status string        `json:"status" example:"success"`
+	Errors string        `json:"errors" example:""`
+	Data   PreviewResult `json:"data"`
+}
+
+// AssetExport records every export of an asset for governance, asynchronous exports are downloaded once completed.
+type AssetExport struct {
+	ExportID    uuid.UUID      `json:"exportId" gorm:"column:export_id; type:uuid;primaryKey;default:(-)" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AssetID     string         `json:"assetId" gorm:"column:asset_id; type:uuid" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AssetName   string         `json:"assetName" gorm:"column:asset_name" example:"users"`
+	Format      string         `json:"format" gorm:"column:format" example:"csv"`
+	Gzip        bool           `json:"gzip" gorm:"column:gzip" example:"false"`
+	Async       bool           `json:"async" gorm:"column:async" example:"false"`
+	Columns     pq.StringArray `json:"columns" gorm:"column:columns; type:varchar[]" example:"[id, email]"`
+	Filters     pq.StringArray `json:"filters" gorm:"column:filters; type:varchar[]" example:"[age:gte:18]"`
+	RowLimit    int            `json:"rowLimit" gorm:"column:row_limit" example:"1000000"`
+	RowCount    int64          `json:"rowCount" gorm:"column:row_count" example:"1000"`
+	Status      string         `json:"status" gorm:"column:status" example:"completed"`
+	Error       string         `json:"error" gorm:"column:error" example:""`
+	FilePath    string         `json:"-" gorm:"column:file_path"`
+	DownloadURL string         `json:"downloadUrl,omitempty" gorm:"-" example:"/pipeline-service/api/v1/assets/exports/b251379e-01a1-11ec-82d6-a312edcd9c7b/download/"`
+	RequestedBy int            `json:"requestedBy" gorm:"column:requested_by; type:int" example:"1"`
+	WorkspaceID int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	CreatedAt   int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+	CompletedAt int64          `json:"completedAt" gorm:"column:completed_at" example:"1660000000000"`
+}
+
+// AssetExportRequest selects the rows of the export like a preview, without a limit the export is capped at the
+// configured max rows.
+type AssetExportRequest struct {
+	Format  string   `form:"format" binding:"required,oneof=csv jsonl parquet" example:"csv"`
+	Gzip    bool     `form:"gzip" example:"false"`
+	Async   bool     `form:"async" example:"false"`
+	Columns string   `form:"columns" example:"id,email"`
+	Filters []string `form:"filter" example:"age:gte:18"`
+	OrderBy string   `form:"orderBy" example:"-created_at"`
+	Limit   int      `form:"limit" binding:"omitempty,min=1" example:"1000"`
+}
+
+type AssetExportFilter struct {
+	AssetID string `form:"assetId" binding:"omitempty,uuid"`
+}
+
+type AssetExportResponse struct {
+	Status string      `json:"status" example:"success"`
+	Errors string      `json:"errors" example:""`
+	Data   AssetExport `json:"data"`
+}
+
+type AssetExportsResponse struct {
+	Status string        `json:"status" example:"success"`
+	Errors string        `json:"errors" example:""`
+	Data   []AssetExport `json:"data"`
+}
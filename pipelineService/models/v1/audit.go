@@ -0,0 +1,36 @@
+package models
+
+import "gorm.io/datatypes"
+
+type AuditLog struct {
+	AuditID      string         `json:"auditId" gorm:"column:audit_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Actor        int            `json:"actor" gorm:"column:actor; type:int" example:"1"`
+	WorkspaceID  int            `json:"workspaceId" gorm:"column:workspace_id; type:int" example:"1"`
+	Action       string         `json:"action" gorm:"column:action; type:string" example:"EditSourceOnAirByte"`
+	Method       string         `json:"method" gorm:"column:method; type:string" example:"PUT"`
+	Path         string         `json:"path" gorm:"column:path; type:string" example:"/pipeline-service/api/v1/sources/:id/"`
+	ResourceType string         `json:"resourceType" gorm:"column:resource_type; type:string" example:"sources"`
+	ResourceID   string         `json:"resourceId" gorm:"column:resource_id; type:string" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Before       datatypes.JSON `json:"before" gorm:"column:before; type:json" example:"{}"`
+	After        datatypes.JSON `json:"after" gorm:"column:after; type:json" example:"{}"`
+	RequestID    string         `json:"requestId" gorm:"column:request_id; type:string" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	StatusCode   int            `json:"statusCode" gorm:"column:status_code; type:int" example:"200"`
+	CreatedAt    int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+}
+
+type AuditLogFilter struct {
+	Actor        int    `form:"actor" example:"1"`
+	Action       string `form:"action" example:"EditSourceOnAirByte"`
+	ResourceType string `form:"resourceType" example:"sources"`
+	ResourceID   string `form:"resourceId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	From         int64  `form:"from" example:"1650000000000"`
+	To           int64  `form:"to" example:"1660000000000"`
+	Limit        int    `form:"limit" example:"100"`
+	Offset       int    `form:"offset" example:"0"`
+}
+
+type AuditLogsResponse struct {
+	Status string     `json:"status" example:"success"`
+	Errors string     `json:"errors" example:""`
+	Data   []AuditLog `json:"data"`
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+)
+
+// ClassificationRule is a workspace specific rule the classifier applies along with the built-in ones. Regex rules
+// match the pattern and dictionary rules match any of the values, case insensitively.
+type ClassificationRule struct {
+	RuleID      uuid.UUID      `json:"ruleId" gorm:"column:rule_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Label       string         `json:"label" gorm:"column:label" binding:"required" example:"employee_id"`
+	Kind        string         `json:"kind" gorm:"column:kind" binding:"required,oneof=regex dictionary" example:"regex"`
+	Target      string         `json:"target" gorm:"column:target" binding:"required,oneof=column_name value" example:"value"`
+	Pattern     string         `json:"pattern,omitempty" gorm:"column:pattern" example:"^EMP-[0-9]{6}$"`
+	Values      pq.StringArray `json:"values,omitempty" gorm:"column:values; type:varchar[]" example:"[emp_id, employee_number]"`
+	CreatedBy   int            `json:"createdBy" gorm:"column:created_by; type:int" example:"1"`
+	WorkspaceID int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	CreatedAt   int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+}
+
+type ClassificationRuleResponse struct {
+	Status string             `json:"status" example:"success"`
+	Errors string             `json:"errors" example:""`
+	Data   ClassificationRule `json:"data"`
+}
+
+type ClassificationRulesResponse struct {
+	Status string               `json:"status" example:"success"`
+	Errors string               `json:"errors" example:""`
+	Data   []ClassificationRule `json:"data"`
+}
+
+type AssetClassification struct {
+	AssetID string              `json:"assetId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Sampled int                 `json:"sampled" example:"10"`
+	Columns map[string][]string `json:"columns"`
+}
+
+// ColumnClassificationRequest sets the labels of a column by hand, the labels are pinned unless Pinned is false.
+type ColumnClassificationRequest struct {
+	Classifications []string `json:"classifications" example:"email"`
+	Pinned          *bool    `json:"pinned" example:"true"`
+}
+
+type AssetClassificationResponse struct {
+	Status string              `json:"status" example:"success"`
+	Errors string              `json:"errors" example:""`
+	Data   AssetClassification `json:"data"`
+}
+
+type ClassificationJobReport struct {
+	Classified []string `json:"classified"`
+	Failures   []string `json:"failures"`
+}
+
+type ClassificationJobReportResponse struct {
+	Status string                  `json:"status" example:"success"`
+	Errors string                  `json:"errors" example:""`
+	Data   ClassificationJobReport `json:"data"`
+}
+
+type SensitiveColumn struct {
+	AssetID         string         `json:"assetId" gorm:"column:asset_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AssetName       string         `json:"assetName" gorm:"column:asset_name" example:"users"`
+	AssetType       string         `json:"assetType" gorm:"column:asset_type" example:"raw"`
+	Column          string         `json:"column" gorm:"column:name" example:"email"`
+	Classifications pq.StringArray `json:"classifications" gorm:"column:classifications; type:varchar[]" example:"[email]"`
+}
+
+type SensitiveColumnsReport struct {
+	ProductID string            `json:"productId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Columns   []SensitiveColumn `json:"columns"`
+}
+
+type SensitiveColumnsReportResponse struct {
+	Status string                 `json:"status" example:"success"`
+	Errors string                 `json:"errors" example:""`
+	Data   SensitiveColumnsReport `json:"data"`
+}
@@ -0,0 +1,28 @@
+package models
+
+// ConfigValidationRequest is a dry run of the validation of a connector configuration against the connection
+// specification of its definition.
+type ConfigValidationRequest struct {
+	DefinitionID            string      `json:"definitionId" binding:"required,uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ConnectionConfiguration interface{} `json:"connectionConfiguration" binding:"required"`
+}
+
+// ConfigFieldError reports a field of a configuration breaking the specification, the field is the JSON path of the
+// value within the configuration.
+type ConfigFieldError struct {
+	Field   string `json:"field" example:"credentials.password"`
+	Message string `json:"message" example:"is required"`
+}
+
+type ConfigValidation struct {
+	DefinitionID string             `json:"definitionId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Version      string             `json:"version" example:"0.4.4"`
+	Valid        bool               `json:"valid" example:"false"`
+	Errors       []ConfigFieldError `json:"errors"`
+}
+
+type ConfigValidationResponse struct {
+	Status string           `json:"status" example:"success"`
+	Errors string           `json:"errors" example:""`
+	Data   ConfigValidation `json:"data"`
+}
@@ -1,8 +1,11 @@
 package models
 
+import "github.com/lib/pq"
+
 type Connection struct {
 	ConnectionID          string `json:"connectionId" gorm:"column:connection_id; type:uuid;primaryKey;default:(-)"`
 	PipelineID            string `json:"pipelineId" gorm:"column:pipeline_id; type:uuid;default:(-)"`
+	SourceID              string `json:"sourceId" gorm:"column:source_id; type:uuid;default:(-)"`
 	CreatedAt             int64  `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
 	AirbyteStatus         string `json:"status" gorm:"column:airbyte_status; type:uuid; default:(-)"`
 	AirbyteLastRun        int    `json:"airbyteLastRun" gorm:"column:airbyte_last_run;"`
@@ -164,3 +167,37 @@ type CheckConnection struct {
 type EnableAssetsInternalRequest struct {
 	ConnectionIDs []string `json:"connectionIDs"`
 }
+
+type StreamDescriptor struct {
+	Name      string `json:"streamName" binding:"required" example:"users"`
+	Namespace string `json:"streamNamespace,omitempty" example:"public"`
+}
+
+type ResetConnectionStreamsRequest struct {
+	ConnectionId string             `json:"connectionId"`
+	Streams      []StreamDescriptor `json:"streams"`
+}
+
+type PipelineOperationRequest struct {
+	Confirm bool               `json:"confirm" example:"true"`
+	Streams []StreamDescriptor `json:"streams,omitempty"`
+}
+
+type PipelineOperation struct {
+	OperationID  string         `json:"operationId" gorm:"column:operation_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	PipelineID   string         `json:"pipelineId" gorm:"column:pipeline_id; type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ConnectionID string         `json:"connectionId" gorm:"column:connection_id; type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Operation    string         `json:"operation" gorm:"column:operation; type:string" example:"reset"`
+	JobID        int            `json:"jobId" gorm:"column:job_id" example:"12"`
+	JobStatus    string         `json:"jobStatus" gorm:"column:job_status; type:string" example:"running"`
+	Streams      pq.StringArray `json:"streams" gorm:"column:streams; type:string[]" example:"[public.users]"`
+	Owner        int            `json:"owner" gorm:"type:int" example:"1"`
+	WorkspaceID  int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	CreatedAt    int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+}
+
+type PipelineOperationsResponse struct {
+	Status string              `json:"status" example:"success"`
+	Errors string              `json:"errors" example:""`
+	Data   []PipelineOperation `json:"data"`
+}
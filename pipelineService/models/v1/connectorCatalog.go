@@ -0,0 +1,85 @@
+package models
+
+// WorkspaceConnector enables or disables a connector of the catalog for a workspace, the connectors without one are
+// enabled.
+type WorkspaceConnector struct {
+	WorkspaceID   int    `json:"workspaceId" gorm:"primaryKey;column:workspace_id" example:"1"`
+	ConnectorType string `json:"connectorType" gorm:"primaryKey;column:connector_type" example:"source"`
+	DefinitionID  string `json:"airbyteDefinitionId" gorm:"primaryKey;column:airbyte_definition_id;type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Enabled       bool   `json:"enabled" gorm:"column:enabled" example:"false"`
+	UpdatedBy     int    `json:"updatedBy" gorm:"column:updated_by" example:"1"`
+	UpdatedAt     int64  `json:"updatedAt" gorm:"column:updated_at" example:"1650000000000"`
+}
+
+type ConnectorAvailabilityRequest struct {
+	Enabled *bool `json:"enabled" binding:"required" example:"false"`
+}
+
+type WorkspaceConnectorResponse struct {
+	Status string             `json:"status" example:"success"`
+	Errors string             `json:"errors" example:""`
+	Data   WorkspaceConnector `json:"data"`
+}
+
+// ConnectorCatalogReport sums up a reconciliation of the catalog with the airbyte definitions, the connectors airbyte
+// stopped listing are deactivated.
+type ConnectorCatalogReport struct {
+	Synced      int   `json:"synced" example:"120"`
+	Deactivated int64 `json:"deactivated" example:"1"`
+	SyncedAt    int64 `json:"syncedAt" example:"1650000000000"`
+}
+
+type ConnectorCatalogReportResponse struct {
+	Status string                 `json:"status" example:"success"`
+	Errors string                 `json:"errors" example:""`
+	Data   ConnectorCatalogReport `json:"data"`
+}
+
+// CustomConnectorRequest registers the docker image of an in-house connector as a definition of the workspace.
+type CustomConnectorRequest struct {
+	Name             string `json:"name" binding:"required,max=50" example:"Billing API"`
+	DockerRepository string `json:"dockerRepository" binding:"required" example:"registry.example.com/source-billing"`
+	DockerImageTag   string `json:"dockerImageTag" binding:"required" example:"1.0.0"`
+	DocumentationURL string `json:"documentationUrl" binding:"required,url" example:"https://wiki.example.com/connectors/billing"`
+	Icon             string `json:"icon"`
+}
+
+type CustomConnectorUpgradeRequest struct {
+	DockerImageTag string `json:"dockerImageTag" binding:"required" example:"1.1.0"`
+}
+
+// IncompatibleConnector is a configured source or destination whose configuration breaks the specification of the
+// version a custom connector was upgraded to.
+type IncompatibleConnector struct {
+	ID     string             `json:"id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Name   string             `json:"name" example:"billing"`
+	Errors []ConfigFieldError `json:"errors"`
+}
+
+// CustomConnectorUpgrade reports the upgrade of a custom connector, the upgrade is rolled back when a configured
+// connector of the workspace is incompatible with the new version.
+type CustomConnectorUpgrade struct {
+	DefinitionID    string                  `json:"airbyteDefinitionId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	PreviousVersion string                  `json:"previousVersion" example:"1.0.0"`
+	Version         string                  `json:"version" example:"1.1.0"`
+	Upgraded        bool                    `json:"upgraded" example:"true"`
+	Incompatible    []IncompatibleConnector `json:"incompatible"`
+}
+
+type CustomConnectorUpgradeResponse struct {
+	Status string                 `json:"status" example:"success"`
+	Errors string                 `json:"errors" example:""`
+	Data   CustomConnectorUpgrade `json:"data"`
+}
+
+type CustomSourceResponse struct {
+	Status string           `json:"status" example:"success"`
+	Errors string           `json:"errors" example:""`
+	Data   SupportedSources `json:"data"`
+}
+
+type CustomDestinationResponse struct {
+	Status string                `json:"status" example:"success"`
+	Errors string                `json:"errors" example:""`
+	Data   SupportedDestinations `json:"data"`
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"gorm.io/datatypes"
+)
+
+// PipelineConnector is a source or destination used by a pipeline along with the version of its definition.
+type PipelineConnector struct {
+	PipelineID          string         `json:"pipelineId" gorm:"column:pipeline_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	PipelineName        string         `json:"pipelineName" gorm:"column:pipeline_name" example:"pipeline-1"`
+	WorkspaceID         int            `json:"-" gorm:"column:workspace_id"`
+	ConnectorType       string         `json:"connectorType" gorm:"column:connector_type" example:"source"`
+	DefinitionID        string         `json:"airbyteDefinitionId" gorm:"column:airbyte_definition_id" example:"decd338e-5647-4c0b-adf4-da0e75f5a750"`
+	Name                string         `json:"name" gorm:"column:name" example:"Postgres"`
+	DockerRepository    string         `json:"dockerRepository" gorm:"column:docker_repository" example:"airbyte/source-postgres"`
+	DockerImageTag      string         `json:"version" gorm:"column:docker_image_tag" example:"0.4.4"`
+	AirbyteConnectionID string         `json:"-" gorm:"column:airbyte_connection_id"`
+	AirbyteActorID      string         `json:"-" gorm:"column:airbyte_actor_id"`
+	Configuration       datatypes.JSON `json:"-" gorm:"column:configuration_details"`
+}
+
+type ConnectorVersionPipeline struct {
+	PipelineID   string `json:"pipelineId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	PipelineName string `json:"pipelineName" example:"pipeline-1"`
+}
+
+// ConnectorVersion groups the pipelines running the same version of a connector definition.
+type ConnectorVersion struct {
+	ConnectorType    string                     `json:"connectorType" example:"source"`
+	DefinitionID     string                     `json:"airbyteDefinitionId" example:"decd338e-5647-4c0b-adf4-da0e75f5a750"`
+	Name             string                     `json:"name" example:"Postgres"`
+	DockerRepository string                     `json:"dockerRepository" example:"airbyte/source-postgres"`
+	Version          string                     `json:"version" example:"0.4.4"`
+	Pipelines        []ConnectorVersionPipeline `json:"pipelines"`
+}
+
+type ConnectorVersionsResponse struct {
+	Status string             `json:"status" example:"success"`
+	Errors string             `json:"errors" example:""`
+	Data   []ConnectorVersion `json:"data"`
+}
+
+// ConnectorUpgrade is the rollout of a new version of a connector definition. Airbyte runs a single version of a
+// definition for the whole instance, so the pipelines of every workspace are checked against the version it commits.
+type ConnectorUpgrade struct {
+	UpgradeID       uuid.UUID      `json:"upgradeId" gorm:"column:upgrade_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ConnectorType   string         `json:"connectorType" gorm:"column:connector_type" example:"source"`
+	DefinitionID    string         `json:"airbyteDefinitionId" gorm:"column:airbyte_definition_id; type:uuid" example:"decd338e-5647-4c0b-adf4-da0e75f5a750"`
+	PreviousVersion string         `json:"previousVersion" gorm:"column:previous_version" example:"0.4.4"`
+	Version         string         `json:"version" gorm:"column:version" example:"0.4.8"`
+	Status          string         `json:"status" gorm:"column:status" example:"scheduled"`
+	Checks          datatypes.JSON `json:"checks" gorm:"column:checks; type:json" example:"[]"`
+	SpecChanges     datatypes.JSON `json:"specChanges" gorm:"column:spec_changes; type:json" example:"[]"`
+	WorkspaceID     int            `json:"workspaceId" gorm:"column:workspace_id; type:int" example:"1"`
+	CreatedBy       int            `json:"createdBy" gorm:"column:created_by; type:int" example:"1"`
+	CreatedAt       int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+	UpdatedAt       int64          `json:"updatedAt" gorm:"autoUpdateTime:milli"`
+}
+
+type ScheduleConnectorUpgradeRequest struct {
+	ConnectorType string `json:"connectorType" binding:"required,oneof=source destination" example:"source"`
+	DefinitionID  string `json:"airbyteDefinitionId" binding:"required,uuid" example:"decd338e-5647-4c0b-adf4-da0e75f5a750"`
+	Version       string `json:"version" binding:"required" example:"0.4.8"`
+}
+
+// ConnectorUpgradeCheck is the outcome of checking a pipeline with the new version, against a candidate definition
+// running it when the upgrade is validated and against the upgraded definition when it is committed. The changes of
+// the catalog discovered with the new version are listed for the sources.
+type ConnectorUpgradeCheck struct {
+	PipelineID     string              `json:"pipelineId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	PipelineName   string              `json:"pipelineName" example:"pipeline-1"`
+	WorkspaceID    int                 `json:"workspaceId" example:"1"`
+	Passed         bool                `json:"passed" example:"true"`
+	Error          string              `json:"error,omitempty" example:""`
+	CatalogChanges []SchemaFieldChange `json:"catalogChanges"`
+}
+
+type SpecFieldChange struct {
+	Field      string `json:"field" example:"replication_method"`
+	ChangeType string `json:"changeType" example:"field_added"`
+	OldType    string `json:"oldType,omitempty" example:"string"`
+	NewType    string `json:"newType,omitempty" example:"object"`
+}
+
+type ConnectorUpgradeResponse struct {
+	Status string           `json:"status" example:"success"`
+	Errors string           `json:"errors" example:""`
+	Data   ConnectorUpgrade `json:"data"`
+}
+
+type ConnectorUpgradesResponse struct {
+	Status string             `json:"status" example:"success"`
+	Errors string             `json:"errors" example:""`
+	Data   []ConnectorUpgrade `json:"data"`
+}
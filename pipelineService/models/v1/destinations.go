@@ -3,6 +3,7 @@ package models
 import (
 	"github.com/gofrs/uuid"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 type Destination struct {
@@ -15,6 +16,7 @@ type Destination struct {
 	Owner                   int            `json:"owner" gorm:"type:int" example:"1"`
 	WorkspaceID             int            `json:"workspaceId" gorm:"type:int" example:"1"`
 	CreatedAt               int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+	DeletedAt               gorm.DeletedAt `json:"-" gorm:"column:deleted_at"`
 }
 
 type ConfiguredDestination struct {
@@ -38,10 +40,24 @@ type DestinationSummaryResponse struct {
 	CreatedAt       int64       `json:"createdAt" binding:"required"`
 }
 
+// SupportedDestinations is the catalog entry of a destination connector, the airbyte fields are kept in sync with the
+// destination definitions of airbyte and Enabled tells whether the workspace listing the catalog allows the connector.
+// A custom destination is only listed for the workspace which registered it.
 type SupportedDestinations struct {
-	ID   uuid.UUID `json:"id" binding:"required" gorm:"type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	Name string    `json:"name" binding:"required" gorm:"type:string;size:50" example:"destination name"`
-	Type string    `json:"type" binding:"required" gorm:"type:string;size:50" example:"destination type"`
+	ID                  uuid.UUID `json:"id" binding:"required" gorm:"type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Name                string    `json:"name" binding:"required" gorm:"type:string;size:50" example:"destination name"`
+	Type                string    `json:"type" binding:"required" gorm:"type:string;size:50" example:"destination type"`
+	IsActive            *bool     `json:"isActive" gorm:"default:(-)" example:"true"`
+	AirbyteDefinitionID string    `json:"airbyteDefinitionId" gorm:"column:airbyte_definition_id;type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	DockerRepository    string    `json:"dockerRepository" gorm:"column:docker_repository" example:"airbyte/destination-postgres"`
+	DockerImageTag      string    `json:"version" gorm:"column:docker_image_tag" example:"0.3.21"`
+	ReleaseStage        string    `json:"releaseStage" gorm:"column:release_stage" example:"beta"`
+	DocumentationURL    string    `json:"documentationUrl" gorm:"column:documentation_url" example:"https://docs.airbyte.io/integrations/destinations/postgres"`
+	Icon                string    `json:"icon" gorm:"column:icon"`
+	SyncedAt            int64     `json:"syncedAt" gorm:"column:synced_at" example:"1650000000000"`
+	Custom              bool      `json:"custom" gorm:"column:custom" example:"false"`
+	WorkspaceID         int       `json:"workspaceId,omitempty" gorm:"column:workspace_id" example:"1"`
+	Enabled             bool      `json:"enabled" gorm:"->;column:enabled" example:"true"`
 }
 
 type SupportedDestinationResponse struct {
@@ -96,6 +112,17 @@ type CreateDestinationConnectorRequestAirbyte struct {
 	WorkspaceId string `json:"workspaceId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
 }
 
+type EditDestinationConnectorRequest struct {
+	DestinationName         string         `json:"destinationName" example:"example_destination_name"`
+	ConnectionConfiguration datatypes.JSON `json:"connectionConfiguration" binding:"required" example:""`
+}
+
+type EditDestinationConnectorRequestAirByte struct {
+	AirbyteDestinationID    string         `json:"destinationId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ConnectionConfiguration datatypes.JSON `json:"connectionConfiguration" binding:"required" example:""`
+	Name                    string         `json:"name" binding:"required" example:"example_destination_name"`
+}
+
 type CreateDestinationConnectorResponseAirbyte struct {
 	AirbyteDestinationId string `json:"destinationId"`
 	DestinationName      string `json:"destinationName"`
@@ -109,6 +136,7 @@ type DestinationDefinition struct {
 	DockerImageTag          string `json:"dockerImageTag"`
 	DocumentationURL        string `json:"documentationUrl"`
 	Icon                    string `json:"icon"`
+	ReleaseStage            string `json:"releaseStage"`
 }
 
 type DestinationDefinitions struct {
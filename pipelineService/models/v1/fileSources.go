@@ -0,0 +1,59 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"gorm.io/datatypes"
+)
+
+// FileUpload is a version of the file of a file source, every upload of the source is kept as a new version and the
+// airbyte source reads the latest one.
+type FileUpload struct {
+	UploadID    uuid.UUID      `json:"uploadId" gorm:"column:upload_id; type:uuid;primaryKey" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	SourceID    string         `json:"sourceId" gorm:"column:source_id; type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Version     int            `json:"version" gorm:"column:version; type:int" example:"1"`
+	FileName    string         `json:"fileName" gorm:"column:file_name" example:"orders.csv"`
+	Format      string         `json:"format" gorm:"column:format" example:"csv"`
+	Size        int64          `json:"size" gorm:"column:size" example:"20480"`
+	Checksum    string         `json:"checksum" gorm:"column:checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	ObjectKey   string         `json:"-" gorm:"column:object_key"`
+	Preview     datatypes.JSON `json:"preview" gorm:"column:preview; type:json"`
+	WorkspaceID int            `json:"workspaceId" gorm:"column:workspace_id; type:int" example:"1"`
+	CreatedBy   int            `json:"createdBy" gorm:"column:created_by; type:int" example:"1"`
+	CreatedAt   int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+}
+
+type FileColumn struct {
+	Name string `json:"name" example:"order_id"`
+	Type string `json:"type" example:"integer"`
+}
+
+// FilePreview is the schema inferred from the first rows of a file along with those rows, a Parquet file has its
+// schema and number of rows read from its footer and no sample rows.
+type FilePreview struct {
+	Columns []FileColumn             `json:"columns"`
+	Rows    []map[string]interface{} `json:"rows"`
+	NumRows *int64                   `json:"numRows,omitempty" example:"1000"`
+}
+
+type FileSourceUpload struct {
+	SourceID        string      `json:"sourceId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	SourceName      string      `json:"sourceName" example:"orders"`
+	UploadID        uuid.UUID   `json:"uploadId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Version         int         `json:"version" example:"2"`
+	Format          string      `json:"format" example:"csv"`
+	Preview         FilePreview `json:"preview"`
+	SyncedPipelines []string    `json:"syncedPipelines"`
+	FailedPipelines []string    `json:"failedPipelines"`
+}
+
+type FileSourceUploadResponse struct {
+	Status string           `json:"status" example:"success"`
+	Errors string           `json:"errors" example:""`
+	Data   FileSourceUpload `json:"data"`
+}
+
+type FileUploadsResponse struct {
+	Status string       `json:"status" example:"success"`
+	Errors string       `json:"errors" example:""`
+	Data   []FileUpload `json:"data"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import "github.com/gofrs/uuid"
+
+// FreshnessSLA declares how stale a data product may get. The product is as fresh as the latest successful sync of
+// the stalest of its pipelines and of its transformation, the SLA is evaluated periodically.
+type FreshnessSLA struct {
+	ProductID           uuid.UUID `json:"productId" gorm:"column:product_id; type:uuid;primaryKey" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	MaxStalenessMinutes int64     `json:"maxStalenessMinutes" gorm:"column:max_staleness_minutes" example:"360"`
+	Status              string    `json:"status" gorm:"column:status" example:"met"`
+	LastUpdatedAt       int64     `json:"lastUpdatedAt" gorm:"column:last_updated_at" example:"1660000000000"`
+	StalenessMinutes    int64     `json:"stalenessMinutes" gorm:"column:staleness_minutes" example:"42"`
+	EvaluatedAt         int64     `json:"evaluatedAt" gorm:"column:evaluated_at" example:"1660000000000"`
+	CreatedBy           int       `json:"createdBy" gorm:"column:created_by; type:int" example:"1"`
+	WorkspaceID         int       `json:"workspaceId" gorm:"type:int" example:"1"`
+	CreatedAt           int64     `json:"createdAt" gorm:"column:created_at" example:"1660000000000"`
+}
+
+type FreshnessSLARequest struct {
+	MaxStalenessMinutes int64 `json:"maxStalenessMinutes" binding:"required,min=1" example:"360"`
+}
+
+type FreshnessSLAResponse struct {
+	Status string       `json:"status" example:"success"`
+	Errors string       `json:"errors" example:""`
+	Data   FreshnessSLA `json:"data"`
+}
+
+// FreshnessSLAEvent is emitted when the SLA of a data product is breached or recovered, the events of a product are
+// its breach history.
+type FreshnessSLAEvent struct {
+	EventID             uuid.UUID `json:"eventId" gorm:"column:event_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ProductID           string    `json:"productId" gorm:"column:product_id; type:uuid; index" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Type                string    `json:"type" gorm:"column:type" example:"breached"`
+	MaxStalenessMinutes int64     `json:"maxStalenessMinutes" gorm:"column:max_staleness_minutes" example:"360"`
+	StalenessMinutes    int64     `json:"stalenessMinutes" gorm:"column:staleness_minutes" example:"412"`
+	LastUpdatedAt       int64     `json:"lastUpdatedAt" gorm:"column:last_updated_at" example:"1660000000000"`
+	WorkspaceID         int       `json:"workspaceId" gorm:"type:int" example:"1"`
+	OccurredAt          int64     `json:"occurredAt" gorm:"column:occurred_at" example:"1660000000000"`
+}
+
+type FreshnessSLAEventsResponse struct {
+	Status string              `json:"status" example:"success"`
+	Errors string              `json:"errors" example:""`
+	Data   []FreshnessSLAEvent `json:"data"`
+}
+
+// FreshnessReport lists the data products whose SLA changed status in an evaluation, the products which couldn't be
+// evaluated are listed as failures.
+type FreshnessReport struct {
+	Evaluated int      `json:"evaluated" example:"3"`
+	Breached  []string `json:"breached"`
+	Recovered []string `json:"recovered"`
+	Failures  []string `json:"failures"`
+}
+
+type FreshnessReportResponse struct {
+	Status string          `json:"status" example:"success"`
+	Errors string          `json:"errors" example:""`
+	Data   FreshnessReport `json:"data"`
+}
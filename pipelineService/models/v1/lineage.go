@@ -0,0 +1,123 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"gorm.io/datatypes"
+)
+
+// LineageQuery selects the lineage of a node. Nodes are identified as source:<sourceId>, table:<assetId> or
+// column:<assetId>:<columnName>, a depth of 0 follows the lineage to its ends.
+type LineageQuery struct {
+	Node        string `form:"node" binding:"required" example:"table:b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Direction   string `form:"direction" binding:"omitempty,oneof=upstream downstream both" example:"both"`
+	Depth       int    `form:"depth" binding:"min=0" example:"2"`
+	Granularity string `form:"granularity" binding:"omitempty,oneof=table column" example:"table"`
+}
+
+type LineageNode struct {
+	ID        string `json:"id" example:"table:b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Type      string `json:"type" example:"table"`
+	Name      string `json:"name" example:"users"`
+	Table     string `json:"table,omitempty" example:"_airbyte_raw_users"`
+	Schema    string `json:"schema,omitempty" example:"sales"`
+	Namespace string `json:"namespace" example:"postgres://localhost:5432/warehouse"`
+	AssetID   string `json:"assetId,omitempty" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Column    string `json:"column,omitempty" example:"email"`
+	DataType  string `json:"dataType,omitempty" example:"string"`
+	ParentID  string `json:"parentId,omitempty" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+}
+
+// LineageEdge flows from an upstream node to a downstream one. The origin tells whether the edge comes from the sync
+// of a pipeline, the transformation of a data product or its dbt manifest, the job is the pipeline or the product.
+type LineageEdge struct {
+	From   string `json:"from" example:"table:b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	To     string `json:"to" example:"table:a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Origin string `json:"origin" example:"pipeline"`
+	JobID  string `json:"jobId" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+}
+
+type LineageGraph struct {
+	Root        string        `json:"root" example:"table:b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Direction   string        `json:"direction" example:"both"`
+	Granularity string        `json:"granularity" example:"table"`
+	Nodes       []LineageNode `json:"nodes"`
+	Edges       []LineageEdge `json:"edges"`
+}
+
+type LineageGraphResponse struct {
+	Status string       `json:"status" example:"success"`
+	Errors string       `json:"errors" example:""`
+	Data   LineageGraph `json:"data"`
+}
+
+// LineageSource is a source synced by a pipeline.
+type LineageSource struct {
+	SourceID   string `gorm:"column:source_id"`
+	SourceName string `gorm:"column:source_name"`
+	PipelineID string `gorm:"column:pipeline_id"`
+}
+
+// LineageTable is a raw asset of a pipeline or a transformed asset of a data product along with the destination
+// holding it.
+type LineageTable struct {
+	AssetID         string `gorm:"column:asset_id"`
+	Name            string `gorm:"column:name"`
+	Prefix          string `gorm:"column:prefix"`
+	Schema          string `gorm:"column:schema_name"`
+	ParentID        string `gorm:"column:parent_id"`
+	Raw             bool   `gorm:"column:raw"`
+	DestinationType string `gorm:"column:destination_type"`
+	Host            string `gorm:"column:host"`
+	Port            string `gorm:"column:port"`
+	Database        string `gorm:"column:database"`
+}
+
+// DbtManifest is the manifest.json compiled by the dbt project transforming a data product, the models it declares
+// give the lineage of the transformed assets.
+type DbtManifest struct {
+	ProductID   uuid.UUID      `json:"productId" gorm:"column:product_id; type:uuid;primaryKey" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Manifest    datatypes.JSON `json:"-" gorm:"column:manifest; type:json"`
+	Models      []string       `json:"models" gorm:"-" example:"orders,customers"`
+	UploadedBy  int            `json:"uploadedBy" gorm:"column:uploaded_by; type:int" example:"1"`
+	WorkspaceID int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	UploadedAt  int64          `json:"uploadedAt" gorm:"column:uploaded_at" example:"1660000000000"`
+}
+
+type DbtManifestResponse struct {
+	Status string      `json:"status" example:"success"`
+	Errors string      `json:"errors" example:""`
+	Data   DbtManifest `json:"data"`
+}
+
+// OpenLineageRunEvent follows the RunEvent of the OpenLineage specification.
+type OpenLineageRunEvent struct {
+	EventType string               `json:"eventType" example:"COMPLETE"`
+	EventTime string               `json:"eventTime" example:"2022-08-08T22:13:20Z"`
+	Run       OpenLineageRun       `json:"run"`
+	Job       OpenLineageJob       `json:"job"`
+	Inputs    []OpenLineageDataset `json:"inputs"`
+	Outputs   []OpenLineageDataset `json:"outputs"`
+	Producer  string               `json:"producer" example:"pipelineService"`
+	SchemaURL string               `json:"schemaURL" example:"https://openlineage.io/spec/1-0-5/OpenLineage.json#/definitions/RunEvent"`
+}
+
+type OpenLineageRun struct {
+	RunID string `json:"runId" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+}
+
+type OpenLineageJob struct {
+	Namespace string `json:"namespace" example:"pipelineService"`
+	Name      string `json:"name" example:"pipeline.b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+}
+
+type OpenLineageDataset struct {
+	Namespace string                 `json:"namespace" example:"postgres://localhost:5432/warehouse"`
+	Name      string                 `json:"name" example:"sales.users"`
+	Facets    map[string]interface{} `json:"facets,omitempty"`
+}
+
+type OpenLineageEventsResponse struct {
+	Status string                `json:"status" example:"success"`
+	Errors string                `json:"errors" example:""`
+	Data   []OpenLineageRunEvent `json:"data"`
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"gorm.io/datatypes"
+)
+
+// OAuthFlow tracks the consent of a source from the consent url to the callback of the provider. The tokens of a
+// completed flow are kept until a source is configured with them and are never returned by the api.
+type OAuthFlow struct {
+	FlowID                  uuid.UUID      `json:"flowId" gorm:"column:flow_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	State                   string         `json:"-" gorm:"column:state"`
+	SourceDefinitionID      string         `json:"airbyteSourceDefinitionId" gorm:"column:source_definition_id; type:uuid" example:"71607ba1-c0ac-4799-8049-7f4b90dd50f7"`
+	SourceID                *string        `json:"sourceId,omitempty" gorm:"column:source_id; type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	RedirectURL             string         `json:"redirectUrl" gorm:"column:redirect_url" example:"https://app.example.com/sources/oauth"`
+	Status                  string         `json:"status" gorm:"column:status" example:"pending"`
+	Error                   string         `json:"error,omitempty" gorm:"column:error" example:""`
+	OAuthInputConfiguration datatypes.JSON `json:"-" gorm:"column:oauth_input_configuration; type:json"`
+	Output                  datatypes.JSON `json:"-" gorm:"column:output; type:json"`
+	WorkspaceID             int            `json:"workspaceId" gorm:"column:workspace_id; type:int" example:"1"`
+	AirbyteWorkspaceID      string         `json:"-" gorm:"column:airbyte_workspace_id"`
+	CreatedBy               int            `json:"createdBy" gorm:"column:created_by; type:int" example:"1"`
+	CreatedAt               int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+	CompletedAt             int64          `json:"completedAt" gorm:"column:completed_at"`
+}
+
+type OAuthConsentRequest struct {
+	SourceDefinitionID      string                 `json:"airbyteSourceDefinitionId" binding:"required,uuid" example:"71607ba1-c0ac-4799-8049-7f4b90dd50f7"`
+	SourceID                string                 `json:"sourceId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	RedirectURL             string                 `json:"redirectUrl" binding:"required,url" example:"https://app.example.com/sources/oauth"`
+	OAuthInputConfiguration map[string]interface{} `json:"oAuthInputConfiguration"`
+}
+
+// SourceOAuthRequestAirbyte is the request of the get_consent_url and complete_oauth endpoints of airbyte.
+type SourceOAuthRequestAirbyte struct {
+	SourceDefinitionID      string                 `json:"sourceDefinitionId"`
+	WorkspaceID             string                 `json:"workspaceId"`
+	RedirectURL             string                 `json:"redirectUrl"`
+	QueryParams             map[string]interface{} `json:"queryParams,omitempty"`
+	OAuthInputConfiguration map[string]interface{} `json:"oAuthInputConfiguration,omitempty"`
+}
+
+type OAuthConsent struct {
+	FlowID     uuid.UUID `json:"flowId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ConsentURL string    `json:"consentUrl" example:"https://accounts.google.com/o/oauth2/v2/auth?client_id=...&state=..."`
+}
+
+type OAuthConsentResponse struct {
+	Status string       `json:"status" example:"success"`
+	Errors string       `json:"errors" example:""`
+	Data   OAuthConsent `json:"data"`
+}
+
+type OAuthFlowResponse struct {
+	Status string    `json:"status" example:"success"`
+	Errors string    `json:"errors" example:""`
+	Data   OAuthFlow `json:"data"`
+}
+
+// OAuthSource is a source configured with the tokens of an OAuth flow.
+type OAuthSource struct {
+	SourceID                  string `gorm:"column:source_id"`
+	AirbyteSourceID           string `gorm:"column:airbyte_source_id"`
+	AirbyteSourceDefinitionID string `gorm:"column:airbyte_source_definition_id"`
+	WorkspaceID               int    `gorm:"column:workspace_id"`
+}
+
+// OAuthTokenFailure records a source whose tokens couldn't be refreshed, it is resolved once the source connects again
+// or is authorized through a new OAuth flow.
+type OAuthTokenFailure struct {
+	FailureID   uuid.UUID `json:"failureId" gorm:"column:failure_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	SourceID    string    `json:"sourceId" gorm:"column:source_id; type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	SourceName  string    `json:"sourceName" gorm:"->;column:source_name" example:"google-sheets"`
+	Error       string    `json:"error" gorm:"column:error" example:"invalid_grant: Token has been expired or revoked."`
+	WorkspaceID int       `json:"workspaceId" gorm:"column:workspace_id; type:int" example:"1"`
+	DetectedAt  int64     `json:"detectedAt" gorm:"column:detected_at"`
+	ResolvedAt  int64     `json:"resolvedAt" gorm:"column:resolved_at"`
+}
+
+type OAuthTokenFailuresResponse struct {
+	Status string              `json:"status" example:"success"`
+	Errors string              `json:"errors" example:""`
+	Data   []OAuthTokenFailure `json:"data"`
+}
+
+type OAuthTokenCheckReport struct {
+	Checked int      `json:"checked" example:"4"`
+	Failed  []string `json:"failed"`
+}
+
+type OAuthTokenCheckReportResponse struct {
+	Status string                `json:"status" example:"success"`
+	Errors string                `json:"errors" example:""`
+	Data   OAuthTokenCheckReport `json:"data"`
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/lib/pq"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 type Response struct {
@@ -22,6 +23,7 @@ type DataProduct struct {
 	LastUpdated           int64          `json:"lastUpdated" gorm:"autoUpdateTime:milli"`
 	Owner                 int            `json:"owner" gorm:"type:int" example:"1"`
 	WorkspaceID           int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	DeletedAt             gorm.DeletedAt `json:"-" gorm:"column:deleted_at"`
 }
 
 type GetAllDataProductsView struct {
@@ -63,8 +65,9 @@ type DataProductView struct {
 }
 
 type GetDataProductView struct {
-	DataProduct DataProductView `json:"dataProduct"`
-	Owner       interface{}     `json:"owner"`
+	DataProduct DataProductView      `json:"dataProduct"`
+	Owner       interface{}          `json:"owner"`
+	Quality     []QualityCheckResult `json:"quality"`
 }
 
 type GetDataProductViewResponse struct {
@@ -104,3 +107,36 @@ type ConfigurationDetails struct {
 	UserName string `json:"username"`
 	Password string `json:"password"`
 }
+
+type PurgeReport struct {
+	Pipelines    []string       `json:"pipelines"`
+	Sources      []string       `json:"sources"`
+	Destinations []string       `json:"destinations"`
+	DataProducts []string       `json:"dataProducts"`
+	Failures     []PurgeFailure `json:"failures"`
+}
+
+type PurgeFailure struct {
+	ResourceType string `json:"resourceType" example:"source"`
+	ResourceID   string `json:"resourceId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Error        string `json:"error" example:"failed to delete the source on airbyte"`
+}
+
+type PurgeReportResponse struct {
+	Status string      `json:"status" example:"success"`
+	Errors string      `json:"errors" example:""`
+	Data   PurgeReport `json:"data"`
+}
+
+type ResourceDependency struct {
+	ResourceType string `json:"resourceType" gorm:"column:resource_type" example:"pipeline"`
+	ResourceID   string `json:"resourceId" gorm:"column:resource_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Name         string `json:"name" gorm:"column:name" example:"pipeline-1"`
+	Deleted      bool   `json:"deleted" gorm:"column:deleted" example:"false"`
+}
+
+type ResourceDependenciesResponse struct {
+	Status string               `json:"status" example:"error"`
+	Errors string               `json:"errors" example:"Destination is used by other resources"`
+	Data   []ResourceDependency `json:"data"`
+}
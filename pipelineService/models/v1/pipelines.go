@@ -4,6 +4,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/lib/pq"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 type Pipeline struct {
@@ -13,6 +14,7 @@ type Pipeline struct {
 	CreatedAt          int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
 	Owner              int            `json:"owner" gorm:"type:int" example:"1"`
 	WorkspaceID        int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	DeletedAt          gorm.DeletedAt `json:"-" gorm:"column:deleted_at"`
 }
 
 type PipelineView struct {
@@ -30,9 +32,23 @@ type PipelineView struct {
 	AirbyteLastRun      int                      `json:"airbyteLastRun" gorm:"column:airbyte_last_run" example:"1645517210"`
 	AirbyteConnectionID string                   `json:"airbyteConnectionId" gorm:"column:airbyte_connection_id" example:""`
 	ConnectionID        string                   `json:"connectionId" gorm:"column:connection_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Destinations        []PipelineDestination    `json:"destinations" gorm:"-"`
 	Product             []map[string]interface{} `json:"dataProduct"`
 }
 
+// PipelineDestination is one of the destinations the pipeline replicates its source to, every destination has an
+// airbyte connection of its own.
+type PipelineDestination struct {
+	PipelineID          string `json:"-" gorm:"column:pipeline_id"`
+	DestinationID       string `json:"destinationId" gorm:"column:destination_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	DestinationName     string `json:"destinationName" gorm:"column:destination_name" example:"redshift"`
+	DestinationType     string `json:"destinationType" gorm:"column:destination_type" example:"postgres"`
+	ConnectionID        string `json:"connectionId" gorm:"column:connection_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AirbyteConnectionID string `json:"airbyteConnectionId" gorm:"column:airbyte_connection_id" example:""`
+	AirbyteStatus       string `json:"airbyteStatus" gorm:"column:airbyte_status" example:"succeeded"`
+	AirbyteLastRun      int    `json:"airbyteLastRun" gorm:"column:airbyte_last_run" example:"1645517210"`
+}
+
 type UpdatePipeline struct {
 	PipelineID         uuid.UUID      `json:"pipelineID" gorm:"type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
 	Name               string         `json:"name" binding:"required" gorm:"type:string;size:50" example:"pipeline-1"`
@@ -40,8 +56,9 @@ type UpdatePipeline struct {
 }
 
 type GetPipelineDetails struct {
-	Pipeline PipelineView `json:"pipeline"`
-	Owner    interface{}  `json:"owner"`
+	Pipeline PipelineView         `json:"pipeline"`
+	Owner    interface{}          `json:"owner"`
+	Quality  []QualityCheckResult `json:"quality"`
 }
 
 type GetPipelineDetailsResponse struct {
@@ -63,20 +80,21 @@ type PipelineResponse struct {
 }
 
 type PipelinesMetaData struct {
-	PipelineID          string         `gorm:"column:pipeline_id" json:"pipelineID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
-	PipelineName        string         `gorm:"column:pipeline_name" json:"pipelineName" example:"pipeline-1"`
-	PipelineGovernance  pq.StringArray `gorm:"column:pipeline_governance;type:varchar[]" json:"pipelineGovernance" example:"[sales, IoT]"`
-	PipelineStatus      string         `gorm:"column:pipeline_status" json:"pipelineStatus" example:"Inactive"`
-	SourceName          string         `gorm:"column:source_name" json:"sourceName" example:"postgres"`
-	SourceID            uuid.UUID      `json:"sourceID" gorm:"column:source_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	DestinationName     string         `gorm:"column:destination_name" json:"destinationName" example:"redshift"`
-	DestinationID       string         `json:"destinationId" binding:"required" gorm:"column:destination_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	AirbyteStatus       string         `gorm:"column:airbyte_status" json:"airbyteStatus" example:"Inactive"`
-	AirbyteLastRun      int            `gorm:"column:airbyte_last_run" json:"airbyteLastRun" example:"1645517210"`
-	AirbyteConnectionID string         `gorm:"column:airbyte_connection_id" json:"airbyteConnectionId" example:""`
-	ConnectionID        string         `json:"connectionId" gorm:"column:connection_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	OwnerID             int            `gorm:"column:owner_id;type:int" json:"-" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	Owner               interface{}    `gorm:"-" json:"owner" example:"{}"`
+	PipelineID          string                `gorm:"column:pipeline_id" json:"pipelineID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	PipelineName        string                `gorm:"column:pipeline_name" json:"pipelineName" example:"pipeline-1"`
+	PipelineGovernance  pq.StringArray        `gorm:"column:pipeline_governance;type:varchar[]" json:"pipelineGovernance" example:"[sales, IoT]"`
+	PipelineStatus      string                `gorm:"column:pipeline_status" json:"pipelineStatus" example:"Inactive"`
+	SourceName          string                `gorm:"column:source_name" json:"sourceName" example:"postgres"`
+	SourceID            uuid.UUID             `json:"sourceID" gorm:"column:source_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	DestinationName     string                `gorm:"column:destination_name" json:"destinationName" example:"redshift"`
+	DestinationID       string                `json:"destinationId" binding:"required" gorm:"column:destination_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AirbyteStatus       string                `gorm:"column:airbyte_status" json:"airbyteStatus" example:"Inactive"`
+	AirbyteLastRun      int                   `gorm:"column:airbyte_last_run" json:"airbyteLastRun" example:"1645517210"`
+	AirbyteConnectionID string                `gorm:"column:airbyte_connection_id" json:"airbyteConnectionId" example:""`
+	ConnectionID        string                `json:"connectionId" gorm:"column:connection_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Destinations        []PipelineDestination `json:"destinations" gorm:"-"`
+	OwnerID             int                   `gorm:"column:owner_id;type:int" json:"-" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Owner               interface{}           `gorm:"-" json:"owner" example:"{}"`
 }
 
 type PipelineMetaDataResponse struct {
@@ -87,8 +105,11 @@ type PipelineMetaDataResponse struct {
 
 type PipelineSourceAndConnectionID struct {
 	PipelineID          string `gorm:"column:pipeline_id" json:"pipelineID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ConnectionID        string `gorm:"column:connection_id" json:"connectionId" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
 	SourceID            string `gorm:"column:airbyte_source_id" json:"sourceID" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
 	AirByteConnectionID string `gorm:"column:airbyte_connection_id" json:"airbyteConnectionId" example:""`
+	// AirByteConnectionIDs holds the airbyte connection of every destination of the pipeline
+	AirByteConnectionIDs pq.StringArray `gorm:"column:airbyte_connection_ids;type:text[]" json:"airbyteConnectionIds" example:"[b251379e-01a1-11ec-82d6-a312edcd9c7b]"`
 }
 
 type GetPipelineSourceAndConnectionID struct {
@@ -98,12 +119,32 @@ type GetPipelineSourceAndConnectionID struct {
 }
 
 type CreatePipelineRequest struct {
-	SourceID      string       `json:"sourceId" binding:"required" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	DestinationID string       `json:"destinationId" binding:"required" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	Schedule      *Schedule    `json:"schedule" example:""`
-	SyncCatalog   SyncCatalog  `json:"syncCatalog" binding:"required" example:""`
-	Prefix        *string      `json:"prefix" binding:"required" example:"t1"`
-	Operations    []Operations `json:"operations,omitempty"`
+	SourceID      string `json:"sourceId" binding:"required" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	PipelineID    string `json:"pipelineId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	DestinationID string `json:"destinationId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	// DestinationIDs fans the source out to several destinations, each of them gets an airbyte connection
+	DestinationIDs []string     `json:"destinationIds,omitempty" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Schedule       *Schedule    `json:"schedule" example:""`
+	SyncCatalog    SyncCatalog  `json:"syncCatalog" binding:"required" example:""`
+	Prefix         *string      `json:"prefix" binding:"required" example:"t1"`
+	Operations     []Operations `json:"operations,omitempty"`
+}
+
+// Destinations returns the destinations of the request without duplicates, the single destination comes first.
+func (r CreatePipelineRequest) Destinations() []string {
+	destinations := make([]string, 0, len(r.DestinationIDs)+1)
+	seen := make(map[string]bool)
+
+	for _, destinationID := range append([]string{r.DestinationID}, r.DestinationIDs...) {
+		if destinationID == "" || seen[destinationID] {
+			continue
+		}
+
+		seen[destinationID] = true
+		destinations = append(destinations, destinationID)
+	}
+
+	return destinations
 }
 
 type CreatePipelineResponse struct {
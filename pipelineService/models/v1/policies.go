@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+)
+
+// MaskingPolicy masks the previewed values of the columns carrying the classification, unless the caller has one of
+// the exempt roles.
+type MaskingPolicy struct {
+	PolicyID       uuid.UUID      `json:"policyId" gorm:"column:policy_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Classification string         `json:"classification" gorm:"column:classification; uniqueIndex:idx_masking_policy" binding:"required" example:"email"`
+	Method         string         `json:"method" gorm:"column:method" binding:"required,oneof=hash redact partial null" example:"partial"`
+	ExemptRoles    pq.StringArray `json:"exemptRoles" gorm:"column:exempt_roles; type:varchar[]" example:"[admin]"`
+	CreatedBy      int            `json:"createdBy" gorm:"column:created_by; type:int" example:"1"`
+	WorkspaceID    int            `json:"workspaceId" gorm:"type:int; uniqueIndex:idx_masking_policy" example:"1"`
+	CreatedAt      int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+}
+
+type MaskingPolicyResponse struct {
+	Status string        `json:"status" example:"success"`
+	Errors string        `json:"errors" example:""`
+	Data   MaskingPolicy `json:"data"`
+}
+
+type MaskingPoliciesResponse struct {
+	Status string          `json:"status" example:"success"`
+	Errors string          `json:"errors" example:""`
+	Data   []MaskingPolicy `json:"data"`
+}
+
+// RowFilter restricts the previewed rows of the assets of a data product to the ones matching the condition, unless
+// the caller has one of the exempt roles.
+type RowFilter struct {
+	FilterID    uuid.UUID      `json:"filterId" gorm:"column:filter_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ProductID   string         `json:"productId" gorm:"column:product_id; type:uuid" binding:"required,uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Column      string         `json:"column" gorm:"column:column_name" binding:"required" example:"region"`
+	Operator    string         `json:"operator" gorm:"column:operator" binding:"required,oneof=eq neq gt gte lt lte in not_in is_null not_null" example:"in"`
+	Values      pq.StringArray `json:"values" gorm:"column:values; type:varchar[]" example:"[EU, UK]"`
+	ExemptRoles pq.StringArray `json:"exemptRoles" gorm:"column:exempt_roles; type:varchar[]" example:"[admin]"`
+	CreatedBy   int            `json:"createdBy" gorm:"column:created_by; type:int" example:"1"`
+	WorkspaceID int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	CreatedAt   int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+}
+
+type RowFilterResponse struct {
+	Status string    `json:"status" example:"success"`
+	Errors string    `json:"errors" example:""`
+	Data   RowFilter `json:"data"`
+}
+
+type RowFiltersResponse struct {
+	Status string      `json:"status" example:"success"`
+	Errors string      `json:"errors" example:""`
+	Data   []RowFilter `json:"data"`
+}
+
+type RowFilterQuery struct {
+	ProductID string `form:"productId" binding:"required,uuid"`
+}
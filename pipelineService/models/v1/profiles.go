@@ -0,0 +1,55 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"gorm.io/datatypes"
+)
+
+// AssetProfile holds the statistics of an asset computed on its destination, a profile is stored on every run and
+// the latest one is shown with the asset.
+type AssetProfile struct {
+	ProfileID  uuid.UUID      `json:"profileId" gorm:"column:profile_id; type:uuid;primaryKey;default:(-)" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AssetID    string         `json:"assetId" gorm:"column:asset_id; type:uuid; index" example:"b251379e-01a1-11ec-82d6-a312edcd9c7b"`
+	RowCount   int64          `json:"rowCount" gorm:"column:row_count" example:"1000"`
+	Columns    datatypes.JSON `json:"columns" gorm:"column:columns; type:json" example:"[]"`
+	ProfiledAt int64          `json:"profiledAt" gorm:"column:profiled_at" example:"1660000000000"`
+}
+
+type TableProfile struct {
+	RowCount int64
+	Columns  []ColumnProfile
+}
+
+// ColumnProfile holds the statistics of a column, the value statistics are only computed for the column types they
+// apply to.
+type ColumnProfile struct {
+	Name          string         `json:"name" example:"email"`
+	Type          string         `json:"type" example:"string"`
+	NullFraction  float64        `json:"nullFraction" example:"0.1"`
+	DistinctCount int64          `json:"distinctCount" example:"900"`
+	Min           *float64       `json:"min,omitempty" example:"0"`
+	Max           *float64       `json:"max,omitempty" example:"100"`
+	Mean          *float64       `json:"mean,omitempty" example:"50"`
+	TopValues     []ValueCount   `json:"topValues,omitempty"`
+	Length        *LengthProfile `json:"length,omitempty"`
+}
+
+type ValueCount struct {
+	Value string `json:"value" example:"user@example.com"`
+	Count int64  `json:"count" example:"2"`
+}
+
+// LengthProfile is the distribution of the lengths of the values of a text column.
+type LengthProfile struct {
+	Min    int64   `json:"min" example:"5"`
+	Max    int64   `json:"max" example:"40"`
+	Mean   float64 `json:"mean" example:"18.5"`
+	Median float64 `json:"median" example:"17"`
+	P95    float64 `json:"p95" example:"32"`
+}
+
+type AssetProfileResponse struct {
+	Status string       `json:"status" example:"success"`
+	Errors string       `json:"errors" example:""`
+	Data   AssetProfile `json:"data"`
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+)
+
+// QualityRule is an expectation on the rows of an asset, the rules of an asset are checked after every successful
+// sync of its pipeline or transformation of its product. A failed rule of error severity degrades the data products
+// of the asset.
+type QualityRule struct {
+	RuleID  uuid.UUID `json:"ruleId" gorm:"column:rule_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AssetID string    `json:"assetId" gorm:"column:asset_id; type:uuid; index" binding:"required,uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	// ParentID and AssetName identify the asset across the syncs recreating it, the rule is checked on its latest asset
+	ParentID        string         `json:"parentId" gorm:"column:parent_id; type:uuid; index:idx_quality_rule_asset" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AssetName       string         `json:"assetName" gorm:"column:asset_name; index:idx_quality_rule_asset" example:"users"`
+	Column          string         `json:"column" gorm:"column:column_name" example:"email"`
+	Check           string         `json:"check" gorm:"column:check_type" binding:"required,oneof=not_null unique accepted_values range regex row_count_delta freshness" example:"not_null"`
+	Values          pq.StringArray `json:"values,omitempty" gorm:"column:values; type:varchar[]" example:"[active, inactive]"`
+	Min             *float64       `json:"min,omitempty" gorm:"column:min_value" example:"0"`
+	Max             *float64       `json:"max,omitempty" gorm:"column:max_value" example:"100"`
+	Pattern         string         `json:"pattern,omitempty" gorm:"column:pattern" example:"^[A-Z]{2}$"`
+	MaxDeltaPercent *float64       `json:"maxDeltaPercent,omitempty" gorm:"column:max_delta_percent" example:"20"`
+	MaxAgeMinutes   int64          `json:"maxAgeMinutes,omitempty" gorm:"column:max_age_minutes" example:"1440"`
+	Severity        string         `json:"severity" gorm:"column:severity" binding:"omitempty,oneof=warning error" example:"error"`
+	CreatedBy       int            `json:"createdBy" gorm:"column:created_by; type:int" example:"1"`
+	WorkspaceID     int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	CreatedAt       int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+}
+
+type QualityRuleResponse struct {
+	Status string      `json:"status" example:"success"`
+	Errors string      `json:"errors" example:""`
+	Data   QualityRule `json:"data"`
+}
+
+type QualityRulesResponse struct {
+	Status string        `json:"status" example:"success"`
+	Errors string        `json:"errors" example:""`
+	Data   []QualityRule `json:"data"`
+}
+
+type QualityRuleQuery struct {
+	AssetID string `form:"assetId" binding:"required,uuid"`
+}
+
+// QualityCheckResult is the outcome of a rule in a run, the results of the rules of the assets checked together
+// share the run.
+type QualityCheckResult struct {
+	ResultID    uuid.UUID `json:"resultId" gorm:"column:result_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	RunID       string    `json:"runId" gorm:"column:run_id; type:uuid; index" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	RuleID      string    `json:"ruleId" gorm:"column:rule_id; type:uuid; index" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AssetID     string    `json:"assetId" gorm:"column:asset_id; type:uuid; index" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Column      string    `json:"column" gorm:"column:column_name" example:"email"`
+	Check       string    `json:"check" gorm:"column:check_type" example:"not_null"`
+	Severity    string    `json:"severity" gorm:"column:severity" example:"error"`
+	Passed      bool      `json:"passed" gorm:"column:passed" example:"false"`
+	RowCount    int64     `json:"rowCount" gorm:"column:row_count" example:"1000"`
+	FailedRows  int64     `json:"failedRows" gorm:"column:failed_rows" example:"12"`
+	Observed    string    `json:"observed" gorm:"column:observed" example:"12 of 1000 rows failed"`
+	Error       string    `json:"error,omitempty" gorm:"column:error" example:""`
+	WorkspaceID int       `json:"workspaceId" gorm:"type:int" example:"1"`
+	CheckedAt   int64     `json:"checkedAt" gorm:"column:checked_at" example:"1660000000000"`
+}
+
+type QualityCheckResultsResponse struct {
+	Status string               `json:"status" example:"success"`
+	Errors string               `json:"errors" example:""`
+	Data   []QualityCheckResult `json:"data"`
+}
+
+type QualityResultFilter struct {
+	AssetID string `form:"assetId" binding:"required,uuid"`
+	RuleID  string `form:"ruleId" binding:"omitempty,uuid"`
+	RunID   string `form:"runId" binding:"omitempty,uuid"`
+}
+
+// QualityMeasure is what a rule measures on the table of an asset, it's evaluated against the rule and the previous
+// results of the rule.
+type QualityMeasure struct {
+	RowCount   int64
+	FailedRows int64
+	Latest     *float64
+}
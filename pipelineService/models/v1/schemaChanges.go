@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"gorm.io/datatypes"
+)
+
+type SchemaChange struct {
+	ChangeID        uuid.UUID      `json:"changeId" gorm:"column:change_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	PipelineID      string         `json:"pipelineId" gorm:"column:pipeline_id; type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	ConnectionID    string         `json:"connectionId" gorm:"column:connection_id; type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Status          string         `json:"status" gorm:"column:status; type:string" example:"pending"`
+	Changes         datatypes.JSON `json:"changes" gorm:"column:changes" example:"[]"`
+	ProposedCatalog datatypes.JSON `json:"-" gorm:"column:proposed_catalog"`
+	Fingerprint     string         `json:"-" gorm:"column:fingerprint"`
+	ReviewedBy      int            `json:"reviewedBy" gorm:"column:reviewed_by; type:int" example:"1"`
+	ReviewedAt      int64          `json:"reviewedAt" gorm:"column:reviewed_at"`
+	WorkspaceID     int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	CreatedAt       int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+	UpdatedAt       int64          `json:"updatedAt" gorm:"autoUpdateTime:milli"`
+}
+
+type SchemaFieldChange struct {
+	Stream     string `json:"stream" example:"public.users"`
+	Field      string `json:"field,omitempty" example:"email"`
+	ChangeType string `json:"changeType" example:"type_changed"`
+	OldType    string `json:"oldType,omitempty" example:"string"`
+	NewType    string `json:"newType,omitempty" example:"integer"`
+}
+
+type SchemaChangeFilter struct {
+	PipelineID string `form:"pipelineId"`
+	Status     string `form:"status"`
+}
+
+type SchemaChangesResponse struct {
+	Status string         `json:"status" example:"success"`
+	Errors string         `json:"errors" example:""`
+	Data   []SchemaChange `json:"data"`
+}
+
+type SchemaDriftReport struct {
+	Checked  int      `json:"checked" example:"10"`
+	Drifted  []string `json:"drifted"`
+	Failures []string `json:"failures"`
+}
+
+type SchemaDriftReportResponse struct {
+	Status string            `json:"status" example:"success"`
+	Errors string            `json:"errors" example:""`
+	Data   SchemaDriftReport `json:"data"`
+}
+
+type DriftCheckConnection struct {
+	ConnectionID        string `json:"connectionId" gorm:"column:connection_id"`
+	PipelineID          string `json:"pipelineId" gorm:"column:pipeline_id"`
+	AirbyteConnectionID string `json:"airbyteConnectionId" gorm:"column:airbyte_connection_id"`
+	AirbyteSourceID     string `json:"airbyteSourceId" gorm:"column:airbyte_source_id"`
+	WorkspaceID         int    `json:"workspaceId" gorm:"column:workspace_id"`
+}
@@ -1,20 +1,45 @@
 package models
 
-import "github.com/gofrs/uuid"
+import (
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
 
 type Source struct {
-	SourceID                  string `json:"sourceId" binding:"required" gorm:"column:source_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	SourceName                string `json:"sourceName" binding:"required" gorm:"column:name; type:string; default:(-)" example:"example_source"`
-	AirbyteSourceID           string `json:"airbyteSourceId" gorm:"column:airbyte_source_id; type:uuid; default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	AirbyteSourceDefinitionID string `json:"airbyteSourceDefinitionId" gorm:"column:airbyte_source_definition_id; type:uuid ; default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	ConnectionID              string `json:"connectionId" binding:"required" gorm:"column:connection_id; type:uuid;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	Owner                     int    `json:"owner" gorm:"type:int" example:"1"`
-	WorkspaceID               int    `json:"workspaceId" gorm:"type:int" example:"1"`
+	SourceID                  string         `json:"sourceId" binding:"required" gorm:"column:source_id; type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	SourceName                string         `json:"sourceName" binding:"required" gorm:"column:name; type:string; default:(-)" example:"example_source"`
+	AirbyteSourceID           string         `json:"airbyteSourceId" gorm:"column:airbyte_source_id; type:uuid; default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AirbyteSourceDefinitionID string         `json:"airbyteSourceDefinitionId" gorm:"column:airbyte_source_definition_id; type:uuid ; default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Owner                     int            `json:"owner" gorm:"type:int" example:"1"`
+	WorkspaceID               int            `json:"workspaceId" gorm:"type:int" example:"1"`
+	CreatedAt                 int64          `json:"createdAt" gorm:"default:(extract(epoch from now()) * 1000)"`
+	DeletedAt                 gorm.DeletedAt `json:"-" gorm:"column:deleted_at"`
 }
 
 type CreateSourceConnectorRequestAPI struct {
 	CreateSourceConnectorRequest
-	Pipeline string `json:"pipelineId" binding:"required" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Pipeline    string `json:"pipelineId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	OAuthFlowID string `json:"oauthFlowId" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+}
+
+type WorkspaceSource struct {
+	SourceID                  string `json:"sourceId" gorm:"column:source_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	SourceName                string `json:"sourceName" gorm:"column:name" example:"example_source"`
+	AirbyteSourceID           string `json:"airbyteSourceId" gorm:"column:airbyte_source_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	AirbyteSourceDefinitionID string `json:"airbyteSourceDefinitionId" gorm:"column:airbyte_source_definition_id" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Owner                     int    `json:"owner" gorm:"column:owner" example:"1"`
+	CreatedAt                 int64  `json:"createdAt" gorm:"column:created_at"`
+	Pipelines                 int    `json:"pipelines" gorm:"column:pipelines" example:"2"`
+}
+
+type WorkspaceSourcesResponse struct {
+	Status string            `json:"status" example:"success"`
+	Errors string            `json:"errors" example:""`
+	Data   []WorkspaceSource `json:"data"`
+}
+
+type AttachSourceRequest struct {
+	PipelineID string `json:"pipelineId" binding:"required" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
 }
 
 type CreateSourceConnectorResposneData struct {
@@ -45,11 +70,13 @@ type AuthSpecification struct {
 	Oauth2Specification Oauth2Specification `json:"oauth2Specification"`
 }
 
+// OauthConfigSpecification holds the JSON schemas of the OAuth flow, the properties of the output specification
+// name the path of the token in the connector configuration with path_in_connector_config.
 type OauthConfigSpecification struct {
-	OauthUserInputFromConnectorConfigSpecification string `json:"oauthUserInputFromConnectorConfigSpecification"`
-	CompleteOAuthOutputSpecification               string `json:"completeOAuthOutputSpecification"`
-	CompleteOAuthServerInputSpecification          string `json:"completeOAuthServerInputSpecification"`
-	CompleteOAuthServerOutputSpecification         string `json:"completeOAuthServerOutputSpecification"`
+	OauthUserInputFromConnectorConfigSpecification interface{} `json:"oauthUserInputFromConnectorConfigSpecification"`
+	CompleteOAuthOutputSpecification               interface{} `json:"completeOAuthOutputSpecification"`
+	CompleteOAuthServerInputSpecification          interface{} `json:"completeOAuthServerInputSpecification"`
+	CompleteOAuthServerOutputSpecification         interface{} `json:"completeOAuthServerOutputSpecification"`
 }
 
 type AdvancedAuth struct {
@@ -108,11 +135,24 @@ type CreateSourceConnectorResponseAirbyte struct {
 	CreateSourceConnectorRequest
 }
 
+// SupportedSources is the catalog entry of a source connector, the airbyte fields are kept in sync with the source
+// definitions of airbyte and Enabled tells whether the workspace listing the catalog allows the connector. A custom
+// source is only listed for the workspace which registered it.
 type SupportedSources struct {
-	ID       uuid.UUID `json:"id" binding:"required" gorm:"type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
-	Name     string    `json:"name" binding:"required" gorm:"type:string;size:50" example:"source name"`
-	Type     string    `json:"type" binding:"required" gorm:"type:string;size:50" example:"source type"`
-	IsActive *bool     `json:"isActive" binding:"required" gorm:"default:(-)" example:"true"`
+	ID                  uuid.UUID `json:"id" binding:"required" gorm:"type:uuid;primaryKey;default:(-)" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	Name                string    `json:"name" binding:"required" gorm:"type:string;size:50" example:"source name"`
+	Type                string    `json:"type" binding:"required" gorm:"type:string;size:50" example:"source type"`
+	IsActive            *bool     `json:"isActive" binding:"required" gorm:"default:(-)" example:"true"`
+	AirbyteDefinitionID string    `json:"airbyteDefinitionId" gorm:"column:airbyte_definition_id;type:uuid" example:"a152379e-01a1-11ec-82d6-a312edcd9c7b"`
+	DockerRepository    string    `json:"dockerRepository" gorm:"column:docker_repository" example:"airbyte/source-postgres"`
+	DockerImageTag      string    `json:"version" gorm:"column:docker_image_tag" example:"0.4.4"`
+	ReleaseStage        string    `json:"releaseStage" gorm:"column:release_stage" example:"generally_available"`
+	DocumentationURL    string    `json:"documentationUrl" gorm:"column:documentation_url" example:"https://docs.airbyte.io/integrations/sources/postgres"`
+	Icon                string    `json:"icon" gorm:"column:icon"`
+	SyncedAt            int64     `json:"syncedAt" gorm:"column:synced_at" example:"1650000000000"`
+	Custom              bool      `json:"custom" gorm:"column:custom" example:"false"`
+	WorkspaceID         int       `json:"workspaceId,omitempty" gorm:"column:workspace_id" example:"1"`
+	Enabled             bool      `json:"enabled" gorm:"->;column:enabled" example:"true"`
 }
 
 type SupportedSourcesResponse struct {
@@ -128,6 +168,8 @@ type SourceDefinition struct {
 	DockerImageTag     string `json:"dockerImageTag"`
 	DocumentationURL   string `json:"documentationUrl"`
 	Icon               string `json:"icon"`
+	SourceType         string `json:"sourceType"`
+	ReleaseStage       string `json:"releaseStage"`
 }
 
 type SourceDefinitions struct {
@@ -0,0 +1,100 @@
+package assetPreview
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/services/destinationPool"
+	"pipelineService/utils"
+)
+
+// previewer reads a page of the raw table of an asset from the destination it was synced to.
+type previewer interface {
+	preview(ctx context.Context, store db.Store, asset models.AssetDetails, query models.PreviewQuery) (models.PreviewResult, error)
+}
+
+// previewers are keyed by the type of the destinations, the types are named after the images of the airbyte
+// destinations.
+var previewers = map[string]previewer{
+	utils.DESTINATION_TYPE_POSTGRES:   postgresPreviewer{},
+	utils.DESTINATION_TYPE_MYSQL:      sqlPreviewer{driver: "mysql", port: configuredPort, dsn: mysqlDSN, naming: mysqlName},
+	utils.DESTINATION_TYPE_CLICKHOUSE: sqlPreviewer{driver: "clickhouse", port: clickhousePort, dsn: clickhouseDSN, naming: standardName},
+	utils.DESTINATION_TYPE_MSSQL:      sqlPreviewer{driver: "sqlserver", port: configuredPort, dsn: mssqlDSN, naming: standardName},
+	utils.DESTINATION_TYPE_CSV:        localFilePreviewer{format: utils.FILE_FORMAT_CSV},
+	utils.DESTINATION_TYPE_LOCAL_JSON: localFilePreviewer{format: utils.FILE_FORMAT_JSONL},
+}
+
+// Supports tells whether the assets synced to the type of destination can be previewed.
+func Supports(destinationType string) bool {
+	_, ok := previewers[strings.ToLower(destinationType)]
+
+	return ok
+}
+
+// Preview reads a page of the raw table of the asset with the previewer of its destination type, the query is
+// cancelled with the context.
+func Preview(ctx context.Context, store db.Store, asset models.AssetDetails, query models.PreviewQuery) (models.PreviewResult, error) {
+	previewer, ok := previewers[strings.ToLower(asset.DestinationType)]
+	if !ok {
+		return models.PreviewResult{}, fmt.Errorf("preview of %s destinations isn't supported", asset.DestinationType)
+	}
+
+	fingerprint := destinationPool.Fingerprint(asset.Configuration.String())
+	table := fmt.Sprintf("%s.%s%s", asset.SchemaName, asset.Prefix, asset.Name)
+
+	return cached(cacheKey(asset.DestinationID, fingerprint, table, query), func() (models.PreviewResult, error) {
+		return previewer.preview(ctx, store, asset, query)
+	})
+}
+
+// PreviewTransformed reads a page of a table the transformations of a data product wrote to its postgres destination.
+func PreviewTransformed(ctx context.Context, store db.Store, asset models.TransformedAssetDetails,
+	query models.PreviewQuery) (models.PreviewResult, error) {
+	configuration := asset.DestinationConfiguration.String()
+	fingerprint := destinationPool.Fingerprint(configuration)
+	table := fmt.Sprintf("%s.%s", asset.ProductName, asset.AssetName)
+
+	return cached(cacheKey(asset.DestinationID, fingerprint, table, query), func() (models.PreviewResult, error) {
+		dbConn, err := destinationPool.Postgres(asset.DestinationID, configuration)
+		if err != nil {
+			return models.PreviewResult{}, err
+		}
+
+		queryCtx, cancel := destinationPool.QueryContext(ctx)
+		defer cancel()
+
+		return store.PreviewData(dbConn.WithContext(queryCtx), asset.ProductName, asset.AssetName, query)
+	})
+}
+
+var nonIdentifierCharacters = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// standardName names the tables and schemas like the standard name transformer of airbyte, the characters other than
+// letters, digits and underscores are replaced.
+func standardName(name string) string {
+	return nonIdentifierCharacters.ReplaceAllString(name, "_")
+}
+
+// mysqlName names the tables and schemas like the mysql destination, the names are lower case and the ones longer
+// than the 64 characters mysql allows are shortened in the middle.
+func mysqlName(name string) string {
+	const maxLength = 64
+
+	name = strings.ToLower(standardName(name))
+	if len(name) <= maxLength {
+		return name
+	}
+
+	half := (maxLength - 2) / 2
+
+	return name[:half] + "__" + name[len(name)-half:]
+}
+
+// rawTableName returns the name airbyte gives the raw table of the stream of the asset.
+func rawTableName(asset models.AssetDetails, naming func(name string) string) string {
+	return naming(fmt.Sprintf("%s_%s%s", utils.AIRBYTE_DEFAULT_PREFIX, asset.Prefix, asset.Name))
+}
@@ -0,0 +1,116 @@
+package assetPreview
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+type cachedResult struct {
+	result  models.PreviewResult
+	expires time.Time
+}
+
+var (
+	resultsMutex sync.Mutex
+	results      = make(map[string]cachedResult)
+)
+
+// cacheKey identifies a preview by the table, the credentials of its destination and the query, the row filters
+// enforced for the caller are part of the query.
+func cacheKey(destinationID string, fingerprint string, table string, query models.PreviewQuery) string {
+	encodedQuery, _ := json.Marshal(query)
+
+	return destinationID + "\x00" + fingerprint + "\x00" + table + "\x00" + string(encodedQuery)
+}
+
+// cached returns the result of an identical preview run within the cache TTL, or loads and caches it. The results are
+// copied in and out of the cache since the masking rewrites the rows it's given.
+func cached(key string, load func() (models.PreviewResult, error)) (models.PreviewResult, error) {
+	if env.Env.PreviewCacheTTL <= 0 {
+		return load()
+	}
+
+	now := time.Now()
+
+	resultsMutex.Lock()
+	hit, ok := results[key]
+	resultsMutex.Unlock()
+
+	if ok && now.Before(hit.expires) {
+		return cloneResult(hit.result), nil
+	}
+
+	result, err := load()
+	if err != nil {
+		return result, err
+	}
+
+	resultsMutex.Lock()
+	defer resultsMutex.Unlock()
+
+	for cachedKey, entry := range results {
+		if !now.Before(entry.expires) {
+			delete(results, cachedKey)
+		}
+	}
+
+	// the entries expiring first make room for the new one
+	for len(results) >= utils.PREVIEW_CACHE_MAX_ENTRIES {
+		oldest := ""
+
+		for cachedKey, entry := range results {
+			if oldest == "" || entry.expires.Before(results[oldest].expires) {
+				oldest = cachedKey
+			}
+		}
+
+		delete(results, oldest)
+	}
+
+	results[key] = cachedResult{result: cloneResult(result), expires: now.Add(env.Env.PreviewCacheTTL)}
+
+	return result, nil
+}
+
+func cloneResult(result models.PreviewResult) models.PreviewResult {
+	clone := models.PreviewResult{
+		Columns:    append([]models.PreviewColumn{}, result.Columns...),
+		Rows:       make([]map[string]interface{}, 0, len(result.Rows)),
+		NextCursor: result.NextCursor,
+	}
+
+	for _, row := range result.Rows {
+		clone.Rows = append(clone.Rows, cloneValue(row).(map[string]interface{}))
+	}
+
+	return clone
+}
+
+// cloneValue copies the maps, the slices and the bytes of a value, the other values are immutable.
+func cloneValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		clone := make(map[string]interface{}, len(typed))
+		for key, nested := range typed {
+			clone[key] = cloneValue(nested)
+		}
+
+		return clone
+	case []interface{}:
+		clone := make([]interface{}, len(typed))
+		for i, nested := range typed {
+			clone[i] = cloneValue(nested)
+		}
+
+		return clone
+	case []byte:
+		return append([]byte{}, typed...)
+	default:
+		return value
+	}
+}
@@ -0,0 +1,473 @@
+package assetPreview
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+// localFilePreviewer reads the files the local CSV and JSON destinations of airbyte write a raw table to, the files
+// have no index so the filters and the order are applied while reading them and only the rows of the page are kept.
+type localFilePreviewer struct {
+	format string
+}
+
+// localMount is the path the local destinations of airbyte see the local root at.
+const localMount = "/local"
+
+// errPageRead stops reading a file once the rows of an unordered page have been read.
+var errPageRead = errors.New("page read")
+
+func (p localFilePreviewer) preview(ctx context.Context, _ db.Store, asset models.AssetDetails,
+	query models.PreviewQuery) (models.PreviewResult, error) {
+	result := models.PreviewResult{Columns: []models.PreviewColumn{}, Rows: make([]map[string]interface{}, 0)}
+
+	if query.Limit <= 0 {
+		query.Limit = utils.PREVIEW_DATA_LIMIT
+	}
+
+	file, err := os.Open(p.path(asset))
+	if err != nil {
+		return result, err
+	}
+
+	defer file.Close()
+
+	// only the rows up to the end of the page are kept, the first ones of the order when the page is ordered
+	keep := query.Offset + query.Limit
+	rows := make([]map[string]interface{}, 0)
+	top := newTopRows(query, keep)
+
+	err = p.read(file, func(row map[string]interface{}) error {
+		// the file is read whole when the page is ordered, reading it stops when the caller goes away
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		matched, err := matches(row, query)
+		if err != nil || !matched {
+			return err
+		}
+
+		if query.OrderBy != "" {
+			top.add(row)
+
+			return nil
+		}
+
+		rows = append(rows, row)
+		if len(rows) == keep {
+			return errPageRead
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errPageRead) {
+		return result, err
+	}
+
+	if query.OrderBy != "" {
+		rows = top.sorted()
+	}
+
+	if query.Offset < len(rows) {
+		rows = rows[query.Offset:]
+	} else {
+		rows = rows[:0]
+	}
+
+	if len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+	}
+
+	result.Columns = previewColumns(query)
+
+	for _, row := range rows {
+		result.Rows = append(result.Rows, selectColumns(row, query))
+	}
+
+	result.NextCursor = nextCursor(rows, query)
+
+	return result, nil
+}
+
+// path returns the file of the raw table, the destination path is relative to the local mount of airbyte.
+func (p localFilePreviewer) path(asset models.AssetDetails) string {
+	destinationPath := gjson.Get(asset.Configuration.String(), "destination_path").String()
+	destinationPath = strings.TrimPrefix(filepath.Clean("/"+destinationPath), localMount)
+
+	extension := ".csv"
+	if p.format == utils.FILE_FORMAT_JSONL {
+		extension = ".jsonl"
+	}
+
+	return filepath.Join(env.Env.AirbyteLocalRoot, destinationPath, rawTableName(asset, standardName)+extension)
+}
+
+// read hands the records of the file to add, the data column of the CSV files is a JSON object written as text.
+func (p localFilePreviewer) read(file io.Reader, add func(row map[string]interface{}) error) error {
+	if p.format == utils.FILE_FORMAT_JSONL {
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+
+			row := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(line), &row); err != nil {
+				return err
+			}
+
+			if err := add(row); err != nil {
+				return err
+			}
+		}
+
+		return scanner.Err()
+	}
+
+	reader := csv.NewReader(file)
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		row := make(map[string]interface{}, len(header))
+		for i, name := range header {
+			if i < len(record) {
+				row[name] = record[i]
+			}
+		}
+
+		if data, ok := utils.DecodeAirbyteData(row); ok {
+			row[utils.AIRBYTE_DATA_COLUMN] = data
+		}
+
+		if err = add(row); err != nil {
+			return err
+		}
+	}
+}
+
+// value returns the value of a column of the record as text, the values which aren't text are written as JSON like
+// the ->> operator of postgres does.
+func value(row map[string]interface{}, column string) (string, bool) {
+	data, _ := utils.DecodeAirbyteData(row)
+
+	raw, ok := data[column]
+	if !ok || raw == nil {
+		return "", false
+	}
+
+	if text, ok := raw.(string); ok {
+		return text, true
+	}
+
+	encoded, _ := json.Marshal(raw)
+
+	return string(encoded), true
+}
+
+// compare compares the values by the cast of their catalog type, the values which can't be cast aren't comparable.
+func compare(left string, right string, catalogType string) (int, bool) {
+	if castOf(catalogType) != "numeric" {
+		return strings.Compare(left, right), true
+	}
+
+	leftNumber, err := strconv.ParseFloat(left, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	rightNumber, err := strconv.ParseFloat(right, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	switch {
+	case leftNumber < rightNumber:
+		return -1, true
+	case leftNumber > rightNumber:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+// castOf returns the type the values of a catalog type are compared as, like the raw tables of the databases.
+func castOf(catalogType string) string {
+	switch catalogType {
+	case "integer", "number":
+		return "numeric"
+	case "boolean":
+		return "boolean"
+	default:
+		return "text"
+	}
+}
+
+// matches applies the filters and the cursor of the query with the semantics of SQL, a null value matches no
+// comparison.
+func matches(row map[string]interface{}, query models.PreviewQuery) (bool, error) {
+	for _, filter := range query.Filters {
+		columnValue, ok := value(row, filter.Column)
+
+		switch filter.Operator {
+		case utils.ROW_FILTER_IS_NULL:
+			if ok {
+				return false, nil
+			}
+
+			continue
+		case utils.ROW_FILTER_NOT_NULL:
+			if !ok {
+				return false, nil
+			}
+
+			continue
+		}
+
+		if len(filter.Values) == 0 {
+			return false, fmt.Errorf("filter on %s has no values", filter.Column)
+		}
+
+		if !ok {
+			return false, nil
+		}
+
+		matched, err := matchesFilter(columnValue, filter, query.RawTypes[filter.Column])
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	if query.OrderBy == "" || query.After == nil {
+		return true, nil
+	}
+
+	orderValue, ok := value(row, query.OrderBy)
+	if !ok {
+		return false, nil
+	}
+
+	comparison, ok := compare(orderValue, query.After.Value, query.RawTypes[query.OrderBy])
+
+	if query.Descending {
+		return ok && comparison < 0, nil
+	}
+
+	return ok && comparison > 0, nil
+}
+
+func matchesFilter(columnValue string, filter models.PreviewFilter, catalogType string) (bool, error) {
+	switch filter.Operator {
+	case utils.ROW_FILTER_IN, utils.ROW_FILTER_NOT_IN:
+		found := false
+
+		for _, filterValue := range filter.Values {
+			if comparison, ok := compare(columnValue, filterValue, catalogType); ok && comparison == 0 {
+				found = true
+			}
+		}
+
+		return found == (filter.Operator == utils.ROW_FILTER_IN), nil
+	}
+
+	comparison, ok := compare(columnValue, filter.Values[0], catalogType)
+	if !ok {
+		return false, nil
+	}
+
+	switch filter.Operator {
+	case utils.ROW_FILTER_EQ:
+		return comparison == 0, nil
+	case utils.ROW_FILTER_NEQ:
+		return comparison != 0, nil
+	case utils.ROW_FILTER_GT:
+		return comparison > 0, nil
+	case utils.ROW_FILTER_GTE:
+		return comparison >= 0, nil
+	case utils.ROW_FILTER_LT:
+		return comparison < 0, nil
+	case utils.ROW_FILTER_LTE:
+		return comparison <= 0, nil
+	default:
+		return false, fmt.Errorf("filter operator %s is not supported", filter.Operator)
+	}
+}
+
+// topRows keeps the first rows of the order of a query in a heap whose root is the row sorted last, the rows read
+// later are sorted after the rows they tie with like a stable sort of the whole file.
+type topRows struct {
+	query models.PreviewQuery
+	size  int
+	rows  []orderedRow
+	read  int
+}
+
+type orderedRow struct {
+	row      map[string]interface{}
+	value    string
+	hasValue bool
+	position int
+}
+
+func newTopRows(query models.PreviewQuery, size int) *topRows {
+	return &topRows{query: query, size: size, rows: make([]orderedRow, 0)}
+}
+
+func (t *topRows) add(row map[string]interface{}) {
+	orderValue, ok := value(row, t.query.OrderBy)
+	candidate := orderedRow{row: row, value: orderValue, hasValue: ok, position: t.read}
+	t.read++
+
+	if len(t.rows) < t.size {
+		heap.Push(t, candidate)
+
+		return
+	}
+
+	if t.before(candidate, t.rows[0]) {
+		t.rows[0] = candidate
+		heap.Fix(t, 0)
+	}
+}
+
+// sorted returns the kept rows in the order of the query.
+func (t *topRows) sorted() []map[string]interface{} {
+	sort.Slice(t.rows, func(i, j int) bool {
+		return t.before(t.rows[i], t.rows[j])
+	})
+
+	rows := make([]map[string]interface{}, 0, len(t.rows))
+	for _, row := range t.rows {
+		rows = append(rows, row.row)
+	}
+
+	return rows
+}
+
+// before orders the rows by the order column of the query then by their position in the file, the nulls are sorted
+// last in both directions.
+func (t *topRows) before(left orderedRow, right orderedRow) bool {
+	if !left.hasValue || !right.hasValue {
+		if left.hasValue != right.hasValue {
+			return left.hasValue
+		}
+
+		return left.position < right.position
+	}
+
+	comparison, _ := compare(left.value, right.value, t.query.RawTypes[t.query.OrderBy])
+	if comparison == 0 {
+		return left.position < right.position
+	}
+
+	if t.query.Descending {
+		return comparison > 0
+	}
+
+	return comparison < 0
+}
+
+func (t *topRows) Len() int { return len(t.rows) }
+
+// Less puts the row sorted last at the root so it's the one replaced by a row sorted before it.
+func (t *topRows) Less(i, j int) bool { return t.before(t.rows[j], t.rows[i]) }
+
+func (t *topRows) Swap(i, j int) { t.rows[i], t.rows[j] = t.rows[j], t.rows[i] }
+
+func (t *topRows) Push(row interface{}) { t.rows = append(t.rows, row.(orderedRow)) }
+
+func (t *topRows) Pop() interface{} {
+	row := t.rows[len(t.rows)-1]
+	t.rows = t.rows[:len(t.rows)-1]
+
+	return row
+}
+
+// previewColumns returns the columns of the page, the raw records are returned whole unless columns are selected.
+func previewColumns(query models.PreviewQuery) []models.PreviewColumn {
+	if len(query.Columns) == 0 {
+		return []models.PreviewColumn{
+			{Name: utils.AIRBYTE_AB_ID_COLUMN, Type: "text"},
+			{Name: utils.AIRBYTE_EMITTED_AT_COLUMN, Type: "numeric"},
+			{Name: utils.AIRBYTE_DATA_COLUMN, Type: "json"},
+		}
+	}
+
+	columns := make([]models.PreviewColumn, 0, len(query.Columns))
+	for _, column := range query.Columns {
+		columns = append(columns, models.PreviewColumn{Name: column, Type: castOf(query.RawTypes[column])})
+	}
+
+	return columns
+}
+
+func selectColumns(row map[string]interface{}, query models.PreviewQuery) map[string]interface{} {
+	if len(query.Columns) == 0 {
+		return row
+	}
+
+	data, _ := utils.DecodeAirbyteData(row)
+	selected := make(map[string]interface{}, len(query.Columns)+1)
+
+	for _, column := range query.Columns {
+		selected[column] = data[column]
+	}
+
+	// the order column is needed to build the cursor of the next page
+	if query.OrderBy != "" {
+		selected[query.OrderBy] = data[query.OrderBy]
+	}
+
+	return selected
+}
+
+// nextCursor returns the cursor of the page following a full page like the previews of the databases.
+func nextCursor(rows []map[string]interface{}, query models.PreviewQuery) string {
+	if query.OrderBy == "" || len(rows) == 0 || len(rows) < query.Limit {
+		return ""
+	}
+
+	orderValue, ok := value(rows[len(rows)-1], query.OrderBy)
+	if !ok {
+		return ""
+	}
+
+	return utils.EncodePreviewCursor(models.PreviewCursor{Value: orderValue, Type: castOf(query.RawTypes[query.OrderBy])})
+}
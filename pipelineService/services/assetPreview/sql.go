@@ -0,0 +1,218 @@
+package assetPreview
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go"
+	_ "github.com/denisenkom/go-mssqldb"
+	"github.com/go-sql-driver/mysql"
+	"github.com/tidwall/gjson"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/services/destinationPool"
+)
+
+// postgresPreviewer reads the raw tables of postgres destinations with gorm like the rest of the service.
+type postgresPreviewer struct{}
+
+func (postgresPreviewer) preview(ctx context.Context, store db.Store, asset models.AssetDetails,
+	query models.PreviewQuery) (models.PreviewResult, error) {
+	dbConn, err := destinationPool.Postgres(asset.DestinationID, asset.Configuration.String())
+	if err != nil {
+		return models.PreviewResult{}, err
+	}
+
+	queryCtx, cancel := destinationPool.QueryContext(ctx)
+	defer cancel()
+
+	return store.PreviewData(dbConn.WithContext(queryCtx), asset.SchemaName,
+		rawTableName(asset, func(name string) string { return name }), query)
+}
+
+// sqlPreviewer reads the raw tables of the destinations reached through a database/sql driver, the schema and the
+// table are named like the destination names them. The DSN is built for the address the pool connects to, which is
+// the local end of the tunnel of the destination when it has one.
+type sqlPreviewer struct {
+	driver string
+	port   func(configuration string) string
+	dsn    func(destinationID string, configuration string, addr string) (string, error)
+	naming func(name string) string
+}
+
+func (p sqlPreviewer) preview(ctx context.Context, store db.Store, asset models.AssetDetails,
+	query models.PreviewQuery) (models.PreviewResult, error) {
+	configuration := asset.Configuration.String()
+
+	conn, err := destinationPool.Get(asset.DestinationID, configuration, p.port(configuration),
+		func(addr string) (*sql.DB, error) {
+			dsn, err := p.dsn(asset.DestinationID, configuration, addr)
+			if err != nil {
+				return nil, err
+			}
+
+			return sql.Open(p.driver, dsn)
+		})
+	if err != nil {
+		return models.PreviewResult{}, err
+	}
+
+	queryCtx, cancel := destinationPool.QueryContext(ctx)
+	defer cancel()
+
+	return store.PreviewSQLData(queryCtx, conn, strings.ToLower(asset.DestinationType), p.naming(asset.SchemaName),
+		rawTableName(asset, p.naming), query)
+}
+
+func configuredPort(configuration string) string {
+	return gjson.Get(configuration, "port").String()
+}
+
+func mysqlDSN(destinationID string, configuration string, addr string) (string, error) {
+	config := mysql.NewConfig()
+	config.Net = "tcp"
+	config.Addr = addr
+	config.User = gjson.Get(configuration, "username").String()
+	config.Passwd = gjson.Get(configuration, "password").String()
+	config.DBName = gjson.Get(configuration, "database").String()
+	config.ParseTime = true
+
+	tlsConfig, err := mysqlTLSConfig(destinationID, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	config.TLSConfig = tlsConfig
+
+	return config.FormatDSN(), nil
+}
+
+// mysqlTLSConfig returns the name of the TLS configuration of the destination, the modes verifying the certificate of
+// the server register a configuration of their own with its CA. Without an ssl_mode the ssl flag of the destination
+// defaults to true and like airbyte the certificate of the server isn't verified.
+func mysqlTLSConfig(destinationID string, configuration string) (string, error) {
+	verifyHost := false
+
+	switch gjson.Get(configuration, "ssl_mode.mode").String() {
+	case "disabled":
+		return "", nil
+	case "preferred":
+		return "preferred", nil
+	case "required":
+		return "skip-verify", nil
+	case "verify_identity":
+		verifyHost = true
+	case "verify_ca":
+	default:
+		if ssl := gjson.Get(configuration, "ssl"); !ssl.Exists() || ssl.Bool() {
+			return "skip-verify", nil
+		}
+
+		return "", nil
+	}
+
+	tlsConfig, err := destinationPool.TLSConfig(configuration, gjson.Get(configuration, "host").String(), verifyHost)
+	if err != nil {
+		return "", err
+	}
+
+	name := "destination-" + destinationID
+	if err = mysql.RegisterTLSConfig(name, tlsConfig); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// clickhousePort is the native port of the destination, its port is the HTTP port airbyte writes through.
+func clickhousePort(configuration string) string {
+	if port := gjson.Get(configuration, "tcp-port").String(); port != "" {
+		return port
+	}
+
+	return "9000"
+}
+
+func clickhouseDSN(destinationID string, configuration string, addr string) (string, error) {
+	params := url.Values{}
+	params.Set("username", gjson.Get(configuration, "username").String())
+	params.Set("password", gjson.Get(configuration, "password").String())
+	params.Set("database", gjson.Get(configuration, "database").String())
+
+	if gjson.Get(configuration, "ssl").Bool() {
+		tlsConfig, skipVerify, err := clickhouseTLSConfig(destinationID, configuration)
+		if err != nil {
+			return "", err
+		}
+
+		params.Set("secure", "true")
+		params.Set("skip_verify", strconv.FormatBool(skipVerify))
+
+		if tlsConfig != "" {
+			params.Set("tls_config", tlsConfig)
+		}
+	}
+
+	return (&url.URL{
+		Scheme:   "tcp",
+		Host:     addr,
+		RawQuery: params.Encode(),
+	}).String(), nil
+}
+
+// clickhouseTLSConfig returns the name of the TLS configuration of the destination when its ssl_mode gives a CA, the
+// certificate of the server is verified with it and its name is only checked in the verify_identity mode. The driver
+// overrides InsecureSkipVerify with the skip_verify parameter, so the flag of the configuration is returned along
+// with it. Without a CA the certificate of the server isn't verified, like airbyte does.
+func clickhouseTLSConfig(destinationID string, configuration string) (string, bool, error) {
+	if gjson.Get(configuration, "ssl_mode.ca_certificate").String() == "" {
+		return "", true, nil
+	}
+
+	verifyHost := gjson.Get(configuration, "ssl_mode.mode").String() == "verify_identity"
+
+	tlsConfig, err := destinationPool.TLSConfig(configuration, gjson.Get(configuration, "host").String(), verifyHost)
+	if err != nil {
+		return "", false, err
+	}
+
+	name := "destination-" + destinationID
+	if err = clickhouse.RegisterTLSConfig(name, tlsConfig); err != nil {
+		return "", false, err
+	}
+
+	return name, tlsConfig.InsecureSkipVerify, nil
+}
+
+func mssqlDSN(_ string, configuration string, addr string) (string, error) {
+	params := url.Values{}
+	params.Set("database", gjson.Get(configuration, "database").String())
+
+	switch gjson.Get(configuration, "ssl_method.ssl_method").String() {
+	case "encrypted_trust_server_certificate":
+		params.Set("encrypt", "true")
+		params.Set("TrustServerCertificate", "true")
+	case "encrypted_verify_certificate":
+		params.Set("encrypt", "true")
+
+		// the certificate is verified against the host of the destination, not the local end of its tunnel
+		hostName := gjson.Get(configuration, "ssl_method.hostNameInCertificate").String()
+		if hostName == "" {
+			hostName = gjson.Get(configuration, "host").String()
+		}
+
+		params.Set("hostNameInCertificate", hostName)
+	default:
+		params.Set("encrypt", "disable")
+	}
+
+	return (&url.URL{
+		Scheme:   "sqlserver",
+		User:     url.UserPassword(gjson.Get(configuration, "username").String(), gjson.Get(configuration, "password").String()),
+		Host:     addr,
+		RawQuery: params.Encode(),
+	}).String(), nil
+}
@@ -0,0 +1,120 @@
+package connectorCatalog
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"pipelineService/clients/airbyte"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+// errNoDefinitions keeps a failing airbyte listing no definitions from deactivating the whole catalog.
+var errNoDefinitions = errors.New("airbyte listed no connector definitions")
+
+// ReconcileSources upserts the catalog of the sources with the source definitions of airbyte, the sources airbyte
+// stopped listing are deactivated. The custom definitions are recorded by the workspaces registering them.
+func ReconcileSources(store db.Store, querier airbyte.AirByteQuerier) (models.ConnectorCatalogReport, error) {
+	definitions, err := querier.GetSourceDefinitions()
+	if err != nil {
+		return models.ConnectorCatalogReport{}, err
+	}
+
+	report := models.ConnectorCatalogReport{SyncedAt: time.Now().UnixMilli()}
+	sources := make([]models.SupportedSources, 0, len(definitions.SourceDefinitions))
+	isActive := true
+
+	for _, definition := range definitions.SourceDefinitions {
+		if definition.ReleaseStage == utils.AIRBYTE_RELEASE_STAGE_CUSTOM {
+			continue
+		}
+
+		id, err := uuid.FromString(definition.SourceDefinitionID)
+		if err != nil {
+			return models.ConnectorCatalogReport{}, err
+		}
+
+		sources = append(sources, models.SupportedSources{
+			ID:                  id,
+			Name:                definition.Name,
+			Type:                definition.SourceType,
+			IsActive:            &isActive,
+			AirbyteDefinitionID: definition.SourceDefinitionID,
+			DockerRepository:    definition.DockerRepository,
+			DockerImageTag:      definition.DockerImageTag,
+			ReleaseStage:        definition.ReleaseStage,
+			DocumentationURL:    definition.DocumentationURL,
+			Icon:                definition.Icon,
+			SyncedAt:            report.SyncedAt,
+		})
+	}
+
+	if len(sources) == 0 {
+		return models.ConnectorCatalogReport{}, errNoDefinitions
+	}
+
+	report.Synced = len(sources)
+
+	report.Deactivated, err = store.SyncSupportedSources(sources)
+
+	return report, err
+}
+
+// ReconcileDestinations upserts the catalog of the destinations with the destination definitions of airbyte, the
+// destinations airbyte stopped listing are deactivated.
+func ReconcileDestinations(store db.Store, querier airbyte.AirByteQuerier) (models.ConnectorCatalogReport, error) {
+	definitions, err := querier.GetDestinationDefinitions()
+	if err != nil {
+		return models.ConnectorCatalogReport{}, err
+	}
+
+	report := models.ConnectorCatalogReport{SyncedAt: time.Now().UnixMilli()}
+	destinations := make([]models.SupportedDestinations, 0, len(definitions.DestinationDefinitions))
+	isActive := true
+
+	for _, definition := range definitions.DestinationDefinitions {
+		if definition.ReleaseStage == utils.AIRBYTE_RELEASE_STAGE_CUSTOM {
+			continue
+		}
+
+		id, err := uuid.FromString(definition.DestinationDefinitionID)
+		if err != nil {
+			return models.ConnectorCatalogReport{}, err
+		}
+
+		destinations = append(destinations, models.SupportedDestinations{
+			ID:                  id,
+			Name:                definition.Name,
+			Type:                destinationType(definition.DockerRepository),
+			IsActive:            &isActive,
+			AirbyteDefinitionID: definition.DestinationDefinitionID,
+			DockerRepository:    definition.DockerRepository,
+			DockerImageTag:      definition.DockerImageTag,
+			ReleaseStage:        definition.ReleaseStage,
+			DocumentationURL:    definition.DocumentationURL,
+			Icon:                definition.Icon,
+			SyncedAt:            report.SyncedAt,
+		})
+	}
+
+	if len(destinations) == 0 {
+		return models.ConnectorCatalogReport{}, errNoDefinitions
+	}
+
+	report.Synced = len(destinations)
+
+	report.Deactivated, err = store.SyncSupportedDestinations(destinations)
+
+	return report, err
+}
+
+// destinationType names the destination after its docker image, airbyte/destination-postgres is a postgres
+// destination.
+func destinationType(dockerRepository string) string {
+	image := dockerRepository[strings.LastIndex(dockerRepository, "/")+1:]
+
+	return strings.TrimPrefix(image, "destination-")
+}
@@ -0,0 +1,213 @@
+package connectorCatalog
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"pipelineService/clients/airbyte"
+	"pipelineService/models/v1"
+	"pipelineService/services/connectorSpec"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+// RegisterCustomSource creates the custom source definition of the docker image in the airbyte workspace and records
+// it in the catalog of the workspace.
+func RegisterCustomSource(store db.Store, querier airbyte.AirByteQuerier, workspaceID int, airbyteWorkspaceID string,
+	request models.CustomConnectorRequest) (models.SupportedSources, error) {
+	definition, err := querier.CreateCustomSourceDefinition(airbyteWorkspaceID, request)
+	if err != nil {
+		return models.SupportedSources{}, err
+	}
+
+	id, err := uuid.FromString(definition.SourceDefinitionID)
+	if err != nil {
+		return models.SupportedSources{}, err
+	}
+
+	isActive := true
+
+	return store.CreateSupportedSource(models.SupportedSources{
+		ID:                  id,
+		Name:                definition.Name,
+		Type:                utils.AIRBYTE_RELEASE_STAGE_CUSTOM,
+		IsActive:            &isActive,
+		AirbyteDefinitionID: definition.SourceDefinitionID,
+		DockerRepository:    definition.DockerRepository,
+		DockerImageTag:      definition.DockerImageTag,
+		ReleaseStage:        utils.AIRBYTE_RELEASE_STAGE_CUSTOM,
+		DocumentationURL:    definition.DocumentationURL,
+		Icon:                definition.Icon,
+		SyncedAt:            time.Now().UnixMilli(),
+		Custom:              true,
+		WorkspaceID:         workspaceID,
+	})
+}
+
+// RegisterCustomDestination creates the custom destination definition of the docker image in the airbyte workspace
+// and records it in the catalog of the workspace.
+func RegisterCustomDestination(store db.Store, querier airbyte.AirByteQuerier, workspaceID int,
+	airbyteWorkspaceID string, request models.CustomConnectorRequest) (models.SupportedDestinations, error) {
+	definition, err := querier.CreateCustomDestinationDefinition(airbyteWorkspaceID, request)
+	if err != nil {
+		return models.SupportedDestinations{}, err
+	}
+
+	id, err := uuid.FromString(definition.DestinationDefinitionID)
+	if err != nil {
+		return models.SupportedDestinations{}, err
+	}
+
+	isActive := true
+
+	return store.CreateSupportedDestination(models.SupportedDestinations{
+		ID:                  id,
+		Name:                definition.Name,
+		Type:                destinationType(definition.DockerRepository),
+		IsActive:            &isActive,
+		AirbyteDefinitionID: definition.DestinationDefinitionID,
+		DockerRepository:    definition.DockerRepository,
+		DockerImageTag:      definition.DockerImageTag,
+		ReleaseStage:        utils.AIRBYTE_RELEASE_STAGE_CUSTOM,
+		DocumentationURL:    definition.DocumentationURL,
+		Icon:                definition.Icon,
+		SyncedAt:            time.Now().UnixMilli(),
+		Custom:              true,
+		WorkspaceID:         workspaceID,
+	})
+}
+
+// UpgradeCustomSource moves the custom source to the docker image tag when the configurations of the sources of the
+// workspace pass the specification of the new version, the previous version is restored otherwise.
+func UpgradeCustomSource(store db.Store, querier airbyte.AirByteQuerier, workspaceID int,
+	source models.SupportedSources, dockerImageTag string) (models.CustomConnectorUpgrade, error) {
+	definitionID := source.AirbyteDefinitionID
+
+	sources, err := store.GetSourcesByDefinition(workspaceID, definitionID)
+	if err != nil {
+		return models.CustomConnectorUpgrade{}, err
+	}
+
+	update := func(dockerImageTag string) error {
+		_, err := querier.UpdateSourceDefinition(definitionID, dockerImageTag)
+
+		return err
+	}
+
+	check := func() ([]models.IncompatibleConnector, error) {
+		incompatible := make([]models.IncompatibleConnector, 0)
+
+		for _, configured := range sources {
+			configuredSource, err := querier.GetConfiguredSource(configured.AirbyteSourceID)
+			if err != nil {
+				return nil, err
+			}
+
+			validation, err := connectorSpec.ValidateSourceConfiguration(querier, definitionID,
+				configuredSource.ConnectionConfiguration)
+			if err != nil {
+				return nil, err
+			}
+
+			if !validation.Valid {
+				incompatible = append(incompatible, models.IncompatibleConnector{
+					ID:     configured.SourceID,
+					Name:   configured.SourceName,
+					Errors: validation.Errors,
+				})
+			}
+		}
+
+		return incompatible, nil
+	}
+
+	record := func() error {
+		return store.UpdateSupportedSourceVersion(definitionID, dockerImageTag)
+	}
+
+	return upgradeCustomConnector(newUpgrade(definitionID, source.DockerImageTag, dockerImageTag), update, check, record)
+}
+
+// UpgradeCustomDestination moves the custom destination to the docker image tag when the configurations of the
+// destinations of the workspace pass the specification of the new version, the previous version is restored otherwise.
+func UpgradeCustomDestination(store db.Store, querier airbyte.AirByteQuerier, workspaceID int,
+	destination models.SupportedDestinations, dockerImageTag string) (models.CustomConnectorUpgrade, error) {
+	definitionID := destination.AirbyteDefinitionID
+
+	destinations, err := store.GetDestinationsByDefinition(workspaceID, definitionID)
+	if err != nil {
+		return models.CustomConnectorUpgrade{}, err
+	}
+
+	update := func(dockerImageTag string) error {
+		_, err := querier.UpdateDestinationDefinition(definitionID, dockerImageTag)
+
+		return err
+	}
+
+	check := func() ([]models.IncompatibleConnector, error) {
+		incompatible := make([]models.IncompatibleConnector, 0)
+
+		for _, configured := range destinations {
+			validation, err := connectorSpec.ValidateDestinationConfiguration(querier, definitionID,
+				configured.ConfigurationDetails)
+			if err != nil {
+				return nil, err
+			}
+
+			if !validation.Valid {
+				incompatible = append(incompatible, models.IncompatibleConnector{
+					ID:     configured.DestinationID,
+					Name:   configured.DestinationName,
+					Errors: validation.Errors,
+				})
+			}
+		}
+
+		return incompatible, nil
+	}
+
+	record := func() error {
+		return store.UpdateSupportedDestinationVersion(definitionID, dockerImageTag)
+	}
+
+	return upgradeCustomConnector(newUpgrade(definitionID, destination.DockerImageTag, dockerImageTag), update, check,
+		record)
+}
+
+func newUpgrade(definitionID string, previousVersion string, version string) models.CustomConnectorUpgrade {
+	return models.CustomConnectorUpgrade{
+		DefinitionID:    definitionID,
+		PreviousVersion: previousVersion,
+		Version:         version,
+		Incompatible:    make([]models.IncompatibleConnector, 0),
+	}
+}
+
+// upgradeCustomConnector updates the definition on airbyte first as its specification is only served for the
+// current version, the definition is rolled back when the configurations can't be checked or are incompatible.
+func upgradeCustomConnector(upgrade models.CustomConnectorUpgrade, update func(dockerImageTag string) error,
+	check func() ([]models.IncompatibleConnector, error), record func() error) (models.CustomConnectorUpgrade, error) {
+	if err := update(upgrade.Version); err != nil {
+		return upgrade, err
+	}
+
+	incompatible, err := check()
+	if err != nil || len(incompatible) > 0 {
+		if incompatible != nil {
+			upgrade.Incompatible = incompatible
+		}
+
+		if rollbackErr := update(upgrade.PreviousVersion); rollbackErr != nil {
+			return upgrade, errors.Wrap(rollbackErr, "couldn't restore the previous version")
+		}
+
+		return upgrade, err
+	}
+
+	err = record()
+	upgrade.Upgraded = err == nil
+
+	return upgrade, err
+}
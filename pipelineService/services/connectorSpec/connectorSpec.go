@@ -0,0 +1,148 @@
+package connectorSpec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"pipelineService/clients/airbyte"
+	"pipelineService/models/v1"
+)
+
+// specs caches the connection specifications by definition ID and version, a connector upgraded to a new docker
+// image tag has its specification fetched again.
+var specs = struct {
+	sync.RWMutex
+	byVersion map[string]map[string]interface{}
+}{byVersion: make(map[string]map[string]interface{})}
+
+// ValidationError lists the fields of a configuration breaking the specification of its connector.
+type ValidationError struct {
+	Errors []models.ConfigFieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+
+	for _, fieldErr := range e.Errors {
+		field := fieldErr.Field
+		if field == "" {
+			field = "connectionConfiguration"
+		}
+
+		messages = append(messages, fmt.Sprintf("%s %s", field, fieldErr.Message))
+	}
+
+	return fmt.Sprintf("invalid connection configuration: %s", strings.Join(messages, "; "))
+}
+
+// ValidateSourceConfiguration validates the configuration of a source against the connection specification of its
+// definition.
+func ValidateSourceConfiguration(querier airbyte.AirByteQuerier, definitionID string,
+	configuration interface{}) (models.ConfigValidation, error) {
+	definition, err := querier.GetSourceDefinition(definitionID)
+	if err != nil {
+		return models.ConfigValidation{}, err
+	}
+
+	spec, err := cachedSpec(definitionID, definition.DockerImageTag, func() (interface{}, error) {
+		specification, err := querier.GetSourceSpecification(definitionID)
+
+		return specification.ConnectionSpecification, err
+	})
+	if err != nil {
+		return models.ConfigValidation{}, err
+	}
+
+	return check(definitionID, definition.DockerImageTag, spec, configuration)
+}
+
+// ValidateDestinationConfiguration validates the configuration of a destination against the connection
+// specification of its definition.
+func ValidateDestinationConfiguration(querier airbyte.AirByteQuerier, definitionID string,
+	configuration interface{}) (models.ConfigValidation, error) {
+	definition, err := querier.GetDestinationDefinition(definitionID)
+	if err != nil {
+		return models.ConfigValidation{}, err
+	}
+
+	spec, err := cachedSpec(definitionID, definition.DockerImageTag, func() (interface{}, error) {
+		specification, err := querier.GetDestinationSpecification(definitionID)
+
+		return specification.ConnectionSpecification, err
+	})
+	if err != nil {
+		return models.ConfigValidation{}, err
+	}
+
+	return check(definitionID, definition.DockerImageTag, spec, configuration)
+}
+
+func cachedSpec(definitionID string, version string, fetch func() (interface{}, error)) (map[string]interface{}, error) {
+	key := definitionID + "@" + version
+
+	specs.RLock()
+	spec, ok := specs.byVersion[key]
+	specs.RUnlock()
+
+	if ok {
+		return spec, nil
+	}
+
+	connectionSpecification, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	// the specification is decoded again so its keywords have the types of a decoded configuration
+	spec = make(map[string]interface{})
+	if err = decode(connectionSpecification, &spec); err != nil {
+		return nil, err
+	}
+
+	specs.Lock()
+	specs.byVersion[key] = spec
+	specs.Unlock()
+
+	return spec, nil
+}
+
+func check(definitionID string, version string, spec map[string]interface{},
+	configuration interface{}) (models.ConfigValidation, error) {
+	validation := models.ConfigValidation{
+		DefinitionID: definitionID,
+		Version:      version,
+		Valid:        true,
+		Errors:       []models.ConfigFieldError{},
+	}
+
+	var value interface{}
+	if err := decode(configuration, &value); err != nil {
+		return validation, err
+	}
+
+	// a connector without a specification accepts any configuration
+	if len(spec) == 0 {
+		return validation, nil
+	}
+
+	validate(spec, value, "", &validation.Errors)
+	validation.Valid = len(validation.Errors) == 0
+
+	return validation, nil
+}
+
+// decode converts a configuration bound from a request or stored as JSON to the generic JSON values.
+func decode(value interface{}, target interface{}) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	if string(encoded) == "null" {
+		return nil
+	}
+
+	return json.Unmarshal(encoded, target)
+}
@@ -0,0 +1,298 @@
+package connectorSpec
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"pipelineService/models/v1"
+)
+
+// validate checks the value against the JSON Schema keywords used by the connection specifications of the Airbyte
+// connectors and appends an error for every field breaking them. The options of oneOf and anyOf are told apart by
+// their const properties, as Airbyte does for the authentication and tunnel methods.
+func validate(schema map[string]interface{}, value interface{}, field string, errs *[]models.ConfigFieldError) {
+	report := func(format string, args ...interface{}) {
+		*errs = append(*errs, models.ConfigFieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if types := schemaTypes(schema["type"]); len(types) > 0 && !matchesAnyType(types, value) {
+		report("must be of type %s", strings.Join(types, " or "))
+
+		return
+	}
+
+	if constant, ok := schema["const"]; ok && !equal(constant, value) {
+		report("must be %v", constant)
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !contains(enum, value) {
+		report("must be one of %v", enum)
+	}
+
+	switch typed := value.(type) {
+	case string:
+		validateString(schema, typed, report)
+	case float64:
+		validateNumber(schema, typed, report)
+	case []interface{}:
+		validateArray(schema, typed, field, errs, report)
+	case map[string]interface{}:
+		validateObject(schema, typed, field, errs)
+	}
+
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if options, ok := schema[keyword].([]interface{}); ok && len(options) > 0 {
+			validateOptions(options, value, field, errs, report)
+		}
+	}
+
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, option := range allOf {
+			if optionSchema, ok := option.(map[string]interface{}); ok {
+				validate(optionSchema, value, field, errs)
+			}
+		}
+	}
+}
+
+func validateString(schema map[string]interface{}, value string, report func(string, ...interface{})) {
+	length := len([]rune(value))
+
+	if minLength, ok := schema["minLength"].(float64); ok && float64(length) < minLength {
+		report("must be at least %v characters long", minLength)
+	}
+
+	if maxLength, ok := schema["maxLength"].(float64); ok && float64(length) > maxLength {
+		report("must be at most %v characters long", maxLength)
+	}
+
+	// patterns RE2 can't compile, such as lookarounds, are left to the connector
+	if pattern, ok := schema["pattern"].(string); ok {
+		if expression, err := regexp.Compile(pattern); err == nil && !expression.MatchString(value) {
+			report("must match the pattern %s", pattern)
+		}
+	}
+}
+
+func validateNumber(schema map[string]interface{}, value float64, report func(string, ...interface{})) {
+	if minimum, ok := schema["minimum"].(float64); ok && value < minimum {
+		report("must be greater than or equal to %v", minimum)
+	}
+
+	if maximum, ok := schema["maximum"].(float64); ok && value > maximum {
+		report("must be less than or equal to %v", maximum)
+	}
+
+	if minimum, ok := schema["exclusiveMinimum"].(float64); ok && value <= minimum {
+		report("must be greater than %v", minimum)
+	}
+
+	if maximum, ok := schema["exclusiveMaximum"].(float64); ok && value >= maximum {
+		report("must be less than %v", maximum)
+	}
+}
+
+func validateArray(schema map[string]interface{}, value []interface{}, field string, errs *[]models.ConfigFieldError,
+	report func(string, ...interface{})) {
+	if minItems, ok := schema["minItems"].(float64); ok && float64(len(value)) < minItems {
+		report("must have at least %v items", minItems)
+	}
+
+	if maxItems, ok := schema["maxItems"].(float64); ok && float64(len(value)) > maxItems {
+		report("must have at most %v items", maxItems)
+	}
+
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		for i, item := range value {
+			validate(items, item, fmt.Sprintf("%s[%d]", field, i), errs)
+		}
+	}
+}
+
+func validateObject(schema map[string]interface{}, value map[string]interface{}, field string,
+	errs *[]models.ConfigFieldError) {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			name, _ := name.(string)
+			if property, ok := value[name]; !ok || property == nil {
+				*errs = append(*errs, models.ConfigFieldError{Field: join(field, name), Message: "is required"})
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertySchema, ok := properties[name].(map[string]interface{})
+		if !ok {
+			if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+				*errs = append(*errs, models.ConfigFieldError{Field: join(field, name), Message: "is not allowed"})
+			}
+
+			continue
+		}
+
+		// a null optional field is treated as left out
+		if value[name] == nil {
+			continue
+		}
+
+		validate(propertySchema, value[name], join(field, name), errs)
+	}
+}
+
+// validateOptions accepts the value when one of the options does, otherwise the errors of the option selected by the
+// const properties of the value are reported.
+func validateOptions(options []interface{}, value interface{}, field string, errs *[]models.ConfigFieldError,
+	report func(string, ...interface{})) {
+	var selected []models.ConfigFieldError
+
+	for _, option := range options {
+		optionSchema, ok := option.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var optionErrs []models.ConfigFieldError
+
+		validate(optionSchema, value, field, &optionErrs)
+
+		if len(optionErrs) == 0 {
+			return
+		}
+
+		if selected == nil && selectsOption(optionSchema, value) {
+			selected = optionErrs
+		}
+	}
+
+	if selected != nil {
+		*errs = append(*errs, selected...)
+
+		return
+	}
+
+	report("must match one of the allowed options")
+}
+
+// selectsOption tells whether the value carries the const properties of the option.
+func selectsOption(option map[string]interface{}, value interface{}) bool {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	properties, _ := option["properties"].(map[string]interface{})
+
+	selects := false
+
+	for name, property := range properties {
+		propertySchema, _ := property.(map[string]interface{})
+
+		constant, ok := propertySchema["const"]
+		if !ok {
+			continue
+		}
+
+		if !equal(constant, object[name]) {
+			return false
+		}
+
+		selects = true
+	}
+
+	return selects
+}
+
+func schemaTypes(schemaType interface{}) []string {
+	switch typed := schemaType.(type) {
+	case string:
+		return []string{typed}
+	case []interface{}:
+		types := make([]string, 0, len(typed))
+		for _, t := range typed {
+			if name, ok := t.(string); ok {
+				types = append(types, name)
+			}
+		}
+
+		return types
+	default:
+		return nil
+	}
+}
+
+func matchesAnyType(types []string, value interface{}) bool {
+	for _, t := range types {
+		if matchesType(t, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesType(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]interface{})
+
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+
+		return ok
+	case "string":
+		_, ok := value.(string)
+
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+
+		return ok
+	case "number":
+		_, ok := value.(float64)
+
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+
+		return ok && number == math.Trunc(number)
+	case "null":
+		return value == nil
+	default:
+		return true
+	}
+}
+
+func equal(a interface{}, b interface{}) bool {
+	return fmt.Sprintf("%#v", a) == fmt.Sprintf("%#v", b)
+}
+
+func contains(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if equal(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func join(field string, name string) string {
+	if field == "" {
+		return name
+	}
+
+	return field + "." + name
+}
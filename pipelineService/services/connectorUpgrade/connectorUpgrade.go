@@ -0,0 +1,418 @@
+package connectorUpgrade
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+	"pipelineService/clients/airbyte"
+	"pipelineService/models/v1"
+	"pipelineService/services/connectorSpec"
+	"pipelineService/services/db"
+	"pipelineService/services/schemaDrift"
+	"pipelineService/utils"
+)
+
+// definition wraps the airbyte and catalog calls which differ between the source and destination definitions.
+type definition struct {
+	update func(dockerImageTag string) error
+	// record updates the catalogs of all the workspaces, they all run the version airbyte runs
+	record func(dockerImageTag string) error
+	// candidate creates a custom definition of the airbyte workspace running the docker image tag, so the version is
+	// checked without changing the definition the pipelines of the instance run
+	candidate     func(dockerImageTag string) (string, error)
+	remove        func(definitionID string) error
+	specification func(definitionID string) (interface{}, error)
+	// validate checks a connector against the candidate definition
+	validate func(definitionID string, connector models.PipelineConnector) error
+	check    func(connector models.PipelineConnector) error
+	// discover is only set for the sources, the destinations have no catalog
+	discover func(connector models.PipelineConnector) ([]models.SchemaFieldChange, error)
+}
+
+// GroupVersions groups the pipelines by the connector definition and the version they run.
+func GroupVersions(connectors []models.PipelineConnector) []models.ConnectorVersion {
+	versions := make([]models.ConnectorVersion, 0)
+	index := make(map[string]int)
+
+	for _, connector := range connectors {
+		key := connector.ConnectorType + "/" + connector.DefinitionID + "@" + connector.DockerImageTag
+
+		i, ok := index[key]
+		if !ok {
+			i = len(versions)
+			index[key] = i
+
+			versions = append(versions, models.ConnectorVersion{
+				ConnectorType:    connector.ConnectorType,
+				DefinitionID:     connector.DefinitionID,
+				Name:             connector.Name,
+				DockerRepository: connector.DockerRepository,
+				Version:          connector.DockerImageTag,
+				Pipelines:        make([]models.ConnectorVersionPipeline, 0),
+			})
+		}
+
+		versions[i].Pipelines = append(versions[i].Pipelines, models.ConnectorVersionPipeline{
+			PipelineID:   connector.PipelineID,
+			PipelineName: connector.PipelineName,
+		})
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		if versions[i].ConnectorType != versions[j].ConnectorType {
+			return versions[i].ConnectorType > versions[j].ConnectorType
+		}
+
+		return versions[i].Name < versions[j].Name
+	})
+
+	return versions
+}
+
+// CurrentVersion returns the docker image tag of the definition in the catalog of the workspace.
+func CurrentVersion(store db.Store, workspaceID int, connectorType string, definitionID string) (string, error) {
+	if connectorType == utils.CONNECTOR_TYPE_SOURCE {
+		source, err := store.GetSupportedSource(workspaceID, definitionID)
+
+		return source.DockerImageTag, err
+	}
+
+	destination, err := store.GetSupportedDestination(workspaceID, definitionID)
+
+	return destination.DockerImageTag, err
+}
+
+// AffectedPipelines returns the connectors of the pipelines of every workspace using the definition of the upgrade.
+func AffectedPipelines(store db.Store, upgrade models.ConnectorUpgrade) ([]models.PipelineConnector, error) {
+	return store.GetDefinitionConnectors(upgrade.ConnectorType, upgrade.DefinitionID)
+}
+
+// Validate checks the affected pipelines against a candidate definition running the new version and records the
+// result along with the changes of its specification. The candidate is a custom definition of the airbyte workspace,
+// the definition the pipelines run is only upgraded by the commit. The destinations run check_connection with their
+// configuration; the configurations airbyte returns for the sources have their secrets masked, so they are validated
+// against the specification of the new version and their connections are checked and discovered by the commit.
+func Validate(store db.Store, querier airbyte.AirByteQuerier, airbyteWorkspaceID string,
+	upgrade models.ConnectorUpgrade) (models.ConnectorUpgrade, error) {
+	fromStatus := upgrade.Status
+
+	connectors, err := AffectedPipelines(store, upgrade)
+	if err != nil {
+		return upgrade, err
+	}
+
+	connectorDefinition := newDefinition(store, querier, airbyteWorkspaceID, upgrade)
+
+	previousSpec, err := connectorDefinition.specification(upgrade.DefinitionID)
+	if err != nil {
+		return upgrade, err
+	}
+
+	candidateID, err := connectorDefinition.candidate(upgrade.Version)
+	if err != nil {
+		return upgrade, err
+	}
+
+	checks, specChanges, err := validateCandidate(connectorDefinition, candidateID, connectors, previousSpec)
+
+	if removeErr := connectorDefinition.remove(candidateID); removeErr != nil {
+		utils.GetLogger().Error(errors.Wrap(removeErr, "couldn't remove the candidate definition").Error())
+	}
+
+	if err != nil {
+		return upgrade, err
+	}
+
+	upgrade.Status = statusOf(checks, utils.CONNECTOR_UPGRADE_STATUS_VALIDATED)
+	upgrade.Checks, _ = json.Marshal(checks)
+	upgrade.SpecChanges, _ = json.Marshal(specChanges)
+
+	return upgrade, store.UpdateConnectorUpgrade(upgrade, fromStatus)
+}
+
+// Commit applies the validated version to the definition on airbyte, for every workspace of the instance, then checks
+// the affected pipelines of all the workspaces and discovers their sources with it. The previous version is restored and the upgrade fails
+// when a check fails, otherwise the version is recorded in the catalogs along with the changes of its specification.
+func Commit(store db.Store, querier airbyte.AirByteQuerier, upgrade models.ConnectorUpgrade) (models.ConnectorUpgrade,
+	error) {
+	fromStatus := upgrade.Status
+
+	connectors, err := AffectedPipelines(store, upgrade)
+	if err != nil {
+		return upgrade, err
+	}
+
+	connectorDefinition := newDefinition(store, querier, "", upgrade)
+
+	previousSpec, err := connectorDefinition.specification(upgrade.DefinitionID)
+	if err != nil {
+		return upgrade, err
+	}
+
+	if err = connectorDefinition.update(upgrade.Version); err != nil {
+		return upgrade, err
+	}
+
+	checks, specChanges, err := inspect(connectorDefinition, upgrade.DefinitionID, connectors, previousSpec)
+	if err == nil {
+		upgrade.Status = statusOf(checks, utils.CONNECTOR_UPGRADE_STATUS_COMMITTED)
+		upgrade.Checks, _ = json.Marshal(checks)
+		upgrade.SpecChanges, _ = json.Marshal(specChanges)
+	}
+
+	if err == nil && upgrade.Status == utils.CONNECTOR_UPGRADE_STATUS_COMMITTED {
+		if err = connectorDefinition.record(upgrade.Version); err == nil {
+			return upgrade, store.UpdateConnectorUpgrade(upgrade, fromStatus)
+		}
+	}
+
+	if restoreErr := connectorDefinition.update(upgrade.PreviousVersion); restoreErr != nil {
+		return upgrade, errors.Wrap(restoreErr, "couldn't restore the previous version")
+	}
+
+	if err != nil {
+		return upgrade, err
+	}
+
+	return upgrade, store.UpdateConnectorUpgrade(upgrade, fromStatus)
+}
+
+// Rollback restores the version the definition ran before the upgrade was committed.
+func Rollback(store db.Store, querier airbyte.AirByteQuerier, upgrade models.ConnectorUpgrade) (models.ConnectorUpgrade,
+	error) {
+	fromStatus := upgrade.Status
+	connectorDefinition := newDefinition(store, querier, "", upgrade)
+
+	if err := connectorDefinition.update(upgrade.PreviousVersion); err != nil {
+		return upgrade, err
+	}
+
+	if err := connectorDefinition.record(upgrade.PreviousVersion); err != nil {
+		return upgrade, err
+	}
+
+	upgrade.Status = utils.CONNECTOR_UPGRADE_STATUS_ROLLED_BACK
+
+	return upgrade, store.UpdateConnectorUpgrade(upgrade, fromStatus)
+}
+
+// statusOf returns the status of the upgrade once its checks ran, it fails when a check didn't pass.
+func statusOf(checks []models.ConnectorUpgradeCheck, passed string) string {
+	for _, check := range checks {
+		if !check.Passed {
+			return utils.CONNECTOR_UPGRADE_STATUS_FAILED
+		}
+	}
+
+	return passed
+}
+
+// validateCandidate checks the connectors against the candidate definition, a connector breaking it is recorded on
+// its pipeline while failing to reach airbyte stops the validation.
+func validateCandidate(connectorDefinition definition, candidateID string, connectors []models.PipelineConnector,
+	previousSpec interface{}) ([]models.ConnectorUpgradeCheck, []models.SpecFieldChange, error) {
+	spec, err := connectorDefinition.specification(candidateID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checks := make([]models.ConnectorUpgradeCheck, 0, len(connectors))
+
+	for _, connector := range connectors {
+		checks = append(checks, checkConnector(connector, func(connector models.PipelineConnector) error {
+			return connectorDefinition.validate(candidateID, connector)
+		}))
+	}
+
+	return checks, diffSpecifications(previousSpec, spec), nil
+}
+
+// inspect checks the connectors with the version applied on airbyte, a failing connection is recorded on its
+// pipeline while failing to reach airbyte stops the inspection.
+func inspect(connectorDefinition definition, definitionID string, connectors []models.PipelineConnector,
+	previousSpec interface{}) ([]models.ConnectorUpgradeCheck, []models.SpecFieldChange, error) {
+	spec, err := connectorDefinition.specification(definitionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checks := make([]models.ConnectorUpgradeCheck, 0, len(connectors))
+
+	for _, connector := range connectors {
+		check := checkConnector(connector, connectorDefinition.check)
+
+		if check.Passed && connectorDefinition.discover != nil {
+			changes, err := connectorDefinition.discover(connector)
+			if err != nil {
+				check.Passed = false
+				check.Error = err.Error()
+			} else {
+				check.CatalogChanges = changes
+			}
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks, diffSpecifications(previousSpec, spec), nil
+}
+
+func checkConnector(connector models.PipelineConnector,
+	check func(connector models.PipelineConnector) error) models.ConnectorUpgradeCheck {
+	result := models.ConnectorUpgradeCheck{
+		PipelineID:     connector.PipelineID,
+		PipelineName:   connector.PipelineName,
+		WorkspaceID:    connector.WorkspaceID,
+		Passed:         true,
+		CatalogChanges: make([]models.SchemaFieldChange, 0),
+	}
+
+	if err := check(connector); err != nil {
+		result.Passed = false
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// newDefinition wraps the calls for the definition of the upgrade, the candidates are created in the airbyte
+// workspace.
+func newDefinition(store db.Store, querier airbyte.AirByteQuerier, airbyteWorkspaceID string,
+	upgrade models.ConnectorUpgrade) definition {
+	definitionID := upgrade.DefinitionID
+
+	if upgrade.ConnectorType == utils.CONNECTOR_TYPE_DESTINATION {
+		check := func(candidateID string, connector models.PipelineConnector) error {
+			return querier.CheckDestinationConnection(map[string]interface{}{
+				"destinationDefinitionId": candidateID,
+				"connectionConfiguration": connector.Configuration,
+			})
+		}
+
+		return definition{
+			update: func(dockerImageTag string) error {
+				_, err := querier.UpdateDestinationDefinition(definitionID, dockerImageTag)
+
+				return err
+			},
+			record: func(dockerImageTag string) error {
+				return store.UpdateSupportedDestinationVersion(definitionID, dockerImageTag)
+			},
+			candidate: func(dockerImageTag string) (string, error) {
+				current, err := querier.GetDestinationDefinition(definitionID)
+				if err != nil {
+					return "", err
+				}
+
+				candidate, err := querier.CreateCustomDestinationDefinition(airbyteWorkspaceID,
+					candidateRequest(current.Name, current.DockerRepository, dockerImageTag, current.DocumentationURL,
+						current.Icon))
+
+				return candidate.DestinationDefinitionID, err
+			},
+			remove: querier.DeleteDestinationDefinition,
+			specification: func(definitionID string) (interface{}, error) {
+				specification, err := querier.GetDestinationSpecification(definitionID)
+
+				return specification.ConnectionSpecification, err
+			},
+			// the configuration of the destination is stored, so it runs check_connection with the candidate
+			validate: check,
+			check: func(connector models.PipelineConnector) error {
+				return check(definitionID, connector)
+			},
+		}
+	}
+
+	// the sources are shared by pipelines, so each one is discovered once per commit
+	discovered := make(map[string]models.SyncCatalog)
+
+	return definition{
+		update: func(dockerImageTag string) error {
+			_, err := querier.UpdateSourceDefinition(definitionID, dockerImageTag)
+
+			return err
+		},
+		record: func(dockerImageTag string) error {
+			return store.UpdateSupportedSourceVersion(definitionID, dockerImageTag)
+		},
+		candidate: func(dockerImageTag string) (string, error) {
+			current, err := querier.GetSourceDefinition(definitionID)
+			if err != nil {
+				return "", err
+			}
+
+			candidate, err := querier.CreateCustomSourceDefinition(airbyteWorkspaceID,
+				candidateRequest(current.Name, current.DockerRepository, dockerImageTag, current.DocumentationURL,
+					current.Icon))
+
+			return candidate.SourceDefinitionID, err
+		},
+		remove: querier.DeleteSourceDefinition,
+		specification: func(definitionID string) (interface{}, error) {
+			specification, err := querier.GetSourceSpecification(definitionID)
+
+			return specification.ConnectionSpecification, err
+		},
+		// airbyte masks the secrets of the configuration it returns, so it is validated against the specification
+		validate: func(candidateID string, connector models.PipelineConnector) error {
+			source, err := querier.GetConfiguredSource(connector.AirbyteActorID)
+			if err != nil {
+				return err
+			}
+
+			validation, err := connectorSpec.ValidateSourceConfiguration(querier, candidateID,
+				source.ConnectionConfiguration)
+			if err != nil {
+				return err
+			}
+
+			if !validation.Valid {
+				return &connectorSpec.ValidationError{Errors: validation.Errors}
+			}
+
+			return nil
+		},
+		// the source is checked by its ID, airbyte masks the secrets of the configuration it returns
+		check: func(connector models.PipelineConnector) error {
+			return querier.CheckSourceConnectionByID(connector.AirbyteActorID)
+		},
+		discover: func(connector models.PipelineConnector) ([]models.SchemaFieldChange, error) {
+			catalog, ok := discovered[connector.AirbyteActorID]
+			if !ok {
+				sourceSchema, err := querier.RefreshSourceSchema(connector.AirbyteActorID)
+				if err != nil {
+					return nil, err
+				}
+
+				if catalog, err = schemaDrift.ToSyncCatalog(sourceSchema); err != nil {
+					return nil, err
+				}
+
+				discovered[connector.AirbyteActorID] = catalog
+			}
+
+			connectionSchema, err := querier.GetConnectionSchema(connector.AirbyteConnectionID)
+			if err != nil {
+				return nil, err
+			}
+
+			changes, _ := schemaDrift.DiffCatalogs(connectionSchema.SyncCatalog, catalog)
+
+			return changes, nil
+		},
+	}
+}
+
+// candidateRequest names the candidate after the definition and the version it runs.
+func candidateRequest(name string, dockerRepository string, dockerImageTag string, documentationURL string,
+	icon string) models.CustomConnectorRequest {
+	return models.CustomConnectorRequest{
+		Name:             name + " " + dockerImageTag,
+		DockerRepository: dockerRepository,
+		DockerImageTag:   dockerImageTag,
+		DocumentationURL: documentationURL,
+		Icon:             icon,
+	}
+}
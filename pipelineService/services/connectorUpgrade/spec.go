@@ -0,0 +1,90 @@
+package connectorUpgrade
+
+import (
+	"sort"
+
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// diffSpecifications compares the top level fields of the connection specifications of two versions, a field
+// becoming required breaks the configurations which leave it out.
+func diffSpecifications(previous interface{}, next interface{}) []models.SpecFieldChange {
+	changes := make([]models.SpecFieldChange, 0)
+
+	previousTypes := specFieldTypes(previous)
+	nextTypes := specFieldTypes(next)
+
+	for _, field := range sortedKeys(nextTypes) {
+		oldType, ok := previousTypes[field]
+
+		switch {
+		case !ok:
+			changes = append(changes, models.SpecFieldChange{
+				Field: field, ChangeType: utils.SCHEMA_CHANGE_FIELD_ADDED, NewType: nextTypes[field]})
+		case oldType != nextTypes[field]:
+			changes = append(changes, models.SpecFieldChange{
+				Field: field, ChangeType: utils.SCHEMA_CHANGE_TYPE_CHANGED, OldType: oldType, NewType: nextTypes[field]})
+		}
+	}
+
+	for _, field := range sortedKeys(previousTypes) {
+		if _, ok := nextTypes[field]; !ok {
+			changes = append(changes, models.SpecFieldChange{
+				Field: field, ChangeType: utils.SCHEMA_CHANGE_FIELD_REMOVED, OldType: previousTypes[field]})
+		}
+	}
+
+	previousRequired := requiredFields(previous)
+	nextRequired := requiredFields(next)
+
+	for _, field := range sortedKeys(nextRequired) {
+		if _, ok := previousRequired[field]; !ok {
+			changes = append(changes, models.SpecFieldChange{Field: field, ChangeType: utils.SPEC_CHANGE_REQUIRED_ADDED})
+		}
+	}
+
+	for _, field := range sortedKeys(previousRequired) {
+		if _, ok := nextRequired[field]; !ok {
+			changes = append(changes, models.SpecFieldChange{Field: field, ChangeType: utils.SPEC_CHANGE_REQUIRED_REMOVED})
+		}
+	}
+
+	return changes
+}
+
+func specFieldTypes(spec interface{}) map[string]string {
+	types := make(map[string]string)
+
+	for _, field := range utils.JSONSchemaFields(spec) {
+		types[field.Name] = field.Type
+	}
+
+	return types
+}
+
+func requiredFields(spec interface{}) map[string]string {
+	required := make(map[string]string)
+
+	schema, _ := spec.(map[string]interface{})
+	fields, _ := schema["required"].([]interface{})
+
+	for _, field := range fields {
+		if name, ok := field.(string); ok {
+			required[name] = name
+		}
+	}
+
+	return required
+}
+
+func sortedKeys(fields map[string]string) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
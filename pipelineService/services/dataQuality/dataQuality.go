@@ -0,0 +1,163 @@
+package dataQuality
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/services/destinationPool"
+	"pipelineService/utils"
+)
+
+// CheckAsset measures the quality rules of an asset on its destination and stores their results in the run. The data
+// products of the asset are degraded when a rule of error severity fails.
+func CheckAsset(store db.Store, runID uuid.UUID, assetID uuid.UUID) ([]models.QualityCheckResult, error) {
+	rules, err := store.GetQualityRules(assetID)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+
+	table, err := store.GetAssetTable(assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := store.GetAssetColumns(assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbConn, err := destinationPool.AssetPostgres(table)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.QualityCheckResult, 0, len(rules))
+	degraded := false
+
+	for _, rule := range rules {
+		result := models.QualityCheckResult{
+			RunID:       runID.String(),
+			RuleID:      rule.RuleID.String(),
+			AssetID:     assetID.String(),
+			Column:      rule.Column,
+			Check:       rule.Check,
+			Severity:    rule.Severity,
+			WorkspaceID: rule.WorkspaceID,
+			CheckedAt:   time.Now().UnixMilli(),
+		}
+
+		// a rule which can't be measured fails, the other rules of the asset are still checked
+		measure, err := store.CheckQualityRule(dbConn, table, rule, columns)
+		if err == nil {
+			err = evaluate(store, rule, measure, &result)
+		}
+
+		if err != nil {
+			result.Passed = false
+			result.Error = err.Error()
+		}
+
+		degraded = degraded || (!result.Passed && rule.Severity == utils.QUALITY_SEVERITY_ERROR)
+		results = append(results, result)
+	}
+
+	if err = store.SaveQualityResults(results); err != nil {
+		return results, err
+	}
+
+	if !degraded {
+		return results, nil
+	}
+
+	productIDs, err := store.GetAssetProductIDs(assetID)
+	if err != nil {
+		return results, err
+	}
+
+	return results, store.SetDataProductsStatus(productIDs, utils.DATA_PRODUCT_STATUS_DEGRADED)
+}
+
+// CheckAssets checks the assets one after the other in a single run, a failure is logged and doesn't stop the other
+// assets.
+func CheckAssets(store db.Store, assetIDs []string) {
+	logger := utils.GetLogger()
+
+	runID, err := uuid.NewV4()
+	if err != nil {
+		logger.Error(err.Error())
+
+		return
+	}
+
+	for _, id := range assetIDs {
+		assetID, err := uuid.FromString(id)
+		if err == nil {
+			_, err = CheckAsset(store, runID, assetID)
+		}
+
+		// the assets of the destinations which can only be previewed are skipped on every sync
+		if errors.Is(err, destinationPool.ErrUnsupportedDestination) {
+			logger.Info(err.Error())
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(err.Error())
+		}
+	}
+}
+
+// evaluate compares the measure of the rule with its expectation. The row count delta is relative to the latest
+// measured result of the rule, the first run of the rule passes.
+func evaluate(store db.Store, rule models.QualityRule, measure models.QualityMeasure, result *models.QualityCheckResult) error {
+	result.RowCount = measure.RowCount
+	result.FailedRows = measure.FailedRows
+
+	switch rule.Check {
+	case utils.QUALITY_CHECK_ROW_COUNT_DELTA:
+		previous, err := store.GetLastQualityResult(rule.RuleID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			result.Passed = true
+			result.Observed = fmt.Sprintf("%d rows, no previous run", measure.RowCount)
+
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		delta := 0.0
+		if previous.RowCount > 0 {
+			delta = math.Abs(float64(measure.RowCount-previous.RowCount)) / float64(previous.RowCount) * 100
+		} else if measure.RowCount > 0 {
+			delta = 100
+		}
+
+		result.Passed = rule.MaxDeltaPercent == nil || delta <= *rule.MaxDeltaPercent
+		result.Observed = fmt.Sprintf("%.2f%% change (%d -> %d rows)", delta, previous.RowCount, measure.RowCount)
+	case utils.QUALITY_CHECK_FRESHNESS:
+		if measure.Latest == nil {
+			result.Observed = "no rows"
+
+			return nil
+		}
+
+		age := time.Since(time.UnixMilli(int64(*measure.Latest)))
+
+		result.Passed = age <= time.Duration(rule.MaxAgeMinutes)*time.Minute
+		result.Observed = fmt.Sprintf("latest row is %d minutes old", int64(age.Minutes()))
+	default:
+		result.Passed = measure.FailedRows == 0
+		result.Observed = fmt.Sprintf("%d of %d rows failed", measure.FailedRows, measure.RowCount)
+	}
+
+	return nil
+}
@@ -1,40 +1,174 @@
 package db
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
 	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"pipelineService/models/v1"
-	"pipelineService/utils"
 )
 
-func (p *PGStore) PreviewData(db *gorm.DB, schema string, table string) ([]map[string]interface{}, error) {
-	schema = strings.ToLower(schema)
+func (p *PGStore) PreviewData(db *gorm.DB, schema string, table string, query models.PreviewQuery) (models.PreviewResult, error) {
+	result := models.PreviewResult{Columns: []models.PreviewColumn{}, Rows: make([]map[string]interface{}, 0)}
 
-	tableName := fmt.Sprintf("%s.%s", schema, table)
+	preview, err := compilePreviewQuery(postgresDialect, strings.ToLower(schema), table, query)
+	if err != nil {
+		return result, err
+	}
+
+	result.Columns, err = scanPreview(db, preview, func(_ []models.PreviewColumn, row map[string]interface{}) error {
+		result.Rows = append(result.Rows, row)
+
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.NextCursor = preview.nextCursor(result)
+
+	return result, nil
+}
+
+// PreviewSQLData runs the preview on a destination other than postgres, the SQL is written in the dialect of the
+// destination type. The schema and the table are named as the destination names them, the query is cancelled with
+// the context.
+func (p *PGStore) PreviewSQLData(ctx context.Context, conn *sql.DB, destinationType string, schema string, table string,
+	query models.PreviewQuery) (models.PreviewResult, error) {
+	result := models.PreviewResult{Columns: []models.PreviewColumn{}, Rows: make([]map[string]interface{}, 0)}
+
+	dialect, ok := previewDialects[destinationType]
+	if !ok {
+		return result, fmt.Errorf("preview of %s destinations isn't supported", destinationType)
+	}
 
-	records, err := db.Table(tableName).Select("*").Limit(utils.PREVIEW_DATA_LIMIT).Rows()
+	preview, err := compilePreviewQuery(dialect, schema, table, query)
+	if err != nil {
+		return result, err
+	}
 
-	data := make([]map[string]interface{}, 0)
+	records, err := conn.QueryContext(ctx, preview.sql, preview.vars...)
+	if err != nil {
+		return result, err
+	}
+
+	result.Columns, err = readPreview(records, scanSQLRow, func(_ []models.PreviewColumn, row map[string]interface{}) error {
+		result.Rows = append(result.Rows, row)
 
+		return nil
+	})
 	if err != nil {
-		return data, err
+		return result, err
+	}
+
+	result.NextCursor = preview.nextCursor(result)
+
+	return result, nil
+}
+
+// StreamData runs the query like PreviewData but hands the rows to write one at a time instead of holding them,
+// the columns are returned even when there are no rows.
+func (p *PGStore) StreamData(db *gorm.DB, schema string, table string, query models.PreviewQuery,
+	write func(columns []models.PreviewColumn, row map[string]interface{}) error) ([]models.PreviewColumn, error) {
+	preview, err := compilePreviewQuery(postgresDialect, strings.ToLower(schema), table, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanPreview(db, preview, write)
+}
+
+func scanPreview(db *gorm.DB, preview previewStatement,
+	write func(columns []models.PreviewColumn, row map[string]interface{}) error) ([]models.PreviewColumn, error) {
+	records, err := db.Raw(preview.sql, preview.vars...).Rows()
+	if err != nil {
+		return make([]models.PreviewColumn, 0), err
+	}
+
+	return readPreview(records, func(records *sql.Rows, record *map[string]interface{}) error {
+		return db.ScanRows(records, record)
+	}, write)
+}
+
+func readPreview(records *sql.Rows, scan func(records *sql.Rows, record *map[string]interface{}) error,
+	write func(columns []models.PreviewColumn, row map[string]interface{}) error) ([]models.PreviewColumn, error) {
+	columns := make([]models.PreviewColumn, 0)
+
+	defer records.Close()
+
+	columnTypes, err := records.ColumnTypes()
+	if err != nil {
+		return columns, err
+	}
+
+	for _, columnType := range columnTypes {
+		columns = append(columns, models.PreviewColumn{
+			Name: columnType.Name(),
+			Type: strings.ToLower(columnType.DatabaseTypeName()),
+		})
 	}
 
 	for records.Next() {
 		var record map[string]interface{}
-		err := db.ScanRows(records, &record)
+		if err = scan(records, &record); err != nil {
+			return columns, err
+		}
+
+		if err = write(columns, record); err != nil {
+			return columns, err
+		}
+	}
+
+	return columns, records.Err()
+}
+
+// scanSQLRow scans a row of a driver other than gorm's, the text the drivers return as bytes is kept as text.
+func scanSQLRow(records *sql.Rows, record *map[string]interface{}) error {
+	names, err := records.Columns()
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, len(names))
+	pointers := make([]interface{}, len(names))
 
-		if err != nil {
-			return data, err
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	if err = records.Scan(pointers...); err != nil {
+		return err
+	}
+
+	*record = make(map[string]interface{}, len(names))
+
+	for i, name := range names {
+		if text, ok := values[i].([]byte); ok {
+			values[i] = string(text)
 		}
 
-		data = append(data, record)
+		(*record)[name] = values[i]
 	}
 
-	return data, err
+	return nil
+}
+
+func (p *PGStore) GetTableColumns(db *gorm.DB, schema string, tables []string) ([]models.TableColumn, error) {
+	var columns []models.TableColumn
+
+	result := db.Table("information_schema.columns").
+		Select("table_name, column_name, data_type, is_nullable, ordinal_position").
+		Where("table_schema = ?", strings.ToLower(schema)).
+		Where("table_name IN ?", tables).
+		Order("table_name, ordinal_position").
+		Find(&columns)
+
+	return columns, result.Error
 }
 
 func (p *PGStore) GetAssetDetails(assetID uuid.UUID) (models.AssetDetails, error) {
@@ -48,7 +182,10 @@ func (p *PGStore) GetAssetDetails(assetID uuid.UUID) (models.AssetDetails, error
 			"configuration_details::json->>'port' as port,"+
 			"configuration_details::json->>'username' as username,"+
 			"configuration_details::json->>'password' as password,"+
-			"configuration_details::json->>'database' as database").
+			"configuration_details::json->>'database' as database,"+
+			"destinations.destination_id AS destination_id, "+
+			"destinations.destination_type AS destination_type, "+
+			"destinations.configuration_details AS configuration_details").
 		Where("pipeline_assets.asset_id = ?", assetID).
 		Where("pipeline_assets.is_enabled = ?", true).
 		Joins("join pipelines on pipelines.pipeline_id = pipeline_assets.pipeline_id").
@@ -56,11 +193,49 @@ func (p *PGStore) GetAssetDetails(assetID uuid.UUID) (models.AssetDetails, error
 		Joins("join connections on pipelines.pipeline_id = connections.pipeline_id").
 		Joins("join connections_destinations on connections.connection_id = connections_destinations.connection_id").
 		Joins("join destinations on connections_destinations.destination_id = destinations.destination_id").
+		Where("pipeline_assets.destination_id IS NULL OR pipeline_assets.destination_id = destinations.destination_id").
 		Find(&asset)
 
 	return asset, result.Error
 }
 
+// GetAssetWorkspaceID returns the workspace of a raw or transformed asset.
+func (p *PGStore) GetAssetWorkspaceID(assetID uuid.UUID) (int, error) {
+	var workspaceIDs []int
+
+	result := p.db.Raw("SELECT workspace_id FROM pipeline_assets WHERE asset_id = ? "+
+		"UNION ALL SELECT workspace_id FROM product_assets WHERE asset_id = ?", assetID, assetID).
+		Scan(&workspaceIDs)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	if len(workspaceIDs) == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	return workspaceIDs[0], nil
+}
+
+// GetAssetIdentity returns the pipeline or product and the name of a raw or transformed asset.
+func (p *PGStore) GetAssetIdentity(assetID uuid.UUID) (models.AssetIdentity, error) {
+	var identities []models.AssetIdentity
+
+	result := p.db.Raw("SELECT pipeline_id AS parent_id, name AS asset_name FROM pipeline_assets WHERE asset_id = ? "+
+		"UNION ALL SELECT product_id AS parent_id, name AS asset_name FROM product_assets WHERE asset_id = ?",
+		assetID, assetID).
+		Scan(&identities)
+	if result.Error != nil {
+		return models.AssetIdentity{}, result.Error
+	}
+
+	if len(identities) == 0 {
+		return models.AssetIdentity{}, gorm.ErrRecordNotFound
+	}
+
+	return identities[0], nil
+}
+
 func (p *PGStore) GetPipelineAssets(pipelineID uuid.UUID) ([]models.PipelineAssets, error) {
 	var assets []models.PipelineAssets
 
@@ -89,6 +264,7 @@ func (p *PGStore) GetTransformedAssetDetails(assetID uuid.UUID) (models.Transfor
 			"product_assets.asset_id AS asset_id, "+
 			"data_products.name AS product_name, "+
 			"data_products.product_id AS product_id, "+
+			"destinations.destination_id AS destination_id, "+
 			"destinations.configuration_details as destination_configuration").
 		Joins("join data_products on data_products.product_id = product_assets.product_id").
 		Joins("join transformation_pipelines on transformation_pipelines.product_id = product_assets.product_id").
@@ -134,3 +310,72 @@ func (p *PGStore) SyncTransformedAssets(productAssetDetails []models.ProductAsse
 		return nil
 	})
 }
+
+func (p *PGStore) SyncAssetColumns(parentID string, columns []models.AssetColumn) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// the documentation of the columns which are still present is kept, the rest are removed
+		staleColumns := tx.Where("parent_id = ?", parentID)
+
+		if len(columns) > 0 {
+			keys := make([][]interface{}, 0, len(columns))
+			for _, column := range columns {
+				keys = append(keys, []interface{}{column.AssetName, column.Name})
+			}
+
+			staleColumns = staleColumns.Where("(asset_name, name) NOT IN ?", keys)
+		}
+
+		result := staleColumns.Delete(&models.AssetColumn{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if len(columns) == 0 {
+			return nil
+		}
+
+		result = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "parent_id"}, {Name: "asset_name"}, {Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"asset_id", "type", "nullable", "position"}),
+		}).Create(&columns)
+
+		return result.Error
+	})
+}
+
+func (p *PGStore) GetAssetColumns(assetID uuid.UUID) ([]models.AssetColumn, error) {
+	var columns []models.AssetColumn
+
+	result := p.db.Where("asset_id = ?", assetID).Order("position").Find(&columns)
+
+	return columns, result.Error
+}
+
+func (p *PGStore) UpdateAssetColumn(column models.AssetColumn) (models.AssetColumn, error) {
+	var updatedColumn models.AssetColumn
+
+	// a map is used so an emptied description or tags are written as well
+	result := p.db.Model(&models.AssetColumn{}).
+		Where("asset_id = ? AND name = ?", column.AssetID, column.Name).
+		Updates(map[string]interface{}{
+			"description":   column.Description,
+			"tags":          column.Tags,
+			"documented_by": column.DocumentedBy,
+			"documented_at": column.DocumentedAt,
+		}).
+		Scan(&updatedColumn)
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return updatedColumn, errors.New("Column doesn't exists")
+	}
+
+	return updatedColumn, result.Error
+}
+
+func (p *PGStore) GetDataDictionary(parentID uuid.UUID) ([]models.AssetColumn, error) {
+	var columns []models.AssetColumn
+
+	result := p.db.Where("parent_id = ?", parentID).Order("asset_name, position").Find(&columns)
+
+	return columns, result.Error
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"encoding/json"
+	"errors"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+type auditedResource struct {
+	table      string
+	primaryKey string
+}
+
+// auditedResources maps the resource types of the routes to the tables whose rows are snapshotted for the audit log.
+var auditedResources = map[string]auditedResource{
+	"pipelines":     {table: "pipelines", primaryKey: "pipeline_id"},
+	"connections":   {table: "connections", primaryKey: "connection_id"},
+	"sources":       {table: "sources", primaryKey: "source_id"},
+	"destinations":  {table: "destinations", primaryKey: "destination_id"},
+	"data-products": {table: "data_products", primaryKey: "product_id"},
+}
+
+func (p *PGStore) CreateAuditLog(auditLog models.AuditLog) error {
+	result := p.db.Create(&auditLog)
+
+	return result.Error
+}
+
+func (p *PGStore) GetAuditLogs(workspaceID int, filter models.AuditLogFilter) ([]models.AuditLog, error) {
+	var auditLogs []models.AuditLog
+
+	query := p.db.Where("workspace_id = ?", workspaceID)
+
+	if filter.Actor != 0 {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+
+	if filter.ResourceType != "" {
+		query = query.Where("resource_type = ?", filter.ResourceType)
+	}
+
+	if filter.ResourceID != "" {
+		query = query.Where("resource_id = ?", filter.ResourceID)
+	}
+
+	if filter.From != 0 {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+
+	if filter.To != 0 {
+		query = query.Where("created_at <= ?", filter.To)
+	}
+
+	result := query.Order("created_at DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&auditLogs)
+
+	return auditLogs, result.Error
+}
+
+// GetAuditSnapshot returns the current row of the resource, it is called by the audit middleware
+// before and after the handler to compute the diff. Resources which are not audited return an empty snapshot.
+func (p *PGStore) GetAuditSnapshot(resourceType string, resourceID string) (datatypes.JSON, error) {
+	resource, ok := auditedResources[resourceType]
+	if !ok {
+		return nil, nil
+	}
+
+	snapshot := make(map[string]interface{})
+
+	result := p.db.Table(resource.table).Where(resource.primaryKey+" = ?", resourceID).Take(&snapshot)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	// the json columns are scanned as text or bytes, they are decoded so their secrets are redacted like the rest
+	for column, value := range snapshot {
+		switch typed := value.(type) {
+		case []byte:
+			if decoded, ok := utils.DecodeJSONDocument(string(typed)); ok {
+				snapshot[column] = decoded
+			} else {
+				snapshot[column] = string(typed)
+			}
+		case string:
+			if decoded, ok := utils.DecodeJSONDocument(typed); ok {
+				snapshot[column] = decoded
+			}
+		}
+	}
+
+	// the pipeline bindings of a product are replaced as a whole by AddPipeline, so they are part of its snapshot
+	if resourceType == "data-products" {
+		var pipelineIDs []string
+
+		result = p.db.Table("products_pipelines").Where("product_id = ?", resourceID).Pluck("pipeline_id", &pipelineIDs)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		snapshot["pipelines"] = pipelineIDs
+	}
+
+	return json.Marshal(snapshot)
+}
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+)
+
+func (p *PGStore) CreateClassificationRule(rule models.ClassificationRule) (models.ClassificationRule, error) {
+	createdRule := models.ClassificationRule{}
+
+	result := p.db.Create(&rule).Scan(&createdRule)
+
+	return createdRule, result.Error
+}
+
+func (p *PGStore) GetClassificationRules(workspaceID int) ([]models.ClassificationRule, error) {
+	var rules []models.ClassificationRule
+
+	result := p.db.Where("workspace_id = ?", workspaceID).Order("created_at").Find(&rules)
+
+	return rules, result.Error
+}
+
+func (p *PGStore) DeleteClassificationRule(ruleID uuid.UUID, workspaceID int) error {
+	result := p.db.Where("rule_id = ? AND workspace_id = ?", ruleID, workspaceID).Delete(&models.ClassificationRule{})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Classification rule doesn't exists")
+	}
+
+	return result.Error
+}
+
+func (p *PGStore) GetClassifiableAssets() ([]string, error) {
+	var assetIDs []string
+
+	result := p.db.Model(&models.AssetColumn{}).Distinct("asset_id").Find(&assetIDs)
+
+	return assetIDs, result.Error
+}
+
+func (p *PGStore) SetColumnClassifications(assetID string, classifications map[string][]string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// the labels of the previous run are replaced, the labels pinned by the users are kept
+		result := tx.Model(&models.AssetColumn{}).
+			Where("asset_id = ? AND NOT classifications_pinned", assetID).
+			Update("classifications", "{}")
+		if result.Error != nil {
+			return result.Error
+		}
+
+		for column, labels := range classifications {
+			result = tx.Model(&models.AssetColumn{}).
+				Where("asset_id = ? AND name = ? AND NOT classifications_pinned", assetID, column).
+				Update("classifications", pq.StringArray(labels))
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+}
+
+func (p *PGStore) UpdateColumnClassifications(assetID uuid.UUID, column string, labels []string,
+	pinned bool) (models.AssetColumn, error) {
+	var updatedColumn models.AssetColumn
+
+	result := p.db.Model(&models.AssetColumn{}).
+		Where("asset_id = ? AND name = ?", assetID, column).
+		Updates(map[string]interface{}{
+			"classifications":        pq.StringArray(labels),
+			"classifications_pinned": pinned,
+		}).
+		Scan(&updatedColumn)
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return updatedColumn, errors.New("Column doesn't exists")
+	}
+
+	return updatedColumn, result.Error
+}
+
+func (p *PGStore) GetSensitiveColumns(productID uuid.UUID) ([]models.SensitiveColumn, error) {
+	var columns []models.SensitiveColumn
+
+	result := p.db.Model(&models.AssetColumn{}).
+		Select("asset_id, asset_name, name, classifications, "+
+			"CASE WHEN parent_id = ? THEN 'transformed' ELSE 'raw' END AS asset_type", productID).
+		Where("cardinality(classifications) > 0").
+		Where("parent_id = ? OR parent_id IN (?)", productID,
+			p.db.Model(&models.ProductsPipelines{}).Select("pipeline_id").Where("product_id = ?", productID)).
+		Order("asset_type, asset_name, position").
+		Find(&columns)
+
+	return columns, result.Error
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"database/sql"
 	"fmt"
 
 	"gorm.io/driver/postgres"
@@ -28,10 +29,18 @@ func GetConnection() *gorm.DB {
 }
 
 func GetClient(host string, user string, password string, dbName string, port string, sslmode string) (*gorm.DB, error) {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
+	return gorm.Open(postgres.Open(PostgresDSN(host, user, password, dbName, port, sslmode)), &gorm.Config{})
+}
+
+func PostgresDSN(host string, user string, password string, dbName string, port string, sslmode string) string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
 		host, user, password, dbName, port, sslmode)
+}
 
-	return gorm.Open(postgres.Open(dsn), &gorm.Config{})
+// NewClient wraps a connection pool opened with the pgx driver, the clients wrapping the same pool share its
+// connections and must not close it.
+func NewClient(conn *sql.DB) (*gorm.DB, error) {
+	return gorm.Open(postgres.New(postgres.Config{Conn: conn}), &gorm.Config{})
 }
 
 func CloseConnection(db *gorm.DB) {
@@ -0,0 +1,212 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+func (p *PGStore) GetSupportedSources(workspaceID int) ([]models.SupportedSources, error) {
+	sources := make([]models.SupportedSources, 0)
+
+	result := supportedConnectors(p.db, "supported_sources", utils.CONNECTOR_TYPE_SOURCE, workspaceID).Find(&sources)
+
+	return sources, result.Error
+}
+
+func (p *PGStore) GetSupportedDestinations(workspaceID int) ([]models.SupportedDestinations, error) {
+	destinations := make([]models.SupportedDestinations, 0)
+
+	result := supportedConnectors(p.db, "supported_destinations", utils.CONNECTOR_TYPE_DESTINATION, workspaceID).
+		Find(&destinations)
+
+	return destinations, result.Error
+}
+
+func (p *PGStore) GetSupportedSource(workspaceID int, definitionID string) (models.SupportedSources, error) {
+	var source models.SupportedSources
+
+	result := supportedConnectors(p.db, "supported_sources", utils.CONNECTOR_TYPE_SOURCE, workspaceID).
+		Where("supported_sources.airbyte_definition_id = ?", definitionID).
+		Take(&source)
+
+	return source, result.Error
+}
+
+func (p *PGStore) GetSupportedDestination(workspaceID int, definitionID string) (models.SupportedDestinations, error) {
+	var destination models.SupportedDestinations
+
+	result := supportedConnectors(p.db, "supported_destinations", utils.CONNECTOR_TYPE_DESTINATION, workspaceID).
+		Where("supported_destinations.airbyte_definition_id = ?", definitionID).
+		Take(&destination)
+
+	return destination, result.Error
+}
+
+// SyncSupportedSources upserts the catalog of the sources with the definitions listed by airbyte and deactivates the
+// sources it stopped listing, it returns the number of deactivated sources.
+func (p *PGStore) SyncSupportedSources(sources []models.SupportedSources) (int64, error) {
+	names := make(map[string]string, len(sources))
+	for _, source := range sources {
+		names[source.AirbyteDefinitionID] = source.Name
+	}
+
+	return syncSupportedConnectors(p.db, "supported_sources", names, &sources)
+}
+
+// SyncSupportedDestinations upserts the catalog of the destinations with the definitions listed by airbyte and
+// deactivates the destinations it stopped listing, it returns the number of deactivated destinations.
+func (p *PGStore) SyncSupportedDestinations(destinations []models.SupportedDestinations) (int64, error) {
+	names := make(map[string]string, len(destinations))
+	for _, destination := range destinations {
+		names[destination.AirbyteDefinitionID] = destination.Name
+	}
+
+	return syncSupportedConnectors(p.db, "supported_destinations", names, &destinations)
+}
+
+// SaveWorkspaceConnector enables or disables a connector for the workspace.
+func (p *PGStore) SaveWorkspaceConnector(connector models.WorkspaceConnector) (models.WorkspaceConnector, error) {
+	result := p.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workspace_id"}, {Name: "connector_type"}, {Name: "airbyte_definition_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_by", "updated_at"}),
+	}).Create(&connector)
+
+	return connector, result.Error
+}
+
+// IsConnectorEnabled tells whether the workspace allows the connector, the connectors are enabled until the workspace
+// disables them.
+func (p *PGStore) IsConnectorEnabled(workspaceID int, connectorType string, definitionID string) (bool, error) {
+	var connectors []models.WorkspaceConnector
+
+	result := p.db.Where("workspace_id = ? AND connector_type = ? AND airbyte_definition_id = ?",
+		workspaceID, connectorType, definitionID).
+		Limit(1).
+		Find(&connectors)
+	if result.Error != nil || len(connectors) == 0 {
+		return true, result.Error
+	}
+
+	return connectors[0].Enabled, nil
+}
+
+// CreateSupportedSource records a custom source registered by a workspace in the catalog.
+func (p *PGStore) CreateSupportedSource(source models.SupportedSources) (models.SupportedSources, error) {
+	result := p.db.Create(&source)
+	source.Enabled = true
+
+	return source, result.Error
+}
+
+// CreateSupportedDestination records a custom destination registered by a workspace in the catalog.
+func (p *PGStore) CreateSupportedDestination(destination models.SupportedDestinations) (models.SupportedDestinations, error) {
+	result := p.db.Create(&destination)
+	destination.Enabled = true
+
+	return destination, result.Error
+}
+
+func (p *PGStore) UpdateSupportedSourceVersion(definitionID string, dockerImageTag string) error {
+	return updateSupportedConnectorVersion(p.db, "supported_sources", definitionID, dockerImageTag)
+}
+
+func (p *PGStore) UpdateSupportedDestinationVersion(definitionID string, dockerImageTag string) error {
+	return updateSupportedConnectorVersion(p.db, "supported_destinations", definitionID, dockerImageTag)
+}
+
+// GetSourcesByDefinition returns the sources of the workspace configured with the airbyte definition.
+func (p *PGStore) GetSourcesByDefinition(workspaceID int, definitionID string) ([]models.Source, error) {
+	sources := make([]models.Source, 0)
+
+	result := p.db.Where("workspace_id = ? AND airbyte_source_definition_id = ?", workspaceID, definitionID).
+		Find(&sources)
+
+	return sources, result.Error
+}
+
+// GetDestinationsByDefinition returns the destinations of the workspace configured with the airbyte definition.
+func (p *PGStore) GetDestinationsByDefinition(workspaceID int, definitionID string) ([]models.Destination, error) {
+	destinations := make([]models.Destination, 0)
+
+	result := p.db.Where("workspace_id = ? AND airbyte_destination_definition_id = ?", workspaceID, definitionID).
+		Find(&destinations)
+
+	return destinations, result.Error
+}
+
+// supportedConnectors selects the catalog of the connector type with the choice of the workspace for every connector,
+// the custom connectors of the other workspaces are left out.
+func supportedConnectors(db *gorm.DB, table string, connectorType string, workspaceID int) *gorm.DB {
+	return db.Table(table).
+		Select(fmt.Sprintf("%s.*, COALESCE(workspace_connectors.enabled, true) AS enabled", table)).
+		Joins(fmt.Sprintf("left join workspace_connectors on workspace_connectors.airbyte_definition_id = "+
+			"%s.airbyte_definition_id and workspace_connectors.connector_type = ? and "+
+			"workspace_connectors.workspace_id = ?", table), connectorType, workspaceID).
+		Where(fmt.Sprintf("NOT COALESCE(%[1]s.custom, false) OR %[1]s.workspace_id = ?", table), workspaceID).
+		Order(fmt.Sprintf("%s.name", table))
+}
+
+func updateSupportedConnectorVersion(db *gorm.DB, table string, definitionID string, dockerImageTag string) error {
+	result := db.Table(table).
+		Where("airbyte_definition_id = ?", definitionID).
+		Update("docker_image_tag", dockerImageTag)
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Supported connector doesn't exists")
+	}
+
+	return result.Error
+}
+
+func syncSupportedConnectors(db *gorm.DB, table string, names map[string]string, connectors interface{}) (int64, error) {
+	var deactivated int64
+
+	definitionIDs := make([]string, 0, len(names))
+	for definitionID := range names {
+		definitionIDs = append(definitionIDs, definitionID)
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// the hand maintained entries are adopted by the airbyte definition of the same name
+		for definitionID, name := range names {
+			result := tx.Table(table).
+				Where("name = ? AND airbyte_definition_id IS NULL", name).
+				Update("airbyte_definition_id", definitionID)
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+
+		// the type of an entry is kept when it was maintained by hand, and whether it's active is only set when it's
+		// inserted so the connectors disabled by the admins stay disabled
+		result := tx.Table(table).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "airbyte_definition_id"}},
+			DoUpdates: append(clause.AssignmentColumns([]string{
+				"name", "docker_repository", "docker_image_tag", "release_stage",
+				"documentation_url", "icon", "synced_at",
+			}), clause.Assignment{
+				Column: clause.Column{Name: "type"},
+				Value:  gorm.Expr(fmt.Sprintf("COALESCE(NULLIF(%s.type, ''), excluded.type)", table)),
+			}),
+		}).Create(connectors)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// the custom connectors are registered by the workspaces and aren't listed with the airbyte definitions
+		result = tx.Table(table).
+			Where("airbyte_definition_id IS NULL OR airbyte_definition_id NOT IN ?", definitionIDs).
+			Where("COALESCE(is_active, true) AND NOT COALESCE(custom, false)").
+			Update("is_active", false)
+		deactivated = result.RowsAffected
+
+		return result.Error
+	})
+
+	return deactivated, err
+}
@@ -0,0 +1,116 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// GetPipelineConnectors returns the sources and destinations of the live pipelines of the workspace with the version
+// of their definition in the catalog.
+func (p *PGStore) GetPipelineConnectors(workspaceID int) ([]models.PipelineConnector, error) {
+	connectors := make([]models.PipelineConnector, 0)
+
+	result := p.pipelineSources().Where("pipelines.workspace_id = ?", workspaceID).Order("pipelines.name").Find(&connectors)
+	if result.Error != nil {
+		return connectors, result.Error
+	}
+
+	destinations := make([]models.PipelineConnector, 0)
+
+	result = p.pipelineDestinations().Where("pipelines.workspace_id = ?", workspaceID).Order("pipelines.name").
+		Find(&destinations)
+
+	return append(connectors, destinations...), result.Error
+}
+
+// GetDefinitionConnectors returns the sources or destinations of the definition used by the live pipelines of every
+// workspace, airbyte runs a single version of a definition for the whole instance.
+func (p *PGStore) GetDefinitionConnectors(connectorType string, definitionID string) ([]models.PipelineConnector,
+	error) {
+	connectors := make([]models.PipelineConnector, 0)
+
+	query := p.pipelineSources().Where("sources.airbyte_source_definition_id = ?", definitionID)
+	if connectorType == utils.CONNECTOR_TYPE_DESTINATION {
+		query = p.pipelineDestinations().Where("destinations.airbyte_destination_definition_id = ?", definitionID)
+	}
+
+	result := query.Order("pipelines.workspace_id, pipelines.name").Find(&connectors)
+
+	return connectors, result.Error
+}
+
+func (p *PGStore) pipelineSources() *gorm.DB {
+	return p.db.Table("pipelines").
+		Select("pipelines.pipeline_id, pipelines.name AS pipeline_name, pipelines.workspace_id, "+
+			"? AS connector_type, sources.airbyte_source_definition_id AS airbyte_definition_id, "+
+			"COALESCE(supported_sources.name, sources.name) AS name, "+
+			"supported_sources.docker_repository, supported_sources.docker_image_tag, "+
+			"connections.airbyte_connection_id, sources.airbyte_source_id AS airbyte_actor_id",
+			utils.CONNECTOR_TYPE_SOURCE).
+		Joins("join connections on pipelines.pipeline_id = connections.pipeline_id").
+		Joins("join sources on connections.source_id = sources.source_id").
+		Joins("left join supported_sources on " +
+			"supported_sources.airbyte_definition_id = sources.airbyte_source_definition_id").
+		Where("pipelines.deleted_at IS NULL").
+		Where("sources.deleted_at IS NULL")
+}
+
+func (p *PGStore) pipelineDestinations() *gorm.DB {
+	return p.db.Table("pipelines").
+		Select("pipelines.pipeline_id, pipelines.name AS pipeline_name, pipelines.workspace_id, "+
+			"? AS connector_type, destinations.airbyte_destination_definition_id AS airbyte_definition_id, "+
+			"COALESCE(supported_destinations.name, destinations.name) AS name, "+
+			"supported_destinations.docker_repository, supported_destinations.docker_image_tag, "+
+			"connections.airbyte_connection_id, destinations.airbyte_destination_id AS airbyte_actor_id, "+
+			"destinations.configuration_details",
+			utils.CONNECTOR_TYPE_DESTINATION).
+		Joins("join connections on pipelines.pipeline_id = connections.pipeline_id").
+		Joins("join connections_destinations on connections.connection_id = connections_destinations.connection_id").
+		Joins("join destinations on connections_destinations.destination_id = destinations.destination_id").
+		Joins("left join supported_destinations on " +
+			"supported_destinations.airbyte_definition_id = destinations.airbyte_destination_definition_id").
+		Where("pipelines.deleted_at IS NULL").
+		Where("destinations.deleted_at IS NULL")
+}
+
+func (p *PGStore) CreateConnectorUpgrade(upgrade models.ConnectorUpgrade) (models.ConnectorUpgrade, error) {
+	result := p.db.Create(&upgrade)
+
+	return upgrade, result.Error
+}
+
+func (p *PGStore) GetConnectorUpgrades(workspaceID int) ([]models.ConnectorUpgrade, error) {
+	upgrades := make([]models.ConnectorUpgrade, 0)
+
+	result := p.db.Where("workspace_id = ?", workspaceID).Order("created_at DESC").Find(&upgrades)
+
+	return upgrades, result.Error
+}
+
+func (p *PGStore) GetConnectorUpgrade(upgradeID uuid.UUID) (models.ConnectorUpgrade, error) {
+	upgrade := models.ConnectorUpgrade{}
+
+	result := p.db.Where("upgrade_id = ?", upgradeID).First(&upgrade)
+
+	return upgrade, result.Error
+}
+
+// UpdateConnectorUpgrade moves the upgrade on from the status it was read with, an upgrade moved on by a concurrent
+// request is left untouched.
+func (p *PGStore) UpdateConnectorUpgrade(upgrade models.ConnectorUpgrade, fromStatus string) error {
+	result := p.db.Model(&models.ConnectorUpgrade{}).
+		Where("upgrade_id = ?", upgrade.UpgradeID).
+		Where("status = ?", fromStatus).
+		Select("status", "checks", "spec_changes", "updated_at").
+		Updates(upgrade)
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Connector upgrade doesn't exists")
+	}
+
+	return result.Error
+}
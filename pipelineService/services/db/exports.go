@@ -0,0 +1,68 @@
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+func (p *PGStore) CreateAssetExport(export models.AssetExport) (models.AssetExport, error) {
+	createdExport := models.AssetExport{}
+
+	result := p.db.Create(&export).Scan(&createdExport)
+
+	return createdExport, result.Error
+}
+
+// UpdateAssetExport records the outcome of an export.
+func (p *PGStore) UpdateAssetExport(export models.AssetExport) error {
+	result := p.db.Model(&models.AssetExport{}).
+		Where("export_id = ?", export.ExportID).
+		Select("status", "row_count", "error", "file_path", "completed_at").
+		Updates(export)
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Export doesn't exists")
+	}
+
+	return result.Error
+}
+
+func (p *PGStore) GetAssetExport(exportID uuid.UUID) (models.AssetExport, error) {
+	export := models.AssetExport{}
+
+	result := p.db.Where("export_id = ?", exportID).First(&export)
+
+	return export, result.Error
+}
+
+func (p *PGStore) GetAssetExports(workspaceID int, filter models.AssetExportFilter) ([]models.AssetExport, error) {
+	exports := make([]models.AssetExport, 0)
+
+	query := p.db.Where("workspace_id = ?", workspaceID)
+
+	if filter.AssetID != "" {
+		query = query.Where("asset_id = ?", filter.AssetID)
+	}
+
+	result := query.Order("created_at DESC").Find(&exports)
+
+	return exports, result.Error
+}
+
+// GetExpiredAssetExports returns the asynchronous exports completed before the retention window whose file is still
+// on disk.
+func (p *PGStore) GetExpiredAssetExports(completedBefore time.Time) ([]models.AssetExport, error) {
+	exports := make([]models.AssetExport, 0)
+
+	result := p.db.Where("status = ?", utils.EXPORT_STATUS_COMPLETED).
+		Where("file_path <> ''").
+		Where("completed_at < ?", completedBefore.UnixMilli()).
+		Order("completed_at").
+		Find(&exports)
+
+	return exports, result.Error
+}
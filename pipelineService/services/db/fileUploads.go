@@ -0,0 +1,42 @@
+package db
+
+import (
+	"pipelineService/models/v1"
+)
+
+func (p *PGStore) CreateFileUpload(upload models.FileUpload) (models.FileUpload, error) {
+	result := p.db.Create(&upload)
+
+	return upload, result.Error
+}
+
+// GetFileUploads returns the versions of the file of the source, the latest first.
+func (p *PGStore) GetFileUploads(sourceID string) ([]models.FileUpload, error) {
+	uploads := make([]models.FileUpload, 0)
+
+	result := p.db.Where("source_id = ?", sourceID).Order("version DESC").Find(&uploads)
+
+	return uploads, result.Error
+}
+
+func (p *PGStore) GetLatestFileUpload(sourceID string) (models.FileUpload, error) {
+	upload := models.FileUpload{}
+
+	result := p.db.Where("source_id = ?", sourceID).Order("version DESC").First(&upload)
+
+	return upload, result.Error
+}
+
+// GetSourceConnections returns the connections of the live pipelines reading from the source.
+func (p *PGStore) GetSourceConnections(sourceID string) ([]models.Connection, error) {
+	connections := make([]models.Connection, 0)
+
+	result := p.db.Table("connections").
+		Select("connections.*").
+		Joins("join pipelines on connections.pipeline_id = pipelines.pipeline_id").
+		Where("connections.source_id = ?", sourceID).
+		Where("pipelines.deleted_at IS NULL").
+		Find(&connections)
+
+	return connections, result.Error
+}
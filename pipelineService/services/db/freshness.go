@@ -0,0 +1,103 @@
+package db
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm/clause"
+	"pipelineService/models/v1"
+)
+
+// SaveFreshnessSLA declares the SLA of the data product or changes the staleness of the declared one, the status of
+// a changed SLA is kept until its next evaluation.
+func (p *PGStore) SaveFreshnessSLA(sla models.FreshnessSLA) (models.FreshnessSLA, error) {
+	savedSLA := models.FreshnessSLA{}
+
+	result := p.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "product_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"max_staleness_minutes"}),
+	}).Create(&sla)
+	if result.Error != nil {
+		return savedSLA, result.Error
+	}
+
+	result = p.db.Where("product_id = ?", sla.ProductID).First(&savedSLA)
+
+	return savedSLA, result.Error
+}
+
+func (p *PGStore) GetFreshnessSLA(productID uuid.UUID) (models.FreshnessSLA, error) {
+	var sla models.FreshnessSLA
+
+	result := p.db.Where("product_id = ?", productID).First(&sla)
+
+	return sla, result.Error
+}
+
+// GetFreshnessSLAs returns the SLAs of the data products which aren't deleted.
+func (p *PGStore) GetFreshnessSLAs() ([]models.FreshnessSLA, error) {
+	slas := make([]models.FreshnessSLA, 0)
+
+	result := p.db.Table("freshness_slas").
+		Select("freshness_slas.*").
+		Joins("join data_products on data_products.product_id = freshness_slas.product_id").
+		Where("data_products.deleted_at IS NULL").
+		Find(&slas)
+
+	return slas, result.Error
+}
+
+func (p *PGStore) UpdateFreshnessSLAStatus(sla models.FreshnessSLA) error {
+	result := p.db.Model(&models.FreshnessSLA{}).
+		Where("product_id = ?", sla.ProductID).
+		Updates(map[string]interface{}{
+			"status":            sla.Status,
+			"last_updated_at":   sla.LastUpdatedAt,
+			"staleness_minutes": sla.StalenessMinutes,
+			"evaluated_at":      sla.EvaluatedAt,
+		})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Freshness SLA doesn't exists")
+	}
+
+	return result.Error
+}
+
+func (p *PGStore) DeleteFreshnessSLA(productID uuid.UUID) error {
+	result := p.db.Where("product_id = ?", productID).Delete(&models.FreshnessSLA{})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Freshness SLA doesn't exists")
+	}
+
+	return result.Error
+}
+
+func (p *PGStore) CreateFreshnessSLAEvent(event models.FreshnessSLAEvent) error {
+	return p.db.Create(&event).Error
+}
+
+func (p *PGStore) GetFreshnessSLAEvents(productID uuid.UUID) ([]models.FreshnessSLAEvent, error) {
+	events := make([]models.FreshnessSLAEvent, 0)
+
+	result := p.db.Where("product_id = ?", productID).Order("occurred_at DESC").Find(&events)
+
+	return events, result.Error
+}
+
+// GetProductAirbyteConnectionIDs returns the airbyte connections feeding the data product, the connections of its
+// pipelines and the connection of its transformation.
+func (p *PGStore) GetProductAirbyteConnectionIDs(productID uuid.UUID) ([]string, error) {
+	connectionIDs := make([]string, 0)
+
+	result := p.db.Raw("SELECT connections.airbyte_connection_id FROM connections "+
+		"JOIN products_pipelines ON products_pipelines.pipeline_id = connections.pipeline_id "+
+		"JOIN pipelines ON pipelines.pipeline_id = connections.pipeline_id "+
+		"WHERE products_pipelines.product_id = ? AND pipelines.deleted_at IS NULL "+
+		"AND connections.airbyte_connection_id IS NOT NULL "+
+		"UNION SELECT airbyte_connection_id FROM transformation_pipelines "+
+		"WHERE product_id = ? AND airbyte_connection_id IS NOT NULL", productID, productID).
+		Scan(&connectionIDs)
+
+	return connectionIDs, result.Error
+}
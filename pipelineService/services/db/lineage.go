@@ -0,0 +1,112 @@
+package db
+
+import (
+	"gorm.io/gorm/clause"
+	"pipelineService/models/v1"
+)
+
+// GetLineageSources returns the sources of the workspace along with the pipelines syncing them.
+func (p *PGStore) GetLineageSources(workspaceID int) ([]models.LineageSource, error) {
+	sources := make([]models.LineageSource, 0)
+
+	result := p.db.Table("connections").
+		Select("sources.source_id AS source_id, sources.name AS source_name, connections.pipeline_id AS pipeline_id").
+		Joins("join sources on sources.source_id = connections.source_id").
+		Joins("join pipelines on pipelines.pipeline_id = connections.pipeline_id").
+		Where("connections.workspace_id = ?", workspaceID).
+		Where("sources.deleted_at IS NULL AND pipelines.deleted_at IS NULL").
+		Find(&sources)
+
+	return sources, result.Error
+}
+
+// GetLineageTables returns the enabled raw assets of the pipelines and transformed assets of the data products of the
+// workspace along with the destinations holding them.
+func (p *PGStore) GetLineageTables(workspaceID int) ([]models.LineageTable, error) {
+	var rawTables, productTables []models.LineageTable
+
+	result := p.db.Table("pipeline_assets").
+		Select("DISTINCT pipeline_assets.asset_id AS asset_id, "+
+			"pipeline_assets.name AS name, "+
+			"pipeline_schemas.prefix AS prefix, "+
+			"pipeline_schemas.name AS schema_name, "+
+			"pipeline_assets.pipeline_id AS parent_id, "+
+			"true AS raw, "+
+			"destinations.destination_type AS destination_type, "+
+			"configuration_details::json->>'host' as host,"+
+			"configuration_details::json->>'port' as port,"+
+			"configuration_details::json->>'database' as database").
+		Joins("join pipelines on pipelines.pipeline_id = pipeline_assets.pipeline_id").
+		Joins("join pipeline_schemas on pipelines.pipeline_id = pipeline_schemas.pipeline_id").
+		Joins("join connections on pipelines.pipeline_id = connections.pipeline_id").
+		Joins("join connections_destinations on connections.connection_id = connections_destinations.connection_id").
+		Joins("join destinations on connections_destinations.destination_id = destinations.destination_id").
+		Where("pipeline_assets.destination_id IS NULL OR pipeline_assets.destination_id = destinations.destination_id").
+		Where("pipeline_assets.workspace_id = ?", workspaceID).
+		Where("pipeline_assets.is_enabled = ?", true).
+		Where("pipelines.deleted_at IS NULL").
+		Find(&rawTables)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	result = p.db.Table("product_assets").
+		Select("DISTINCT product_assets.asset_id AS asset_id, "+
+			"product_assets.name AS name, "+
+			"data_products.name AS schema_name, "+
+			"product_assets.product_id AS parent_id, "+
+			"false AS raw, "+
+			"destinations.destination_type AS destination_type, "+
+			"configuration_details::json->>'host' as host,"+
+			"configuration_details::json->>'port' as port,"+
+			"configuration_details::json->>'database' as database").
+		Joins("join data_products on data_products.product_id = product_assets.product_id").
+		Joins("join transformation_pipelines on transformation_pipelines.product_id = product_assets.product_id").
+		Joins("join destinations on destinations.destination_id = transformation_pipelines.destination_id").
+		Where("product_assets.workspace_id = ?", workspaceID).
+		Where("product_assets.is_enabled = ?", true).
+		Where("data_products.deleted_at IS NULL").
+		Find(&productTables)
+
+	return append(rawTables, productTables...), result.Error
+}
+
+// GetLineageProductPipelines returns the pipelines feeding the data products of the workspace.
+func (p *PGStore) GetLineageProductPipelines(workspaceID int) ([]models.ProductsPipelines, error) {
+	productPipelines := make([]models.ProductsPipelines, 0)
+
+	result := p.db.Table("products_pipelines").
+		Select("products_pipelines.*").
+		Joins("join data_products on data_products.product_id = products_pipelines.product_id").
+		Where("data_products.workspace_id = ?", workspaceID).
+		Where("data_products.deleted_at IS NULL").
+		Find(&productPipelines)
+
+	return productPipelines, result.Error
+}
+
+func (p *PGStore) GetLineageColumns(workspaceID int) ([]models.AssetColumn, error) {
+	columns := make([]models.AssetColumn, 0)
+
+	result := p.db.Where("workspace_id = ?", workspaceID).Order("asset_name, position").Find(&columns)
+
+	return columns, result.Error
+}
+
+// SaveDbtManifest stores the manifest of the data product, replacing the one uploaded before.
+func (p *PGStore) SaveDbtManifest(manifest models.DbtManifest) (models.DbtManifest, error) {
+	result := p.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "product_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"manifest", "uploaded_by", "uploaded_at"}),
+	}).Create(&manifest)
+
+	return manifest, result.Error
+}
+
+func (p *PGStore) GetDbtManifests(workspaceID int) ([]models.DbtManifest, error) {
+	manifests := make([]models.DbtManifest, 0)
+
+	result := p.db.Where("workspace_id = ?", workspaceID).Find(&manifests)
+
+	return manifests, result.Error
+}
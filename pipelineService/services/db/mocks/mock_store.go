@@ -5,11 +5,15 @@
 package mock_store
 
 import (
+	context "context"
+	sql "database/sql"
 	models "pipelineService/models/v1"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "github.com/golang/mock/gomock"
+	datatypes "gorm.io/datatypes"
 	gorm "gorm.io/gorm"
 )
 
@@ -50,6 +54,138 @@ func (mr *MockStoreMockRecorder) AddPipeline(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipeline", reflect.TypeOf((*MockStore)(nil).AddPipeline), arg0, arg1)
 }
 
+// AddPipelineDestinations mocks base method.
+func (m *MockStore) AddPipelineDestinations(arg0, arg1 string, arg2 []string) ([]models.AirbyteSourceAndDestinations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPipelineDestinations", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.AirbyteSourceAndDestinations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPipelineDestinations indicates an expected call of AddPipelineDestinations.
+func (mr *MockStoreMockRecorder) AddPipelineDestinations(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineDestinations", reflect.TypeOf((*MockStore)(nil).AddPipelineDestinations), arg0, arg1, arg2)
+}
+
+// AttachSourceToPipeline mocks base method.
+func (m *MockStore) AttachSourceToPipeline(arg0 models.Connection) (models.Connection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachSourceToPipeline", arg0)
+	ret0, _ := ret[0].(models.Connection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachSourceToPipeline indicates an expected call of AttachSourceToPipeline.
+func (mr *MockStoreMockRecorder) AttachSourceToPipeline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachSourceToPipeline", reflect.TypeOf((*MockStore)(nil).AttachSourceToPipeline), arg0)
+}
+
+// BackfillConnectionSources mocks base method.
+func (m *MockStore) BackfillConnectionSources() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillConnectionSources")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillConnectionSources indicates an expected call of BackfillConnectionSources.
+func (mr *MockStoreMockRecorder) BackfillConnectionSources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillConnectionSources", reflect.TypeOf((*MockStore)(nil).BackfillConnectionSources))
+}
+
+// BackfillQualityRuleAssets mocks base method.
+func (m *MockStore) BackfillQualityRuleAssets() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillQualityRuleAssets")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillQualityRuleAssets indicates an expected call of BackfillQualityRuleAssets.
+func (mr *MockStoreMockRecorder) BackfillQualityRuleAssets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillQualityRuleAssets", reflect.TypeOf((*MockStore)(nil).BackfillQualityRuleAssets))
+}
+
+// CheckQualityRule mocks base method.
+func (m *MockStore) CheckQualityRule(arg0 *gorm.DB, arg1 models.AssetTable, arg2 models.QualityRule, arg3 []models.AssetColumn) (models.QualityMeasure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckQualityRule", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.QualityMeasure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckQualityRule indicates an expected call of CheckQualityRule.
+func (mr *MockStoreMockRecorder) CheckQualityRule(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckQualityRule", reflect.TypeOf((*MockStore)(nil).CheckQualityRule), arg0, arg1, arg2, arg3)
+}
+
+// CreateAssetExport mocks base method.
+func (m *MockStore) CreateAssetExport(arg0 models.AssetExport) (models.AssetExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssetExport", arg0)
+	ret0, _ := ret[0].(models.AssetExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAssetExport indicates an expected call of CreateAssetExport.
+func (mr *MockStoreMockRecorder) CreateAssetExport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssetExport", reflect.TypeOf((*MockStore)(nil).CreateAssetExport), arg0)
+}
+
+// CreateAssetProfile mocks base method.
+func (m *MockStore) CreateAssetProfile(arg0 models.AssetProfile) (models.AssetProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssetProfile", arg0)
+	ret0, _ := ret[0].(models.AssetProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAssetProfile indicates an expected call of CreateAssetProfile.
+func (mr *MockStoreMockRecorder) CreateAssetProfile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssetProfile", reflect.TypeOf((*MockStore)(nil).CreateAssetProfile), arg0)
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockStore) CreateAuditLog(arg0 models.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockStoreMockRecorder) CreateAuditLog(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockStore)(nil).CreateAuditLog), arg0)
+}
+
+// CreateClassificationRule mocks base method.
+func (m *MockStore) CreateClassificationRule(arg0 models.ClassificationRule) (models.ClassificationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClassificationRule", arg0)
+	ret0, _ := ret[0].(models.ClassificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClassificationRule indicates an expected call of CreateClassificationRule.
+func (mr *MockStoreMockRecorder) CreateClassificationRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClassificationRule", reflect.TypeOf((*MockStore)(nil).CreateClassificationRule), arg0)
+}
+
 // CreateConnectionAndSourceAgainstAPipeline mocks base method.
 func (m *MockStore) CreateConnectionAndSourceAgainstAPipeline(arg0 models.Source, arg1 models.Connection) (models.Source, models.Connection, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +202,21 @@ func (mr *MockStoreMockRecorder) CreateConnectionAndSourceAgainstAPipeline(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConnectionAndSourceAgainstAPipeline", reflect.TypeOf((*MockStore)(nil).CreateConnectionAndSourceAgainstAPipeline), arg0, arg1)
 }
 
+// CreateConnectorUpgrade mocks base method.
+func (m *MockStore) CreateConnectorUpgrade(arg0 models.ConnectorUpgrade) (models.ConnectorUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConnectorUpgrade", arg0)
+	ret0, _ := ret[0].(models.ConnectorUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConnectorUpgrade indicates an expected call of CreateConnectorUpgrade.
+func (mr *MockStoreMockRecorder) CreateConnectorUpgrade(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConnectorUpgrade", reflect.TypeOf((*MockStore)(nil).CreateConnectorUpgrade), arg0)
+}
+
 // CreateDataProduct mocks base method.
 func (m *MockStore) CreateDataProduct(arg0 models.DataProduct) (models.DataProduct, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +247,65 @@ func (mr *MockStoreMockRecorder) CreateDestination(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDestination", reflect.TypeOf((*MockStore)(nil).CreateDestination), arg0)
 }
 
+// CreateFileUpload mocks base method.
+func (m *MockStore) CreateFileUpload(arg0 models.FileUpload) (models.FileUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFileUpload", arg0)
+	ret0, _ := ret[0].(models.FileUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFileUpload indicates an expected call of CreateFileUpload.
+func (mr *MockStoreMockRecorder) CreateFileUpload(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFileUpload", reflect.TypeOf((*MockStore)(nil).CreateFileUpload), arg0)
+}
+
+// CreateFreshnessSLAEvent mocks base method.
+func (m *MockStore) CreateFreshnessSLAEvent(arg0 models.FreshnessSLAEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFreshnessSLAEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFreshnessSLAEvent indicates an expected call of CreateFreshnessSLAEvent.
+func (mr *MockStoreMockRecorder) CreateFreshnessSLAEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFreshnessSLAEvent", reflect.TypeOf((*MockStore)(nil).CreateFreshnessSLAEvent), arg0)
+}
+
+// CreateMaskingPolicy mocks base method.
+func (m *MockStore) CreateMaskingPolicy(arg0 models.MaskingPolicy) (models.MaskingPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaskingPolicy", arg0)
+	ret0, _ := ret[0].(models.MaskingPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMaskingPolicy indicates an expected call of CreateMaskingPolicy.
+func (mr *MockStoreMockRecorder) CreateMaskingPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaskingPolicy", reflect.TypeOf((*MockStore)(nil).CreateMaskingPolicy), arg0)
+}
+
+// CreateOAuthFlow mocks base method.
+func (m *MockStore) CreateOAuthFlow(arg0 models.OAuthFlow) (models.OAuthFlow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOAuthFlow", arg0)
+	ret0, _ := ret[0].(models.OAuthFlow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOAuthFlow indicates an expected call of CreateOAuthFlow.
+func (mr *MockStoreMockRecorder) CreateOAuthFlow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOAuthFlow", reflect.TypeOf((*MockStore)(nil).CreateOAuthFlow), arg0)
+}
+
 // CreatePipeline mocks base method.
 func (m *MockStore) CreatePipeline(arg0 models.Pipeline) (models.Pipeline, error) {
 	m.ctrl.T.Helper()
@@ -112,11 +322,12 @@ func (mr *MockStoreMockRecorder) CreatePipeline(arg0 interface{}) *gomock.Call {
 }
 
 // CreatePipelineAssets mocks base method.
-func (m *MockStore) CreatePipelineAssets(arg0 []models.PipelineAssets) error {
+func (m *MockStore) CreatePipelineAssets(arg0 []models.PipelineAssets) ([]models.PipelineAssets, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePipelineAssets", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]models.PipelineAssets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreatePipelineAssets indicates an expected call of CreatePipelineAssets.
@@ -125,6 +336,21 @@ func (mr *MockStoreMockRecorder) CreatePipelineAssets(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipelineAssets", reflect.TypeOf((*MockStore)(nil).CreatePipelineAssets), arg0)
 }
 
+// CreatePipelineOperation mocks base method.
+func (m *MockStore) CreatePipelineOperation(arg0 models.PipelineOperation) (models.PipelineOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipelineOperation", arg0)
+	ret0, _ := ret[0].(models.PipelineOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePipelineOperation indicates an expected call of CreatePipelineOperation.
+func (mr *MockStoreMockRecorder) CreatePipelineOperation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipelineOperation", reflect.TypeOf((*MockStore)(nil).CreatePipelineOperation), arg0)
+}
+
 // CreatePipelineSchema mocks base method.
 func (m *MockStore) CreatePipelineSchema(arg0 models.PipelineSchemas) (models.PipelineSchemas, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +366,81 @@ func (mr *MockStoreMockRecorder) CreatePipelineSchema(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipelineSchema", reflect.TypeOf((*MockStore)(nil).CreatePipelineSchema), arg0)
 }
 
+// CreateQualityRule mocks base method.
+func (m *MockStore) CreateQualityRule(arg0 models.QualityRule) (models.QualityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQualityRule", arg0)
+	ret0, _ := ret[0].(models.QualityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQualityRule indicates an expected call of CreateQualityRule.
+func (mr *MockStoreMockRecorder) CreateQualityRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQualityRule", reflect.TypeOf((*MockStore)(nil).CreateQualityRule), arg0)
+}
+
+// CreateRowFilter mocks base method.
+func (m *MockStore) CreateRowFilter(arg0 models.RowFilter) (models.RowFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRowFilter", arg0)
+	ret0, _ := ret[0].(models.RowFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRowFilter indicates an expected call of CreateRowFilter.
+func (mr *MockStoreMockRecorder) CreateRowFilter(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRowFilter", reflect.TypeOf((*MockStore)(nil).CreateRowFilter), arg0)
+}
+
+// CreateSource mocks base method.
+func (m *MockStore) CreateSource(arg0 models.Source) (models.Source, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSource", arg0)
+	ret0, _ := ret[0].(models.Source)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSource indicates an expected call of CreateSource.
+func (mr *MockStoreMockRecorder) CreateSource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSource", reflect.TypeOf((*MockStore)(nil).CreateSource), arg0)
+}
+
+// CreateSupportedDestination mocks base method.
+func (m *MockStore) CreateSupportedDestination(arg0 models.SupportedDestinations) (models.SupportedDestinations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSupportedDestination", arg0)
+	ret0, _ := ret[0].(models.SupportedDestinations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSupportedDestination indicates an expected call of CreateSupportedDestination.
+func (mr *MockStoreMockRecorder) CreateSupportedDestination(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSupportedDestination", reflect.TypeOf((*MockStore)(nil).CreateSupportedDestination), arg0)
+}
+
+// CreateSupportedSource mocks base method.
+func (m *MockStore) CreateSupportedSource(arg0 models.SupportedSources) (models.SupportedSources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSupportedSource", arg0)
+	ret0, _ := ret[0].(models.SupportedSources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSupportedSource indicates an expected call of CreateSupportedSource.
+func (mr *MockStoreMockRecorder) CreateSupportedSource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSupportedSource", reflect.TypeOf((*MockStore)(nil).CreateSupportedSource), arg0)
+}
+
 // CreateTransformationPipeline mocks base method.
 func (m *MockStore) CreateTransformationPipeline(arg0 models.TransformationPipelines) (models.TransformationPipelines, error) {
 	m.ctrl.T.Helper()
@@ -155,18 +456,88 @@ func (mr *MockStoreMockRecorder) CreateTransformationPipeline(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransformationPipeline", reflect.TypeOf((*MockStore)(nil).CreateTransformationPipeline), arg0)
 }
 
+// DeleteClassificationRule mocks base method.
+func (m *MockStore) DeleteClassificationRule(arg0 uuid.UUID, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClassificationRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClassificationRule indicates an expected call of DeleteClassificationRule.
+func (mr *MockStoreMockRecorder) DeleteClassificationRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClassificationRule", reflect.TypeOf((*MockStore)(nil).DeleteClassificationRule), arg0, arg1)
+}
+
+// DeleteDataProduct mocks base method.
+func (m *MockStore) DeleteDataProduct(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDataProduct", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDataProduct indicates an expected call of DeleteDataProduct.
+func (mr *MockStoreMockRecorder) DeleteDataProduct(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataProduct", reflect.TypeOf((*MockStore)(nil).DeleteDataProduct), arg0)
+}
+
+// DeleteDestination mocks base method.
+func (m *MockStore) DeleteDestination(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDestination", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDestination indicates an expected call of DeleteDestination.
+func (mr *MockStoreMockRecorder) DeleteDestination(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDestination", reflect.TypeOf((*MockStore)(nil).DeleteDestination), arg0)
+}
+
+// DeleteFreshnessSLA mocks base method.
+func (m *MockStore) DeleteFreshnessSLA(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFreshnessSLA", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFreshnessSLA indicates an expected call of DeleteFreshnessSLA.
+func (mr *MockStoreMockRecorder) DeleteFreshnessSLA(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFreshnessSLA", reflect.TypeOf((*MockStore)(nil).DeleteFreshnessSLA), arg0)
+}
+
+// DeleteMaskingPolicy mocks base method.
+func (m *MockStore) DeleteMaskingPolicy(arg0 uuid.UUID, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaskingPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMaskingPolicy indicates an expected call of DeleteMaskingPolicy.
+func (mr *MockStoreMockRecorder) DeleteMaskingPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaskingPolicy", reflect.TypeOf((*MockStore)(nil).DeleteMaskingPolicy), arg0, arg1)
+}
+
 // DeletePipeline mocks base method.
-func (m *MockStore) DeletePipeline(arg0 uuid.UUID) error {
+func (m *MockStore) DeletePipeline(arg0 uuid.UUID, arg1 []models.Connection) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePipeline", arg0)
+	ret := m.ctrl.Call(m, "DeletePipeline", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePipeline indicates an expected call of DeletePipeline.
-func (mr *MockStoreMockRecorder) DeletePipeline(arg0 interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeletePipeline(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*MockStore)(nil).DeletePipeline), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*MockStore)(nil).DeletePipeline), arg0, arg1)
 }
 
 // DeletePipelineSchema mocks base method.
@@ -183,6 +554,48 @@ func (mr *MockStoreMockRecorder) DeletePipelineSchema(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipelineSchema", reflect.TypeOf((*MockStore)(nil).DeletePipelineSchema), arg0)
 }
 
+// DeleteQualityRule mocks base method.
+func (m *MockStore) DeleteQualityRule(arg0 uuid.UUID, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQualityRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQualityRule indicates an expected call of DeleteQualityRule.
+func (mr *MockStoreMockRecorder) DeleteQualityRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQualityRule", reflect.TypeOf((*MockStore)(nil).DeleteQualityRule), arg0, arg1)
+}
+
+// DeleteRowFilter mocks base method.
+func (m *MockStore) DeleteRowFilter(arg0 uuid.UUID, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRowFilter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRowFilter indicates an expected call of DeleteRowFilter.
+func (mr *MockStoreMockRecorder) DeleteRowFilter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRowFilter", reflect.TypeOf((*MockStore)(nil).DeleteRowFilter), arg0, arg1)
+}
+
+// DeleteSource mocks base method.
+func (m *MockStore) DeleteSource(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSource", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSource indicates an expected call of DeleteSource.
+func (mr *MockStoreMockRecorder) DeleteSource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSource", reflect.TypeOf((*MockStore)(nil).DeleteSource), arg0)
+}
+
 // EnablePipelineAssets mocks base method.
 func (m *MockStore) EnablePipelineAssets(arg0 []string) error {
 	m.ctrl.T.Helper()
@@ -242,6 +655,21 @@ func (mr *MockStoreMockRecorder) GetAllPipelines(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPipelines", reflect.TypeOf((*MockStore)(nil).GetAllPipelines), arg0)
 }
 
+// GetAssetColumns mocks base method.
+func (m *MockStore) GetAssetColumns(arg0 uuid.UUID) ([]models.AssetColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetColumns", arg0)
+	ret0, _ := ret[0].([]models.AssetColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetColumns indicates an expected call of GetAssetColumns.
+func (mr *MockStoreMockRecorder) GetAssetColumns(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetColumns", reflect.TypeOf((*MockStore)(nil).GetAssetColumns), arg0)
+}
+
 // GetAssetDetails mocks base method.
 func (m *MockStore) GetAssetDetails(arg0 uuid.UUID) (models.AssetDetails, error) {
 	m.ctrl.T.Helper()
@@ -257,334 +685,1625 @@ func (mr *MockStoreMockRecorder) GetAssetDetails(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetDetails", reflect.TypeOf((*MockStore)(nil).GetAssetDetails), arg0)
 }
 
-// GetConfiguredDestination mocks base method.
-func (m *MockStore) GetConfiguredDestination(arg0 int) ([]models.ConfiguredDestination, error) {
+// GetAssetExport mocks base method.
+func (m *MockStore) GetAssetExport(arg0 uuid.UUID) (models.AssetExport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConfiguredDestination", arg0)
-	ret0, _ := ret[0].([]models.ConfiguredDestination)
+	ret := m.ctrl.Call(m, "GetAssetExport", arg0)
+	ret0, _ := ret[0].(models.AssetExport)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetConfiguredDestination indicates an expected call of GetConfiguredDestination.
-func (mr *MockStoreMockRecorder) GetConfiguredDestination(arg0 interface{}) *gomock.Call {
+// GetAssetExport indicates an expected call of GetAssetExport.
+func (mr *MockStoreMockRecorder) GetAssetExport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetExport", reflect.TypeOf((*MockStore)(nil).GetAssetExport), arg0)
+}
+
+// GetAssetExports mocks base method.
+func (m *MockStore) GetAssetExports(arg0 int, arg1 models.AssetExportFilter) ([]models.AssetExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetExports", arg0, arg1)
+	ret0, _ := ret[0].([]models.AssetExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetExports indicates an expected call of GetAssetExports.
+func (mr *MockStoreMockRecorder) GetAssetExports(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetExports", reflect.TypeOf((*MockStore)(nil).GetAssetExports), arg0, arg1)
+}
+
+// GetAssetIdentity mocks base method.
+func (m *MockStore) GetAssetIdentity(arg0 uuid.UUID) (models.AssetIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetIdentity", arg0)
+	ret0, _ := ret[0].(models.AssetIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetIdentity indicates an expected call of GetAssetIdentity.
+func (mr *MockStoreMockRecorder) GetAssetIdentity(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetIdentity", reflect.TypeOf((*MockStore)(nil).GetAssetIdentity), arg0)
+}
+
+// GetAssetProductIDs mocks base method.
+func (m *MockStore) GetAssetProductIDs(arg0 uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetProductIDs", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetProductIDs indicates an expected call of GetAssetProductIDs.
+func (mr *MockStoreMockRecorder) GetAssetProductIDs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetProductIDs", reflect.TypeOf((*MockStore)(nil).GetAssetProductIDs), arg0)
+}
+
+// GetAssetRowFilters mocks base method.
+func (m *MockStore) GetAssetRowFilters(arg0 uuid.UUID) ([]models.RowFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetRowFilters", arg0)
+	ret0, _ := ret[0].([]models.RowFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetRowFilters indicates an expected call of GetAssetRowFilters.
+func (mr *MockStoreMockRecorder) GetAssetRowFilters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetRowFilters", reflect.TypeOf((*MockStore)(nil).GetAssetRowFilters), arg0)
+}
+
+// GetAssetTable mocks base method.
+func (m *MockStore) GetAssetTable(arg0 uuid.UUID) (models.AssetTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetTable", arg0)
+	ret0, _ := ret[0].(models.AssetTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetTable indicates an expected call of GetAssetTable.
+func (mr *MockStoreMockRecorder) GetAssetTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetTable", reflect.TypeOf((*MockStore)(nil).GetAssetTable), arg0)
+}
+
+// GetAssetWorkspaceID mocks base method.
+func (m *MockStore) GetAssetWorkspaceID(arg0 uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetWorkspaceID", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetWorkspaceID indicates an expected call of GetAssetWorkspaceID.
+func (mr *MockStoreMockRecorder) GetAssetWorkspaceID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetWorkspaceID", reflect.TypeOf((*MockStore)(nil).GetAssetWorkspaceID), arg0)
+}
+
+// GetAuditLogs mocks base method.
+func (m *MockStore) GetAuditLogs(arg0 int, arg1 models.AuditLogFilter) ([]models.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogs", arg0, arg1)
+	ret0, _ := ret[0].([]models.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogs indicates an expected call of GetAuditLogs.
+func (mr *MockStoreMockRecorder) GetAuditLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogs", reflect.TypeOf((*MockStore)(nil).GetAuditLogs), arg0, arg1)
+}
+
+// GetAuditSnapshot mocks base method.
+func (m *MockStore) GetAuditSnapshot(arg0, arg1 string) (datatypes.JSON, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(datatypes.JSON)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditSnapshot indicates an expected call of GetAuditSnapshot.
+func (mr *MockStoreMockRecorder) GetAuditSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditSnapshot", reflect.TypeOf((*MockStore)(nil).GetAuditSnapshot), arg0, arg1)
+}
+
+// GetClassifiableAssets mocks base method.
+func (m *MockStore) GetClassifiableAssets() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClassifiableAssets")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClassifiableAssets indicates an expected call of GetClassifiableAssets.
+func (mr *MockStoreMockRecorder) GetClassifiableAssets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClassifiableAssets", reflect.TypeOf((*MockStore)(nil).GetClassifiableAssets))
+}
+
+// GetClassificationRules mocks base method.
+func (m *MockStore) GetClassificationRules(arg0 int) ([]models.ClassificationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClassificationRules", arg0)
+	ret0, _ := ret[0].([]models.ClassificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClassificationRules indicates an expected call of GetClassificationRules.
+func (mr *MockStoreMockRecorder) GetClassificationRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClassificationRules", reflect.TypeOf((*MockStore)(nil).GetClassificationRules), arg0)
+}
+
+// GetConfiguredDestination mocks base method.
+func (m *MockStore) GetConfiguredDestination(arg0 int) ([]models.ConfiguredDestination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfiguredDestination", arg0)
+	ret0, _ := ret[0].([]models.ConfiguredDestination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfiguredDestination indicates an expected call of GetConfiguredDestination.
+func (mr *MockStoreMockRecorder) GetConfiguredDestination(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfiguredDestination", reflect.TypeOf((*MockStore)(nil).GetConfiguredDestination), arg0)
+}
+
+// GetConnection mocks base method.
+func (m *MockStore) GetConnection(arg0 string) (models.Connection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnection", arg0)
+	ret0, _ := ret[0].(models.Connection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnection indicates an expected call of GetConnection.
+func (mr *MockStoreMockRecorder) GetConnection(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockStore)(nil).GetConnection), arg0)
+}
+
+// GetConnectorUpgrade mocks base method.
+func (m *MockStore) GetConnectorUpgrade(arg0 uuid.UUID) (models.ConnectorUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnectorUpgrade", arg0)
+	ret0, _ := ret[0].(models.ConnectorUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnectorUpgrade indicates an expected call of GetConnectorUpgrade.
+func (mr *MockStoreMockRecorder) GetConnectorUpgrade(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectorUpgrade", reflect.TypeOf((*MockStore)(nil).GetConnectorUpgrade), arg0)
+}
+
+// GetConnectorUpgrades mocks base method.
+func (m *MockStore) GetConnectorUpgrades(arg0 int) ([]models.ConnectorUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnectorUpgrades", arg0)
+	ret0, _ := ret[0].([]models.ConnectorUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnectorUpgrades indicates an expected call of GetConnectorUpgrades.
+func (mr *MockStoreMockRecorder) GetConnectorUpgrades(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectorUpgrades", reflect.TypeOf((*MockStore)(nil).GetConnectorUpgrades), arg0)
+}
+
+// GetDataDictionary mocks base method.
+func (m *MockStore) GetDataDictionary(arg0 uuid.UUID) ([]models.AssetColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataDictionary", arg0)
+	ret0, _ := ret[0].([]models.AssetColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataDictionary indicates an expected call of GetDataDictionary.
+func (mr *MockStoreMockRecorder) GetDataDictionary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataDictionary", reflect.TypeOf((*MockStore)(nil).GetDataDictionary), arg0)
+}
+
+// GetDataProduct mocks base method.
+func (m *MockStore) GetDataProduct(arg0 uuid.UUID) (models.DataProductView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataProduct", arg0)
+	ret0, _ := ret[0].(models.DataProductView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataProduct indicates an expected call of GetDataProduct.
+func (mr *MockStoreMockRecorder) GetDataProduct(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataProduct", reflect.TypeOf((*MockStore)(nil).GetDataProduct), arg0)
+}
+
+// GetDataProductInfo mocks base method.
+func (m *MockStore) GetDataProductInfo(arg0 uuid.UUID) (models.DataProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataProductInfo", arg0)
+	ret0, _ := ret[0].(models.DataProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataProductInfo indicates an expected call of GetDataProductInfo.
+func (mr *MockStoreMockRecorder) GetDataProductInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataProductInfo", reflect.TypeOf((*MockStore)(nil).GetDataProductInfo), arg0)
+}
+
+// GetDbtManifests mocks base method.
+func (m *MockStore) GetDbtManifests(arg0 int) ([]models.DbtManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbtManifests", arg0)
+	ret0, _ := ret[0].([]models.DbtManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDbtManifests indicates an expected call of GetDbtManifests.
+func (mr *MockStoreMockRecorder) GetDbtManifests(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbtManifests", reflect.TypeOf((*MockStore)(nil).GetDbtManifests), arg0)
+}
+
+// GetDefinitionConnectors mocks base method.
+func (m *MockStore) GetDefinitionConnectors(arg0, arg1 string) ([]models.PipelineConnector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefinitionConnectors", arg0, arg1)
+	ret0, _ := ret[0].([]models.PipelineConnector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefinitionConnectors indicates an expected call of GetDefinitionConnectors.
+func (mr *MockStoreMockRecorder) GetDefinitionConnectors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefinitionConnectors", reflect.TypeOf((*MockStore)(nil).GetDefinitionConnectors), arg0, arg1)
+}
+
+// GetDestination mocks base method.
+func (m *MockStore) GetDestination(arg0 uuid.UUID) (models.Destination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDestination", arg0)
+	ret0, _ := ret[0].(models.Destination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDestination indicates an expected call of GetDestination.
+func (mr *MockStoreMockRecorder) GetDestination(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDestination", reflect.TypeOf((*MockStore)(nil).GetDestination), arg0)
+}
+
+// GetDestinationDependencies mocks base method.
+func (m *MockStore) GetDestinationDependencies(arg0 uuid.UUID) ([]models.ResourceDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDestinationDependencies", arg0)
+	ret0, _ := ret[0].([]models.ResourceDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDestinationDependencies indicates an expected call of GetDestinationDependencies.
+func (mr *MockStoreMockRecorder) GetDestinationDependencies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDestinationDependencies", reflect.TypeOf((*MockStore)(nil).GetDestinationDependencies), arg0)
+}
+
+// GetDestinationSummary mocks base method.
+func (m *MockStore) GetDestinationSummary(arg0 uuid.UUID) (models.DestinationSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDestinationSummary", arg0)
+	ret0, _ := ret[0].(models.DestinationSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDestinationSummary indicates an expected call of GetDestinationSummary.
+func (mr *MockStoreMockRecorder) GetDestinationSummary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDestinationSummary", reflect.TypeOf((*MockStore)(nil).GetDestinationSummary), arg0)
+}
+
+// GetDestinationsByDefinition mocks base method.
+func (m *MockStore) GetDestinationsByDefinition(arg0 int, arg1 string) ([]models.Destination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDestinationsByDefinition", arg0, arg1)
+	ret0, _ := ret[0].([]models.Destination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDestinationsByDefinition indicates an expected call of GetDestinationsByDefinition.
+func (mr *MockStoreMockRecorder) GetDestinationsByDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDestinationsByDefinition", reflect.TypeOf((*MockStore)(nil).GetDestinationsByDefinition), arg0, arg1)
+}
+
+// GetDriftCheckConnections mocks base method.
+func (m *MockStore) GetDriftCheckConnections() ([]models.DriftCheckConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDriftCheckConnections")
+	ret0, _ := ret[0].([]models.DriftCheckConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDriftCheckConnections indicates an expected call of GetDriftCheckConnections.
+func (mr *MockStoreMockRecorder) GetDriftCheckConnections() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDriftCheckConnections", reflect.TypeOf((*MockStore)(nil).GetDriftCheckConnections))
+}
+
+// GetExpiredAssetExports mocks base method.
+func (m *MockStore) GetExpiredAssetExports(arg0 time.Time) ([]models.AssetExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredAssetExports", arg0)
+	ret0, _ := ret[0].([]models.AssetExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredAssetExports indicates an expected call of GetExpiredAssetExports.
+func (mr *MockStoreMockRecorder) GetExpiredAssetExports(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredAssetExports", reflect.TypeOf((*MockStore)(nil).GetExpiredAssetExports), arg0)
+}
+
+// GetFileUploads mocks base method.
+func (m *MockStore) GetFileUploads(arg0 string) ([]models.FileUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileUploads", arg0)
+	ret0, _ := ret[0].([]models.FileUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileUploads indicates an expected call of GetFileUploads.
+func (mr *MockStoreMockRecorder) GetFileUploads(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileUploads", reflect.TypeOf((*MockStore)(nil).GetFileUploads), arg0)
+}
+
+// GetFreshnessSLA mocks base method.
+func (m *MockStore) GetFreshnessSLA(arg0 uuid.UUID) (models.FreshnessSLA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreshnessSLA", arg0)
+	ret0, _ := ret[0].(models.FreshnessSLA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFreshnessSLA indicates an expected call of GetFreshnessSLA.
+func (mr *MockStoreMockRecorder) GetFreshnessSLA(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreshnessSLA", reflect.TypeOf((*MockStore)(nil).GetFreshnessSLA), arg0)
+}
+
+// GetFreshnessSLAEvents mocks base method.
+func (m *MockStore) GetFreshnessSLAEvents(arg0 uuid.UUID) ([]models.FreshnessSLAEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreshnessSLAEvents", arg0)
+	ret0, _ := ret[0].([]models.FreshnessSLAEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFreshnessSLAEvents indicates an expected call of GetFreshnessSLAEvents.
+func (mr *MockStoreMockRecorder) GetFreshnessSLAEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreshnessSLAEvents", reflect.TypeOf((*MockStore)(nil).GetFreshnessSLAEvents), arg0)
+}
+
+// GetFreshnessSLAs mocks base method.
+func (m *MockStore) GetFreshnessSLAs() ([]models.FreshnessSLA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreshnessSLAs")
+	ret0, _ := ret[0].([]models.FreshnessSLA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFreshnessSLAs indicates an expected call of GetFreshnessSLAs.
+func (mr *MockStoreMockRecorder) GetFreshnessSLAs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreshnessSLAs", reflect.TypeOf((*MockStore)(nil).GetFreshnessSLAs))
+}
+
+// GetLastQualityResult mocks base method.
+func (m *MockStore) GetLastQualityResult(arg0 uuid.UUID) (models.QualityCheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastQualityResult", arg0)
+	ret0, _ := ret[0].(models.QualityCheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastQualityResult indicates an expected call of GetLastQualityResult.
+func (mr *MockStoreMockRecorder) GetLastQualityResult(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastQualityResult", reflect.TypeOf((*MockStore)(nil).GetLastQualityResult), arg0)
+}
+
+// GetLatestAssetProfiles mocks base method.
+func (m *MockStore) GetLatestAssetProfiles(arg0 []string) ([]models.AssetProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestAssetProfiles", arg0)
+	ret0, _ := ret[0].([]models.AssetProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestAssetProfiles indicates an expected call of GetLatestAssetProfiles.
+func (mr *MockStoreMockRecorder) GetLatestAssetProfiles(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestAssetProfiles", reflect.TypeOf((*MockStore)(nil).GetLatestAssetProfiles), arg0)
+}
+
+// GetLatestFileUpload mocks base method.
+func (m *MockStore) GetLatestFileUpload(arg0 string) (models.FileUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestFileUpload", arg0)
+	ret0, _ := ret[0].(models.FileUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestFileUpload indicates an expected call of GetLatestFileUpload.
+func (mr *MockStoreMockRecorder) GetLatestFileUpload(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestFileUpload", reflect.TypeOf((*MockStore)(nil).GetLatestFileUpload), arg0)
+}
+
+// GetLineageColumns mocks base method.
+func (m *MockStore) GetLineageColumns(arg0 int) ([]models.AssetColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLineageColumns", arg0)
+	ret0, _ := ret[0].([]models.AssetColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLineageColumns indicates an expected call of GetLineageColumns.
+func (mr *MockStoreMockRecorder) GetLineageColumns(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLineageColumns", reflect.TypeOf((*MockStore)(nil).GetLineageColumns), arg0)
+}
+
+// GetLineageProductPipelines mocks base method.
+func (m *MockStore) GetLineageProductPipelines(arg0 int) ([]models.ProductsPipelines, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLineageProductPipelines", arg0)
+	ret0, _ := ret[0].([]models.ProductsPipelines)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLineageProductPipelines indicates an expected call of GetLineageProductPipelines.
+func (mr *MockStoreMockRecorder) GetLineageProductPipelines(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLineageProductPipelines", reflect.TypeOf((*MockStore)(nil).GetLineageProductPipelines), arg0)
+}
+
+// GetLineageSources mocks base method.
+func (m *MockStore) GetLineageSources(arg0 int) ([]models.LineageSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLineageSources", arg0)
+	ret0, _ := ret[0].([]models.LineageSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLineageSources indicates an expected call of GetLineageSources.
+func (mr *MockStoreMockRecorder) GetLineageSources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLineageSources", reflect.TypeOf((*MockStore)(nil).GetLineageSources), arg0)
+}
+
+// GetLineageTables mocks base method.
+func (m *MockStore) GetLineageTables(arg0 int) ([]models.LineageTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLineageTables", arg0)
+	ret0, _ := ret[0].([]models.LineageTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLineageTables indicates an expected call of GetLineageTables.
+func (mr *MockStoreMockRecorder) GetLineageTables(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLineageTables", reflect.TypeOf((*MockStore)(nil).GetLineageTables), arg0)
+}
+
+// GetMaskingPolicies mocks base method.
+func (m *MockStore) GetMaskingPolicies(arg0 int) ([]models.MaskingPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaskingPolicies", arg0)
+	ret0, _ := ret[0].([]models.MaskingPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaskingPolicies indicates an expected call of GetMaskingPolicies.
+func (mr *MockStoreMockRecorder) GetMaskingPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaskingPolicies", reflect.TypeOf((*MockStore)(nil).GetMaskingPolicies), arg0)
+}
+
+// GetOAuthFlow mocks base method.
+func (m *MockStore) GetOAuthFlow(arg0 uuid.UUID) (models.OAuthFlow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuthFlow", arg0)
+	ret0, _ := ret[0].(models.OAuthFlow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuthFlow indicates an expected call of GetOAuthFlow.
+func (mr *MockStoreMockRecorder) GetOAuthFlow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuthFlow", reflect.TypeOf((*MockStore)(nil).GetOAuthFlow), arg0)
+}
+
+// GetOAuthFlowByState mocks base method.
+func (m *MockStore) GetOAuthFlowByState(arg0 string) (models.OAuthFlow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuthFlowByState", arg0)
+	ret0, _ := ret[0].(models.OAuthFlow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuthFlowByState indicates an expected call of GetOAuthFlowByState.
+func (mr *MockStoreMockRecorder) GetOAuthFlowByState(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuthFlowByState", reflect.TypeOf((*MockStore)(nil).GetOAuthFlowByState), arg0)
+}
+
+// GetOAuthSources mocks base method.
+func (m *MockStore) GetOAuthSources() ([]models.OAuthSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuthSources")
+	ret0, _ := ret[0].([]models.OAuthSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuthSources indicates an expected call of GetOAuthSources.
+func (mr *MockStoreMockRecorder) GetOAuthSources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuthSources", reflect.TypeOf((*MockStore)(nil).GetOAuthSources))
+}
+
+// GetOAuthTokenFailures mocks base method.
+func (m *MockStore) GetOAuthTokenFailures(arg0 int) ([]models.OAuthTokenFailure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuthTokenFailures", arg0)
+	ret0, _ := ret[0].([]models.OAuthTokenFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuthTokenFailures indicates an expected call of GetOAuthTokenFailures.
+func (mr *MockStoreMockRecorder) GetOAuthTokenFailures(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuthTokenFailures", reflect.TypeOf((*MockStore)(nil).GetOAuthTokenFailures), arg0)
+}
+
+// GetPipeline mocks base method.
+func (m *MockStore) GetPipeline(arg0 uuid.UUID) (models.PipelineView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipeline", arg0)
+	ret0, _ := ret[0].(models.PipelineView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipeline indicates an expected call of GetPipeline.
+func (mr *MockStoreMockRecorder) GetPipeline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockStore)(nil).GetPipeline), arg0)
+}
+
+// GetPipelineAssets mocks base method.
+func (m *MockStore) GetPipelineAssets(arg0 uuid.UUID) ([]models.PipelineAssets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineAssets", arg0)
+	ret0, _ := ret[0].([]models.PipelineAssets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineAssets indicates an expected call of GetPipelineAssets.
+func (mr *MockStoreMockRecorder) GetPipelineAssets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineAssets", reflect.TypeOf((*MockStore)(nil).GetPipelineAssets), arg0)
+}
+
+// GetPipelineConnection mocks base method.
+func (m *MockStore) GetPipelineConnection(arg0 string) (models.PipelineConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineConnection", arg0)
+	ret0, _ := ret[0].(models.PipelineConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineConnection indicates an expected call of GetPipelineConnection.
+func (mr *MockStoreMockRecorder) GetPipelineConnection(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineConnection", reflect.TypeOf((*MockStore)(nil).GetPipelineConnection), arg0)
+}
+
+// GetPipelineConnections mocks base method.
+func (m *MockStore) GetPipelineConnections(arg0 uuid.UUID) ([]models.Connection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineConnections", arg0)
+	ret0, _ := ret[0].([]models.Connection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineConnections indicates an expected call of GetPipelineConnections.
+func (mr *MockStoreMockRecorder) GetPipelineConnections(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineConnections", reflect.TypeOf((*MockStore)(nil).GetPipelineConnections), arg0)
+}
+
+// GetPipelineConnectors mocks base method.
+func (m *MockStore) GetPipelineConnectors(arg0 int) ([]models.PipelineConnector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineConnectors", arg0)
+	ret0, _ := ret[0].([]models.PipelineConnector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineConnectors indicates an expected call of GetPipelineConnectors.
+func (mr *MockStoreMockRecorder) GetPipelineConnectors(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineConnectors", reflect.TypeOf((*MockStore)(nil).GetPipelineConnectors), arg0)
+}
+
+// GetPipelineDeletedDestinations mocks base method.
+func (m *MockStore) GetPipelineDeletedDestinations(arg0 uuid.UUID) ([]models.ResourceDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineDeletedDestinations", arg0)
+	ret0, _ := ret[0].([]models.ResourceDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineDeletedDestinations indicates an expected call of GetPipelineDeletedDestinations.
+func (mr *MockStoreMockRecorder) GetPipelineDeletedDestinations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineDeletedDestinations", reflect.TypeOf((*MockStore)(nil).GetPipelineDeletedDestinations), arg0)
+}
+
+// GetPipelineIncludingDeleted mocks base method.
+func (m *MockStore) GetPipelineIncludingDeleted(arg0 uuid.UUID) (models.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineIncludingDeleted", arg0)
+	ret0, _ := ret[0].(models.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineIncludingDeleted indicates an expected call of GetPipelineIncludingDeleted.
+func (mr *MockStoreMockRecorder) GetPipelineIncludingDeleted(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineIncludingDeleted", reflect.TypeOf((*MockStore)(nil).GetPipelineIncludingDeleted), arg0)
+}
+
+// GetPipelineOperations mocks base method.
+func (m *MockStore) GetPipelineOperations(arg0 uuid.UUID) ([]models.PipelineOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineOperations", arg0)
+	ret0, _ := ret[0].([]models.PipelineOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineOperations indicates an expected call of GetPipelineOperations.
+func (mr *MockStoreMockRecorder) GetPipelineOperations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineOperations", reflect.TypeOf((*MockStore)(nil).GetPipelineOperations), arg0)
+}
+
+// GetPipelineQualityResults mocks base method.
+func (m *MockStore) GetPipelineQualityResults(arg0 uuid.UUID) ([]models.QualityCheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineQualityResults", arg0)
+	ret0, _ := ret[0].([]models.QualityCheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineQualityResults indicates an expected call of GetPipelineQualityResults.
+func (mr *MockStoreMockRecorder) GetPipelineQualityResults(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineQualityResults", reflect.TypeOf((*MockStore)(nil).GetPipelineQualityResults), arg0)
+}
+
+// GetPipelineSchema mocks base method.
+func (m *MockStore) GetPipelineSchema(arg0 uuid.UUID) (models.PipelineSchemas, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineSchema", arg0)
+	ret0, _ := ret[0].(models.PipelineSchemas)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineSchema indicates an expected call of GetPipelineSchema.
+func (mr *MockStoreMockRecorder) GetPipelineSchema(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineSchema", reflect.TypeOf((*MockStore)(nil).GetPipelineSchema), arg0)
+}
+
+// GetPipelineSourceAndConnectionID mocks base method.
+func (m *MockStore) GetPipelineSourceAndConnectionID(arg0 uuid.UUID) (models.PipelineSourceAndConnectionID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineSourceAndConnectionID", arg0)
+	ret0, _ := ret[0].(models.PipelineSourceAndConnectionID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineSourceAndConnectionID indicates an expected call of GetPipelineSourceAndConnectionID.
+func (mr *MockStoreMockRecorder) GetPipelineSourceAndConnectionID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineSourceAndConnectionID", reflect.TypeOf((*MockStore)(nil).GetPipelineSourceAndConnectionID), arg0)
+}
+
+// GetProductAirbyteConnectionIDs mocks base method.
+func (m *MockStore) GetProductAirbyteConnectionIDs(arg0 uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductAirbyteConnectionIDs", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductAirbyteConnectionIDs indicates an expected call of GetProductAirbyteConnectionIDs.
+func (mr *MockStoreMockRecorder) GetProductAirbyteConnectionIDs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAirbyteConnectionIDs", reflect.TypeOf((*MockStore)(nil).GetProductAirbyteConnectionIDs), arg0)
+}
+
+// GetProductConnection mocks base method.
+func (m *MockStore) GetProductConnection(arg0 uuid.UUID) (models.TransformationPipelines, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductConnection", arg0)
+	ret0, _ := ret[0].(models.TransformationPipelines)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductConnection indicates an expected call of GetProductConnection.
+func (mr *MockStoreMockRecorder) GetProductConnection(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductConnection", reflect.TypeOf((*MockStore)(nil).GetProductConnection), arg0)
+}
+
+// GetProductDetails mocks base method.
+func (m *MockStore) GetProductDetails() ([]models.ProductDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductDetails")
+	ret0, _ := ret[0].([]models.ProductDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductDetails indicates an expected call of GetProductDetails.
+func (mr *MockStoreMockRecorder) GetProductDetails() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductDetails", reflect.TypeOf((*MockStore)(nil).GetProductDetails))
+}
+
+// GetProductQualityResults mocks base method.
+func (m *MockStore) GetProductQualityResults(arg0 uuid.UUID) ([]models.QualityCheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductQualityResults", arg0)
+	ret0, _ := ret[0].([]models.QualityCheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductQualityResults indicates an expected call of GetProductQualityResults.
+func (mr *MockStoreMockRecorder) GetProductQualityResults(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductQualityResults", reflect.TypeOf((*MockStore)(nil).GetProductQualityResults), arg0)
+}
+
+// GetPurgeableDataProducts mocks base method.
+func (m *MockStore) GetPurgeableDataProducts(arg0 time.Time) ([]models.DataProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurgeableDataProducts", arg0)
+	ret0, _ := ret[0].([]models.DataProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurgeableDataProducts indicates an expected call of GetPurgeableDataProducts.
+func (mr *MockStoreMockRecorder) GetPurgeableDataProducts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurgeableDataProducts", reflect.TypeOf((*MockStore)(nil).GetPurgeableDataProducts), arg0)
+}
+
+// GetPurgeableDestinations mocks base method.
+func (m *MockStore) GetPurgeableDestinations(arg0 time.Time) ([]models.Destination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurgeableDestinations", arg0)
+	ret0, _ := ret[0].([]models.Destination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurgeableDestinations indicates an expected call of GetPurgeableDestinations.
+func (mr *MockStoreMockRecorder) GetPurgeableDestinations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurgeableDestinations", reflect.TypeOf((*MockStore)(nil).GetPurgeableDestinations), arg0)
+}
+
+// GetPurgeablePipelines mocks base method.
+func (m *MockStore) GetPurgeablePipelines(arg0 time.Time) ([]models.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurgeablePipelines", arg0)
+	ret0, _ := ret[0].([]models.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurgeablePipelines indicates an expected call of GetPurgeablePipelines.
+func (mr *MockStoreMockRecorder) GetPurgeablePipelines(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurgeablePipelines", reflect.TypeOf((*MockStore)(nil).GetPurgeablePipelines), arg0)
+}
+
+// GetPurgeableSources mocks base method.
+func (m *MockStore) GetPurgeableSources(arg0 time.Time) ([]models.Source, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurgeableSources", arg0)
+	ret0, _ := ret[0].([]models.Source)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurgeableSources indicates an expected call of GetPurgeableSources.
+func (mr *MockStoreMockRecorder) GetPurgeableSources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurgeableSources", reflect.TypeOf((*MockStore)(nil).GetPurgeableSources), arg0)
+}
+
+// GetQualityResults mocks base method.
+func (m *MockStore) GetQualityResults(arg0 int, arg1 models.QualityResultFilter) ([]models.QualityCheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQualityResults", arg0, arg1)
+	ret0, _ := ret[0].([]models.QualityCheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQualityResults indicates an expected call of GetQualityResults.
+func (mr *MockStoreMockRecorder) GetQualityResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQualityResults", reflect.TypeOf((*MockStore)(nil).GetQualityResults), arg0, arg1)
+}
+
+// GetQualityRules mocks base method.
+func (m *MockStore) GetQualityRules(arg0 uuid.UUID) ([]models.QualityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQualityRules", arg0)
+	ret0, _ := ret[0].([]models.QualityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQualityRules indicates an expected call of GetQualityRules.
+func (mr *MockStoreMockRecorder) GetQualityRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQualityRules", reflect.TypeOf((*MockStore)(nil).GetQualityRules), arg0)
+}
+
+// GetRowFilters mocks base method.
+func (m *MockStore) GetRowFilters(arg0 uuid.UUID) ([]models.RowFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRowFilters", arg0)
+	ret0, _ := ret[0].([]models.RowFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRowFilters indicates an expected call of GetRowFilters.
+func (mr *MockStoreMockRecorder) GetRowFilters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowFilters", reflect.TypeOf((*MockStore)(nil).GetRowFilters), arg0)
+}
+
+// GetSchemaChange mocks base method.
+func (m *MockStore) GetSchemaChange(arg0 uuid.UUID) (models.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchemaChange", arg0)
+	ret0, _ := ret[0].(models.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchemaChange indicates an expected call of GetSchemaChange.
+func (mr *MockStoreMockRecorder) GetSchemaChange(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchemaChange", reflect.TypeOf((*MockStore)(nil).GetSchemaChange), arg0)
+}
+
+// GetSchemaChanges mocks base method.
+func (m *MockStore) GetSchemaChanges(arg0 int, arg1 models.SchemaChangeFilter) ([]models.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchemaChanges", arg0, arg1)
+	ret0, _ := ret[0].([]models.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchemaChanges indicates an expected call of GetSchemaChanges.
+func (mr *MockStoreMockRecorder) GetSchemaChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchemaChanges", reflect.TypeOf((*MockStore)(nil).GetSchemaChanges), arg0, arg1)
+}
+
+// GetSensitiveColumns mocks base method.
+func (m *MockStore) GetSensitiveColumns(arg0 uuid.UUID) ([]models.SensitiveColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSensitiveColumns", arg0)
+	ret0, _ := ret[0].([]models.SensitiveColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSensitiveColumns indicates an expected call of GetSensitiveColumns.
+func (mr *MockStoreMockRecorder) GetSensitiveColumns(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSensitiveColumns", reflect.TypeOf((*MockStore)(nil).GetSensitiveColumns), arg0)
+}
+
+// GetSource mocks base method.
+func (m *MockStore) GetSource(arg0 string) (models.Source, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSource", arg0)
+	ret0, _ := ret[0].(models.Source)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSource indicates an expected call of GetSource.
+func (mr *MockStoreMockRecorder) GetSource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSource", reflect.TypeOf((*MockStore)(nil).GetSource), arg0)
+}
+
+// GetSourceAndConnectionDetails mocks base method.
+func (m *MockStore) GetSourceAndConnectionDetails(arg0 string) (models.ConnectionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourceAndConnectionDetails", arg0)
+	ret0, _ := ret[0].(models.ConnectionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSourceAndConnectionDetails indicates an expected call of GetSourceAndConnectionDetails.
+func (mr *MockStoreMockRecorder) GetSourceAndConnectionDetails(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceAndConnectionDetails", reflect.TypeOf((*MockStore)(nil).GetSourceAndConnectionDetails), arg0)
+}
+
+// GetSourceAndDestinationAirbyteInfo mocks base method.
+func (m *MockStore) GetSourceAndDestinationAirbyteInfo(arg0, arg1, arg2 string) (models.AirbyteSourceAndDestinations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourceAndDestinationAirbyteInfo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.AirbyteSourceAndDestinations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSourceAndDestinationAirbyteInfo indicates an expected call of GetSourceAndDestinationAirbyteInfo.
+func (mr *MockStoreMockRecorder) GetSourceAndDestinationAirbyteInfo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceAndDestinationAirbyteInfo", reflect.TypeOf((*MockStore)(nil).GetSourceAndDestinationAirbyteInfo), arg0, arg1, arg2)
+}
+
+// GetSourceConnections mocks base method.
+func (m *MockStore) GetSourceConnections(arg0 string) ([]models.Connection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourceConnections", arg0)
+	ret0, _ := ret[0].([]models.Connection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSourceConnections indicates an expected call of GetSourceConnections.
+func (mr *MockStoreMockRecorder) GetSourceConnections(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceConnections", reflect.TypeOf((*MockStore)(nil).GetSourceConnections), arg0)
+}
+
+// GetSourceDependencies mocks base method.
+func (m *MockStore) GetSourceDependencies(arg0 string) ([]models.ResourceDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourceDependencies", arg0)
+	ret0, _ := ret[0].([]models.ResourceDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSourceDependencies indicates an expected call of GetSourceDependencies.
+func (mr *MockStoreMockRecorder) GetSourceDependencies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceDependencies", reflect.TypeOf((*MockStore)(nil).GetSourceDependencies), arg0)
+}
+
+// GetSourcesByDefinition mocks base method.
+func (m *MockStore) GetSourcesByDefinition(arg0 int, arg1 string) ([]models.Source, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourcesByDefinition", arg0, arg1)
+	ret0, _ := ret[0].([]models.Source)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSourcesByDefinition indicates an expected call of GetSourcesByDefinition.
+func (mr *MockStoreMockRecorder) GetSourcesByDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourcesByDefinition", reflect.TypeOf((*MockStore)(nil).GetSourcesByDefinition), arg0, arg1)
+}
+
+// GetSupportedDestination mocks base method.
+func (m *MockStore) GetSupportedDestination(arg0 int, arg1 string) (models.SupportedDestinations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedDestination", arg0, arg1)
+	ret0, _ := ret[0].(models.SupportedDestinations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportedDestination indicates an expected call of GetSupportedDestination.
+func (mr *MockStoreMockRecorder) GetSupportedDestination(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedDestination", reflect.TypeOf((*MockStore)(nil).GetSupportedDestination), arg0, arg1)
+}
+
+// GetSupportedDestinations mocks base method.
+func (m *MockStore) GetSupportedDestinations(arg0 int) ([]models.SupportedDestinations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedDestinations", arg0)
+	ret0, _ := ret[0].([]models.SupportedDestinations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportedDestinations indicates an expected call of GetSupportedDestinations.
+func (mr *MockStoreMockRecorder) GetSupportedDestinations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedDestinations", reflect.TypeOf((*MockStore)(nil).GetSupportedDestinations), arg0)
+}
+
+// GetSupportedSource mocks base method.
+func (m *MockStore) GetSupportedSource(arg0 int, arg1 string) (models.SupportedSources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedSource", arg0, arg1)
+	ret0, _ := ret[0].(models.SupportedSources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportedSource indicates an expected call of GetSupportedSource.
+func (mr *MockStoreMockRecorder) GetSupportedSource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedSource", reflect.TypeOf((*MockStore)(nil).GetSupportedSource), arg0, arg1)
+}
+
+// GetSupportedSources mocks base method.
+func (m *MockStore) GetSupportedSources(arg0 int) ([]models.SupportedSources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedSources", arg0)
+	ret0, _ := ret[0].([]models.SupportedSources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportedSources indicates an expected call of GetSupportedSources.
+func (mr *MockStoreMockRecorder) GetSupportedSources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedSources", reflect.TypeOf((*MockStore)(nil).GetSupportedSources), arg0)
+}
+
+// GetTableColumns mocks base method.
+func (m *MockStore) GetTableColumns(arg0 *gorm.DB, arg1 string, arg2 []string) ([]models.TableColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableColumns", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.TableColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableColumns indicates an expected call of GetTableColumns.
+func (mr *MockStoreMockRecorder) GetTableColumns(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableColumns", reflect.TypeOf((*MockStore)(nil).GetTableColumns), arg0, arg1, arg2)
+}
+
+// GetTransformationPipeline mocks base method.
+func (m *MockStore) GetTransformationPipeline(arg0 uuid.UUID) (models.TransformationPipelines, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransformationPipeline", arg0)
+	ret0, _ := ret[0].(models.TransformationPipelines)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransformationPipeline indicates an expected call of GetTransformationPipeline.
+func (mr *MockStoreMockRecorder) GetTransformationPipeline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransformationPipeline", reflect.TypeOf((*MockStore)(nil).GetTransformationPipeline), arg0)
+}
+
+// GetTransformedAssetDetails mocks base method.
+func (m *MockStore) GetTransformedAssetDetails(arg0 uuid.UUID) (models.TransformedAssetDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransformedAssetDetails", arg0)
+	ret0, _ := ret[0].(models.TransformedAssetDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransformedAssetDetails indicates an expected call of GetTransformedAssetDetails.
+func (mr *MockStoreMockRecorder) GetTransformedAssetDetails(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransformedAssetDetails", reflect.TypeOf((*MockStore)(nil).GetTransformedAssetDetails), arg0)
+}
+
+// GetTransformedAssets mocks base method.
+func (m *MockStore) GetTransformedAssets(arg0 uuid.UUID) ([]models.ProductAssets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransformedAssets", arg0)
+	ret0, _ := ret[0].([]models.ProductAssets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransformedAssets indicates an expected call of GetTransformedAssets.
+func (mr *MockStoreMockRecorder) GetTransformedAssets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransformedAssets", reflect.TypeOf((*MockStore)(nil).GetTransformedAssets), arg0)
+}
+
+// GetWorkspaceSources mocks base method.
+func (m *MockStore) GetWorkspaceSources(arg0 int) ([]models.WorkspaceSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceSources", arg0)
+	ret0, _ := ret[0].([]models.WorkspaceSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceSources indicates an expected call of GetWorkspaceSources.
+func (mr *MockStoreMockRecorder) GetWorkspaceSources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceSources", reflect.TypeOf((*MockStore)(nil).GetWorkspaceSources), arg0)
+}
+
+// IsConnectorEnabled mocks base method.
+func (m *MockStore) IsConnectorEnabled(arg0 int, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsConnectorEnabled", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsConnectorEnabled indicates an expected call of IsConnectorEnabled.
+func (mr *MockStoreMockRecorder) IsConnectorEnabled(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsConnectorEnabled", reflect.TypeOf((*MockStore)(nil).IsConnectorEnabled), arg0, arg1, arg2)
+}
+
+// IsSchemaChangeRejected mocks base method.
+func (m *MockStore) IsSchemaChangeRejected(arg0, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSchemaChangeRejected", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSchemaChangeRejected indicates an expected call of IsSchemaChangeRejected.
+func (mr *MockStoreMockRecorder) IsSchemaChangeRejected(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSchemaChangeRejected", reflect.TypeOf((*MockStore)(nil).IsSchemaChangeRejected), arg0, arg1)
+}
+
+// PreviewData mocks base method.
+func (m *MockStore) PreviewData(arg0 *gorm.DB, arg1, arg2 string, arg3 models.PreviewQuery) (models.PreviewResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewData", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.PreviewResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewData indicates an expected call of PreviewData.
+func (mr *MockStoreMockRecorder) PreviewData(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewData", reflect.TypeOf((*MockStore)(nil).PreviewData), arg0, arg1, arg2, arg3)
+}
+
+// PreviewSQLData mocks base method.
+func (m *MockStore) PreviewSQLData(arg0 context.Context, arg1 *sql.DB, arg2, arg3, arg4 string, arg5 models.PreviewQuery) (models.PreviewResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewSQLData", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(models.PreviewResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewSQLData indicates an expected call of PreviewSQLData.
+func (mr *MockStoreMockRecorder) PreviewSQLData(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewSQLData", reflect.TypeOf((*MockStore)(nil).PreviewSQLData), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// ProfileTable mocks base method.
+func (m *MockStore) ProfileTable(arg0 *gorm.DB, arg1 models.AssetTable, arg2 []models.AssetColumn, arg3 []models.PreviewFilter) (models.TableProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProfileTable", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.TableProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProfileTable indicates an expected call of ProfileTable.
+func (mr *MockStoreMockRecorder) ProfileTable(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProfileTable", reflect.TypeOf((*MockStore)(nil).ProfileTable), arg0, arg1, arg2, arg3)
+}
+
+// PurgeDataProduct mocks base method.
+func (m *MockStore) PurgeDataProduct(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDataProduct", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDataProduct indicates an expected call of PurgeDataProduct.
+func (mr *MockStoreMockRecorder) PurgeDataProduct(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfiguredDestination", reflect.TypeOf((*MockStore)(nil).GetConfiguredDestination), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDataProduct", reflect.TypeOf((*MockStore)(nil).PurgeDataProduct), arg0)
 }
 
-// GetConnection mocks base method.
-func (m *MockStore) GetConnection(arg0 string) (models.Connection, error) {
+// PurgeDestination mocks base method.
+func (m *MockStore) PurgeDestination(arg0 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConnection", arg0)
-	ret0, _ := ret[0].(models.Connection)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "PurgeDestination", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetConnection indicates an expected call of GetConnection.
-func (mr *MockStoreMockRecorder) GetConnection(arg0 interface{}) *gomock.Call {
+// PurgeDestination indicates an expected call of PurgeDestination.
+func (mr *MockStoreMockRecorder) PurgeDestination(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockStore)(nil).GetConnection), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDestination", reflect.TypeOf((*MockStore)(nil).PurgeDestination), arg0)
 }
 
-// GetDataProduct mocks base method.
-func (m *MockStore) GetDataProduct(arg0 uuid.UUID) (models.DataProductView, error) {
+// PurgePipeline mocks base method.
+func (m *MockStore) PurgePipeline(arg0 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDataProduct", arg0)
-	ret0, _ := ret[0].(models.DataProductView)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "PurgePipeline", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetDataProduct indicates an expected call of GetDataProduct.
-func (mr *MockStoreMockRecorder) GetDataProduct(arg0 interface{}) *gomock.Call {
+// PurgePipeline indicates an expected call of PurgePipeline.
+func (mr *MockStoreMockRecorder) PurgePipeline(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataProduct", reflect.TypeOf((*MockStore)(nil).GetDataProduct), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePipeline", reflect.TypeOf((*MockStore)(nil).PurgePipeline), arg0)
 }
 
-// GetDataProductInfo mocks base method.
-func (m *MockStore) GetDataProductInfo(arg0 uuid.UUID) (models.DataProduct, error) {
+// PurgeSource mocks base method.
+func (m *MockStore) PurgeSource(arg0 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDataProductInfo", arg0)
-	ret0, _ := ret[0].(models.DataProduct)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "PurgeSource", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetDataProductInfo indicates an expected call of GetDataProductInfo.
-func (mr *MockStoreMockRecorder) GetDataProductInfo(arg0 interface{}) *gomock.Call {
+// PurgeSource indicates an expected call of PurgeSource.
+func (mr *MockStoreMockRecorder) PurgeSource(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataProductInfo", reflect.TypeOf((*MockStore)(nil).GetDataProductInfo), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeSource", reflect.TypeOf((*MockStore)(nil).PurgeSource), arg0)
 }
 
-// GetDestination mocks base method.
-func (m *MockStore) GetDestination(arg0 uuid.UUID) (models.Destination, error) {
+// ReleasePipelineDestinations mocks base method.
+func (m *MockStore) ReleasePipelineDestinations(arg0 []models.AirbyteSourceAndDestinations) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDestination", arg0)
-	ret0, _ := ret[0].(models.Destination)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ReleasePipelineDestinations", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetDestination indicates an expected call of GetDestination.
-func (mr *MockStoreMockRecorder) GetDestination(arg0 interface{}) *gomock.Call {
+// ReleasePipelineDestinations indicates an expected call of ReleasePipelineDestinations.
+func (mr *MockStoreMockRecorder) ReleasePipelineDestinations(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDestination", reflect.TypeOf((*MockStore)(nil).GetDestination), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePipelineDestinations", reflect.TypeOf((*MockStore)(nil).ReleasePipelineDestinations), arg0)
 }
 
-// GetDestinationSummary mocks base method.
-func (m *MockStore) GetDestinationSummary(arg0 uuid.UUID) (models.DestinationSummary, error) {
+// ResolveOAuthTokenFailures mocks base method.
+func (m *MockStore) ResolveOAuthTokenFailures(arg0 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDestinationSummary", arg0)
-	ret0, _ := ret[0].(models.DestinationSummary)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ResolveOAuthTokenFailures", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetDestinationSummary indicates an expected call of GetDestinationSummary.
-func (mr *MockStoreMockRecorder) GetDestinationSummary(arg0 interface{}) *gomock.Call {
+// ResolveOAuthTokenFailures indicates an expected call of ResolveOAuthTokenFailures.
+func (mr *MockStoreMockRecorder) ResolveOAuthTokenFailures(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDestinationSummary", reflect.TypeOf((*MockStore)(nil).GetDestinationSummary), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveOAuthTokenFailures", reflect.TypeOf((*MockStore)(nil).ResolveOAuthTokenFailures), arg0)
 }
 
-// GetPipeline mocks base method.
-func (m *MockStore) GetPipeline(arg0 uuid.UUID) (models.PipelineView, error) {
+// RestoreDataProduct mocks base method.
+func (m *MockStore) RestoreDataProduct(arg0 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipeline", arg0)
-	ret0, _ := ret[0].(models.PipelineView)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RestoreDataProduct", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPipeline indicates an expected call of GetPipeline.
-func (mr *MockStoreMockRecorder) GetPipeline(arg0 interface{}) *gomock.Call {
+// RestoreDataProduct indicates an expected call of RestoreDataProduct.
+func (mr *MockStoreMockRecorder) RestoreDataProduct(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockStore)(nil).GetPipeline), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDataProduct", reflect.TypeOf((*MockStore)(nil).RestoreDataProduct), arg0)
 }
 
-// GetPipelineAssets mocks base method.
-func (m *MockStore) GetPipelineAssets(arg0 uuid.UUID) ([]models.PipelineAssets, error) {
+// RestoreDestination mocks base method.
+func (m *MockStore) RestoreDestination(arg0 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipelineAssets", arg0)
-	ret0, _ := ret[0].([]models.PipelineAssets)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RestoreDestination", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPipelineAssets indicates an expected call of GetPipelineAssets.
-func (mr *MockStoreMockRecorder) GetPipelineAssets(arg0 interface{}) *gomock.Call {
+// RestoreDestination indicates an expected call of RestoreDestination.
+func (mr *MockStoreMockRecorder) RestoreDestination(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineAssets", reflect.TypeOf((*MockStore)(nil).GetPipelineAssets), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDestination", reflect.TypeOf((*MockStore)(nil).RestoreDestination), arg0)
 }
 
-// GetPipelineConnection mocks base method.
-func (m *MockStore) GetPipelineConnection(arg0 string) (models.PipelineConnection, error) {
+// RestorePipeline mocks base method.
+func (m *MockStore) RestorePipeline(arg0 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipelineConnection", arg0)
-	ret0, _ := ret[0].(models.PipelineConnection)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RestorePipeline", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPipelineConnection indicates an expected call of GetPipelineConnection.
-func (mr *MockStoreMockRecorder) GetPipelineConnection(arg0 interface{}) *gomock.Call {
+// RestorePipeline indicates an expected call of RestorePipeline.
+func (mr *MockStoreMockRecorder) RestorePipeline(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineConnection", reflect.TypeOf((*MockStore)(nil).GetPipelineConnection), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePipeline", reflect.TypeOf((*MockStore)(nil).RestorePipeline), arg0)
 }
 
-// GetPipelineSchema mocks base method.
-func (m *MockStore) GetPipelineSchema(arg0 uuid.UUID) (models.PipelineSchemas, error) {
+// ReviewSchemaChange mocks base method.
+func (m *MockStore) ReviewSchemaChange(arg0 models.SchemaChange) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipelineSchema", arg0)
-	ret0, _ := ret[0].(models.PipelineSchemas)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ReviewSchemaChange", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPipelineSchema indicates an expected call of GetPipelineSchema.
-func (mr *MockStoreMockRecorder) GetPipelineSchema(arg0 interface{}) *gomock.Call {
+// ReviewSchemaChange indicates an expected call of ReviewSchemaChange.
+func (mr *MockStoreMockRecorder) ReviewSchemaChange(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineSchema", reflect.TypeOf((*MockStore)(nil).GetPipelineSchema), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewSchemaChange", reflect.TypeOf((*MockStore)(nil).ReviewSchemaChange), arg0)
 }
 
-// GetPipelineSourceAndConnectionID mocks base method.
-func (m *MockStore) GetPipelineSourceAndConnectionID(arg0 uuid.UUID) (models.PipelineSourceAndConnectionID, error) {
+// SaveDbtManifest mocks base method.
+func (m *MockStore) SaveDbtManifest(arg0 models.DbtManifest) (models.DbtManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipelineSourceAndConnectionID", arg0)
-	ret0, _ := ret[0].(models.PipelineSourceAndConnectionID)
+	ret := m.ctrl.Call(m, "SaveDbtManifest", arg0)
+	ret0, _ := ret[0].(models.DbtManifest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPipelineSourceAndConnectionID indicates an expected call of GetPipelineSourceAndConnectionID.
-func (mr *MockStoreMockRecorder) GetPipelineSourceAndConnectionID(arg0 interface{}) *gomock.Call {
+// SaveDbtManifest indicates an expected call of SaveDbtManifest.
+func (mr *MockStoreMockRecorder) SaveDbtManifest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineSourceAndConnectionID", reflect.TypeOf((*MockStore)(nil).GetPipelineSourceAndConnectionID), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDbtManifest", reflect.TypeOf((*MockStore)(nil).SaveDbtManifest), arg0)
 }
 
-// GetProductConnection mocks base method.
-func (m *MockStore) GetProductConnection(arg0 uuid.UUID) (models.TransformationPipelines, error) {
+// SaveFreshnessSLA mocks base method.
+func (m *MockStore) SaveFreshnessSLA(arg0 models.FreshnessSLA) (models.FreshnessSLA, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductConnection", arg0)
-	ret0, _ := ret[0].(models.TransformationPipelines)
+	ret := m.ctrl.Call(m, "SaveFreshnessSLA", arg0)
+	ret0, _ := ret[0].(models.FreshnessSLA)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProductConnection indicates an expected call of GetProductConnection.
-func (mr *MockStoreMockRecorder) GetProductConnection(arg0 interface{}) *gomock.Call {
+// SaveFreshnessSLA indicates an expected call of SaveFreshnessSLA.
+func (mr *MockStoreMockRecorder) SaveFreshnessSLA(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductConnection", reflect.TypeOf((*MockStore)(nil).GetProductConnection), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFreshnessSLA", reflect.TypeOf((*MockStore)(nil).SaveFreshnessSLA), arg0)
 }
 
-// GetProductDetails mocks base method.
-func (m *MockStore) GetProductDetails() ([]models.ProductDetail, error) {
+// SaveOAuthTokenFailure mocks base method.
+func (m *MockStore) SaveOAuthTokenFailure(arg0 models.OAuthTokenFailure) (models.OAuthTokenFailure, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductDetails")
-	ret0, _ := ret[0].([]models.ProductDetail)
+	ret := m.ctrl.Call(m, "SaveOAuthTokenFailure", arg0)
+	ret0, _ := ret[0].(models.OAuthTokenFailure)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProductDetails indicates an expected call of GetProductDetails.
-func (mr *MockStoreMockRecorder) GetProductDetails() *gomock.Call {
+// SaveOAuthTokenFailure indicates an expected call of SaveOAuthTokenFailure.
+func (mr *MockStoreMockRecorder) SaveOAuthTokenFailure(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductDetails", reflect.TypeOf((*MockStore)(nil).GetProductDetails))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOAuthTokenFailure", reflect.TypeOf((*MockStore)(nil).SaveOAuthTokenFailure), arg0)
 }
 
-// GetSource mocks base method.
-func (m *MockStore) GetSource(arg0 string) (models.Source, error) {
+// SaveQualityResults mocks base method.
+func (m *MockStore) SaveQualityResults(arg0 []models.QualityCheckResult) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSource", arg0)
-	ret0, _ := ret[0].(models.Source)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SaveQualityResults", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetSource indicates an expected call of GetSource.
-func (mr *MockStoreMockRecorder) GetSource(arg0 interface{}) *gomock.Call {
+// SaveQualityResults indicates an expected call of SaveQualityResults.
+func (mr *MockStoreMockRecorder) SaveQualityResults(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSource", reflect.TypeOf((*MockStore)(nil).GetSource), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveQualityResults", reflect.TypeOf((*MockStore)(nil).SaveQualityResults), arg0)
 }
 
-// GetSourceAndConnectionDetails mocks base method.
-func (m *MockStore) GetSourceAndConnectionDetails(arg0 string) (models.ConnectionSummary, error) {
+// SaveSchemaChange mocks base method.
+func (m *MockStore) SaveSchemaChange(arg0 models.SchemaChange) (models.SchemaChange, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSourceAndConnectionDetails", arg0)
-	ret0, _ := ret[0].(models.ConnectionSummary)
+	ret := m.ctrl.Call(m, "SaveSchemaChange", arg0)
+	ret0, _ := ret[0].(models.SchemaChange)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSourceAndConnectionDetails indicates an expected call of GetSourceAndConnectionDetails.
-func (mr *MockStoreMockRecorder) GetSourceAndConnectionDetails(arg0 interface{}) *gomock.Call {
+// SaveSchemaChange indicates an expected call of SaveSchemaChange.
+func (mr *MockStoreMockRecorder) SaveSchemaChange(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceAndConnectionDetails", reflect.TypeOf((*MockStore)(nil).GetSourceAndConnectionDetails), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSchemaChange", reflect.TypeOf((*MockStore)(nil).SaveSchemaChange), arg0)
 }
 
-// GetSourceAndDestinationAirbyteInfo mocks base method.
-func (m *MockStore) GetSourceAndDestinationAirbyteInfo(arg0, arg1 string) (models.AirbyteSourceAndDestinations, error) {
+// SaveWorkspaceConnector mocks base method.
+func (m *MockStore) SaveWorkspaceConnector(arg0 models.WorkspaceConnector) (models.WorkspaceConnector, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSourceAndDestinationAirbyteInfo", arg0, arg1)
-	ret0, _ := ret[0].(models.AirbyteSourceAndDestinations)
+	ret := m.ctrl.Call(m, "SaveWorkspaceConnector", arg0)
+	ret0, _ := ret[0].(models.WorkspaceConnector)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSourceAndDestinationAirbyteInfo indicates an expected call of GetSourceAndDestinationAirbyteInfo.
-func (mr *MockStoreMockRecorder) GetSourceAndDestinationAirbyteInfo(arg0, arg1 interface{}) *gomock.Call {
+// SaveWorkspaceConnector indicates an expected call of SaveWorkspaceConnector.
+func (mr *MockStoreMockRecorder) SaveWorkspaceConnector(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceAndDestinationAirbyteInfo", reflect.TypeOf((*MockStore)(nil).GetSourceAndDestinationAirbyteInfo), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWorkspaceConnector", reflect.TypeOf((*MockStore)(nil).SaveWorkspaceConnector), arg0)
 }
 
-// GetSupportedDestinations mocks base method.
-func (m *MockStore) GetSupportedDestinations() ([]models.SupportedDestinations, error) {
+// SetColumnClassifications mocks base method.
+func (m *MockStore) SetColumnClassifications(arg0 string, arg1 map[string][]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSupportedDestinations")
-	ret0, _ := ret[0].([]models.SupportedDestinations)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetColumnClassifications", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetSupportedDestinations indicates an expected call of GetSupportedDestinations.
-func (mr *MockStoreMockRecorder) GetSupportedDestinations() *gomock.Call {
+// SetColumnClassifications indicates an expected call of SetColumnClassifications.
+func (mr *MockStoreMockRecorder) SetColumnClassifications(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedDestinations", reflect.TypeOf((*MockStore)(nil).GetSupportedDestinations))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetColumnClassifications", reflect.TypeOf((*MockStore)(nil).SetColumnClassifications), arg0, arg1)
 }
 
-// GetSupportedSources mocks base method.
-func (m *MockStore) GetSupportedSources() ([]models.SupportedSources, error) {
+// SetDataProductsStatus mocks base method.
+func (m *MockStore) SetDataProductsStatus(arg0 []string, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSupportedSources")
-	ret0, _ := ret[0].([]models.SupportedSources)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetDataProductsStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetSupportedSources indicates an expected call of GetSupportedSources.
-func (mr *MockStoreMockRecorder) GetSupportedSources() *gomock.Call {
+// SetDataProductsStatus indicates an expected call of SetDataProductsStatus.
+func (mr *MockStoreMockRecorder) SetDataProductsStatus(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedSources", reflect.TypeOf((*MockStore)(nil).GetSupportedSources))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDataProductsStatus", reflect.TypeOf((*MockStore)(nil).SetDataProductsStatus), arg0, arg1)
 }
 
-// GetTransformationPipeline mocks base method.
-func (m *MockStore) GetTransformationPipeline(arg0 uuid.UUID) (models.TransformationPipelines, error) {
+// StreamData mocks base method.
+func (m *MockStore) StreamData(arg0 *gorm.DB, arg1, arg2 string, arg3 models.PreviewQuery, arg4 func([]models.PreviewColumn, map[string]interface{}) error) ([]models.PreviewColumn, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransformationPipeline", arg0)
-	ret0, _ := ret[0].(models.TransformationPipelines)
+	ret := m.ctrl.Call(m, "StreamData", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.PreviewColumn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransformationPipeline indicates an expected call of GetTransformationPipeline.
-func (mr *MockStoreMockRecorder) GetTransformationPipeline(arg0 interface{}) *gomock.Call {
+// StreamData indicates an expected call of StreamData.
+func (mr *MockStoreMockRecorder) StreamData(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransformationPipeline", reflect.TypeOf((*MockStore)(nil).GetTransformationPipeline), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamData", reflect.TypeOf((*MockStore)(nil).StreamData), arg0, arg1, arg2, arg3, arg4)
 }
 
-// GetTransformedAssetDetails mocks base method.
-func (m *MockStore) GetTransformedAssetDetails(arg0 uuid.UUID) (models.TransformedAssetDetails, error) {
+// SyncAssetColumns mocks base method.
+func (m *MockStore) SyncAssetColumns(arg0 string, arg1 []models.AssetColumn) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransformedAssetDetails", arg0)
-	ret0, _ := ret[0].(models.TransformedAssetDetails)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SyncAssetColumns", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTransformedAssetDetails indicates an expected call of GetTransformedAssetDetails.
-func (mr *MockStoreMockRecorder) GetTransformedAssetDetails(arg0 interface{}) *gomock.Call {
+// SyncAssetColumns indicates an expected call of SyncAssetColumns.
+func (mr *MockStoreMockRecorder) SyncAssetColumns(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransformedAssetDetails", reflect.TypeOf((*MockStore)(nil).GetTransformedAssetDetails), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAssetColumns", reflect.TypeOf((*MockStore)(nil).SyncAssetColumns), arg0, arg1)
 }
 
-// GetTransformedAssets mocks base method.
-func (m *MockStore) GetTransformedAssets(arg0 uuid.UUID) ([]models.ProductAssets, error) {
+// SyncSupportedDestinations mocks base method.
+func (m *MockStore) SyncSupportedDestinations(arg0 []models.SupportedDestinations) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransformedAssets", arg0)
-	ret0, _ := ret[0].([]models.ProductAssets)
+	ret := m.ctrl.Call(m, "SyncSupportedDestinations", arg0)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransformedAssets indicates an expected call of GetTransformedAssets.
-func (mr *MockStoreMockRecorder) GetTransformedAssets(arg0 interface{}) *gomock.Call {
+// SyncSupportedDestinations indicates an expected call of SyncSupportedDestinations.
+func (mr *MockStoreMockRecorder) SyncSupportedDestinations(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransformedAssets", reflect.TypeOf((*MockStore)(nil).GetTransformedAssets), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncSupportedDestinations", reflect.TypeOf((*MockStore)(nil).SyncSupportedDestinations), arg0)
 }
 
-// PreviewData mocks base method.
-func (m *MockStore) PreviewData(arg0 *gorm.DB, arg1, arg2 string) ([]map[string]interface{}, error) {
+// SyncSupportedSources mocks base method.
+func (m *MockStore) SyncSupportedSources(arg0 []models.SupportedSources) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PreviewData", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]map[string]interface{})
+	ret := m.ctrl.Call(m, "SyncSupportedSources", arg0)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PreviewData indicates an expected call of PreviewData.
-func (mr *MockStoreMockRecorder) PreviewData(arg0, arg1, arg2 interface{}) *gomock.Call {
+// SyncSupportedSources indicates an expected call of SyncSupportedSources.
+func (mr *MockStoreMockRecorder) SyncSupportedSources(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewData", reflect.TypeOf((*MockStore)(nil).PreviewData), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncSupportedSources", reflect.TypeOf((*MockStore)(nil).SyncSupportedSources), arg0)
 }
 
 // SyncTransformedAssets mocks base method.
@@ -601,6 +2320,50 @@ func (mr *MockStoreMockRecorder) SyncTransformedAssets(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncTransformedAssets", reflect.TypeOf((*MockStore)(nil).SyncTransformedAssets), arg0)
 }
 
+// UpdateAssetColumn mocks base method.
+func (m *MockStore) UpdateAssetColumn(arg0 models.AssetColumn) (models.AssetColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssetColumn", arg0)
+	ret0, _ := ret[0].(models.AssetColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAssetColumn indicates an expected call of UpdateAssetColumn.
+func (mr *MockStoreMockRecorder) UpdateAssetColumn(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetColumn", reflect.TypeOf((*MockStore)(nil).UpdateAssetColumn), arg0)
+}
+
+// UpdateAssetExport mocks base method.
+func (m *MockStore) UpdateAssetExport(arg0 models.AssetExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssetExport", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAssetExport indicates an expected call of UpdateAssetExport.
+func (mr *MockStoreMockRecorder) UpdateAssetExport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetExport", reflect.TypeOf((*MockStore)(nil).UpdateAssetExport), arg0)
+}
+
+// UpdateColumnClassifications mocks base method.
+func (m *MockStore) UpdateColumnClassifications(arg0 uuid.UUID, arg1 string, arg2 []string, arg3 bool) (models.AssetColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateColumnClassifications", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.AssetColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateColumnClassifications indicates an expected call of UpdateColumnClassifications.
+func (mr *MockStoreMockRecorder) UpdateColumnClassifications(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateColumnClassifications", reflect.TypeOf((*MockStore)(nil).UpdateColumnClassifications), arg0, arg1, arg2, arg3)
+}
+
 // UpdateConnectionInfo mocks base method.
 func (m *MockStore) UpdateConnectionInfo(arg0 models.Connection, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -643,6 +2406,20 @@ func (mr *MockStoreMockRecorder) UpdateConnections(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnections", reflect.TypeOf((*MockStore)(nil).UpdateConnections), arg0)
 }
 
+// UpdateConnectorUpgrade mocks base method.
+func (m *MockStore) UpdateConnectorUpgrade(arg0 models.ConnectorUpgrade, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConnectorUpgrade", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConnectorUpgrade indicates an expected call of UpdateConnectorUpgrade.
+func (mr *MockStoreMockRecorder) UpdateConnectorUpgrade(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnectorUpgrade", reflect.TypeOf((*MockStore)(nil).UpdateConnectorUpgrade), arg0, arg1)
+}
+
 // UpdateDataProduct mocks base method.
 func (m *MockStore) UpdateDataProduct(arg0 models.DataProduct) (models.DataProduct, error) {
 	m.ctrl.T.Helper()
@@ -658,6 +2435,49 @@ func (mr *MockStoreMockRecorder) UpdateDataProduct(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDataProduct", reflect.TypeOf((*MockStore)(nil).UpdateDataProduct), arg0)
 }
 
+// UpdateDestination mocks base method.
+func (m *MockStore) UpdateDestination(arg0 models.Destination) (models.Destination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDestination", arg0)
+	ret0, _ := ret[0].(models.Destination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDestination indicates an expected call of UpdateDestination.
+func (mr *MockStoreMockRecorder) UpdateDestination(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDestination", reflect.TypeOf((*MockStore)(nil).UpdateDestination), arg0)
+}
+
+// UpdateFreshnessSLAStatus mocks base method.
+func (m *MockStore) UpdateFreshnessSLAStatus(arg0 models.FreshnessSLA) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFreshnessSLAStatus", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFreshnessSLAStatus indicates an expected call of UpdateFreshnessSLAStatus.
+func (mr *MockStoreMockRecorder) UpdateFreshnessSLAStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFreshnessSLAStatus", reflect.TypeOf((*MockStore)(nil).UpdateFreshnessSLAStatus), arg0)
+}
+
+// UpdateOAuthFlow mocks base method.
+func (m *MockStore) UpdateOAuthFlow(arg0 models.OAuthFlow, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOAuthFlow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOAuthFlow indicates an expected call of UpdateOAuthFlow.
+func (mr *MockStoreMockRecorder) UpdateOAuthFlow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOAuthFlow", reflect.TypeOf((*MockStore)(nil).UpdateOAuthFlow), arg0, arg1)
+}
+
 // UpdatePipeline mocks base method.
 func (m *MockStore) UpdatePipeline(arg0 models.UpdatePipeline) (models.Pipeline, error) {
 	m.ctrl.T.Helper()
@@ -686,3 +2506,31 @@ func (mr *MockStoreMockRecorder) UpdatePipelineStatus(arg0, arg1 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipelineStatus", reflect.TypeOf((*MockStore)(nil).UpdatePipelineStatus), arg0, arg1)
 }
+
+// UpdateSupportedDestinationVersion mocks base method.
+func (m *MockStore) UpdateSupportedDestinationVersion(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSupportedDestinationVersion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSupportedDestinationVersion indicates an expected call of UpdateSupportedDestinationVersion.
+func (mr *MockStoreMockRecorder) UpdateSupportedDestinationVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSupportedDestinationVersion", reflect.TypeOf((*MockStore)(nil).UpdateSupportedDestinationVersion), arg0, arg1)
+}
+
+// UpdateSupportedSourceVersion mocks base method.
+func (m *MockStore) UpdateSupportedSourceVersion(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSupportedSourceVersion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSupportedSourceVersion indicates an expected call of UpdateSupportedSourceVersion.
+func (mr *MockStoreMockRecorder) UpdateSupportedSourceVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSupportedSourceVersion", reflect.TypeOf((*MockStore)(nil).UpdateSupportedSourceVersion), arg0, arg1)
+}
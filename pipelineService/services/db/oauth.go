@@ -0,0 +1,108 @@
+package db
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+func (p *PGStore) CreateOAuthFlow(flow models.OAuthFlow) (models.OAuthFlow, error) {
+	result := p.db.Create(&flow)
+
+	return flow, result.Error
+}
+
+func (p *PGStore) GetOAuthFlow(flowID uuid.UUID) (models.OAuthFlow, error) {
+	flow := models.OAuthFlow{}
+
+	result := p.db.Where("flow_id = ?", flowID).First(&flow)
+
+	return flow, result.Error
+}
+
+// GetOAuthFlowByState returns the flow the state of the callback was issued for.
+func (p *PGStore) GetOAuthFlowByState(state string) (models.OAuthFlow, error) {
+	flow := models.OAuthFlow{}
+
+	result := p.db.Where("state = ?", state).First(&flow)
+
+	return flow, result.Error
+}
+
+// UpdateOAuthFlow moves the flow on from the status it was read with, so a callback replayed by the provider can't
+// complete the flow twice.
+func (p *PGStore) UpdateOAuthFlow(flow models.OAuthFlow, fromStatus string) error {
+	result := p.db.Model(&models.OAuthFlow{}).
+		Where("flow_id = ?", flow.FlowID).
+		Where("status = ?", fromStatus).
+		Select("status", "error", "output", "source_id", "completed_at").
+		Updates(flow)
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("OAuth flow doesn't exists")
+	}
+
+	return result.Error
+}
+
+// GetOAuthSources returns the sources which were configured with the tokens of an OAuth flow.
+func (p *PGStore) GetOAuthSources() ([]models.OAuthSource, error) {
+	sources := make([]models.OAuthSource, 0)
+
+	result := p.db.Table("sources").
+		Distinct("sources.source_id, sources.airbyte_source_id, sources.airbyte_source_definition_id, "+
+			"sources.workspace_id").
+		Joins("join o_auth_flows on o_auth_flows.source_id = sources.source_id").
+		Where("o_auth_flows.status = ?", utils.OAUTH_FLOW_STATUS_CONSUMED).
+		Where("sources.deleted_at IS NULL").
+		Find(&sources)
+
+	return sources, result.Error
+}
+
+// SaveOAuthTokenFailure records the failure of the source, a source keeps a single open failure with its last error.
+func (p *PGStore) SaveOAuthTokenFailure(failure models.OAuthTokenFailure) (models.OAuthTokenFailure, error) {
+	result := p.db.Model(&models.OAuthTokenFailure{}).
+		Where("source_id = ?", failure.SourceID).
+		Where("resolved_at = 0").
+		Update("error", failure.Error)
+	if result.Error != nil {
+		return failure, result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		return failure, nil
+	}
+
+	result = p.db.Create(&failure)
+
+	return failure, result.Error
+}
+
+func (p *PGStore) ResolveOAuthTokenFailures(sourceID string) error {
+	result := p.db.Model(&models.OAuthTokenFailure{}).
+		Where("source_id = ?", sourceID).
+		Where("resolved_at = 0").
+		Update("resolved_at", time.Now().UnixMilli())
+
+	return result.Error
+}
+
+// GetOAuthTokenFailures returns the open failures of the sources of the workspace.
+func (p *PGStore) GetOAuthTokenFailures(workspaceID int) ([]models.OAuthTokenFailure, error) {
+	failures := make([]models.OAuthTokenFailure, 0)
+
+	result := p.db.Table("o_auth_token_failures").
+		Select("o_auth_token_failures.*, sources.name AS source_name").
+		Joins("join sources on sources.source_id = o_auth_token_failures.source_id").
+		Where("o_auth_token_failures.workspace_id = ?", workspaceID).
+		Where("o_auth_token_failures.resolved_at = 0").
+		Where("sources.deleted_at IS NULL").
+		Order("o_auth_token_failures.detected_at DESC").
+		Find(&failures)
+
+	return failures, result.Error
+}
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"fmt"
+
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -36,7 +38,9 @@ func (p *PGStore) GetProductConnection(productID uuid.UUID) (models.Transformati
 func (p *PGStore) GetDataProduct(dataProductID uuid.UUID) (models.DataProductView, error) {
 	dataProduct := models.DataProductView{}
 
-	result := p.db.Table("data_products").Where("product_id = ?", dataProductID).First(&dataProduct)
+	result := p.db.Table("data_products").Where("product_id = ?", dataProductID).
+		Where("deleted_at IS NULL").
+		First(&dataProduct)
 
 	var pipelines []map[string]interface{}
 
@@ -44,6 +48,7 @@ func (p *PGStore) GetDataProduct(dataProductID uuid.UUID) (models.DataProductVie
 		Select("pipelines.*").
 		Joins("join products_pipelines on pipelines.pipeline_id = products_pipelines.pipeline_id").
 		Where("products_pipelines.product_id = ?", dataProductID).
+		Where("pipelines.deleted_at IS NULL").
 		Find(&pipelines)
 
 	dataProduct.Pipelines = pipelines
@@ -58,6 +63,7 @@ func (p *PGStore) GetAllDataProducts(workspaceId int) ([]models.GetAllDataProduc
 		Select("data_products.*, count(products_pipelines.product_id) AS pipeline_count").
 		Joins("left join products_pipelines on data_products.product_id = products_pipelines.product_id").
 		Where("workspace_id = ?", workspaceId).
+		Where("data_products.deleted_at IS NULL").
 		Group("data_products.product_id").
 		Order("data_products.created_at DESC").
 		Find(&dataProducts)
@@ -113,14 +119,6 @@ func (p *PGStore) UpdatePipeline(pipeline models.UpdatePipeline) (models.Pipelin
 	return updatedPipeline, result.Error
 }
 
-func (p *PGStore) GetSupportedSources() ([]models.SupportedSources, error) {
-	sources := make([]models.SupportedSources, 0)
-	p.db.Find(&sources)
-	result := p.db.Find(&sources)
-
-	return sources, result.Error
-}
-
 func (p *PGStore) GetAllConnections() ([]models.Connection, error) {
 	connections := make([]models.Connection, 0)
 
@@ -188,14 +186,42 @@ func (p *PGStore) GetAllPipelines(workspaceID int) ([]models.PipelinesMetaData,
 			"connections.airbyte_last_run AS airbyte_last_run, "+
 			"pipelines.owner AS owner_id").
 		Joins("LEFT join connections on pipelines.pipeline_id = connections.pipeline_id").
-		Joins("LEFT join sources on connections.connection_id = sources.connection_id").
+		Joins("LEFT join sources on connections.source_id = sources.source_id").
 		Joins("LEFT join connections_destinations on connections.connection_id = connections_destinations.connection_id").
 		Joins("LEFT join destinations on connections_destinations.destination_id = destinations.destination_id").
 		Where("pipelines.workspace_id = ?", workspaceID).
+		Where("pipelines.deleted_at IS NULL").
 		Order("pipelines.created_at DESC").
+		Order("connections.created_at").
 		Find(&results)
 
-	return results, nil
+	// a pipeline fanning out to several destinations has a row per destination, they are grouped under its first one
+	pipelines := make([]models.PipelinesMetaData, 0, len(results))
+	indexes := make(map[string]int)
+
+	for _, result := range results {
+		index, ok := indexes[result.PipelineID]
+		if !ok {
+			index = len(pipelines)
+			indexes[result.PipelineID] = index
+			result.Destinations = make([]models.PipelineDestination, 0)
+			pipelines = append(pipelines, result)
+		}
+
+		if result.DestinationID != "" {
+			pipelines[index].Destinations = append(pipelines[index].Destinations, models.PipelineDestination{
+				PipelineID:          result.PipelineID,
+				DestinationID:       result.DestinationID,
+				DestinationName:     result.DestinationName,
+				ConnectionID:        result.ConnectionID,
+				AirbyteConnectionID: result.AirbyteConnectionID,
+				AirbyteStatus:       result.AirbyteStatus,
+				AirbyteLastRun:      result.AirbyteLastRun,
+			})
+		}
+	}
+
+	return pipelines, nil
 }
 
 func (p *PGStore) GetPipeline(pipelineID uuid.UUID) (models.PipelineView, error) {
@@ -216,18 +242,39 @@ func (p *PGStore) GetPipeline(pipelineID uuid.UUID) (models.PipelineView, error)
 			"connections.connection_id AS connection_id, "+
 			"connections.airbyte_last_run AS airbyte_last_run ").
 		Where("pipelines.pipeline_id = ?", pipelineID).
+		Where("pipelines.deleted_at IS NULL").
 		Joins("join connections on pipelines.pipeline_id = connections.pipeline_id").
-		Joins("join sources on connections.connection_id = sources.connection_id").
+		Joins("join sources on connections.source_id = sources.source_id").
 		Joins("join connections_destinations on connections.connection_id = connections_destinations.connection_id").
 		Joins("join destinations on connections_destinations.destination_id = destinations.destination_id").
+		Order("connections.created_at").
+		Limit(1).
 		Find(&pipeline)
 
+	pipeline.Destinations = make([]models.PipelineDestination, 0)
+
+	_ = p.db.Table("connections").
+		Select("connections.pipeline_id, "+
+			"destinations.destination_id, "+
+			"destinations.name AS destination_name, "+
+			"destinations.destination_type, "+
+			"connections.connection_id, "+
+			"connections.airbyte_connection_id, "+
+			"connections.airbyte_status, "+
+			"connections.airbyte_last_run").
+		Joins("join connections_destinations on connections.connection_id = connections_destinations.connection_id").
+		Joins("join destinations on connections_destinations.destination_id = destinations.destination_id").
+		Where("connections.pipeline_id = ?", pipelineID).
+		Order("connections.created_at").
+		Find(&pipeline.Destinations)
+
 	var dataProducts []map[string]interface{}
 
 	_ = p.db.Table("data_products").
 		Select("data_products.*").
 		Joins("join products_pipelines on data_products.product_id = products_pipelines.product_id").
 		Where("products_pipelines.pipeline_id = ?", pipelineID).
+		Where("data_products.deleted_at IS NULL").
 		Find(&dataProducts)
 
 	pipeline.Product = dataProducts
@@ -235,10 +282,29 @@ func (p *PGStore) GetPipeline(pipelineID uuid.UUID) (models.PipelineView, error)
 	return pipeline, result.Error
 }
 
-func (p *PGStore) DeletePipeline(pipelineID uuid.UUID) error {
-	result := p.db.Where("pipeline_id = ?", pipelineID).Delete(&models.Pipeline{})
+// DeletePipeline soft deletes the pipeline, the airbyte resources are kept until the pipeline is purged.
+// Sources are workspace resources shared by pipelines and are deleted on their own.
+// DeletePipeline soft deletes the pipeline and records the airbyte status its connections had, they are set back to it
+// when the pipeline is restored.
+func (p *PGStore) DeletePipeline(pipelineID uuid.UUID, connections []models.Connection) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		for _, connection := range connections {
+			result := tx.Model(&models.Connection{}).
+				Where("pipeline_id = ?", pipelineID).
+				Where("airbyte_connection_id = ?", connection.AirbyteConnectionID).
+				Update("airbyte_status", connection.AirbyteStatus)
+			if result.Error != nil {
+				return result.Error
+			}
+		}
 
-	return result.Error
+		result := tx.Where("pipeline_id = ?", pipelineID).Delete(&models.Pipeline{})
+		if result.RowsAffected == 0 && result.Error == nil {
+			return errors.New("Pipeline doesn't exists")
+		}
+
+		return result.Error
+	})
 }
 
 func (p *PGStore) GetPipelineSourceAndConnectionID(pipelineID uuid.UUID) (models.PipelineSourceAndConnectionID, error) {
@@ -247,16 +313,37 @@ func (p *PGStore) GetPipelineSourceAndConnectionID(pipelineID uuid.UUID) (models
 	result := p.db.Table("pipelines").
 		Select("pipelines.pipeline_id AS pipeline_id, "+
 			"connections.connection_id, "+
-			"sources.airbyte_source_id AS airbyte_source_id, "+
-			"connections.airbyte_connection_id AS airbyte_connection_id").
+			// a source shared with other pipelines must outlive this pipeline, so it is not handed to the deletion workflow
+			"CASE WHEN EXISTS (SELECT 1 FROM connections AS shared WHERE shared.source_id = connections.source_id "+
+			"AND shared.pipeline_id <> connections.pipeline_id) "+
+			"THEN NULL ELSE sources.airbyte_source_id END AS airbyte_source_id, "+
+			"connections.airbyte_connection_id AS airbyte_connection_id, "+
+			// the airbyte connections of all the destinations of the pipeline are torn down together
+			"ARRAY(SELECT grouped.airbyte_connection_id::text FROM connections AS grouped "+
+			"WHERE grouped.pipeline_id = pipelines.pipeline_id AND grouped.airbyte_connection_id IS NOT NULL "+
+			"ORDER BY grouped.created_at) AS airbyte_connection_ids").
 		Joins("LEFT join connections on pipelines.pipeline_id = connections.pipeline_id").
-		Joins("LEFT join sources on connections.connection_id = sources.connection_id").
+		Joins("LEFT join sources on connections.source_id = sources.source_id").
 		Where("pipelines.pipeline_id = ?", pipelineID).
+		Order("connections.created_at").
+		Limit(1).
 		Find(&pipeline)
 
 	return pipeline, result.Error
 }
 
+// GetPipelineConnections returns the connections the pipeline has on airbyte, one for every destination of the pipeline.
+func (p *PGStore) GetPipelineConnections(pipelineID uuid.UUID) ([]models.Connection, error) {
+	connections := make([]models.Connection, 0)
+
+	result := p.db.Where("pipeline_id = ?", pipelineID).
+		Where("airbyte_connection_id IS NOT NULL").
+		Order("created_at").
+		Find(&connections)
+
+	return connections, result.Error
+}
+
 func (p *PGStore) GetSourceAndConnectionDetails(sourceID string) (models.ConnectionSummary, error) {
 	connectionSummary := models.ConnectionSummary{}
 
@@ -265,8 +352,10 @@ func (p *PGStore) GetSourceAndConnectionDetails(sourceID string) (models.Connect
 			" connections.owner,"+
 			" connections.created_at,"+
 			" connections.airbyte_connection_id").
-		Joins("join connections on sources.connection_id = connections.connection_id").
+		Joins("join connections on sources.source_id = connections.source_id").
 		Where("sources.source_id = ?", sourceID).
+		Order("connections.created_at").
+		Limit(1).
 		Scan(&connectionSummary)
 
 	return connectionSummary, result.Error
@@ -290,6 +379,40 @@ func (p *PGStore) GetSource(sourceID string) (models.Source, error) {
 	return source, result.Error
 }
 
+func (p *PGStore) CreateSource(source models.Source) (models.Source, error) {
+	createdSource := models.Source{}
+
+	result := p.db.Create(&source).Scan(&createdSource)
+
+	return createdSource, result.Error
+}
+
+// GetWorkspaceSources returns the sources of the workspace along with the number of live pipelines reading from them.
+func (p *PGStore) GetWorkspaceSources(workspaceID int) ([]models.WorkspaceSource, error) {
+	sources := make([]models.WorkspaceSource, 0)
+
+	result := p.db.Table("sources").
+		Select("sources.source_id, sources.name, sources.airbyte_source_id, sources.airbyte_source_definition_id, "+
+			"sources.owner, sources.created_at, COUNT(pipelines.pipeline_id) AS pipelines").
+		Joins("LEFT join connections on sources.source_id = connections.source_id").
+		Joins("LEFT join pipelines on connections.pipeline_id = pipelines.pipeline_id AND pipelines.deleted_at IS NULL").
+		Where("sources.workspace_id = ?", workspaceID).
+		Where("sources.deleted_at IS NULL").
+		Group("sources.source_id").
+		Order("sources.created_at DESC").
+		Find(&sources)
+
+	return sources, result.Error
+}
+
+func (p *PGStore) AttachSourceToPipeline(connection models.Connection) (models.Connection, error) {
+	insertedConnection := models.Connection{}
+
+	result := p.db.Select("pipeline_id", "connection_id", "source_id").Create(&connection).Scan(&insertedConnection)
+
+	return insertedConnection, result.Error
+}
+
 func (p *PGStore) GetPipelineConnection(pipelineID string) (models.PipelineConnection, error) {
 	var pipelineConnections models.PipelineConnection
 
@@ -299,7 +422,7 @@ func (p *PGStore) GetPipelineConnection(pipelineID string) (models.PipelineConne
 			"sources.source_id AS source_id, "+
 			"sources.name AS source_name ").
 		Where("connections.pipeline_id = ?", pipelineID).
-		Joins("join sources on sources.connection_id = connections.connection_id").
+		Joins("join sources on connections.source_id = sources.source_id").
 		Scan(&pipelineConnections)
 
 	return pipelineConnections, result.Error
@@ -312,7 +435,7 @@ func (p *PGStore) CreateConnectionAndSourceAgainstAPipeline(source models.Source
 
 	tx := p.db.Begin()
 
-	result := tx.Select("pipeline_id", "connection_id").Create(&connection).Scan(&insertedConnection)
+	result := tx.Create(&source).Scan(&insertedSource)
 	if result.Error != nil {
 		err := tx.Rollback()
 		if err.Error != nil {
@@ -322,9 +445,9 @@ func (p *PGStore) CreateConnectionAndSourceAgainstAPipeline(source models.Source
 		return insertedSource, insertedConnection, result.Error
 	}
 
-	source.ConnectionID = insertedConnection.ConnectionID
+	connection.SourceID = insertedSource.SourceID
 
-	result = tx.Create(&source).Scan(&insertedSource)
+	result = tx.Select("pipeline_id", "connection_id", "source_id").Create(&connection).Scan(&insertedConnection)
 	if result.Error != nil {
 		err := tx.Rollback()
 		if err.Error != nil {
@@ -341,14 +464,125 @@ func (p *PGStore) CreateConnectionAndSourceAgainstAPipeline(source models.Source
 	return insertedSource, insertedConnection, nil
 }
 
-func (p *PGStore) GetSourceAndDestinationAirbyteInfo(sourceId, destinationId string) (models.AirbyteSourceAndDestinations, error) {
+// GetSourceAndDestinationAirbyteInfo returns the airbyte information of the source, the destination and the
+// connection of the source which is not created on airbyte yet, narrowed to the pipeline when one is given. The
+// connection reserved for the destination is picked before one which isn't reserved for any destination.
+func (p *PGStore) GetSourceAndDestinationAirbyteInfo(sourceId, destinationId, pipelineID string) (models.AirbyteSourceAndDestinations, error) {
+	return sourceAndDestinationAirbyteInfo(p.db, sourceId, destinationId, pipelineID)
+}
+
+// AddPipelineDestinations reserves a connection of the source for every destination the pipeline fans out to, the
+// first destination takes the connection the source was attached with and the others get a connection of their own.
+func (p *PGStore) AddPipelineDestinations(sourceID, pipelineID string, destinationIDs []string) ([]models.AirbyteSourceAndDestinations, error) {
+	connections := make([]models.AirbyteSourceAndDestinations, 0, len(destinationIDs))
+
+	err := p.db.Transaction(func(tx *gorm.DB) error {
+		for _, destinationID := range destinationIDs {
+			connectionInfo, err := sourceAndDestinationAirbyteInfo(tx, sourceID, destinationID, pipelineID)
+			if errors.Is(err, gorm.ErrRecordNotFound) && pipelineID != "" {
+				connection := models.Connection{PipelineID: pipelineID, SourceID: sourceID}
+
+				result := tx.Select("pipeline_id", "source_id").Create(&connection)
+				if result.Error != nil {
+					return result.Error
+				}
+
+				connectionInfo, err = sourceAndDestinationAirbyteInfo(tx, sourceID, destinationID, pipelineID)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			pipelineID = connectionInfo.PipelineID
+
+			var connected int64
+
+			result := tx.Table("connections").
+				Joins("join connections_destinations on connections.connection_id = connections_destinations.connection_id").
+				Where("connections.pipeline_id = ?", pipelineID).
+				Where("connections_destinations.destination_id = ?", destinationID).
+				Where("connections.airbyte_connection_id IS NOT NULL").
+				Count(&connected)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if connected > 0 {
+				return fmt.Errorf("destination %s is already a destination of the pipeline", destinationID)
+			}
+
+			connectionDestination := models.ConnectionsDestinations{
+				ConnectionID:  connectionInfo.ConnectionID,
+				DestinationID: destinationID,
+			}
+
+			result = tx.Where("connection_id = ? AND destination_id = ?", connectionInfo.ConnectionID, destinationID).
+				FirstOrCreate(&connectionDestination)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			connections = append(connections, connectionInfo)
+		}
+
+		return nil
+	})
+
+	return connections, err
+}
+
+// ReleasePipelineDestinations undoes AddPipelineDestinations for destinations whose airbyte connections were not all
+// created. The pipeline keeps one connection to its source so that it can be connected again.
+func (p *PGStore) ReleasePipelineDestinations(connections []models.AirbyteSourceAndDestinations) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		for _, connection := range connections {
+			result := tx.Where("connection_id = ? AND destination_id = ?", connection.ConnectionID, connection.DestinationID).
+				Delete(&models.ConnectionsDestinations{})
+			if result.Error != nil {
+				return result.Error
+			}
+
+			var remaining int64
+
+			result = tx.Model(&models.Connection{}).
+				Where("pipeline_id = ?", connection.PipelineID).
+				Where("connection_id <> ?", connection.ConnectionID).
+				Count(&remaining)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if remaining > 0 {
+				result = tx.Where("connection_id = ?", connection.ConnectionID).Delete(&models.Connection{})
+			} else {
+				result = tx.Model(&models.Connection{}).
+					Where("connection_id = ?", connection.ConnectionID).
+					Updates(map[string]interface{}{
+						"airbyte_connection_id":   nil,
+						"airbyte_status":          nil,
+						"airbyte_frequency_units": nil,
+						"airbyte_time_unit":       nil,
+					})
+			}
+
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+}
+
+func sourceAndDestinationAirbyteInfo(tx *gorm.DB, sourceId, destinationId, pipelineID string) (models.AirbyteSourceAndDestinations, error) {
 	var (
 		sourceAndDestination models.AirbyteSourceAndDestinations
 		destination          models.Destination
 		source               models.SourceAndPipelineName
 	)
 
-	result := p.db.Select("airbyte_destination_id", "destination_id", "destination_type", "configuration_details").
+	result := tx.Select("airbyte_destination_id", "destination_id", "destination_type", "configuration_details").
 		Where("destination_id = ?", destinationId).
 		First(&destination)
 	if result.Error != nil {
@@ -361,15 +595,26 @@ func (p *PGStore) GetSourceAndDestinationAirbyteInfo(sourceId, destinationId str
 		return sourceAndDestination, err
 	}
 
-	result = p.db.Table("sources").
+	query := tx.Table("sources").
 		Select("sources.airbyte_source_id, "+
-			"sources.connection_id, "+
+			"connections.connection_id, "+
 			"sources.source_id, "+
 			"pipelines.name AS pipeline_name, "+
 			"pipelines.pipeline_id AS pipeline_id ").
-		Joins("join connections on sources.connection_id = connections.connection_id").
+		Joins("join connections on sources.source_id = connections.source_id").
 		Joins("join pipelines on connections.pipeline_id = pipelines.pipeline_id").
-		Where("source_id = ?", sourceId).
+		Joins("LEFT join connections_destinations on connections.connection_id = connections_destinations.connection_id").
+		Where("sources.source_id = ?", sourceId).
+		Where("connections.airbyte_connection_id IS NULL").
+		Where("connections_destinations.destination_id IS NULL OR connections_destinations.destination_id = ?", destinationId).
+		Where("pipelines.deleted_at IS NULL")
+
+	if pipelineID != "" {
+		query = query.Where("pipelines.pipeline_id = ?", pipelineID)
+	}
+
+	result = query.Order("connections_destinations.destination_id IS NULL").
+		Order("connections.created_at").
 		First(&source)
 	if result.Error != nil {
 		return sourceAndDestination, result.Error
@@ -414,7 +659,9 @@ func (p *PGStore) UpdateConnectionInfo(connection models.Connection, destination
 		DestinationID: destinationId,
 	}
 
-	result = tx.Create(&connectionDestination)
+	// the connection is already linked when it was reserved for the destination of a fanned out pipeline
+	result = tx.Where("connection_id = ? AND destination_id = ?", connection.ConnectionID, destinationId).
+		FirstOrCreate(&connectionDestination)
 	if result.Error != nil {
 		err := tx.Rollback()
 		if err.Error != nil {
@@ -442,23 +689,13 @@ func (p *PGStore) CreateDestination(destination models.Destination) (models.Dest
 	return insertedDestination, nil
 }
 
-func (p *PGStore) GetSupportedDestinations() ([]models.SupportedDestinations, error) {
-	destinations := make([]models.SupportedDestinations, 0)
-
-	result := p.db.Find(&destinations)
-	if result.Error != nil {
-		return destinations, result.Error
-	}
-
-	return destinations, result.Error
-}
-
 func (p *PGStore) GetConfiguredDestination(workspaceId int) ([]models.ConfiguredDestination, error) {
 	configuredDestinations := make([]models.ConfiguredDestination, 0)
 
 	result := p.db.Table("destinations").
 		Select("destination_id,name,destination_type,airbyte_destination_id,configuration_details::json->>'host' as host").
 		Where("destinations.workspace_id = ?", workspaceId).
+		Where("destinations.deleted_at IS NULL").
 		Order("destinations.created_at DESC").
 		Scan(&configuredDestinations)
 
@@ -473,6 +710,7 @@ func (p *PGStore) GetDestinationSummary(destinationID uuid.UUID) (models.Destina
 			"owner,"+
 			"created_at").
 		Where("destinations.destination_id = ?", destinationID).
+		Where("destinations.deleted_at IS NULL").
 		First(&destinationSummary)
 
 	return destinationSummary, result.Error
@@ -486,11 +724,19 @@ func (p *PGStore) CreatePipelineSchema(pipelineSchema models.PipelineSchemas) (m
 	return createdPipelineSchema, result.Error
 }
 
-func (p *PGStore) CreatePipelineAssets(pipelineAssets []models.PipelineAssets) error {
-	return db.Transaction(func(tx *gorm.DB) error {
+func (p *PGStore) CreatePipelineAssets(pipelineAssets []models.PipelineAssets) ([]models.PipelineAssets, error) {
+	createdAssets := make([]models.PipelineAssets, 0, len(pipelineAssets))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
 		pipelineID, _ := uuid.FromString(pipelineAssets[0].PipelineID)
 
-		result := p.db.Where("pipeline_id = ? ", pipelineID).Delete(&models.PipelineAssets{})
+		// the assets synced to a destination replace the previous assets of that destination only
+		query := p.db.Where("pipeline_id = ? ", pipelineID)
+		if destinationID := pipelineAssets[0].DestinationID; destinationID != nil {
+			query = query.Where("destination_id = ?", *destinationID)
+		}
+
+		result := query.Delete(&models.PipelineAssets{})
 		if result.Error != nil {
 			return result.Error
 		}
@@ -501,10 +747,14 @@ func (p *PGStore) CreatePipelineAssets(pipelineAssets []models.PipelineAssets) e
 			if result.Error != nil {
 				return result.Error
 			}
+
+			createdAssets = append(createdAssets, pipelineAsset)
 		}
 
 		return nil
 	})
+
+	return createdAssets, err
 }
 
 func (p *PGStore) DeletePipelineSchema(schemaID uuid.UUID) error {
@@ -530,6 +780,8 @@ func (p *PGStore) EnablePipelineAssets(connectionIDs []string) error {
 
 	result := p.db.Table("pipeline_assets").
 		Where("pipeline_assets.pipeline_id IN ?", pipelineIDs).
+		Where("pipeline_assets.destination_id IS NULL OR pipeline_assets.destination_id IN (?)",
+			p.db.Table("connections_destinations").Select("destination_id").Where("connection_id IN ?", connectionIDs)).
 		Update("is_enabled", true)
 
 	if result.RowsAffected == 0 {
@@ -548,6 +800,23 @@ func (p *PGStore) GetDestination(destinationID uuid.UUID) (models.Destination, e
 	return destination, result.Error
 }
 
+func (p *PGStore) UpdateDestination(destination models.Destination) (models.Destination, error) {
+	updatedDestination := models.Destination{}
+
+	result := p.db.Model(&models.Destination{}).
+		Where("destination_id = ?", destination.DestinationID).
+		Updates(models.Destination{
+			DestinationName:      destination.DestinationName,
+			ConfigurationDetails: destination.ConfigurationDetails,
+		}).
+		Scan(&updatedDestination)
+	if result.RowsAffected == 0 && result.Error == nil {
+		return updatedDestination, errors.New("Destination doesn't exists")
+	}
+
+	return updatedDestination, result.Error
+}
+
 func (p *PGStore) CreateTransformationPipeline(transformationPipeline models.TransformationPipelines) (models.TransformationPipelines, error) {
 	createdTransformationPipeline := models.TransformationPipelines{}
 
@@ -579,3 +848,21 @@ func (p *PGStore) GetTransformationPipeline(productID uuid.UUID) (models.Transfo
 
 	return transformationPipeline, result.Error
 }
+
+func (p *PGStore) CreatePipelineOperation(operation models.PipelineOperation) (models.PipelineOperation, error) {
+	createdOperation := models.PipelineOperation{}
+
+	result := p.db.Create(&operation).Scan(&createdOperation)
+
+	return createdOperation, result.Error
+}
+
+func (p *PGStore) GetPipelineOperations(pipelineID uuid.UUID) ([]models.PipelineOperation, error) {
+	operations := make([]models.PipelineOperation, 0)
+
+	result := p.db.Where("pipeline_id = ?", pipelineID).
+		Order("created_at DESC").
+		Find(&operations)
+
+	return operations, result.Error
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"pipelineService/models/v1"
+)
+
+func (p *PGStore) CreateMaskingPolicy(policy models.MaskingPolicy) (models.MaskingPolicy, error) {
+	createdPolicy := models.MaskingPolicy{}
+
+	result := p.db.Create(&policy).Scan(&createdPolicy)
+
+	return createdPolicy, result.Error
+}
+
+func (p *PGStore) GetMaskingPolicies(workspaceID int) ([]models.MaskingPolicy, error) {
+	var policies []models.MaskingPolicy
+
+	result := p.db.Where("workspace_id = ?", workspaceID).Order("created_at").Find(&policies)
+
+	return policies, result.Error
+}
+
+func (p *PGStore) DeleteMaskingPolicy(policyID uuid.UUID, workspaceID int) error {
+	result := p.db.Where("policy_id = ? AND workspace_id = ?", policyID, workspaceID).Delete(&models.MaskingPolicy{})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Masking policy doesn't exists")
+	}
+
+	return result.Error
+}
+
+func (p *PGStore) CreateRowFilter(filter models.RowFilter) (models.RowFilter, error) {
+	createdFilter := models.RowFilter{}
+
+	result := p.db.Create(&filter).Scan(&createdFilter)
+
+	return createdFilter, result.Error
+}
+
+func (p *PGStore) GetRowFilters(productID uuid.UUID) ([]models.RowFilter, error) {
+	var filters []models.RowFilter
+
+	result := p.db.Where("product_id = ?", productID).Order("created_at").Find(&filters)
+
+	return filters, result.Error
+}
+
+func (p *PGStore) DeleteRowFilter(filterID uuid.UUID, workspaceID int) error {
+	result := p.db.Where("filter_id = ? AND workspace_id = ?", filterID, workspaceID).Delete(&models.RowFilter{})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Row filter doesn't exists")
+	}
+
+	return result.Error
+}
+
+// GetAssetRowFilters returns the filters of the data products the asset belongs to, either as a transformed asset of
+// the product or as a raw asset of one of its pipelines.
+func (p *PGStore) GetAssetRowFilters(assetID uuid.UUID) ([]models.RowFilter, error) {
+	var filters []models.RowFilter
+
+	result := p.db.
+		Where("product_id IN (?) OR product_id IN (?)",
+			p.db.Table("product_assets").Select("product_id").Where("asset_id = ?", assetID),
+			p.db.Table("products_pipelines").Select("products_pipelines.product_id").
+				Joins("join pipeline_assets on pipeline_assets.pipeline_id = products_pipelines.pipeline_id").
+				Where("pipeline_assets.asset_id = ?", assetID)).
+		Order("created_at").
+		Find(&filters)
+
+	return filters, result.Error
+}
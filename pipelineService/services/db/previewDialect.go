@@ -0,0 +1,163 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"pipelineService/utils"
+)
+
+// previewDialect holds the SQL of a preview that differs between the destinations, the statement is built with ?
+// parameters and bound to the placeholders of the driver last.
+type previewDialect struct {
+	quote func(identifier string) string
+	// rawColumn reads a key of the JSON data column of a raw table, cast to the catalog type of the column
+	rawColumn func(data string, key string, catalogType string) string
+	// cursor returns the parameter the order column is compared with, typed as the cursor
+	cursor func(cursorType string) string
+	// orderBy returns the ORDER BY terms of the column, the nulls are sorted last
+	orderBy func(column string, direction string) string
+	// page returns the clause limiting the rows and its parameters
+	page func(ordered bool, limit int, offset int) (string, []interface{})
+	bind func(sql string) string
+}
+
+// previewDialects are keyed by the type of the destinations.
+var previewDialects = map[string]previewDialect{
+	utils.DESTINATION_TYPE_POSTGRES:   postgresDialect,
+	utils.DESTINATION_TYPE_MYSQL:      mysqlDialect,
+	utils.DESTINATION_TYPE_CLICKHOUSE: clickhouseDialect,
+	utils.DESTINATION_TYPE_MSSQL:      mssqlDialect,
+}
+
+var postgresDialect = previewDialect{
+	quote: pq.QuoteIdentifier,
+	rawColumn: func(data string, key string, catalogType string) string {
+		expression := fmt.Sprintf("(%s->>%s)", data, pq.QuoteLiteral(key))
+		if cast, ok := rawCasts[catalogType]; ok {
+			expression = fmt.Sprintf("%s::%s", expression, cast)
+		}
+
+		return expression
+	},
+	cursor: func(cursorType string) string {
+		return fmt.Sprintf("CAST(? AS %s)", cursorType)
+	},
+	orderBy: nullsLast,
+	page:    limitOffset,
+	bind:    func(sql string) string { return sql },
+}
+
+var mysqlDialect = previewDialect{
+	quote: func(identifier string) string {
+		return "`" + strings.ReplaceAll(identifier, "`", "``") + "`"
+	},
+	rawColumn: func(data string, key string, catalogType string) string {
+		expression := fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%s, %s))", data, backslashLiteral(jsonPath(key)))
+		if rawCastOf(catalogType) == "numeric" {
+			expression = fmt.Sprintf("CAST(%s AS DECIMAL(65, 30))", expression)
+		}
+
+		return expression
+	},
+	cursor: untypedCursor,
+	// mysql sorts the nulls first in ascending order
+	orderBy: func(column string, direction string) string {
+		return fmt.Sprintf("%s IS NULL, %s %s", column, column, direction)
+	},
+	page: limitOffset,
+	bind: func(sql string) string { return sql },
+}
+
+var clickhouseDialect = previewDialect{
+	quote: func(identifier string) string {
+		return "`" + strings.ReplaceAll(strings.ReplaceAll(identifier, `\`, `\\`), "`", "\\`") + "`"
+	},
+	// the data column is a String, the missing keys are read as null instead of the default of the type
+	rawColumn: func(data string, key string, catalogType string) string {
+		switch rawCastOf(catalogType) {
+		case "numeric":
+			return fmt.Sprintf("JSONExtract(%s, %s, 'Nullable(Float64)')", data, backslashLiteral(key))
+		case "boolean":
+			return fmt.Sprintf("JSONExtractRaw(%s, %s)", data, backslashLiteral(key))
+		default:
+			return fmt.Sprintf("JSONExtract(%s, %s, 'Nullable(String)')", data, backslashLiteral(key))
+		}
+	},
+	cursor:  untypedCursor,
+	orderBy: nullsLast,
+	page:    limitOffset,
+	bind:    func(sql string) string { return sql },
+}
+
+var mssqlDialect = previewDialect{
+	quote: func(identifier string) string {
+		return "[" + strings.ReplaceAll(identifier, "]", "]]") + "]"
+	},
+	rawColumn: func(data string, key string, catalogType string) string {
+		expression := fmt.Sprintf("JSON_VALUE(%s, N'%s')", data, strings.ReplaceAll(jsonPath(key), "'", "''"))
+		if rawCastOf(catalogType) == "numeric" {
+			expression = fmt.Sprintf("TRY_CAST(%s AS DECIMAL(38, 10))", expression)
+		}
+
+		return expression
+	},
+	cursor: untypedCursor,
+	// sql server sorts the nulls first in ascending order
+	orderBy: func(column string, direction string) string {
+		return fmt.Sprintf("CASE WHEN %s IS NULL THEN 1 ELSE 0 END, %s %s", column, column, direction)
+	},
+	// OFFSET FETCH requires an ORDER BY
+	page: func(ordered bool, limit int, offset int) (string, []interface{}) {
+		page := " OFFSET ? ROWS FETCH NEXT ? ROWS ONLY"
+		if !ordered {
+			page = " ORDER BY (SELECT NULL)" + page
+		}
+
+		return page, []interface{}{offset, limit}
+	},
+	// the sqlserver driver takes @p1 to @pN parameters, the identifiers and literals of a preview never hold a ?
+	bind: func(sql string) string {
+		var bound strings.Builder
+
+		parameter := 0
+
+		for _, char := range sql {
+			if char != '?' {
+				bound.WriteRune(char)
+
+				continue
+			}
+
+			parameter++
+			bound.WriteString(fmt.Sprintf("@p%d", parameter))
+		}
+
+		return bound.String()
+	},
+}
+
+func nullsLast(column string, direction string) string {
+	return fmt.Sprintf("%s %s NULLS LAST", column, direction)
+}
+
+func limitOffset(_ bool, limit int, offset int) (string, []interface{}) {
+	return " LIMIT ? OFFSET ?", []interface{}{limit, offset}
+}
+
+// untypedCursor compares the order column with the text of the cursor, the database converts it to the type of the
+// column.
+func untypedCursor(_ string) string {
+	return "?"
+}
+
+// jsonPath returns the path of a key of a JSON object, the key is quoted so it may hold any character.
+func jsonPath(key string) string {
+	return fmt.Sprintf(`$."%s"`, strings.ReplaceAll(strings.ReplaceAll(key, `\`, `\\`), `"`, `\"`))
+}
+
+// backslashLiteral quotes a string literal for the databases treating the backslash as an escape character.
+func backslashLiteral(value string) string {
+	return "'" + strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), "'", `\'`) + "'"
+}
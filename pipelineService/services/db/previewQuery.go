@@ -0,0 +1,254 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// previewOperators maps the comparison operators of the filters to SQL, the operators without a value and the list
+// operators are compiled separately.
+var previewOperators = map[string]string{
+	utils.ROW_FILTER_EQ:  "=",
+	utils.ROW_FILTER_NEQ: "<>",
+	utils.ROW_FILTER_GT:  ">",
+	utils.ROW_FILTER_GTE: ">=",
+	utils.ROW_FILTER_LT:  "<",
+	utils.ROW_FILTER_LTE: "<=",
+}
+
+// rawCasts maps the catalog types of raw columns to the type their text value is cast to before comparing.
+var rawCasts = map[string]string{
+	"integer": "numeric",
+	"number":  "numeric",
+	"boolean": "boolean",
+}
+
+type previewStatement struct {
+	sql       string
+	vars      []interface{}
+	query     models.PreviewQuery
+	raw       bool
+	orderType string
+	dialect   previewDialect
+}
+
+// compilePreviewQuery builds the parameterized SELECT of a preview. Identifiers are always quoted and the values of
+// the filters and of the cursor are always passed as parameters.
+func compilePreviewQuery(dialect previewDialect, schema string, table string, query models.PreviewQuery) (previewStatement, error) {
+	statement := previewStatement{
+		query:   query,
+		raw:     strings.HasPrefix(table, utils.AIRBYTE_DEFAULT_PREFIX),
+		dialect: dialect,
+	}
+
+	identifiers := append([]string{schema, table, query.OrderBy}, query.Columns...)
+	for _, filter := range query.Filters {
+		identifiers = append(identifiers, filter.Column)
+	}
+
+	if err := checkIdentifiers(identifiers...); err != nil {
+		return statement, err
+	}
+
+	selected := "*"
+
+	if len(query.Columns) > 0 {
+		columns := make([]string, 0, len(query.Columns)+1)
+		hasOrder := query.OrderBy == ""
+
+		for _, column := range query.Columns {
+			columns = append(columns, statement.selectColumn(column))
+			hasOrder = hasOrder || column == query.OrderBy
+		}
+
+		// the order column is needed to build the cursor of the next page
+		if !hasOrder {
+			columns = append(columns, statement.selectColumn(query.OrderBy))
+		}
+
+		selected = strings.Join(columns, ", ")
+	}
+
+	sql := fmt.Sprintf("SELECT %s FROM %s.%s", selected, dialect.quote(schema), dialect.quote(table))
+
+	conditions := make([]string, 0, len(query.Filters)+1)
+
+	for _, filter := range query.Filters {
+		condition, vars, err := statement.filterCondition(filter)
+		if err != nil {
+			return statement, err
+		}
+
+		conditions = append(conditions, condition)
+		statement.vars = append(statement.vars, vars...)
+	}
+
+	if query.OrderBy != "" {
+		if statement.raw {
+			statement.orderType = rawCastOf(query.RawTypes[query.OrderBy])
+		}
+
+		if query.After != nil {
+			operator := ">"
+			if query.Descending {
+				operator = "<"
+			}
+
+			conditions = append(conditions, fmt.Sprintf("%s %s %s", statement.column(query.OrderBy), operator, dialect.cursor(query.After.Type)))
+			statement.vars = append(statement.vars, query.After.Value)
+		}
+	}
+
+	if len(conditions) > 0 {
+		sql += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	if query.OrderBy != "" {
+		direction := "ASC"
+		if query.Descending {
+			direction = "DESC"
+		}
+
+		sql += " ORDER BY " + dialect.orderBy(statement.column(query.OrderBy), direction)
+	}
+
+	if statement.query.Limit <= 0 {
+		statement.query.Limit = utils.PREVIEW_DATA_LIMIT
+	}
+
+	page, vars := dialect.page(query.OrderBy != "", statement.query.Limit, query.Offset)
+	statement.vars = append(statement.vars, vars...)
+
+	statement.sql = dialect.bind(sql + page)
+
+	return statement, nil
+}
+
+// checkIdentifiers rejects the identifiers which can't be quoted safely, gorm replaces every ? of a statement with a
+// parameter including the ones in quoted identifiers.
+func checkIdentifiers(identifiers ...string) error {
+	for _, identifier := range identifiers {
+		if strings.ContainsAny(identifier, "?\x00") {
+			return fmt.Errorf("invalid column name %q", identifier)
+		}
+	}
+
+	return nil
+}
+
+func rawCastOf(columnType string) string {
+	if cast, ok := rawCasts[columnType]; ok {
+		return cast
+	}
+
+	return "text"
+}
+
+// column returns the expression of a column, the columns of raw tables are read from the JSON data column and cast
+// to the type of the catalog so they are compared and ordered by value.
+func (s previewStatement) column(name string) string {
+	if !s.raw {
+		return s.dialect.quote(name)
+	}
+
+	return s.dialect.rawColumn(s.dialect.quote(utils.AIRBYTE_DATA_COLUMN), name, s.query.RawTypes[name])
+}
+
+func (s previewStatement) selectColumn(name string) string {
+	if !s.raw {
+		return s.dialect.quote(name)
+	}
+
+	return fmt.Sprintf("%s AS %s", s.column(name), s.dialect.quote(name))
+}
+
+func (s previewStatement) filterCondition(filter models.PreviewFilter) (string, []interface{}, error) {
+	column := s.column(filter.Column)
+
+	switch filter.Operator {
+	case utils.ROW_FILTER_IS_NULL:
+		return fmt.Sprintf("%s IS NULL", column), nil, nil
+	case utils.ROW_FILTER_NOT_NULL:
+		return fmt.Sprintf("%s IS NOT NULL", column), nil, nil
+	}
+
+	if len(filter.Values) == 0 {
+		return "", nil, fmt.Errorf("filter on %s has no values", filter.Column)
+	}
+
+	// the lists are expanded here, the drivers other than gorm's don't expand a slice parameter
+	switch filter.Operator {
+	case utils.ROW_FILTER_IN, utils.ROW_FILTER_NOT_IN:
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(filter.Values)), ", ")
+		vars := make([]interface{}, 0, len(filter.Values))
+
+		for _, value := range filter.Values {
+			vars = append(vars, value)
+		}
+
+		if filter.Operator == utils.ROW_FILTER_NOT_IN {
+			return fmt.Sprintf("%s NOT IN (%s)", column, placeholders), vars, nil
+		}
+
+		return fmt.Sprintf("%s IN (%s)", column, placeholders), vars, nil
+	}
+
+	operator, ok := previewOperators[filter.Operator]
+	if !ok {
+		return "", nil, fmt.Errorf("filter operator %s is not supported", filter.Operator)
+	}
+
+	return fmt.Sprintf("%s %s ?", column, operator), []interface{}{filter.Values[0]}, nil
+}
+
+// nextCursor returns the cursor of the page following a full page, the cursor holds the value of the order column
+// of the last row. Pages ordered by a column of a type that can't be compared are paged with the offset.
+func (s previewStatement) nextCursor(result models.PreviewResult) string {
+	if s.query.OrderBy == "" || len(result.Rows) == 0 || len(result.Rows) < s.query.Limit {
+		return ""
+	}
+
+	orderType := s.orderType
+
+	if !s.raw {
+		for _, column := range result.Columns {
+			if column.Name == s.query.OrderBy {
+				orderType = column.Type
+			}
+		}
+	}
+
+	if !utils.IsPreviewCursorType(orderType) {
+		return ""
+	}
+
+	row := result.Rows[len(result.Rows)-1]
+
+	value, ok := row[s.query.OrderBy]
+	if !ok && s.raw {
+		data, _ := utils.DecodeAirbyteData(row)
+		value, ok = data[s.query.OrderBy]
+	}
+
+	// rows with a null order value are sorted last, there's nothing to page after them
+	if !ok || value == nil {
+		return ""
+	}
+
+	var text string
+
+	switch typed := value.(type) {
+	case time.Time:
+		text = typed.Format(time.RFC3339Nano)
+	case []byte:
+		text = string(typed)
+	default:
+		text = fmt.Sprint(typed)
+	}
+
+	return utils.EncodePreviewCursor(models.PreviewCursor{Value: text, Type: orderType})
+}
@@ -0,0 +1,255 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// numericTypes are the catalog types of raw columns and the information schema types of transformed columns which
+// are profiled as numbers.
+var numericTypes = map[string]bool{
+	"integer": true, "number": true, "smallint": true, "bigint": true, "numeric": true, "decimal": true, "real": true,
+	"double precision": true,
+}
+
+// textTypes are the catalog and information schema types which are profiled by the length of their values.
+var textTypes = map[string]bool{
+	"string": true, "text": true, "character varying": true, "character": true,
+}
+
+type columnStatistics struct {
+	Nulls         int64
+	DistinctCount int64
+	Min           *float64
+	Max           *float64
+	Mean          *float64
+	MinLength     *int64
+	MaxLength     *int64
+	MeanLength    *float64
+	MedianLength  *float64
+	P95Length     *float64
+}
+
+// GetAssetTable returns the table of a raw or transformed asset and the connection to the destination holding it.
+func (p *PGStore) GetAssetTable(assetID uuid.UUID) (models.AssetTable, error) {
+	assetDetails, err := p.GetAssetDetails(assetID)
+	if err != nil {
+		return models.AssetTable{}, err
+	}
+
+	if assetDetails.Name != "" {
+		return models.AssetTable{
+			AssetID:   assetID.String(),
+			AssetName: assetDetails.Name,
+			Schema:    assetDetails.SchemaName,
+			Table:     fmt.Sprintf("%s_%s%s", utils.AIRBYTE_DEFAULT_PREFIX, assetDetails.Prefix, assetDetails.Name),
+			Host:      assetDetails.Host,
+			Port:      assetDetails.Port,
+			Database:  assetDetails.DbName,
+			UserName:  assetDetails.UserName,
+			Password:  assetDetails.Password,
+			Raw:       true,
+
+			DestinationID:   assetDetails.DestinationID,
+			DestinationType: assetDetails.DestinationType,
+			Configuration:   assetDetails.Configuration,
+		}, nil
+	}
+
+	var table models.AssetTable
+
+	result := p.db.Table("product_assets").
+		Select("product_assets.asset_id AS asset_id, "+
+			"product_assets.name AS asset_name, "+
+			"product_assets.name AS table_name, "+
+			"data_products.name AS schema_name, "+
+			"configuration_details::json->>'host' as host,"+
+			"configuration_details::json->>'port' as port,"+
+			"configuration_details::json->>'username' as username,"+
+			"configuration_details::json->>'password' as password,"+
+			"configuration_details::json->>'database' as database, "+
+			"destinations.destination_id AS destination_id, "+
+			"destinations.destination_type AS destination_type, "+
+			"destinations.configuration_details AS configuration_details").
+		Joins("join data_products on data_products.product_id = product_assets.product_id").
+		Joins("join transformation_pipelines on transformation_pipelines.product_id = product_assets.product_id").
+		Joins("join destinations on destinations.destination_id = transformation_pipelines.destination_id").
+		Where("product_assets.asset_id = ?", assetID).
+		Where("product_assets.is_enabled = ?", true).
+		Find(&table)
+
+	if result.Error == nil && table.AssetName == "" {
+		return table, gorm.ErrRecordNotFound
+	}
+
+	return table, result.Error
+}
+
+// ProfileTable computes the row count of the table and the statistics of the given columns on the rows matched by the
+// filters, every column is profiled with its own scans of the table.
+func (p *PGStore) ProfileTable(db *gorm.DB, table models.AssetTable, columns []models.AssetColumn, filters []models.PreviewFilter) (models.TableProfile, error) {
+	profile := models.TableProfile{Columns: make([]models.ColumnProfile, 0, len(columns))}
+
+	schema := strings.ToLower(table.Schema)
+
+	identifiers := []string{schema, table.Table}
+	for _, filter := range filters {
+		identifiers = append(identifiers, filter.Column)
+	}
+
+	if err := checkIdentifiers(identifiers...); err != nil {
+		return profile, err
+	}
+
+	tableName := fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(table.Table))
+
+	statement := previewStatement{
+		raw:     table.Raw,
+		query:   models.PreviewQuery{RawTypes: make(map[string]string, len(columns))},
+		dialect: postgresDialect,
+	}
+
+	for _, column := range columns {
+		statement.query.RawTypes[column.Name] = column.Type
+	}
+
+	// the filters are compiled as in the preview so the statistics cover the rows the caller can preview
+	conditions := make([]string, 0, len(filters))
+
+	for _, filter := range filters {
+		condition, vars, err := statement.filterCondition(filter)
+		if err != nil {
+			return profile, err
+		}
+
+		conditions = append(conditions, condition)
+		statement.vars = append(statement.vars, vars...)
+	}
+
+	err := db.Raw(fmt.Sprintf("SELECT count(*) FROM %s%s", tableName, whereClause(conditions)), statement.vars...).
+		Scan(&profile.RowCount).Error
+	if err != nil {
+		return profile, err
+	}
+
+	for _, column := range columns {
+		if err := checkIdentifiers(column.Name); err != nil {
+			return profile, err
+		}
+
+		columnProfile, err := profileColumn(db, tableName, statement, column, conditions, profile.RowCount)
+		if err != nil {
+			return profile, err
+		}
+
+		profile.Columns = append(profile.Columns, columnProfile)
+	}
+
+	return profile, nil
+}
+
+// whereClause joins the conditions of a statement, no clause is returned without conditions.
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+func profileColumn(db *gorm.DB, tableName string, statement previewStatement, column models.AssetColumn, conditions []string, rowCount int64) (models.ColumnProfile, error) {
+	columnProfile := models.ColumnProfile{Name: column.Name, Type: column.Type}
+
+	expression := statement.column(column.Name)
+
+	// the catalog types of raw columns may carry their format e.g. string(date-time)
+	columnType := strings.SplitN(column.Type, "(", 2)[0]
+
+	selects := []string{
+		fmt.Sprintf("count(*) - count(%s) AS nulls", expression),
+		fmt.Sprintf("count(DISTINCT (%s)::text) AS distinct_count", expression),
+	}
+
+	if numericTypes[columnType] {
+		selects = append(selects,
+			fmt.Sprintf("min(%s)::float8 AS min", expression),
+			fmt.Sprintf("max(%s)::float8 AS max", expression),
+			fmt.Sprintf("avg(%s)::float8 AS mean", expression))
+	}
+
+	if textTypes[columnType] {
+		length := fmt.Sprintf("length((%s)::text)", expression)
+
+		selects = append(selects,
+			fmt.Sprintf("min(%s) AS min_length", length),
+			fmt.Sprintf("max(%s) AS max_length", length),
+			fmt.Sprintf("avg(%s)::float8 AS mean_length", length),
+			fmt.Sprintf("percentile_cont(0.5) WITHIN GROUP (ORDER BY %s)::float8 AS median_length", length),
+			fmt.Sprintf("percentile_cont(0.95) WITHIN GROUP (ORDER BY %s)::float8 AS p95_length", length))
+	}
+
+	var statistics columnStatistics
+
+	err := db.Raw(fmt.Sprintf("SELECT %s FROM %s%s", strings.Join(selects, ", "), tableName, whereClause(conditions)),
+		statement.vars...).Scan(&statistics).Error
+	if err != nil {
+		return columnProfile, err
+	}
+
+	if rowCount > 0 {
+		columnProfile.NullFraction = float64(statistics.Nulls) / float64(rowCount)
+	}
+
+	columnProfile.DistinctCount = statistics.DistinctCount
+	columnProfile.Min, columnProfile.Max, columnProfile.Mean = statistics.Min, statistics.Max, statistics.Mean
+
+	if statistics.MinLength != nil {
+		columnProfile.Length = &models.LengthProfile{
+			Min:    *statistics.MinLength,
+			Max:    *statistics.MaxLength,
+			Mean:   *statistics.MeanLength,
+			Median: *statistics.MedianLength,
+			P95:    *statistics.P95Length,
+		}
+	}
+
+	columnProfile.TopValues = make([]models.ValueCount, 0, utils.PROFILE_TOP_VALUES)
+
+	valueConditions := append([]string{fmt.Sprintf("%s IS NOT NULL", expression)}, conditions...)
+	vars := append(append([]interface{}{}, statement.vars...), utils.PROFILE_TOP_VALUES)
+
+	err = db.Raw(fmt.Sprintf("SELECT (%s)::text AS value, count(*) AS count FROM %s%s GROUP BY 1 ORDER BY 2 DESC, 1 LIMIT ?",
+		expression, tableName, whereClause(valueConditions)), vars...).
+		Scan(&columnProfile.TopValues).Error
+
+	return columnProfile, err
+}
+
+func (p *PGStore) CreateAssetProfile(profile models.AssetProfile) (models.AssetProfile, error) {
+	createdProfile := models.AssetProfile{}
+
+	result := p.db.Create(&profile).Scan(&createdProfile)
+
+	return createdProfile, result.Error
+}
+
+// GetLatestAssetProfiles returns the latest profile of each of the assets which have been profiled.
+func (p *PGStore) GetLatestAssetProfiles(assetIDs []string) ([]models.AssetProfile, error) {
+	profiles := make([]models.AssetProfile, 0)
+
+	if len(assetIDs) == 0 {
+		return profiles, nil
+	}
+
+	result := p.db.Raw("SELECT DISTINCT ON (asset_id) * FROM asset_profiles WHERE asset_id IN ? "+
+		"ORDER BY asset_id, profiled_at DESC", assetIDs).
+		Scan(&profiles)
+
+	return profiles, result.Error
+}
@@ -0,0 +1,242 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+func (p *PGStore) CreateQualityRule(rule models.QualityRule) (models.QualityRule, error) {
+	createdRule := models.QualityRule{}
+
+	result := p.db.Create(&rule).Scan(&createdRule)
+
+	return createdRule, result.Error
+}
+
+// GetQualityRules returns the rules of the asset by its pipeline or product and its name, the rules created on the
+// assets of the previous syncs apply to the asset recreated by the latest one.
+func (p *PGStore) GetQualityRules(assetID uuid.UUID) ([]models.QualityRule, error) {
+	rules := make([]models.QualityRule, 0)
+
+	result := p.db.Where("(parent_id, asset_name) IN (?) OR (parent_id, asset_name) IN (?)",
+		p.db.Table("pipeline_assets").Select("pipeline_id, name").Where("asset_id = ?", assetID),
+		p.db.Table("product_assets").Select("product_id, name").Where("asset_id = ?", assetID)).
+		Order("created_at").
+		Find(&rules)
+
+	return rules, result.Error
+}
+
+// BackfillQualityRuleAssets keys the rules created before the rules were looked up by their asset identity with the
+// pipeline or product and the name of their asset. Rules already keyed are left untouched.
+func (p *PGStore) BackfillQualityRuleAssets() error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("UPDATE quality_rules SET parent_id = pipeline_assets.pipeline_id, " +
+			"asset_name = pipeline_assets.name FROM pipeline_assets " +
+			"WHERE pipeline_assets.asset_id = quality_rules.asset_id AND quality_rules.parent_id IS NULL")
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Exec("UPDATE quality_rules SET parent_id = product_assets.product_id, " +
+			"asset_name = product_assets.name FROM product_assets " +
+			"WHERE product_assets.asset_id = quality_rules.asset_id AND quality_rules.parent_id IS NULL")
+
+		return result.Error
+	})
+}
+
+func (p *PGStore) DeleteQualityRule(ruleID uuid.UUID, workspaceID int) error {
+	result := p.db.Where("rule_id = ? AND workspace_id = ?", ruleID, workspaceID).Delete(&models.QualityRule{})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Quality rule doesn't exists")
+	}
+
+	return result.Error
+}
+
+// CheckQualityRule measures the rule on the table of the asset with a single scan, the failed rows are counted with
+// the rows of the table.
+func (p *PGStore) CheckQualityRule(db *gorm.DB, table models.AssetTable, rule models.QualityRule,
+	columns []models.AssetColumn) (models.QualityMeasure, error) {
+	var measure models.QualityMeasure
+
+	schema := strings.ToLower(table.Schema)
+
+	if err := checkIdentifiers(schema, table.Table, rule.Column); err != nil {
+		return measure, err
+	}
+
+	statement := previewStatement{
+		raw:   table.Raw,
+		query: models.PreviewQuery{RawTypes: make(map[string]string, len(columns))},
+	}
+
+	for _, column := range columns {
+		statement.query.RawTypes[column.Name] = column.Type
+	}
+
+	expression := statement.column(rule.Column)
+
+	// the emission time of the rows is a column of the raw and of the normalized tables, not a field of their data
+	if rule.Column == "" || rule.Column == utils.AIRBYTE_EMITTED_AT_COLUMN {
+		expression = pq.QuoteIdentifier(utils.AIRBYTE_EMITTED_AT_COLUMN)
+	}
+
+	failed := "0"
+	latest := "NULL::float8"
+
+	var vars []interface{}
+
+	switch rule.Check {
+	case utils.QUALITY_CHECK_NOT_NULL:
+		failed = fmt.Sprintf("count(*) FILTER (WHERE %s IS NULL)", expression)
+	case utils.QUALITY_CHECK_UNIQUE:
+		failed = fmt.Sprintf("count(%s) - count(DISTINCT (%s)::text)", expression, expression)
+	case utils.QUALITY_CHECK_ACCEPTED_VALUES:
+		failed = fmt.Sprintf("count(*) FILTER (WHERE %s IS NOT NULL AND (%s)::text NOT IN ?)", expression, expression)
+		vars = append(vars, []string(rule.Values))
+	case utils.QUALITY_CHECK_RANGE:
+		conditions := make([]string, 0, 2)
+
+		if rule.Min != nil {
+			conditions = append(conditions, fmt.Sprintf("(%s)::numeric < ?", expression))
+			vars = append(vars, *rule.Min)
+		}
+
+		if rule.Max != nil {
+			conditions = append(conditions, fmt.Sprintf("(%s)::numeric > ?", expression))
+			vars = append(vars, *rule.Max)
+		}
+
+		if len(conditions) == 0 {
+			return measure, fmt.Errorf("range check on %s has no bounds", rule.Column)
+		}
+
+		failed = fmt.Sprintf("count(*) FILTER (WHERE %s)", strings.Join(conditions, " OR "))
+	case utils.QUALITY_CHECK_REGEX:
+		failed = fmt.Sprintf("count(*) FILTER (WHERE %s IS NOT NULL AND (%s)::text !~ ?)", expression, expression)
+		vars = append(vars, rule.Pattern)
+	case utils.QUALITY_CHECK_FRESHNESS:
+		latest = fmt.Sprintf("extract(epoch FROM max((%s)::timestamptz))::float8 * 1000", expression)
+	case utils.QUALITY_CHECK_ROW_COUNT_DELTA:
+	default:
+		return measure, fmt.Errorf("quality check %s is not supported", rule.Check)
+	}
+
+	sql := fmt.Sprintf("SELECT count(*) AS row_count, %s AS failed_rows, %s AS latest FROM %s.%s",
+		failed, latest, pq.QuoteIdentifier(schema), pq.QuoteIdentifier(table.Table))
+
+	err := db.Raw(sql, vars...).Scan(&measure).Error
+
+	return measure, err
+}
+
+func (p *PGStore) SaveQualityResults(results []models.QualityCheckResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	return p.db.Create(&results).Error
+}
+
+// GetLastQualityResult returns the latest result of the rule which was measured, gorm.ErrRecordNotFound is returned
+// when the rule has never been measured.
+func (p *PGStore) GetLastQualityResult(ruleID uuid.UUID) (models.QualityCheckResult, error) {
+	var result models.QualityCheckResult
+
+	err := p.db.Where("rule_id = ? AND error = ''", ruleID).Order("checked_at DESC").First(&result).Error
+
+	return result, err
+}
+
+func (p *PGStore) GetQualityResults(workspaceID int, filter models.QualityResultFilter) ([]models.QualityCheckResult, error) {
+	results := make([]models.QualityCheckResult, 0)
+
+	query := p.db.Where("workspace_id = ? AND asset_id = ?", workspaceID, filter.AssetID)
+
+	if filter.RuleID != "" {
+		query = query.Where("rule_id = ?", filter.RuleID)
+	}
+
+	if filter.RunID != "" {
+		query = query.Where("run_id = ?", filter.RunID)
+	}
+
+	result := query.Order("checked_at DESC").Limit(utils.QUALITY_RESULTS_LIMIT).Find(&results)
+
+	return results, result.Error
+}
+
+// GetPipelineQualityResults returns the results of the latest run of each asset of the pipeline.
+func (p *PGStore) GetPipelineQualityResults(pipelineID uuid.UUID) ([]models.QualityCheckResult, error) {
+	return p.getLatestQualityResults(
+		p.db.Table("pipeline_assets").Select("asset_id").Where("pipeline_id = ?", pipelineID))
+}
+
+// GetProductQualityResults returns the results of the latest run of each asset of the data product, either a
+// transformed asset of the product or a raw asset of one of its pipelines.
+func (p *PGStore) GetProductQualityResults(productID uuid.UUID) ([]models.QualityCheckResult, error) {
+	return p.getLatestQualityResults(
+		p.db.Table("product_assets").Select("asset_id").Where("product_id = ?", productID),
+		p.db.Table("pipeline_assets").Select("pipeline_assets.asset_id").
+			Joins("join products_pipelines on products_pipelines.pipeline_id = pipeline_assets.pipeline_id").
+			Where("products_pipelines.product_id = ?", productID))
+}
+
+func (p *PGStore) getLatestQualityResults(assets ...*gorm.DB) ([]models.QualityCheckResult, error) {
+	results := make([]models.QualityCheckResult, 0)
+
+	latestRuns := p.db.Table("quality_check_results").
+		Select("DISTINCT ON (asset_id) run_id").
+		Order("asset_id, checked_at DESC")
+
+	conditions := make([]string, 0, len(assets))
+	vars := make([]interface{}, 0, len(assets))
+
+	for _, assetIDs := range assets {
+		conditions = append(conditions, "asset_id IN (?)")
+		vars = append(vars, assetIDs)
+	}
+
+	latestRuns = latestRuns.Where(strings.Join(conditions, " OR "), vars...)
+
+	result := p.db.Where("run_id IN (?)", latestRuns).
+		Where(strings.Join(conditions, " OR "), vars...).
+		Order("asset_id, checked_at").
+		Find(&results)
+
+	return results, result.Error
+}
+
+// GetAssetProductIDs returns the data products the asset belongs to, either as a transformed asset of the product
+// or as a raw asset of one of its pipelines.
+func (p *PGStore) GetAssetProductIDs(assetID uuid.UUID) ([]string, error) {
+	productIDs := make([]string, 0)
+
+	result := p.db.Raw("SELECT product_id FROM product_assets WHERE asset_id = ? "+
+		"UNION SELECT products_pipelines.product_id FROM products_pipelines "+
+		"JOIN pipeline_assets ON pipeline_assets.pipeline_id = products_pipelines.pipeline_id "+
+		"WHERE pipeline_assets.asset_id = ?", assetID, assetID).
+		Scan(&productIDs)
+
+	return productIDs, result.Error
+}
+
+func (p *PGStore) SetDataProductsStatus(productIDs []string, status string) error {
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	return p.db.Model(&models.DataProduct{}).
+		Where("product_id IN ?", productIDs).
+		Update("data_product_status", status).Error
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// GetDriftCheckConnections returns the connections of the live pipelines which are created on airbyte.
+func (p *PGStore) GetDriftCheckConnections() ([]models.DriftCheckConnection, error) {
+	connections := make([]models.DriftCheckConnection, 0)
+
+	result := p.db.Table("connections").
+		Select("connections.connection_id, connections.pipeline_id, connections.airbyte_connection_id, " +
+			"sources.airbyte_source_id, pipelines.workspace_id").
+		Joins("join pipelines on connections.pipeline_id = pipelines.pipeline_id").
+		Joins("join sources on connections.source_id = sources.source_id").
+		Where("connections.airbyte_connection_id IS NOT NULL").
+		Where("pipelines.deleted_at IS NULL").
+		Where("sources.deleted_at IS NULL").
+		Find(&connections)
+
+	return connections, result.Error
+}
+
+// SaveSchemaChange keeps a single pending change per connection, a newly detected drift replaces the
+// pending one instead of piling up changes which are already outdated.
+func (p *PGStore) SaveSchemaChange(schemaChange models.SchemaChange) (models.SchemaChange, error) {
+	savedSchemaChange := models.SchemaChange{}
+
+	err := p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("connection_id = ?", schemaChange.ConnectionID).
+			Where("status = ?", utils.SCHEMA_CHANGE_STATUS_PENDING).
+			First(&savedSchemaChange)
+		if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return result.Error
+		}
+
+		if result.Error == nil {
+			return tx.Model(&savedSchemaChange).
+				Updates(models.SchemaChange{
+					Changes:         schemaChange.Changes,
+					ProposedCatalog: schemaChange.ProposedCatalog,
+					Fingerprint:     schemaChange.Fingerprint,
+				}).Error
+		}
+
+		schemaChange.Status = utils.SCHEMA_CHANGE_STATUS_PENDING
+
+		return tx.Create(&schemaChange).Scan(&savedSchemaChange).Error
+	})
+
+	return savedSchemaChange, err
+}
+
+// IsSchemaChangeRejected reports whether a change with the fingerprint was rejected on the connection.
+func (p *PGStore) IsSchemaChangeRejected(connectionID string, fingerprint string) (bool, error) {
+	var count int64
+
+	result := p.db.Model(&models.SchemaChange{}).
+		Where("connection_id = ?", connectionID).
+		Where("fingerprint = ?", fingerprint).
+		Where("status = ?", utils.SCHEMA_CHANGE_STATUS_REJECTED).
+		Count(&count)
+
+	return count > 0, result.Error
+}
+
+func (p *PGStore) GetSchemaChanges(workspaceID int, filter models.SchemaChangeFilter) ([]models.SchemaChange, error) {
+	schemaChanges := make([]models.SchemaChange, 0)
+
+	query := p.db.Where("workspace_id = ?", workspaceID)
+
+	if filter.PipelineID != "" {
+		query = query.Where("pipeline_id = ?", filter.PipelineID)
+	}
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	result := query.Order("created_at DESC").Find(&schemaChanges)
+
+	return schemaChanges, result.Error
+}
+
+func (p *PGStore) GetSchemaChange(changeID uuid.UUID) (models.SchemaChange, error) {
+	schemaChange := models.SchemaChange{}
+
+	result := p.db.Where("change_id = ?", changeID).First(&schemaChange)
+
+	return schemaChange, result.Error
+}
+
+// ReviewSchemaChange records the decision on a pending change, changes which are already reviewed are left untouched.
+// The same drift is raised on the connection of every destination of the pipeline, their pending changes with the same
+// fingerprint get the decision as well.
+func (p *PGStore) ReviewSchemaChange(schemaChange models.SchemaChange) error {
+	// the conditions are grouped so the pending status applies to every change they match
+	query := p.db.Where("change_id = ?", schemaChange.ChangeID)
+
+	if schemaChange.Fingerprint != "" {
+		query = query.Or("pipeline_id = ? AND fingerprint = ?", schemaChange.PipelineID, schemaChange.Fingerprint)
+	}
+
+	result := p.db.Model(&models.SchemaChange{}).
+		Where(query).
+		Where("status = ?", utils.SCHEMA_CHANGE_STATUS_PENDING).
+		Updates(models.SchemaChange{
+			Status:     schemaChange.Status,
+			ReviewedBy: schemaChange.ReviewedBy,
+			ReviewedAt: schemaChange.ReviewedAt,
+		})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Pending schema change doesn't exists")
+	}
+
+	return result.Error
+}
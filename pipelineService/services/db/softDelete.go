@@ -0,0 +1,247 @@
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+)
+
+func (p *PGStore) RestorePipeline(pipelineID uuid.UUID) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&models.Pipeline{}).
+			Where("pipeline_id = ?", pipelineID).
+			Where("deleted_at IS NOT NULL").
+			Update("deleted_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errors.New("Deleted pipeline doesn't exists")
+		}
+
+		return nil
+	})
+}
+
+// PurgePipeline hard deletes the pipeline along with the sources only it was reading from, the deletion workflow
+// deleted the airbyte sources which aren't shared with another pipeline.
+func (p *PGStore) PurgePipeline(pipelineID uuid.UUID) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var sourceIDs []string
+
+		result := tx.Table("connections").
+			Where("connections.pipeline_id = ?", pipelineID).
+			Where("connections.source_id IS NOT NULL").
+			Where("NOT EXISTS (SELECT 1 FROM connections AS shared WHERE shared.source_id = connections.source_id "+
+				"AND shared.pipeline_id <> connections.pipeline_id)").
+			Distinct().
+			Pluck("connections.source_id", &sourceIDs)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Unscoped().Where("pipeline_id = ?", pipelineID).Delete(&models.Pipeline{})
+		if result.Error != nil || len(sourceIDs) == 0 {
+			return result.Error
+		}
+
+		result = tx.Unscoped().Where("source_id IN ?", sourceIDs).Delete(&models.Source{})
+
+		return result.Error
+	})
+}
+
+// GetPipelineIncludingDeleted returns the pipeline whether it is soft deleted or not.
+func (p *PGStore) GetPipelineIncludingDeleted(pipelineID uuid.UUID) (models.Pipeline, error) {
+	var pipeline models.Pipeline
+
+	result := p.db.Unscoped().Where("pipeline_id = ?", pipelineID).First(&pipeline)
+
+	return pipeline, result.Error
+}
+
+// GetPipelineDeletedDestinations returns the soft deleted destinations the connections of the pipeline are writing to.
+func (p *PGStore) GetPipelineDeletedDestinations(pipelineID uuid.UUID) ([]models.ResourceDependency, error) {
+	destinations := make([]models.ResourceDependency, 0)
+
+	result := p.db.Table("connections_destinations").
+		Select("DISTINCT 'destination' AS resource_type, destinations.destination_id AS resource_id, destinations.name AS name, "+
+			"TRUE AS deleted").
+		Joins("join connections on connections_destinations.connection_id = connections.connection_id").
+		Joins("join destinations on connections_destinations.destination_id = destinations.destination_id").
+		Where("connections.pipeline_id = ?", pipelineID).
+		Where("destinations.deleted_at IS NOT NULL").
+		Find(&destinations)
+
+	return destinations, result.Error
+}
+
+// BackfillConnectionSources points the connections of the pipelines created before the sources were shared at their
+// source, those sources reference their connection only. Connections already pointed at a source are left untouched.
+func (p *PGStore) BackfillConnectionSources() error {
+	result := p.db.Exec("UPDATE connections SET source_id = sources.source_id FROM sources " +
+		"WHERE sources.connection_id = connections.connection_id AND connections.source_id IS NULL")
+
+	return result.Error
+}
+
+func (p *PGStore) GetPurgeablePipelines(deletedBefore time.Time) ([]models.Pipeline, error) {
+	var pipelines []models.Pipeline
+
+	result := p.db.Unscoped().Where("deleted_at < ?", deletedBefore).Find(&pipelines)
+
+	return pipelines, result.Error
+}
+
+func (p *PGStore) DeleteDestination(destinationID uuid.UUID) error {
+	result := p.db.Where("destination_id = ?", destinationID).Delete(&models.Destination{})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Destination doesn't exists")
+	}
+
+	return result.Error
+}
+
+func (p *PGStore) RestoreDestination(destinationID uuid.UUID) error {
+	result := p.db.Unscoped().Model(&models.Destination{}).
+		Where("destination_id = ?", destinationID).
+		Where("deleted_at IS NOT NULL").
+		Update("deleted_at", nil)
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Deleted destination doesn't exists")
+	}
+
+	return result.Error
+}
+
+// GetDestinationDependencies returns the pipelines and data products which are still using the destination, the soft
+// deleted ones are dependencies until they are purged since they can be restored.
+func (p *PGStore) GetDestinationDependencies(destinationID uuid.UUID) ([]models.ResourceDependency, error) {
+	dependencies := make([]models.ResourceDependency, 0)
+
+	var pipelines []models.ResourceDependency
+
+	result := p.db.Table("connections_destinations").
+		Select("DISTINCT 'pipeline' AS resource_type, pipelines.pipeline_id AS resource_id, pipelines.name AS name, "+
+			"pipelines.deleted_at IS NOT NULL AS deleted").
+		Joins("join connections on connections_destinations.connection_id = connections.connection_id").
+		Joins("join pipelines on connections.pipeline_id = pipelines.pipeline_id").
+		Where("connections_destinations.destination_id = ?", destinationID).
+		Find(&pipelines)
+	if result.Error != nil {
+		return dependencies, result.Error
+	}
+
+	var dataProducts []models.ResourceDependency
+
+	result = p.db.Table("transformation_pipelines").
+		Select("'data-product' AS resource_type, data_products.product_id AS resource_id, data_products.name AS name, "+
+			"data_products.deleted_at IS NOT NULL AS deleted").
+		Joins("join data_products on transformation_pipelines.product_id = data_products.product_id").
+		Where("transformation_pipelines.destination_id = ?", destinationID).
+		Find(&dataProducts)
+	if result.Error != nil {
+		return dependencies, result.Error
+	}
+
+	dependencies = append(dependencies, pipelines...)
+	dependencies = append(dependencies, dataProducts...)
+
+	return dependencies, nil
+}
+
+func (p *PGStore) PurgeDestination(destinationID uuid.UUID) error {
+	result := p.db.Unscoped().Where("destination_id = ?", destinationID).Delete(&models.Destination{})
+
+	return result.Error
+}
+
+func (p *PGStore) GetPurgeableDestinations(deletedBefore time.Time) ([]models.Destination, error) {
+	var destinations []models.Destination
+
+	result := p.db.Unscoped().Where("deleted_at < ?", deletedBefore).Find(&destinations)
+
+	return destinations, result.Error
+}
+
+func (p *PGStore) DeleteSource(sourceID string) error {
+	result := p.db.Where("source_id = ?", sourceID).Delete(&models.Source{})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Source doesn't exists")
+	}
+
+	return result.Error
+}
+
+// GetSourceDependencies returns the pipelines which are reading from the source, the soft deleted ones included until
+// they are purged.
+func (p *PGStore) GetSourceDependencies(sourceID string) ([]models.ResourceDependency, error) {
+	dependencies := make([]models.ResourceDependency, 0)
+
+	result := p.db.Table("connections").
+		Select("DISTINCT 'pipeline' AS resource_type, pipelines.pipeline_id AS resource_id, pipelines.name AS name, "+
+			"pipelines.deleted_at IS NOT NULL AS deleted").
+		Joins("join pipelines on connections.pipeline_id = pipelines.pipeline_id").
+		Where("connections.source_id = ?", sourceID).
+		Find(&dependencies)
+
+	return dependencies, result.Error
+}
+
+func (p *PGStore) PurgeSource(sourceID string) error {
+	result := p.db.Unscoped().Where("source_id = ?", sourceID).Delete(&models.Source{})
+
+	return result.Error
+}
+
+func (p *PGStore) GetPurgeableSources(deletedBefore time.Time) ([]models.Source, error) {
+	var sources []models.Source
+
+	result := p.db.Unscoped().Where("deleted_at < ?", deletedBefore).Find(&sources)
+
+	return sources, result.Error
+}
+
+func (p *PGStore) DeleteDataProduct(productID uuid.UUID) error {
+	result := p.db.Where("product_id = ?", productID).Delete(&models.DataProduct{})
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Data Product doesn't exists")
+	}
+
+	return result.Error
+}
+
+func (p *PGStore) RestoreDataProduct(productID uuid.UUID) error {
+	result := p.db.Unscoped().Model(&models.DataProduct{}).
+		Where("product_id = ?", productID).
+		Where("deleted_at IS NOT NULL").
+		Update("deleted_at", nil)
+
+	if result.RowsAffected == 0 && result.Error == nil {
+		return errors.New("Deleted data product doesn't exists")
+	}
+
+	return result.Error
+}
+
+func (p *PGStore) PurgeDataProduct(productID uuid.UUID) error {
+	result := p.db.Unscoped().Where("product_id = ?", productID).Delete(&models.DataProduct{})
+
+	return result.Error
+}
+
+func (p *PGStore) GetPurgeableDataProducts(deletedBefore time.Time) ([]models.DataProduct, error) {
+	var dataProducts []models.DataProduct
+
+	result := p.db.Unscoped().Where("deleted_at < ?", deletedBefore).Find(&dataProducts)
+
+	return dataProducts, result.Error
+}
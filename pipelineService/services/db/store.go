@@ -3,7 +3,12 @@
 package db
 
 import (
+	"context"
+	"database/sql"
+	"time"
+
 	"github.com/gofrs/uuid"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"pipelineService/models/v1"
 )
@@ -15,15 +20,28 @@ type Store interface {
 	GetProductConnection(productID uuid.UUID) (models.TransformationPipelines, error)
 	GetAllDataProducts(workspaceId int) ([]models.GetAllDataProductsView, error)
 	UpdateDataProduct(product models.DataProduct) (models.DataProduct, error)
+	DeleteDataProduct(productID uuid.UUID) error
+	RestoreDataProduct(productID uuid.UUID) error
+	PurgeDataProduct(productID uuid.UUID) error
+	GetPurgeableDataProducts(deletedBefore time.Time) ([]models.DataProduct, error)
 	AddPipeline(productID uuid.UUID, productPipelines []models.ProductsPipelines) error
 	CreatePipeline(pipeline models.Pipeline) (models.Pipeline, error)
 	UpdatePipeline(pipeline models.UpdatePipeline) (models.Pipeline, error)
 	GetAllPipelines(workspaceId int) ([]models.PipelinesMetaData, error)
 	GetPipeline(pipelineID uuid.UUID) (models.PipelineView, error)
-	DeletePipeline(pipelineID uuid.UUID) error
+	DeletePipeline(pipelineID uuid.UUID, connections []models.Connection) error
+	RestorePipeline(pipelineID uuid.UUID) error
+	PurgePipeline(pipelineID uuid.UUID) error
+	BackfillConnectionSources() error
+	GetPipelineIncludingDeleted(pipelineID uuid.UUID) (models.Pipeline, error)
+	GetPipelineDeletedDestinations(pipelineID uuid.UUID) ([]models.ResourceDependency, error)
+	GetPurgeablePipelines(deletedBefore time.Time) ([]models.Pipeline, error)
 	GetPipelineSourceAndConnectionID(pipelineID uuid.UUID) (models.PipelineSourceAndConnectionID, error)
+	GetPipelineConnections(pipelineID uuid.UUID) ([]models.Connection, error)
 	EnablePipelineAssets(connectionIDs []string) error
 	UpdatePipelineStatus(pipelineID uuid.UUID, pipelineStatus string) error
+	CreatePipelineOperation(operation models.PipelineOperation) (models.PipelineOperation, error)
+	GetPipelineOperations(pipelineID uuid.UUID) ([]models.PipelineOperation, error)
 
 	GetAllConnections() ([]models.Connection, error)
 	GetConnection(connectionID string) (models.Connection, error)
@@ -31,33 +49,158 @@ type Store interface {
 	UpdateConnectionSchedule(connection models.Connection) error
 	GetPipelineConnection(pipelineID string) (models.PipelineConnection, error)
 	CreateConnectionAndSourceAgainstAPipeline(source models.Source, connection models.Connection) (models.Source, models.Connection, error)
-	GetSupportedSources() ([]models.SupportedSources, error)
+	GetSupportedSources(workspaceID int) ([]models.SupportedSources, error)
 	UpdateConnectionInfo(connection models.Connection, destinationId string) error
 
 	GetSource(sourceId string) (models.Source, error)
-	GetSourceAndDestinationAirbyteInfo(sourceId string, destinationId string) (models.AirbyteSourceAndDestinations, error)
+	CreateSource(source models.Source) (models.Source, error)
+	GetWorkspaceSources(workspaceID int) ([]models.WorkspaceSource, error)
+	AttachSourceToPipeline(connection models.Connection) (models.Connection, error)
+	DeleteSource(sourceID string) error
+	GetSourceDependencies(sourceID string) ([]models.ResourceDependency, error)
+	PurgeSource(sourceID string) error
+	GetPurgeableSources(deletedBefore time.Time) ([]models.Source, error)
+	GetSourceAndDestinationAirbyteInfo(sourceId string, destinationId string, pipelineID string) (models.AirbyteSourceAndDestinations, error)
+	AddPipelineDestinations(sourceID string, pipelineID string, destinationIDs []string) ([]models.AirbyteSourceAndDestinations, error)
+	ReleasePipelineDestinations(connections []models.AirbyteSourceAndDestinations) error
 
 	CreateDestination(source models.Destination) (models.Destination, error)
-	GetSupportedDestinations() ([]models.SupportedDestinations, error)
+	GetSupportedDestinations(workspaceID int) ([]models.SupportedDestinations, error)
 	GetConfiguredDestination(workspaceId int) ([]models.ConfiguredDestination, error)
 	GetDestinationSummary(destinationID uuid.UUID) (models.DestinationSummary, error)
 	GetSourceAndConnectionDetails(sourceID string) (models.ConnectionSummary, error)
 	CreatePipelineSchema(pipelineSchema models.PipelineSchemas) (models.PipelineSchemas, error)
-	CreatePipelineAssets(pipelineAssets []models.PipelineAssets) error
+	CreatePipelineAssets(pipelineAssets []models.PipelineAssets) ([]models.PipelineAssets, error)
 	DeletePipelineSchema(schemaID uuid.UUID) error
 	GetPipelineSchema(pipelineID uuid.UUID) (models.PipelineSchemas, error)
 
-	PreviewData(db *gorm.DB, schema string, table string) ([]map[string]interface{}, error)
+	PreviewData(db *gorm.DB, schema string, table string, query models.PreviewQuery) (models.PreviewResult, error)
+	PreviewSQLData(ctx context.Context, conn *sql.DB, destinationType string, schema string, table string, query models.PreviewQuery) (models.PreviewResult, error)
 	GetAssetDetails(assetID uuid.UUID) (models.AssetDetails, error)
+	GetAssetWorkspaceID(assetID uuid.UUID) (int, error)
+	GetAssetIdentity(assetID uuid.UUID) (models.AssetIdentity, error)
 	GetPipelineAssets(pipelineID uuid.UUID) ([]models.PipelineAssets, error)
+	GetTableColumns(db *gorm.DB, schema string, tables []string) ([]models.TableColumn, error)
+	SyncAssetColumns(parentID string, columns []models.AssetColumn) error
+	GetAssetColumns(assetID uuid.UUID) ([]models.AssetColumn, error)
+	UpdateAssetColumn(column models.AssetColumn) (models.AssetColumn, error)
+	GetDataDictionary(parentID uuid.UUID) ([]models.AssetColumn, error)
+	StreamData(db *gorm.DB, schema string, table string, query models.PreviewQuery,
+		write func(columns []models.PreviewColumn, row map[string]interface{}) error) ([]models.PreviewColumn, error)
+	CreateAssetExport(export models.AssetExport) (models.AssetExport, error)
+	UpdateAssetExport(export models.AssetExport) error
+	GetAssetExport(exportID uuid.UUID) (models.AssetExport, error)
+	GetAssetExports(workspaceID int, filter models.AssetExportFilter) ([]models.AssetExport, error)
+	GetExpiredAssetExports(completedBefore time.Time) ([]models.AssetExport, error)
+	GetAssetTable(assetID uuid.UUID) (models.AssetTable, error)
+	ProfileTable(db *gorm.DB, table models.AssetTable, columns []models.AssetColumn, filters []models.PreviewFilter) (models.TableProfile, error)
+	CreateAssetProfile(profile models.AssetProfile) (models.AssetProfile, error)
+	GetLatestAssetProfiles(assetIDs []string) ([]models.AssetProfile, error)
+
+	CreateQualityRule(rule models.QualityRule) (models.QualityRule, error)
+	GetQualityRules(assetID uuid.UUID) ([]models.QualityRule, error)
+	BackfillQualityRuleAssets() error
+	DeleteQualityRule(ruleID uuid.UUID, workspaceID int) error
+	CheckQualityRule(db *gorm.DB, table models.AssetTable, rule models.QualityRule,
+		columns []models.AssetColumn) (models.QualityMeasure, error)
+	SaveQualityResults(results []models.QualityCheckResult) error
+	GetLastQualityResult(ruleID uuid.UUID) (models.QualityCheckResult, error)
+	GetQualityResults(workspaceID int, filter models.QualityResultFilter) ([]models.QualityCheckResult, error)
+	GetPipelineQualityResults(pipelineID uuid.UUID) ([]models.QualityCheckResult, error)
+	GetProductQualityResults(productID uuid.UUID) ([]models.QualityCheckResult, error)
+	GetAssetProductIDs(assetID uuid.UUID) ([]string, error)
+	SetDataProductsStatus(productIDs []string, status string) error
+
+	SaveFreshnessSLA(sla models.FreshnessSLA) (models.FreshnessSLA, error)
+	GetFreshnessSLA(productID uuid.UUID) (models.FreshnessSLA, error)
+	GetFreshnessSLAs() ([]models.FreshnessSLA, error)
+	UpdateFreshnessSLAStatus(sla models.FreshnessSLA) error
+	DeleteFreshnessSLA(productID uuid.UUID) error
+	CreateFreshnessSLAEvent(event models.FreshnessSLAEvent) error
+	GetFreshnessSLAEvents(productID uuid.UUID) ([]models.FreshnessSLAEvent, error)
+	GetProductAirbyteConnectionIDs(productID uuid.UUID) ([]string, error)
+
+	GetLineageSources(workspaceID int) ([]models.LineageSource, error)
+	GetLineageTables(workspaceID int) ([]models.LineageTable, error)
+	GetLineageProductPipelines(workspaceID int) ([]models.ProductsPipelines, error)
+	GetLineageColumns(workspaceID int) ([]models.AssetColumn, error)
+	SaveDbtManifest(manifest models.DbtManifest) (models.DbtManifest, error)
+	GetDbtManifests(workspaceID int) ([]models.DbtManifest, error)
+
+	CreateClassificationRule(rule models.ClassificationRule) (models.ClassificationRule, error)
+	GetClassificationRules(workspaceID int) ([]models.ClassificationRule, error)
+	DeleteClassificationRule(ruleID uuid.UUID, workspaceID int) error
+	GetClassifiableAssets() ([]string, error)
+	SetColumnClassifications(assetID string, classifications map[string][]string) error
+	UpdateColumnClassifications(assetID uuid.UUID, column string, labels []string, pinned bool) (models.AssetColumn, error)
+	GetSensitiveColumns(productID uuid.UUID) ([]models.SensitiveColumn, error)
+
+	CreateMaskingPolicy(policy models.MaskingPolicy) (models.MaskingPolicy, error)
+	GetMaskingPolicies(workspaceID int) ([]models.MaskingPolicy, error)
+	DeleteMaskingPolicy(policyID uuid.UUID, workspaceID int) error
+	CreateRowFilter(filter models.RowFilter) (models.RowFilter, error)
+	GetRowFilters(productID uuid.UUID) ([]models.RowFilter, error)
+	DeleteRowFilter(filterID uuid.UUID, workspaceID int) error
+	GetAssetRowFilters(assetID uuid.UUID) ([]models.RowFilter, error)
 
 	GetDestination(destinationID uuid.UUID) (models.Destination, error)
+	UpdateDestination(destination models.Destination) (models.Destination, error)
+	DeleteDestination(destinationID uuid.UUID) error
+	RestoreDestination(destinationID uuid.UUID) error
+	GetDestinationDependencies(destinationID uuid.UUID) ([]models.ResourceDependency, error)
+	PurgeDestination(destinationID uuid.UUID) error
+	GetPurgeableDestinations(deletedBefore time.Time) ([]models.Destination, error)
 	CreateTransformationPipeline(transformationPipeline models.TransformationPipelines) (models.TransformationPipelines, error)
 	GetTransformedAssets(productID uuid.UUID) ([]models.ProductAssets, error)
 	GetTransformedAssetDetails(assetID uuid.UUID) (models.TransformedAssetDetails, error)
 	GetProductDetails() ([]models.ProductDetail, error)
 	SyncTransformedAssets(productAssetDetails []models.ProductAssetDetails) error
 	GetTransformationPipeline(productID uuid.UUID) (models.TransformationPipelines, error)
+
+	GetSupportedSource(workspaceID int, definitionID string) (models.SupportedSources, error)
+	GetSupportedDestination(workspaceID int, definitionID string) (models.SupportedDestinations, error)
+	SyncSupportedSources(sources []models.SupportedSources) (int64, error)
+	SyncSupportedDestinations(destinations []models.SupportedDestinations) (int64, error)
+	SaveWorkspaceConnector(connector models.WorkspaceConnector) (models.WorkspaceConnector, error)
+	IsConnectorEnabled(workspaceID int, connectorType string, definitionID string) (bool, error)
+	CreateSupportedSource(source models.SupportedSources) (models.SupportedSources, error)
+	CreateSupportedDestination(destination models.SupportedDestinations) (models.SupportedDestinations, error)
+	UpdateSupportedSourceVersion(definitionID string, dockerImageTag string) error
+	UpdateSupportedDestinationVersion(definitionID string, dockerImageTag string) error
+	GetSourcesByDefinition(workspaceID int, definitionID string) ([]models.Source, error)
+	GetDestinationsByDefinition(workspaceID int, definitionID string) ([]models.Destination, error)
+
+	GetPipelineConnectors(workspaceID int) ([]models.PipelineConnector, error)
+	GetDefinitionConnectors(connectorType string, definitionID string) ([]models.PipelineConnector, error)
+	CreateConnectorUpgrade(upgrade models.ConnectorUpgrade) (models.ConnectorUpgrade, error)
+	GetConnectorUpgrades(workspaceID int) ([]models.ConnectorUpgrade, error)
+	GetConnectorUpgrade(upgradeID uuid.UUID) (models.ConnectorUpgrade, error)
+	UpdateConnectorUpgrade(upgrade models.ConnectorUpgrade, fromStatus string) error
+
+	CreateOAuthFlow(flow models.OAuthFlow) (models.OAuthFlow, error)
+	GetOAuthFlow(flowID uuid.UUID) (models.OAuthFlow, error)
+	GetOAuthFlowByState(state string) (models.OAuthFlow, error)
+	UpdateOAuthFlow(flow models.OAuthFlow, fromStatus string) error
+	GetOAuthSources() ([]models.OAuthSource, error)
+	SaveOAuthTokenFailure(failure models.OAuthTokenFailure) (models.OAuthTokenFailure, error)
+	ResolveOAuthTokenFailures(sourceID string) error
+	GetOAuthTokenFailures(workspaceID int) ([]models.OAuthTokenFailure, error)
+
+	CreateFileUpload(upload models.FileUpload) (models.FileUpload, error)
+	GetFileUploads(sourceID string) ([]models.FileUpload, error)
+	GetLatestFileUpload(sourceID string) (models.FileUpload, error)
+	GetSourceConnections(sourceID string) ([]models.Connection, error)
+
+	GetDriftCheckConnections() ([]models.DriftCheckConnection, error)
+	SaveSchemaChange(schemaChange models.SchemaChange) (models.SchemaChange, error)
+	IsSchemaChangeRejected(connectionID string, fingerprint string) (bool, error)
+	GetSchemaChanges(workspaceID int, filter models.SchemaChangeFilter) ([]models.SchemaChange, error)
+	GetSchemaChange(changeID uuid.UUID) (models.SchemaChange, error)
+	ReviewSchemaChange(schemaChange models.SchemaChange) error
+
+	CreateAuditLog(auditLog models.AuditLog) error
+	GetAuditLogs(workspaceID int, filter models.AuditLogFilter) ([]models.AuditLog, error)
+	GetAuditSnapshot(resourceType string, resourceID string) (datatypes.JSON, error)
 }
 
 type PGStore struct {
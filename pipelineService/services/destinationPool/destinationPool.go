@@ -0,0 +1,180 @@
+package destinationPool
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/tidwall/gjson"
+	"pipelineService/env"
+	"pipelineService/services/sshTunnel"
+	"pipelineService/utils"
+)
+
+// pool is the connection pool of a destination, the fingerprint of the credentials it was opened with tells when the
+// destination was edited since. The connections of the pool go through its tunnel.
+type pool struct {
+	conn        *sql.DB
+	tunnel      *sshTunnel.Tunnel
+	fingerprint string
+	lastUsed    time.Time
+}
+
+var (
+	mutex   sync.Mutex
+	pools   = make(map[string]*pool)
+	janitor sync.Once
+)
+
+// Fingerprint identifies the credentials of a destination, the pools and the cached previews opened with other
+// credentials are stale.
+func Fingerprint(configuration string) string {
+	sum := sha256.Sum256([]byte(configuration))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Get returns the connection pool of the destination, a pool is opened with open when the destination has none or
+// when its credentials changed. The port is the one of the destination the pool connects to, open is given the
+// address to connect to, which is the local end of the SSH tunnel of the destination when it has one. The pools are
+// shared by the callers which must not close them.
+func Get(destinationID string, configuration string, port string, open func(addr string) (*sql.DB, error)) (*sql.DB, error) {
+	janitor.Do(func() {
+		go evictIdle()
+	})
+
+	fingerprint := Fingerprint(configuration)
+
+	if conn, ok := lookup(destinationID, fingerprint); ok {
+		return conn, nil
+	}
+
+	// the tunnel is opened without holding the mutex, a slow bastion host doesn't hold up the other destinations
+	tunnel, err := sshTunnel.Open(configuration, gjson.Get(configuration, "host").String(), port)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := open(tunnel.Addr())
+	if err != nil {
+		closeTunnel(tunnel)
+
+		return nil, err
+	}
+
+	conn.SetMaxOpenConns(env.Env.PreviewPoolMaxOpenConns)
+	conn.SetMaxIdleConns(env.Env.PreviewPoolMaxOpenConns)
+	conn.SetConnMaxIdleTime(env.Env.PreviewPoolIdleTimeout)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	opened := &pool{conn: conn, tunnel: tunnel, fingerprint: fingerprint, lastUsed: time.Now()}
+
+	if cached, ok := pools[destinationID]; ok {
+		// the pool opened meanwhile by a concurrent caller is kept
+		if cached.fingerprint == fingerprint {
+			cached.lastUsed = opened.lastUsed
+			go closeConnections(opened)
+
+			return cached.conn, nil
+		}
+
+		closePool(destinationID)
+	}
+
+	// the least recently used pool makes room for the new one
+	for len(pools) >= env.Env.PreviewPoolMaxSize {
+		oldest := ""
+
+		for id, cached := range pools {
+			if oldest == "" || cached.lastUsed.Before(pools[oldest].lastUsed) {
+				oldest = id
+			}
+		}
+
+		closePool(oldest)
+	}
+
+	pools[destinationID] = opened
+
+	return conn, nil
+}
+
+// lookup returns the pool of the destination opened with the same credentials.
+func lookup(destinationID string, fingerprint string) (*sql.DB, bool) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	cached, ok := pools[destinationID]
+	if !ok || cached.fingerprint != fingerprint {
+		return nil, false
+	}
+
+	cached.lastUsed = time.Now()
+
+	return cached.conn, true
+}
+
+// Invalidate closes the pool of the destination, the next preview opens a new one with the current credentials.
+func Invalidate(destinationID string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	closePool(destinationID)
+}
+
+// QueryContext bounds a query on a destination by the preview query timeout, the statement is cancelled on the
+// destination when the timeout expires or when the caller goes away.
+func QueryContext(parent context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(parent, env.Env.PreviewQueryTimeout)
+}
+
+// closePool closes the pool of the destination, the queries running on it are finished first. The caller holds the
+// mutex.
+func closePool(destinationID string) {
+	cached, ok := pools[destinationID]
+	if !ok {
+		return
+	}
+
+	delete(pools, destinationID)
+
+	go closeConnections(cached)
+}
+
+// closeConnections closes the connections of the pool then its tunnel.
+func closeConnections(cached *pool) {
+	if err := cached.conn.Close(); err != nil {
+		utils.GetLogger().Error(err.Error())
+	}
+
+	closeTunnel(cached.tunnel)
+}
+
+func closeTunnel(tunnel *sshTunnel.Tunnel) {
+	if err := tunnel.Close(); err != nil {
+		utils.GetLogger().Error(err.Error())
+	}
+}
+
+// evictIdle closes the pools of the destinations which weren't previewed within the idle timeout.
+func evictIdle() {
+	ticker := time.NewTicker(env.Env.PreviewPoolIdleTimeout / 2)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		mutex.Lock()
+
+		for id, cached := range pools {
+			if time.Since(cached.lastUsed) > env.Env.PreviewPoolIdleTimeout {
+				closePool(id)
+			}
+		}
+
+		mutex.Unlock()
+	}
+}
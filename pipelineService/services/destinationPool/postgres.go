@@ -0,0 +1,130 @@
+package destinationPool
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/tidwall/gjson"
+	"gorm.io/gorm"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+// ErrUnsupportedDestination is returned for the assets of the destinations which can only be previewed, the exports,
+// the profiles and the quality checks run SQL of the postgres dialect.
+var ErrUnsupportedDestination = errors.New("destination isn't supported")
+
+// SupportsQueries reports whether the exports, the profiles and the quality checks can run on the destination.
+func SupportsQueries(destinationType string) bool {
+	return strings.EqualFold(destinationType, utils.DESTINATION_TYPE_POSTGRES)
+}
+
+// AssetPostgres returns a gorm client on the pool of the destination of an asset, the destinations other than
+// postgres are rejected with ErrUnsupportedDestination.
+func AssetPostgres(table models.AssetTable) (*gorm.DB, error) {
+	if !SupportsQueries(table.DestinationType) {
+		return nil, fmt.Errorf("%w: %s destinations can only be previewed", ErrUnsupportedDestination, table.DestinationType)
+	}
+
+	return Postgres(table.DestinationID, table.Configuration.String())
+}
+
+// Postgres returns a gorm client on the pool of a postgres destination, its connections honor the ssl_mode and the
+// tunnel of the destination.
+func Postgres(destinationID string, configuration string) (*gorm.DB, error) {
+	conn, err := Get(destinationID, configuration, gjson.Get(configuration, "port").String(),
+		func(addr string) (*sql.DB, error) {
+			config, err := postgresConfig(configuration, addr)
+			if err != nil {
+				return nil, err
+			}
+
+			return stdlib.OpenDB(*config), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return db.NewClient(conn)
+}
+
+// postgresConfig parses the settings of the destination then points them at the address to connect to, the TLS
+// settings are parsed for the host of the destination so its certificate is verified against it through a tunnel.
+func postgresConfig(configuration string, addr string) (*pgx.ConnConfig, error) {
+	sslMode := postgresSSLMode(configuration)
+
+	// like libpq the certificate of the server is verified in the require mode when a CA is given
+	if sslMode == "require" && gjson.Get(configuration, "ssl_mode.ca_certificate").String() != "" {
+		sslMode = "verify-ca"
+	}
+
+	config, err := pgx.ParseConfig(db.PostgresDSN(gjson.Get(configuration, "host").String(),
+		gjson.Get(configuration, "username").String(), gjson.Get(configuration, "password").String(),
+		gjson.Get(configuration, "database").String(), gjson.Get(configuration, "port").String(), sslMode))
+	if err != nil {
+		return nil, err
+	}
+
+	rootCAs, certificates, err := sslCertificates(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	portNumber, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return nil, err
+	}
+
+	config.Host, config.Port = host, uint16(portNumber)
+	withCertificates(config.TLSConfig, rootCAs, certificates)
+
+	for _, fallback := range config.Fallbacks {
+		fallback.Host, fallback.Port = host, uint16(portNumber)
+		withCertificates(fallback.TLSConfig, rootCAs, certificates)
+	}
+
+	return config, nil
+}
+
+// withCertificates adds the CA and the client certificate of the destination to a TLS configuration parsed by pgx.
+func withCertificates(config *tls.Config, rootCAs *x509.CertPool, certificates []tls.Certificate) {
+	if config == nil {
+		return
+	}
+
+	if rootCAs != nil {
+		config.RootCAs = rootCAs
+	}
+
+	if len(certificates) > 0 {
+		config.Certificates = certificates
+	}
+}
+
+// postgresSSLMode returns the sslmode of the destination, the ssl_mode of the recent versions of the destination wins
+// over the ssl flag of the former ones.
+func postgresSSLMode(configuration string) string {
+	if mode := gjson.Get(configuration, "ssl_mode.mode").String(); mode != "" {
+		return mode
+	}
+
+	if gjson.Get(configuration, "ssl").Bool() {
+		return "require"
+	}
+
+	return "disable"
+}
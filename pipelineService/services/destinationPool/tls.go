@@ -0,0 +1,87 @@
+package destinationPool
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/tidwall/gjson"
+)
+
+// TLSConfig returns the TLS configuration verifying the certificate of a destination with the CA of its ssl_mode, the
+// name of the server is only checked with verifyHost. The server name is the host of the destination since the
+// connections going through a tunnel are made to a local address.
+func TLSConfig(configuration string, serverName string, verifyHost bool) (*tls.Config, error) {
+	rootCAs, certificates, err := sslCertificates(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{RootCAs: rootCAs, Certificates: certificates, ServerName: serverName}
+
+	if !verifyHost {
+		// the chain is verified without the name of the server like the verify-ca mode of postgres
+		config.InsecureSkipVerify = true
+		config.VerifyPeerCertificate = func(rawCertificates [][]byte, _ [][]*x509.Certificate) error {
+			return verifyChain(rawCertificates, rootCAs)
+		}
+	}
+
+	return config, nil
+}
+
+// sslCertificates returns the CA and the client certificate of the ssl_mode of a destination, they are given as PEM
+// in its configuration.
+func sslCertificates(configuration string) (*x509.CertPool, []tls.Certificate, error) {
+	var rootCAs *x509.CertPool
+
+	if ca := gjson.Get(configuration, "ssl_mode.ca_certificate").String(); ca != "" {
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM([]byte(ca)) {
+			return nil, nil, errors.New("ca certificate of the destination is invalid")
+		}
+	}
+
+	clientCertificate := gjson.Get(configuration, "ssl_mode.client_certificate").String()
+	clientKey := gjson.Get(configuration, "ssl_mode.client_key").String()
+
+	if clientCertificate == "" && clientKey == "" {
+		return rootCAs, nil, nil
+	}
+
+	certificate, err := tls.X509KeyPair([]byte(clientCertificate), []byte(clientKey))
+	if err != nil {
+		return nil, nil, fmt.Errorf("client certificate of the destination is invalid: %w", err)
+	}
+
+	return rootCAs, []tls.Certificate{certificate}, nil
+}
+
+// verifyChain verifies the certificates of the server up to the CA, the system roots are used without a CA.
+func verifyChain(rawCertificates [][]byte, rootCAs *x509.CertPool) error {
+	if len(rawCertificates) == 0 {
+		return errors.New("server sent no certificate")
+	}
+
+	options := x509.VerifyOptions{Roots: rootCAs, Intermediates: x509.NewCertPool()}
+
+	certificates := make([]*x509.Certificate, len(rawCertificates))
+
+	for i, raw := range rawCertificates {
+		certificate, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("certificate of the server is invalid: %w", err)
+		}
+
+		certificates[i] = certificate
+
+		if i > 0 {
+			options.Intermediates.AddCert(certificate)
+		}
+	}
+
+	_, err := certificates[0].Verify(options)
+
+	return err
+}
@@ -0,0 +1,115 @@
+package fileSource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"pipelineService/utils"
+)
+
+// File is an uploaded file, multipart files are read twice, once for the preview and once to be stored.
+type File interface {
+	io.Reader
+	io.ReaderAt
+	io.Seeker
+}
+
+var (
+	unsafeNameCharacters    = regexp.MustCompile(`[^a-z0-9._-]+`)
+	unsafeDatasetCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+)
+
+// DetectFormat returns the format of the file from its extension.
+func DetectFormat(fileName string) (string, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return utils.FILE_FORMAT_CSV, nil
+	case ".jsonl", ".ndjson":
+		return utils.FILE_FORMAT_JSONL, nil
+	case ".xlsx":
+		return utils.FILE_FORMAT_EXCEL, nil
+	case ".parquet":
+		return utils.FILE_FORMAT_PARQUET, nil
+	default:
+		return "", fmt.Errorf("unsupported file %s, the supported files are .csv, .jsonl, .xlsx and .parquet", fileName)
+	}
+}
+
+// ObjectKey returns the key the upload of the workspace is stored under, every upload has its own key so the
+// previous versions of a file are kept.
+func ObjectKey(workspaceID int, uploadID uuid.UUID, fileName string) string {
+	return fmt.Sprintf("uploads/%d/%s/%s", workspaceID, uploadID, safeName(fileName))
+}
+
+// DatasetName returns the name of the stream the airbyte file source reads the file as.
+func DatasetName(name string) string {
+	datasetName := strings.Trim(unsafeDatasetCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if datasetName == "" {
+		return "file"
+	}
+
+	return datasetName
+}
+
+// Store stores the file in the object store and returns its checksum.
+func Store(objectStore ObjectStore, key string, file File, size int64) (string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return checksum, objectStore.Put(key, file, size, checksum)
+}
+
+// Configure points the configuration of the airbyte file source at the stored object, the other settings of the
+// configuration like its reader options are kept.
+func Configure(objectStore ObjectStore, configuration interface{}, datasetName string, format string,
+	key string) map[string]interface{} {
+	configured := make(map[string]interface{})
+
+	if existing, ok := configuration.(map[string]interface{}); ok {
+		for name, value := range existing {
+			configured[name] = value
+		}
+	}
+
+	if _, ok := configured["dataset_name"]; !ok {
+		configured["dataset_name"] = datasetName
+	}
+
+	url, provider := objectStore.Location(key)
+
+	configured["format"] = format
+	configured["url"] = url
+	configured["provider"] = provider
+
+	return configured
+}
+
+func safeName(fileName string) string {
+	extension := strings.ToLower(filepath.Ext(fileName))
+	name := strings.Trim(unsafeNameCharacters.ReplaceAllString(strings.ToLower(strings.TrimSuffix(fileName,
+		filepath.Ext(fileName))), "_"), "._-")
+
+	if name == "" {
+		name = "file"
+	}
+
+	return name + extension
+}
@@ -0,0 +1,183 @@
+package fileSource
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"pipelineService/env"
+	"pipelineService/utils"
+)
+
+// ObjectStore stores the uploaded files where the airbyte file source can read them.
+type ObjectStore interface {
+	// Put stores the content under the key, the checksum is the hex encoded SHA-256 of the content.
+	Put(key string, content io.Reader, size int64, checksum string) error
+	// Location returns the url and the provider of the airbyte file source reading the object.
+	Location(key string) (string, map[string]interface{})
+}
+
+// NewObjectStore returns the object store configured for the service.
+func NewObjectStore() ObjectStore {
+	if env.Env.FileStore == utils.FILE_STORE_S3 {
+		return &S3Store{
+			Endpoint:        strings.TrimSuffix(env.Env.FileStoreS3Endpoint, "/"),
+			Bucket:          env.Env.FileStoreS3Bucket,
+			Region:          env.Env.FileStoreS3Region,
+			AccessKeyID:     env.Env.FileStoreS3AccessKeyID,
+			SecretAccessKey: env.Env.FileStoreS3SecretKey,
+			Client:          &http.Client{Timeout: 10 * time.Minute},
+		}
+	}
+
+	return &LocalStore{
+		Directory: env.Env.FileUploadDirectory,
+		// airbyte mounts the directory at the parent of the default CSV source path
+		MountPath: path.Dir(env.Env.DefaultCSVSourcePath),
+	}
+}
+
+// LocalStore stores the files in the directory shared with airbyte.
+type LocalStore struct {
+	Directory string
+	MountPath string
+}
+
+func (s *LocalStore) Put(key string, content io.Reader, size int64, checksum string) error {
+	filePath := filepath.Join(s.Directory, filepath.FromSlash(key))
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(file, content); err != nil {
+		file.Close()
+		os.Remove(filePath)
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func (s *LocalStore) Location(key string) (string, map[string]interface{}) {
+	return path.Join(s.MountPath, key), map[string]interface{}{"storage": "local"}
+}
+
+// S3Store stores the files in a bucket of an S3 compatible object store, the objects are addressed path style so the
+// compatible stores work without a DNS entry per bucket.
+type S3Store struct {
+	Endpoint        string
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	Client          *http.Client
+}
+
+func (s *S3Store) Put(key string, content io.Reader, size int64, checksum string) error {
+	objectURL, err := url.Parse(fmt.Sprintf("%s/%s/%s", s.Endpoint, s.Bucket, escapeKey(key)))
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPut, objectURL.String(), content)
+	if err != nil {
+		return err
+	}
+
+	request.ContentLength = size
+	s.sign(request, checksum, time.Now().UTC())
+
+	response, err := s.Client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+
+		return fmt.Errorf("failed to store %s: %s %s", key, response.Status, string(body))
+	}
+
+	return nil
+}
+
+func (s *S3Store) Location(key string) (string, map[string]interface{}) {
+	return fmt.Sprintf("s3://%s/%s", s.Bucket, key), map[string]interface{}{
+		"storage":               "S3",
+		"aws_access_key_id":     s.AccessKeyID,
+		"aws_secret_access_key": s.SecretAccessKey,
+	}
+}
+
+// sign adds the AWS signature version 4 of the request, the payload is signed with its checksum.
+func (s *S3Store) sign(request *http.Request, checksum string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", now.Format("20060102"), s.Region)
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+
+	request.Header.Set("x-amz-content-sha256", checksum)
+	request.Header.Set("x-amz-date", amzDate)
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		"host:" + request.URL.Host,
+		"x-amz-content-sha256:" + checksum,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		checksum,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.SecretAccessKey), now.Format("20060102"))
+	signingKey = hmacSHA256(signingKey, s.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+
+	request.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.AccessKeyID, scope, signedHeaders, hex.EncodeToString(hmacSHA256(signingKey, stringToSign))))
+}
+
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,403 @@
+package fileSource
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// Preview infers the schema of the file from its first rows.
+func Preview(format string, content io.ReaderAt, size int64) (models.FilePreview, error) {
+	switch format {
+	case utils.FILE_FORMAT_CSV:
+		return previewCSV(io.NewSectionReader(content, 0, size))
+	case utils.FILE_FORMAT_JSONL:
+		return previewJSONL(io.NewSectionReader(content, 0, size))
+	case utils.FILE_FORMAT_EXCEL:
+		return previewExcel(content, size)
+	case utils.FILE_FORMAT_PARQUET:
+		return previewParquet(content, size)
+	default:
+		return models.FilePreview{}, fmt.Errorf("unsupported file format %s", format)
+	}
+}
+
+func previewCSV(content io.Reader) (models.FilePreview, error) {
+	reader := csv.NewReader(content)
+	reader.FieldsPerRecord = -1
+
+	records := make([][]string, 0, utils.FILE_PREVIEW_ROWS+1)
+
+	for len(records) <= utils.FILE_PREVIEW_ROWS {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return models.FilePreview{}, err
+		}
+
+		records = append(records, record)
+	}
+
+	return previewRecords(records)
+}
+
+func previewJSONL(content io.Reader) (models.FilePreview, error) {
+	scanner := bufio.NewScanner(content)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	preview := models.FilePreview{Columns: make([]models.FileColumn, 0), Rows: make([]map[string]interface{}, 0)}
+	types := make(map[string]string)
+
+	for len(preview.Rows) < utils.FILE_PREVIEW_ROWS && scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		row := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(line), &row); err != nil {
+			return preview, fmt.Errorf("line %d isn't a JSON object: %s", len(preview.Rows)+1, err.Error())
+		}
+
+		// the keys of a JSON object have no order, the new columns of a row are added sorted
+		keys := make([]string, 0, len(row))
+		for key := range row {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			valueType := jsonValueType(row[key])
+
+			previous, ok := types[key]
+			if !ok {
+				preview.Columns = append(preview.Columns, models.FileColumn{Name: key})
+			}
+
+			types[key] = mergeTypes(previous, valueType)
+		}
+
+		preview.Rows = append(preview.Rows, row)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return preview, err
+	}
+
+	for i := range preview.Columns {
+		preview.Columns[i].Type = columnType(types[preview.Columns[i].Name])
+	}
+
+	return preview, nil
+}
+
+type xlsxSharedStrings struct {
+	Items []struct {
+		Text string `xml:"t"`
+		Runs []struct {
+			Text string `xml:"t"`
+		} `xml:"r"`
+	} `xml:"si"`
+}
+
+type xlsxRow struct {
+	Cells []struct {
+		Ref    string `xml:"r,attr"`
+		Type   string `xml:"t,attr"`
+		Value  string `xml:"v"`
+		Inline struct {
+			Text string `xml:"t"`
+		} `xml:"is"`
+	} `xml:"c"`
+}
+
+// previewExcel reads the first rows of the first worksheet of a xlsx workbook, the first row holds the header.
+func previewExcel(content io.ReaderAt, size int64) (models.FilePreview, error) {
+	workbook, err := zip.NewReader(content, size)
+	if err != nil {
+		return models.FilePreview{}, errors.New("the file isn't a xlsx workbook")
+	}
+
+	var sharedStrings []string
+
+	var worksheet *zip.File
+
+	for _, file := range workbook.File {
+		switch {
+		case file.Name == "xl/sharedStrings.xml":
+			if sharedStrings, err = readSharedStrings(file); err != nil {
+				return models.FilePreview{}, err
+			}
+		case strings.HasPrefix(file.Name, "xl/worksheets/") && strings.HasSuffix(file.Name, ".xml"):
+			if worksheet == nil || file.Name == "xl/worksheets/sheet1.xml" {
+				worksheet = file
+			}
+		}
+	}
+
+	if worksheet == nil {
+		return models.FilePreview{}, errors.New("the workbook has no worksheet")
+	}
+
+	sheet, err := worksheet.Open()
+	if err != nil {
+		return models.FilePreview{}, err
+	}
+
+	defer sheet.Close()
+
+	decoder := xml.NewDecoder(sheet)
+	records := make([][]string, 0, utils.FILE_PREVIEW_ROWS+1)
+
+	for len(records) <= utils.FILE_PREVIEW_ROWS {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return models.FilePreview{}, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		var row xlsxRow
+		if err = decoder.DecodeElement(&row, &start); err != nil {
+			return models.FilePreview{}, err
+		}
+
+		record := make([]string, 0, len(row.Cells))
+
+		for i, cell := range row.Cells {
+			index := cellColumn(cell.Ref, i)
+			for len(record) <= index {
+				record = append(record, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				if shared, err := strconv.Atoi(cell.Value); err == nil && shared < len(sharedStrings) {
+					record[index] = sharedStrings[shared]
+				}
+			case "inlineStr":
+				record[index] = cell.Inline.Text
+			case "b":
+				record[index] = strconv.FormatBool(cell.Value == "1")
+			default:
+				record[index] = cell.Value
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return previewRecords(records)
+}
+
+func previewParquet(content io.ReaderAt, size int64) (models.FilePreview, error) {
+	columns, numRows, err := utils.ReadParquetSchema(content, size)
+	if err != nil {
+		return models.FilePreview{}, err
+	}
+
+	preview := models.FilePreview{
+		Columns: make([]models.FileColumn, 0, len(columns)),
+		Rows:    make([]map[string]interface{}, 0),
+		NumRows: &numRows,
+	}
+
+	for _, column := range columns {
+		preview.Columns = append(preview.Columns, models.FileColumn{Name: column.Name, Type: column.Type})
+	}
+
+	return preview, nil
+}
+
+func readSharedStrings(file *zip.File) ([]string, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	defer reader.Close()
+
+	var shared xlsxSharedStrings
+	if err = xml.NewDecoder(reader).Decode(&shared); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, len(shared.Items))
+
+	for i, item := range shared.Items {
+		values[i] = item.Text
+		for _, run := range item.Runs {
+			values[i] += run.Text
+		}
+	}
+
+	return values, nil
+}
+
+// cellColumn returns the index of the column of a cell reference like B12, a cell without reference takes the
+// position it has in the row.
+func cellColumn(ref string, position int) int {
+	index := 0
+
+	for _, char := range strings.ToUpper(ref) {
+		if char < 'A' || char > 'Z' {
+			break
+		}
+
+		index = index*26 + int(char-'A') + 1
+	}
+
+	if index == 0 {
+		return position
+	}
+
+	return index - 1
+}
+
+// previewRecords infers the schema of rows of text, the first record holds the header.
+func previewRecords(records [][]string) (models.FilePreview, error) {
+	preview := models.FilePreview{Columns: make([]models.FileColumn, 0), Rows: make([]map[string]interface{}, 0)}
+
+	if len(records) == 0 {
+		return preview, errors.New("the file is empty")
+	}
+
+	names := make([]string, len(records[0]))
+	seen := make(map[string]int)
+
+	for i, header := range records[0] {
+		name := strings.TrimSpace(header)
+		if name == "" {
+			name = fmt.Sprintf("column_%d", i+1)
+		}
+
+		// airbyte can't load two columns with the same name
+		if seen[name]++; seen[name] > 1 {
+			name = fmt.Sprintf("%s_%d", name, seen[name])
+		}
+
+		names[i] = name
+	}
+
+	types := make([]string, len(names))
+
+	for _, record := range records[1:] {
+		row := make(map[string]interface{}, len(names))
+
+		for i, name := range names {
+			value := ""
+			if i < len(record) {
+				value = record[i]
+			}
+
+			row[name] = value
+			types[i] = mergeTypes(types[i], textType(value))
+		}
+
+		preview.Rows = append(preview.Rows, row)
+	}
+
+	for i, name := range names {
+		preview.Columns = append(preview.Columns, models.FileColumn{Name: name, Type: columnType(types[i])})
+	}
+
+	return preview, nil
+}
+
+// textType returns the narrowest type of a text value, an empty value has no type.
+func textType(value string) string {
+	value = strings.TrimSpace(value)
+
+	switch {
+	case value == "":
+		return ""
+	case isInteger(value):
+		return "integer"
+	case isNumber(value):
+		return "number"
+	case strings.EqualFold(value, "true") || strings.EqualFold(value, "false"):
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+func jsonValueType(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// mergeTypes widens the type of a column to hold a value of the other type, integers widen to numbers and any other
+// mix of types to strings.
+func mergeTypes(current string, other string) string {
+	switch {
+	case current == "" || current == other:
+		return other
+	case other == "":
+		return current
+	case (current == "integer" && other == "number") || (current == "number" && other == "integer"):
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+// columnType defaults the columns without a value in the previewed rows to strings.
+func columnType(inferred string) string {
+	if inferred == "" {
+		return "string"
+	}
+
+	return inferred
+}
+
+func isInteger(value string) bool {
+	_, err := strconv.ParseInt(value, 10, 64)
+
+	return err == nil
+}
+
+func isNumber(value string) bool {
+	_, err := strconv.ParseFloat(value, 64)
+
+	return err == nil && !strings.ContainsAny(value, "xXnN")
+}
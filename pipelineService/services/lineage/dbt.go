@@ -0,0 +1,94 @@
+package lineage
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type dbtManifest struct {
+	Nodes   map[string]dbtNode `json:"nodes"`
+	Sources map[string]dbtNode `json:"sources"`
+}
+
+type dbtNode struct {
+	ResourceType string `json:"resource_type"`
+	Name         string `json:"name"`
+	Alias        string `json:"alias"`
+	Identifier   string `json:"identifier"`
+	DependsOn    struct {
+		Nodes []string `json:"nodes"`
+	} `json:"depends_on"`
+}
+
+// relation returns the name of the table dbt builds for a model or reads for a source.
+func (node dbtNode) relation() string {
+	switch {
+	case node.Alias != "":
+		return strings.ToLower(node.Alias)
+	case node.Identifier != "":
+		return strings.ToLower(node.Identifier)
+	default:
+		return strings.ToLower(node.Name)
+	}
+}
+
+// dbtModel lists the relations a model is built from, the tables synced by the pipelines are read as sources and the
+// transformed tables as models.
+type dbtModel struct {
+	sources []string
+	models  []string
+}
+
+// parseDbtManifest returns the models of the manifest keyed by their relation.
+func parseDbtManifest(manifest []byte) (map[string]dbtModel, error) {
+	var parsed dbtManifest
+	if err := json.Unmarshal(manifest, &parsed); err != nil {
+		return nil, errors.Wrap(err, "invalid dbt manifest")
+	}
+
+	if len(parsed.Nodes) == 0 {
+		return nil, errors.New("dbt manifest has no nodes")
+	}
+
+	dbtModels := make(map[string]dbtModel)
+
+	for _, node := range parsed.Nodes {
+		if node.ResourceType != "model" {
+			continue
+		}
+
+		var model dbtModel
+
+		for _, dependency := range node.DependsOn.Nodes {
+			if source, ok := parsed.Sources[dependency]; ok {
+				model.sources = append(model.sources, source.relation())
+			} else if upstream, ok := parsed.Nodes[dependency]; ok && upstream.ResourceType == "model" {
+				model.models = append(model.models, upstream.relation())
+			}
+		}
+
+		dbtModels[node.relation()] = model
+	}
+
+	return dbtModels, nil
+}
+
+// DbtModels validates a dbt manifest and returns the relations of its models.
+func DbtModels(manifest []byte) ([]string, error) {
+	dbtModels, err := parseDbtManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	relations := make([]string, 0, len(dbtModels))
+	for relation := range dbtModels {
+		relations = append(relations, relation)
+	}
+
+	sort.Strings(relations)
+
+	return relations, nil
+}
@@ -0,0 +1,359 @@
+package lineage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+var (
+	ErrNodeNotFound    = errors.New("Lineage node not found")
+	ErrNoColumnLineage = errors.New("Sources have no column lineage")
+)
+
+// Graph is the lineage of a workspace: sources feed the raw tables of the pipelines syncing them, the raw tables of
+// the pipelines of a data product feed its transformed tables and columns flow into the same named columns of the
+// downstream tables. The transformed tables described by the dbt manifest of a product only depend on the relations
+// their model is built from.
+type Graph struct {
+	nodes      map[string]models.LineageNode
+	upstream   map[string][]models.LineageEdge
+	downstream map[string][]models.LineageEdge
+	columns    map[string][]string
+	edges      map[string]bool
+}
+
+func SourceNodeID(sourceID string) string {
+	return fmt.Sprintf("%s:%s", utils.LINEAGE_NODE_SOURCE, sourceID)
+}
+
+func TableNodeID(assetID string) string {
+	return fmt.Sprintf("%s:%s", utils.LINEAGE_NODE_TABLE, assetID)
+}
+
+func ColumnNodeID(assetID string, column string) string {
+	return fmt.Sprintf("%s:%s:%s", utils.LINEAGE_NODE_COLUMN, assetID, column)
+}
+
+// Load builds the lineage graph of the workspace from the store.
+func Load(store db.Store, workspaceID int) (*Graph, error) {
+	sources, err := store.GetLineageSources(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := store.GetLineageTables(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	productPipelines, err := store.GetLineageProductPipelines(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := store.GetLineageColumns(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests, err := store.GetDbtManifests(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGraph(sources, tables, productPipelines, columns, manifests), nil
+}
+
+func NewGraph(sources []models.LineageSource, tables []models.LineageTable, productPipelines []models.ProductsPipelines,
+	columns []models.AssetColumn, manifests []models.DbtManifest) *Graph {
+	logger := utils.GetLogger()
+
+	graph := &Graph{
+		nodes:      make(map[string]models.LineageNode),
+		upstream:   make(map[string][]models.LineageEdge),
+		downstream: make(map[string][]models.LineageEdge),
+		columns:    make(map[string][]string),
+		edges:      make(map[string]bool),
+	}
+
+	rawTables := make(map[string][]models.LineageTable)
+	productTables := make(map[string][]models.LineageTable)
+
+	for _, table := range tables {
+		id := TableNodeID(table.AssetID)
+		if _, ok := graph.nodes[id]; ok {
+			continue
+		}
+
+		graph.nodes[id] = models.LineageNode{
+			ID:        id,
+			Type:      utils.LINEAGE_NODE_TABLE,
+			Name:      table.Name,
+			Table:     physicalTable(table),
+			Schema:    table.Schema,
+			Namespace: namespace(table),
+			AssetID:   table.AssetID,
+			ParentID:  table.ParentID,
+		}
+
+		if table.Raw {
+			rawTables[table.ParentID] = append(rawTables[table.ParentID], table)
+		} else {
+			productTables[table.ParentID] = append(productTables[table.ParentID], table)
+		}
+	}
+
+	for _, source := range sources {
+		id := SourceNodeID(source.SourceID)
+		graph.nodes[id] = models.LineageNode{
+			ID:        id,
+			Type:      utils.LINEAGE_NODE_SOURCE,
+			Name:      source.SourceName,
+			Namespace: utils.LINEAGE_SOURCE_NAMESPACE,
+		}
+
+		for _, table := range rawTables[source.PipelineID] {
+			graph.addEdge(id, TableNodeID(table.AssetID), utils.LINEAGE_ORIGIN_PIPELINE, source.PipelineID)
+		}
+	}
+
+	productRawTables := make(map[string][]models.LineageTable)
+	for _, productPipeline := range productPipelines {
+		productID := productPipeline.ProductID.String()
+		productRawTables[productID] = append(productRawTables[productID], rawTables[productPipeline.PipelineID]...)
+	}
+
+	dbtModels := make(map[string]map[string]dbtModel)
+
+	for _, manifest := range manifests {
+		parsed, err := parseDbtManifest(manifest.Manifest)
+		if err != nil {
+			// a manifest is validated on upload so this is only logged, the product falls back to its pipelines
+			logger.Error(err.Error())
+
+			continue
+		}
+
+		dbtModels[manifest.ProductID.String()] = parsed
+	}
+
+	for productID, transformedTables := range productTables {
+		for _, table := range transformedTables {
+			model, ok := dbtModels[productID][strings.ToLower(table.Name)]
+			if !ok {
+				for _, rawTable := range productRawTables[productID] {
+					graph.addEdge(TableNodeID(rawTable.AssetID), TableNodeID(table.AssetID),
+						utils.LINEAGE_ORIGIN_TRANSFORMATION, productID)
+				}
+
+				continue
+			}
+
+			for _, relation := range model.sources {
+				for _, rawTable := range productRawTables[productID] {
+					if readsRawTable(relation, rawTable) {
+						graph.addEdge(TableNodeID(rawTable.AssetID), TableNodeID(table.AssetID),
+							utils.LINEAGE_ORIGIN_DBT, productID)
+					}
+				}
+			}
+
+			for _, relation := range model.models {
+				for _, upstreamTable := range transformedTables {
+					if strings.ToLower(upstreamTable.Name) == relation {
+						graph.addEdge(TableNodeID(upstreamTable.AssetID), TableNodeID(table.AssetID),
+							utils.LINEAGE_ORIGIN_DBT, productID)
+					}
+				}
+			}
+		}
+	}
+
+	graph.addColumns(columns)
+
+	return graph
+}
+
+// addColumns adds the cataloged columns of the tables and connects the same named columns of the tables linked
+// together.
+func (graph *Graph) addColumns(columns []models.AssetColumn) {
+	for _, column := range columns {
+		table, ok := graph.nodes[TableNodeID(column.AssetID)]
+		if !ok {
+			continue
+		}
+
+		id := ColumnNodeID(column.AssetID, column.Name)
+		graph.nodes[id] = models.LineageNode{
+			ID:        id,
+			Type:      utils.LINEAGE_NODE_COLUMN,
+			Name:      table.Name,
+			Table:     table.Table,
+			Schema:    table.Schema,
+			Namespace: table.Namespace,
+			AssetID:   column.AssetID,
+			Column:    column.Name,
+			DataType:  column.Type,
+			ParentID:  table.ID,
+		}
+		graph.columns[table.ID] = append(graph.columns[table.ID], id)
+	}
+
+	for tableID, tableEdges := range graph.upstream {
+		if graph.nodes[tableID].Type != utils.LINEAGE_NODE_TABLE {
+			continue
+		}
+
+		for _, edge := range tableEdges {
+			upstreamTable := graph.nodes[edge.From]
+			if upstreamTable.Type != utils.LINEAGE_NODE_TABLE {
+				continue
+			}
+
+			for _, columnID := range graph.columns[tableID] {
+				upstreamColumnID := ColumnNodeID(upstreamTable.AssetID, graph.nodes[columnID].Column)
+				if _, ok := graph.nodes[upstreamColumnID]; ok {
+					graph.addEdge(upstreamColumnID, columnID, edge.Origin, edge.JobID)
+				}
+			}
+		}
+	}
+}
+
+func (graph *Graph) addEdge(from string, to string, origin string, jobID string) {
+	key := from + "|" + to
+	if graph.edges[key] {
+		return
+	}
+
+	graph.edges[key] = true
+
+	edge := models.LineageEdge{From: from, To: to, Origin: origin, JobID: jobID}
+	graph.upstream[to] = append(graph.upstream[to], edge)
+	graph.downstream[from] = append(graph.downstream[from], edge)
+}
+
+// Traverse returns the lineage of the root node up to the given depth, 0 following it to its ends. At column
+// granularity the lineage of a table is the lineage of its columns, at table granularity the lineage of a column is
+// the lineage of its table.
+func (graph *Graph) Traverse(root string, direction string, depth int, granularity string) (models.LineageGraph, error) {
+	if direction == "" {
+		direction = utils.LINEAGE_DIRECTION_BOTH
+	}
+
+	if granularity == "" {
+		granularity = utils.LINEAGE_GRANULARITY_TABLE
+	}
+
+	lineage := models.LineageGraph{
+		Root:        root,
+		Direction:   direction,
+		Granularity: granularity,
+		Nodes:       []models.LineageNode{},
+		Edges:       []models.LineageEdge{},
+	}
+
+	rootNode, ok := graph.nodes[root]
+	if !ok {
+		return lineage, ErrNodeNotFound
+	}
+
+	starts := []string{root}
+
+	switch {
+	case granularity == utils.LINEAGE_GRANULARITY_COLUMN && rootNode.Type == utils.LINEAGE_NODE_SOURCE:
+		return lineage, ErrNoColumnLineage
+	case granularity == utils.LINEAGE_GRANULARITY_COLUMN && rootNode.Type == utils.LINEAGE_NODE_TABLE:
+		starts = graph.columns[root]
+	case granularity == utils.LINEAGE_GRANULARITY_TABLE && rootNode.Type == utils.LINEAGE_NODE_COLUMN:
+		starts = []string{rootNode.ParentID}
+	}
+
+	nodes := map[string]bool{root: true}
+	edges := make(map[string]models.LineageEdge)
+
+	for _, start := range starts {
+		nodes[start] = true
+	}
+
+	if direction != utils.LINEAGE_DIRECTION_DOWNSTREAM {
+		graph.walk(starts, depth, graph.upstream, func(edge models.LineageEdge) string { return edge.From }, nodes, edges)
+	}
+
+	if direction != utils.LINEAGE_DIRECTION_UPSTREAM {
+		graph.walk(starts, depth, graph.downstream, func(edge models.LineageEdge) string { return edge.To }, nodes, edges)
+	}
+
+	for id := range nodes {
+		lineage.Nodes = append(lineage.Nodes, graph.nodes[id])
+	}
+
+	for _, edge := range edges {
+		lineage.Edges = append(lineage.Edges, edge)
+	}
+
+	sort.Slice(lineage.Nodes, func(i, j int) bool { return lineage.Nodes[i].ID < lineage.Nodes[j].ID })
+	sort.Slice(lineage.Edges, func(i, j int) bool {
+		if lineage.Edges[i].From == lineage.Edges[j].From {
+			return lineage.Edges[i].To < lineage.Edges[j].To
+		}
+
+		return lineage.Edges[i].From < lineage.Edges[j].From
+	})
+
+	return lineage, nil
+}
+
+// walk follows the edges breadth first from the start nodes, edges between nodes of different types only exist from
+// sources to tables so a walk stays at the granularity of its start.
+func (graph *Graph) walk(starts []string, depth int, adjacent map[string][]models.LineageEdge,
+	next func(edge models.LineageEdge) string, nodes map[string]bool, edges map[string]models.LineageEdge) {
+	visited := make(map[string]bool)
+	frontier := starts
+
+	for level := 0; len(frontier) > 0 && (depth == 0 || level < depth); level++ {
+		var nextFrontier []string
+
+		for _, id := range frontier {
+			visited[id] = true
+
+			for _, edge := range adjacent[id] {
+				edges[edge.From+"|"+edge.To] = edge
+				nodes[next(edge)] = true
+
+				if !visited[next(edge)] {
+					visited[next(edge)] = true
+					nextFrontier = append(nextFrontier, next(edge))
+				}
+			}
+		}
+
+		frontier = nextFrontier
+	}
+}
+
+// physicalTable returns the table holding the asset, airbyte prefixes the raw tables it syncs.
+func physicalTable(table models.LineageTable) string {
+	if !table.Raw {
+		return table.Name
+	}
+
+	return fmt.Sprintf("%s_%s%s", utils.AIRBYTE_DEFAULT_PREFIX, table.Prefix, table.Name)
+}
+
+func namespace(table models.LineageTable) string {
+	return fmt.Sprintf("%s://%s:%s/%s", strings.ToLower(table.DestinationType), table.Host, table.Port, table.Database)
+}
+
+// readsRawTable tells whether a dbt source is the raw table of a stream or the table airbyte normalized it to.
+func readsRawTable(relation string, table models.LineageTable) bool {
+	name := strings.ToLower(table.Prefix + table.Name)
+
+	return relation == name || relation == strings.ToLower(table.Name) || relation == strings.ToLower(physicalTable(table))
+}
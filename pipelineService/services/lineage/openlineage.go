@@ -0,0 +1,147 @@
+package lineage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// OpenLineage exports the table lineage as OpenLineage run events, one for every table a job writes. The pipelines
+// and the transformations of the data products are the jobs, the outputs carry their schema and the column lineage
+// of the graph.
+func (graph *Graph) OpenLineage(lineage models.LineageGraph, eventTime time.Time) []models.OpenLineageRunEvent {
+	type run struct {
+		jobID  string
+		origin string
+		output string
+		inputs []string
+	}
+
+	runs := make(map[string]*run)
+
+	for _, edge := range lineage.Edges {
+		if graph.nodes[edge.To].Type != utils.LINEAGE_NODE_TABLE {
+			continue
+		}
+
+		key := edge.JobID + "|" + edge.To
+		if _, ok := runs[key]; !ok {
+			runs[key] = &run{jobID: edge.JobID, origin: edge.Origin, output: edge.To}
+		}
+
+		runs[key].inputs = append(runs[key].inputs, edge.From)
+	}
+
+	events := make([]models.OpenLineageRunEvent, 0, len(runs))
+
+	for key, jobRun := range runs {
+		jobName := fmt.Sprintf("data-product.%s", jobRun.jobID)
+		if jobRun.origin == utils.LINEAGE_ORIGIN_PIPELINE {
+			jobName = fmt.Sprintf("pipeline.%s", jobRun.jobID)
+		}
+
+		inputs := make([]models.OpenLineageDataset, 0, len(jobRun.inputs))
+		for _, input := range jobRun.inputs {
+			inputs = append(inputs, dataset(graph.nodes[input]))
+		}
+
+		sort.Slice(inputs, func(i, j int) bool { return inputs[i].Name < inputs[j].Name })
+
+		events = append(events, models.OpenLineageRunEvent{
+			EventType: "COMPLETE",
+			EventTime: eventTime.UTC().Format(time.RFC3339),
+			Run:       models.OpenLineageRun{RunID: uuid.NewV5(uuid.NamespaceOID, key).String()},
+			Job:       models.OpenLineageJob{Namespace: utils.OPENLINEAGE_NAMESPACE, Name: jobName},
+			Inputs:    inputs,
+			Outputs:   []models.OpenLineageDataset{graph.outputDataset(jobRun.output, jobRun.inputs)},
+			Producer:  utils.OPENLINEAGE_PRODUCER,
+			SchemaURL: utils.OPENLINEAGE_RUN_EVENT_SCHEMA,
+		})
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Job.Name == events[j].Job.Name {
+			return events[i].Outputs[0].Name < events[j].Outputs[0].Name
+		}
+
+		return events[i].Job.Name < events[j].Job.Name
+	})
+
+	return events
+}
+
+// outputDataset describes the table written by a run with its columns and where they were read from.
+func (graph *Graph) outputDataset(tableID string, inputs []string) models.OpenLineageDataset {
+	output := dataset(graph.nodes[tableID])
+
+	columnIDs := graph.columns[tableID]
+	if len(columnIDs) == 0 {
+		return output
+	}
+
+	readTables := make(map[string]bool)
+	for _, input := range inputs {
+		readTables[input] = true
+	}
+
+	fields := make([]map[string]string, 0, len(columnIDs))
+	columnLineage := make(map[string]interface{})
+
+	for _, columnID := range columnIDs {
+		column := graph.nodes[columnID]
+		fields = append(fields, map[string]string{"name": column.Column, "type": column.DataType})
+
+		inputFields := make([]map[string]string, 0)
+
+		for _, edge := range graph.upstream[columnID] {
+			inputColumn := graph.nodes[edge.From]
+			if !readTables[inputColumn.ParentID] {
+				continue
+			}
+
+			inputFields = append(inputFields, map[string]string{
+				"namespace": inputColumn.Namespace,
+				"name":      datasetName(inputColumn),
+				"field":     inputColumn.Column,
+			})
+		}
+
+		if len(inputFields) > 0 {
+			columnLineage[column.Column] = map[string]interface{}{"inputFields": inputFields}
+		}
+	}
+
+	output.Facets = map[string]interface{}{
+		"schema": map[string]interface{}{
+			"_producer":  utils.OPENLINEAGE_PRODUCER,
+			"_schemaURL": utils.OPENLINEAGE_SCHEMA_FACET,
+			"fields":     fields,
+		},
+	}
+
+	if len(columnLineage) > 0 {
+		output.Facets["columnLineage"] = map[string]interface{}{
+			"_producer":  utils.OPENLINEAGE_PRODUCER,
+			"_schemaURL": utils.OPENLINEAGE_COLUMN_LINEAGE_FACET,
+			"fields":     columnLineage,
+		}
+	}
+
+	return output
+}
+
+func dataset(node models.LineageNode) models.OpenLineageDataset {
+	return models.OpenLineageDataset{Namespace: node.Namespace, Name: datasetName(node)}
+}
+
+func datasetName(node models.LineageNode) string {
+	if node.Type == utils.LINEAGE_NODE_SOURCE {
+		return node.Name
+	}
+
+	return fmt.Sprintf("%s.%s", node.Schema, node.Table)
+}
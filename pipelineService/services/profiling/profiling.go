@@ -0,0 +1,92 @@
+package profiling
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/services/destinationPool"
+	"pipelineService/utils"
+)
+
+// ProfileAsset computes the statistics of the cataloged columns of an asset on its destination and stores them as
+// the latest profile of the asset.
+func ProfileAsset(store db.Store, assetID uuid.UUID) (models.AssetProfile, error) {
+	table, err := store.GetAssetTable(assetID)
+	if err != nil {
+		return models.AssetProfile{}, err
+	}
+
+	columns, err := store.GetAssetColumns(assetID)
+	if err != nil {
+		return models.AssetProfile{}, err
+	}
+
+	profile, err := profileTable(store, assetID, table, columns, nil)
+	if err != nil {
+		return models.AssetProfile{}, err
+	}
+
+	return store.CreateAssetProfile(profile)
+}
+
+// ProfileFilteredAsset computes the statistics of the given columns of an asset on the rows matched by the filters,
+// the profile isn't stored since it only holds for the callers the filters are enforced on.
+func ProfileFilteredAsset(store db.Store, assetID uuid.UUID, columns []models.AssetColumn, filters []models.PreviewFilter) (models.AssetProfile, error) {
+	table, err := store.GetAssetTable(assetID)
+	if err != nil {
+		return models.AssetProfile{}, err
+	}
+
+	return profileTable(store, assetID, table, columns, filters)
+}
+
+func profileTable(store db.Store, assetID uuid.UUID, table models.AssetTable, columns []models.AssetColumn, filters []models.PreviewFilter) (models.AssetProfile, error) {
+	dbConn, err := destinationPool.AssetPostgres(table)
+	if err != nil {
+		return models.AssetProfile{}, err
+	}
+
+	tableProfile, err := store.ProfileTable(dbConn, table, columns, filters)
+	if err != nil {
+		return models.AssetProfile{}, err
+	}
+
+	columnProfiles, err := json.Marshal(tableProfile.Columns)
+	if err != nil {
+		return models.AssetProfile{}, err
+	}
+
+	return models.AssetProfile{
+		AssetID:    assetID.String(),
+		RowCount:   tableProfile.RowCount,
+		Columns:    columnProfiles,
+		ProfiledAt: time.Now().UnixMilli(),
+	}, nil
+}
+
+// ProfileAssets profiles the assets one after the other, a failure is logged and doesn't stop the other assets.
+func ProfileAssets(store db.Store, assetIDs []string) {
+	logger := utils.GetLogger()
+
+	for _, id := range assetIDs {
+		assetID, err := uuid.FromString(id)
+		if err == nil {
+			_, err = ProfileAsset(store, assetID)
+		}
+
+		// the assets of the destinations which can only be previewed are skipped on every sync
+		if errors.Is(err, destinationPool.ErrUnsupportedDestination) {
+			logger.Info(err.Error())
+
+			continue
+		}
+
+		if err != nil {
+			logger.Error(err.Error())
+		}
+	}
+}
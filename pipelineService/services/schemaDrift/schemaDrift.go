@@ -0,0 +1,155 @@
+package schemaDrift
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"pipelineService/models/v1"
+	"pipelineService/utils"
+)
+
+// streamKey identifies a stream by its namespace and name, the same stream name can exist in multiple namespaces.
+func streamKey(stream models.Stream) string {
+	if stream.Namespace == nil || fmt.Sprint(stream.Namespace) == "" {
+		return stream.Name
+	}
+
+	return fmt.Sprintf("%v.%s", stream.Namespace, stream.Name)
+}
+
+// fieldTypes returns the types of the top level properties of a stream's JSON schema, nullability is ignored
+// as sources mark most of the columns nullable.
+func fieldTypes(jsonSchema interface{}) map[string]string {
+	types := make(map[string]string)
+
+	for _, field := range utils.JSONSchemaFields(jsonSchema) {
+		types[field.Name] = field.Type
+	}
+
+	return types
+}
+
+// DiffCatalogs compares the catalog the connection is configured with against the discovered one. It returns the
+// changes along with the catalog to apply, which keeps the configuration of the existing streams and adds the new
+// streams unselected.
+func DiffCatalogs(configured models.SyncCatalog, discovered models.SyncCatalog) ([]models.SchemaFieldChange, models.SyncCatalog) {
+	changes := make([]models.SchemaFieldChange, 0)
+	proposed := models.SyncCatalog{Streams: make([]models.Streams, 0, len(discovered.Streams))}
+
+	configuredStreams := make(map[string]models.Streams)
+	for _, stream := range configured.Streams {
+		configuredStreams[streamKey(stream.Stream)] = stream
+	}
+
+	discoveredStreams := make(map[string]bool)
+
+	for _, stream := range discovered.Streams {
+		key := streamKey(stream.Stream)
+		discoveredStreams[key] = true
+
+		configuredStream, ok := configuredStreams[key]
+		if !ok {
+			changes = append(changes, models.SchemaFieldChange{Stream: key, ChangeType: utils.SCHEMA_CHANGE_STREAM_ADDED})
+
+			stream.Config.Selected = false
+			proposed.Streams = append(proposed.Streams, stream)
+
+			continue
+		}
+
+		changes = append(changes, diffFields(key, configuredStream.Stream.JsonSchema, stream.Stream.JsonSchema)...)
+		proposed.Streams = append(proposed.Streams, models.Streams{Stream: stream.Stream, Config: configuredStream.Config})
+	}
+
+	for _, stream := range configured.Streams {
+		key := streamKey(stream.Stream)
+		if !discoveredStreams[key] {
+			changes = append(changes, models.SchemaFieldChange{Stream: key, ChangeType: utils.SCHEMA_CHANGE_STREAM_REMOVED})
+		}
+	}
+
+	return changes, proposed
+}
+
+// Fingerprint identifies a drift by its changes whatever the order the streams are discovered in, a drift with the
+// fingerprint of a rejected change was already reviewed.
+func Fingerprint(changes []models.SchemaFieldChange) string {
+	sorted := append([]models.SchemaFieldChange(nil), changes...)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Stream != sorted[j].Stream {
+			return sorted[i].Stream < sorted[j].Stream
+		}
+
+		if sorted[i].Field != sorted[j].Field {
+			return sorted[i].Field < sorted[j].Field
+		}
+
+		return sorted[i].ChangeType < sorted[j].ChangeType
+	})
+
+	changesJSON, _ := json.Marshal(sorted)
+	sum := sha256.Sum256(changesJSON)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func diffFields(stream string, configuredSchema interface{}, discoveredSchema interface{}) []models.SchemaFieldChange {
+	changes := make([]models.SchemaFieldChange, 0)
+
+	configuredTypes := fieldTypes(configuredSchema)
+	discoveredTypes := fieldTypes(discoveredSchema)
+
+	fields := make([]string, 0, len(discoveredTypes))
+	for field := range discoveredTypes {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		oldType, ok := configuredTypes[field]
+
+		switch {
+		case !ok:
+			changes = append(changes, models.SchemaFieldChange{
+				Stream: stream, Field: field, ChangeType: utils.SCHEMA_CHANGE_FIELD_ADDED, NewType: discoveredTypes[field]})
+		case oldType != discoveredTypes[field]:
+			changes = append(changes, models.SchemaFieldChange{
+				Stream: stream, Field: field, ChangeType: utils.SCHEMA_CHANGE_TYPE_CHANGED, OldType: oldType, NewType: discoveredTypes[field]})
+		}
+	}
+
+	fields = fields[:0]
+	for field := range configuredTypes {
+		if _, ok := discoveredTypes[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		changes = append(changes, models.SchemaFieldChange{
+			Stream: stream, Field: field, ChangeType: utils.SCHEMA_CHANGE_FIELD_REMOVED, OldType: configuredTypes[field]})
+	}
+
+	return changes
+}
+
+// ToSyncCatalog converts the discovered catalog into the catalog model used by the connections.
+func ToSyncCatalog(sourceSchema models.SourceSchema) (models.SyncCatalog, error) {
+	var catalog models.SyncCatalog
+
+	data, err := json.Marshal(sourceSchema.Catalog)
+	if err != nil {
+		return catalog, err
+	}
+
+	err = json.Unmarshal(data, &catalog)
+
+	return catalog, err
+}
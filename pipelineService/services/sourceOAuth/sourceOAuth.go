@@ -0,0 +1,288 @@
+package sourceOAuth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"pipelineService/clients/airbyte"
+	"pipelineService/env"
+	"pipelineService/models/v1"
+	"pipelineService/services/db"
+	"pipelineService/utils"
+)
+
+const authTypeOAuth2 = "oauth2.0"
+
+// SupportsOAuth tells whether the source definition authenticates through an OAuth consent flow.
+func SupportsOAuth(spec models.SourceSpecification) bool {
+	advancedAuth, authSpecification := decodeAuth(spec)
+
+	return advancedAuth.AuthFlowType == authTypeOAuth2 || authSpecification.AuthType == authTypeOAuth2
+}
+
+// AllowedRedirect tells whether the redirect url of a consent is on one of the allowed frontend origins, the callback
+// redirects the browser there once the consent completes.
+func AllowedRedirect(redirectURL string) bool {
+	parsedURL, err := url.Parse(redirectURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" ||
+		parsedURL.User != nil {
+		return false
+	}
+
+	origin := strings.ToLower(parsedURL.Scheme + "://" + parsedURL.Host)
+
+	for _, allowedOrigin := range env.Env.OAuthAllowedOrigins {
+		if strings.ToLower(strings.TrimRight(strings.TrimSpace(allowedOrigin), "/")) == origin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConsentState returns the state the provider sends back to the callback, it identifies the flow of the consent url.
+func ConsentState(consentURL string) (string, error) {
+	parsedURL, err := url.Parse(consentURL)
+	if err != nil {
+		return "", err
+	}
+
+	state := parsedURL.Query().Get("state")
+	if state == "" {
+		return "", errors.New("the consent url has no state")
+	}
+
+	return state, nil
+}
+
+// InjectTokens writes the output of a completed flow into the configuration of the source. The advanced auth of the
+// specification names the path of each token, the legacy auth specification nests them all under its root object
+// and a specification naming neither takes the tokens at the top level.
+func InjectTokens(spec models.SourceSpecification, configuration interface{},
+	output map[string]interface{}) map[string]interface{} {
+	injected := make(map[string]interface{})
+
+	if existing, ok := configuration.(map[string]interface{}); ok {
+		for key, value := range existing {
+			injected[key] = value
+		}
+	}
+
+	advancedAuth, authSpecification := decodeAuth(spec)
+
+	if paths := outputPaths(advancedAuth); len(paths) > 0 {
+		if len(advancedAuth.PredicateKey) > 0 {
+			setPath(injected, advancedAuth.PredicateKey, advancedAuth.PredicateValue)
+		}
+
+		for key, value := range output {
+			if path, ok := paths[key]; ok {
+				setPath(injected, path, value)
+			}
+		}
+
+		return injected
+	}
+
+	if authSpecification.AuthType == authTypeOAuth2 {
+		root := make([]string, 0, len(authSpecification.Oauth2Specification.RootObject))
+
+		for _, element := range authSpecification.Oauth2Specification.RootObject {
+			// the index of a oneOf variant isn't part of the configuration path
+			if name, ok := element.(string); ok {
+				root = append(root, name)
+			}
+		}
+
+		for _, parameter := range authSpecification.Oauth2Specification.OauthFlowOutputParameters {
+			if len(parameter) == 0 {
+				continue
+			}
+
+			if value, ok := output[parameter[len(parameter)-1]]; ok {
+				setPath(injected, append(append([]string{}, root...), parameter...), value)
+			}
+		}
+
+		return injected
+	}
+
+	for key, value := range output {
+		injected[key] = value
+	}
+
+	return injected
+}
+
+// Complete exchanges the query params of the callback for the tokens of the flow. The tokens of a new source are
+// kept on the flow until the source is configured with them, while an existing source is authorized again right away.
+// A refused consent fails the flow without returning an error, only failing to record the flow does.
+func Complete(store db.Store, querier airbyte.AirByteQuerier, flow models.OAuthFlow,
+	queryParams map[string]interface{}) (models.OAuthFlow, error) {
+	fromStatus := flow.Status
+
+	inputConfiguration := make(map[string]interface{})
+	_ = json.Unmarshal(flow.OAuthInputConfiguration, &inputConfiguration)
+
+	output, err := querier.CompleteSourceOAuth(models.SourceOAuthRequestAirbyte{
+		SourceDefinitionID:      flow.SourceDefinitionID,
+		WorkspaceID:             flow.AirbyteWorkspaceID,
+		RedirectURL:             env.Env.OAuthRedirectURL,
+		QueryParams:             queryParams,
+		OAuthInputConfiguration: inputConfiguration,
+	})
+	if err == nil && flow.SourceID != nil {
+		err = reauthorize(store, querier, *flow.SourceID, output)
+	}
+
+	flow.CompletedAt = time.Now().UnixMilli()
+
+	switch {
+	case err != nil:
+		flow.Status = utils.OAUTH_FLOW_STATUS_FAILED
+		flow.Error = err.Error()
+	case flow.SourceID != nil:
+		flow.Status = utils.OAUTH_FLOW_STATUS_CONSUMED
+	default:
+		flow.Status = utils.OAUTH_FLOW_STATUS_COMPLETED
+		flow.Output, _ = json.Marshal(output)
+	}
+
+	return flow, store.UpdateOAuthFlow(flow, fromStatus)
+}
+
+// CheckTokens checks the connection of the sources authorized through OAuth, a failing check means their tokens can
+// no longer be refreshed and is recorded until the source connects again.
+func CheckTokens(store db.Store, querier airbyte.AirByteQuerier) (models.OAuthTokenCheckReport, error) {
+	report := models.OAuthTokenCheckReport{Failed: make([]string, 0)}
+
+	sources, err := store.GetOAuthSources()
+	if err != nil {
+		return report, err
+	}
+
+	for _, source := range sources {
+		configuredSource, err := querier.GetConfiguredSource(source.AirbyteSourceID)
+		if err != nil {
+			return report, err
+		}
+
+		report.Checked++
+
+		checkErr := querier.CheckSourceConnection(map[string]interface{}{
+			"sourceDefinitionId":      source.AirbyteSourceDefinitionID,
+			"connectionConfiguration": configuredSource.ConnectionConfiguration,
+		})
+		if checkErr == nil {
+			if err = store.ResolveOAuthTokenFailures(source.SourceID); err != nil {
+				return report, err
+			}
+
+			continue
+		}
+
+		report.Failed = append(report.Failed, source.SourceID)
+
+		_, err = store.SaveOAuthTokenFailure(models.OAuthTokenFailure{
+			SourceID:    source.SourceID,
+			Error:       checkErr.Error(),
+			WorkspaceID: source.WorkspaceID,
+			DetectedAt:  time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+func reauthorize(store db.Store, querier airbyte.AirByteQuerier, sourceID string, output map[string]interface{}) error {
+	source, err := store.GetSource(sourceID)
+	if err != nil {
+		return err
+	}
+
+	configuredSource, err := querier.GetConfiguredSource(source.AirbyteSourceID)
+	if err != nil {
+		return err
+	}
+
+	spec, err := querier.GetSourceSpecification(source.AirbyteSourceDefinitionID)
+	if err != nil {
+		return err
+	}
+
+	_, err = querier.EditSourceConnectorOnAirByte(models.EditSourceConnectorRequestAirByte{
+		AirByteSourceID:         source.AirbyteSourceID,
+		ConnectionConfiguration: InjectTokens(spec, configuredSource.ConnectionConfiguration, output),
+		Name:                    source.SourceName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return store.ResolveOAuthTokenFailures(source.SourceID)
+}
+
+func decodeAuth(spec models.SourceSpecification) (models.AdvancedAuth, models.AuthSpecification) {
+	advancedAuth := models.AdvancedAuth{}
+	authSpecification := models.AuthSpecification{}
+
+	if data, err := json.Marshal(spec.AdvancedAuth); err == nil {
+		_ = json.Unmarshal(data, &advancedAuth)
+	}
+
+	if data, err := json.Marshal(spec.AuthSpecification); err == nil {
+		_ = json.Unmarshal(data, &authSpecification)
+	}
+
+	return advancedAuth, authSpecification
+}
+
+// outputPaths returns the path_in_connector_config of the properties of the complete OAuth output specification.
+func outputPaths(advancedAuth models.AdvancedAuth) map[string][]string {
+	paths := make(map[string][]string)
+
+	outputSpec, _ := advancedAuth.OauthConfigSpecification.CompleteOAuthOutputSpecification.(map[string]interface{})
+	properties, _ := outputSpec["properties"].(map[string]interface{})
+
+	for key, property := range properties {
+		propertySpec, _ := property.(map[string]interface{})
+		elements, _ := propertySpec["path_in_connector_config"].([]interface{})
+
+		path := make([]string, 0, len(elements))
+
+		for _, element := range elements {
+			if name, ok := element.(string); ok {
+				path = append(path, name)
+			}
+		}
+
+		if len(path) > 0 {
+			paths[key] = path
+		}
+	}
+
+	return paths
+}
+
+// setPath sets the value under the nested path of the configuration, creating the missing objects on the way.
+func setPath(configuration map[string]interface{}, path []string, value interface{}) {
+	current := configuration
+
+	for _, key := range path[:len(path)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			current[key] = next
+		}
+
+		current = next
+	}
+
+	current[path[len(path)-1]] = value
+}
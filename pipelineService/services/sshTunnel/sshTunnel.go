@@ -0,0 +1,251 @@
+package sshTunnel
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tidwall/gjson"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"pipelineService/utils"
+)
+
+// dialTimeout bounds the SSH handshake with the bastion host.
+const dialTimeout = 15 * time.Second
+
+// Tunnel forwards the connections accepted on a local port to a destination through the bastion host of its
+// tunnel_method, the drivers connect to the address of the tunnel like they would to the destination. The destinations
+// without a tunnel are connected to directly.
+type Tunnel struct {
+	addr     string
+	remote   string
+	bastion  string
+	config   *ssh.ClientConfig
+	listener net.Listener
+
+	mutex  sync.Mutex
+	client *ssh.Client
+	closed bool
+}
+
+// Open opens the tunnel to the host and port of a destination with the tunnel_method of its configuration, the
+// host key and the credentials of the bastion host are checked before the tunnel is returned.
+func Open(configuration string, host string, port string) (*Tunnel, error) {
+	remote := net.JoinHostPort(host, port)
+
+	method := gjson.Get(configuration, "tunnel_method.tunnel_method").String()
+	if method == "" || method == utils.TUNNEL_METHOD_NONE {
+		return &Tunnel{addr: remote}, nil
+	}
+
+	config, err := clientConfig(configuration, method)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnelPort := gjson.Get(configuration, "tunnel_method.tunnel_port").String()
+	if tunnelPort == "" {
+		tunnelPort = utils.TUNNEL_DEFAULT_PORT
+	}
+
+	tunnel := &Tunnel{
+		remote:  remote,
+		bastion: net.JoinHostPort(gjson.Get(configuration, "tunnel_method.tunnel_host").String(), tunnelPort),
+		config:  config,
+	}
+
+	tunnel.client, err = ssh.Dial("tcp", tunnel.bastion, config)
+	if err != nil {
+		return nil, fmt.Errorf("ssh tunnel to %s failed: %w", tunnel.bastion, err)
+	}
+
+	tunnel.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tunnel.client.Close()
+
+		return nil, err
+	}
+
+	tunnel.addr = tunnel.listener.Addr().String()
+
+	go tunnel.serve()
+
+	return tunnel, nil
+}
+
+// clientConfig authenticates with the private key or the password of the tunnel user.
+func clientConfig(configuration string, method string) (*ssh.ClientConfig, error) {
+	var auth ssh.AuthMethod
+
+	switch method {
+	case utils.TUNNEL_METHOD_SSH_KEY:
+		signer, err := ssh.ParsePrivateKey([]byte(gjson.Get(configuration, "tunnel_method.ssh_key").String()))
+		if err != nil {
+			return nil, fmt.Errorf("ssh key of the tunnel is invalid: %w", err)
+		}
+
+		auth = ssh.PublicKeys(signer)
+	case utils.TUNNEL_METHOD_SSH_PASSWORD:
+		auth = ssh.Password(gjson.Get(configuration, "tunnel_method.tunnel_user_password").String())
+	default:
+		return nil, fmt.Errorf("tunnel method %s is not supported", method)
+	}
+
+	hostKeyCallback, err := hostKeyCallback(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssh.ClientConfig{
+		User:            gjson.Get(configuration, "tunnel_method.tunnel_user").String(),
+		Auth:            []ssh.AuthMethod{auth},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         dialTimeout,
+	}, nil
+}
+
+// hostKeyCallback verifies the bastion host against the public key of its host_key or the entries of its
+// known_hosts. A tunnel with neither is refused unless skip_host_key_verification explicitly opts out of the check.
+func hostKeyCallback(configuration string) (ssh.HostKeyCallback, error) {
+	if hostKey := gjson.Get(configuration, "tunnel_method.host_key").String(); hostKey != "" {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+		if err != nil {
+			return nil, fmt.Errorf("host key of the tunnel is invalid: %w", err)
+		}
+
+		return ssh.FixedHostKey(key), nil
+	}
+
+	if knownHosts := gjson.Get(configuration, "tunnel_method.known_hosts").String(); knownHosts != "" {
+		return knownHostsCallback(knownHosts)
+	}
+
+	if gjson.Get(configuration, "tunnel_method.skip_host_key_verification").Bool() {
+		utils.GetLogger().Warn("the host key of the ssh tunnel is not verified")
+
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	return nil, errors.New("ssh tunnel has no host key, set the host key or the known hosts of the bastion host")
+}
+
+// knownHostsCallback parses the known_hosts entries of the tunnel, knownhosts only reads them from a file which is
+// removed once they are loaded.
+func knownHostsCallback(knownHosts string) (ssh.HostKeyCallback, error) {
+	file, err := os.CreateTemp("", "known_hosts")
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(knownHosts)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	callback, err := knownhosts.New(file.Name())
+	if err != nil {
+		return nil, fmt.Errorf("known hosts of the tunnel are invalid: %w", err)
+	}
+
+	return callback, nil
+}
+
+// Addr returns the address the drivers connect to, the local end of the tunnel or the destination itself.
+func (t *Tunnel) Addr() string {
+	return t.addr
+}
+
+// Close stops the tunnel, the connections forwarded through it are closed with the SSH session.
+func (t *Tunnel) Close() error {
+	if t.listener == nil {
+		return nil
+	}
+
+	err := t.listener.Close()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.closed = true
+
+	if closeErr := t.client.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+func (t *Tunnel) serve() {
+	for {
+		local, err := t.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go t.forward(local)
+	}
+}
+
+// forward copies the traffic of a local connection both ways until either end closes it.
+func (t *Tunnel) forward(local net.Conn) {
+	defer local.Close()
+
+	remote, err := t.dial()
+	if err != nil {
+		utils.GetLogger().Error(err.Error())
+
+		return
+	}
+
+	defer remote.Close()
+
+	done := make(chan struct{}, 2)
+
+	go func() {
+		_, _ = io.Copy(remote, local)
+		done <- struct{}{}
+	}()
+
+	go func() {
+		_, _ = io.Copy(local, remote)
+		done <- struct{}{}
+	}()
+
+	<-done
+}
+
+// dial opens a channel to the destination, the SSH session is opened again once when the bastion dropped it.
+func (t *Tunnel) dial() (net.Conn, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.closed {
+		return nil, errors.New("ssh tunnel is closed")
+	}
+
+	conn, err := t.client.Dial("tcp", t.remote)
+	if err == nil {
+		return conn, nil
+	}
+
+	client, dialErr := ssh.Dial("tcp", t.bastion, t.config)
+	if dialErr != nil {
+		return nil, fmt.Errorf("ssh tunnel to %s failed: %w", t.bastion, dialErr)
+	}
+
+	t.client.Close()
+	t.client = client
+
+	return t.client.Dial("tcp", t.remote)
+}
@@ -0,0 +1,40 @@
+package utils
+
+import "encoding/json"
+
+// DecodeAirbyteData returns the record of a raw Airbyte table row, the synced columns are stored as a JSON object in
+// its data column.
+func DecodeAirbyteData(row map[string]interface{}) (map[string]interface{}, bool) {
+	var data map[string]interface{}
+
+	switch value := row[AIRBYTE_DATA_COLUMN].(type) {
+	case map[string]interface{}:
+		return value, true
+	case string:
+		if err := json.Unmarshal([]byte(value), &data); err != nil {
+			return nil, false
+		}
+	case []byte:
+		if err := json.Unmarshal(value, &data); err != nil {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	return data, true
+}
+
+// EncodeAirbyteData writes the record back into the data column of the row, keeping the representation it was read in.
+func EncodeAirbyteData(row map[string]interface{}, data map[string]interface{}) {
+	switch row[AIRBYTE_DATA_COLUMN].(type) {
+	case map[string]interface{}:
+		row[AIRBYTE_DATA_COLUMN] = data
+	case string:
+		encoded, _ := json.Marshal(data)
+		row[AIRBYTE_DATA_COLUMN] = string(encoded)
+	case []byte:
+		encoded, _ := json.Marshal(data)
+		row[AIRBYTE_DATA_COLUMN] = encoded
+	}
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// secretKeys are the key fragments of the connector configurations and request bodies which hold credentials.
+var secretKeys = []string{
+	"password", "secret", "token", "api_key", "apikey", "access_key", "private_key", "ssh_key", "credentials",
+	"client_key",
+}
+
+// RedactSecrets replaces the values of all the secret keys of a JSON document, at any depth, with REDACTED_VALUE. The
+// string values holding JSON documents, like the configurations of the connectors stored as text, are decoded and
+// redacted as well.
+func RedactSecrets(document []byte) []byte {
+	if len(document) == 0 {
+		return document
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(document, &value); err != nil {
+		return nil
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return nil
+	}
+
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if isSecretKey(key) {
+				v[key] = REDACTED_VALUE
+
+				continue
+			}
+
+			v[key] = redactValue(nested)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	case string:
+		if decoded, ok := DecodeJSONDocument(v); ok {
+			return redactValue(decoded)
+		}
+	}
+
+	return value
+}
+
+// DecodeJSONDocument decodes a JSON object or array held by a string, the other strings aren't documents.
+func DecodeJSONDocument(text string) (interface{}, bool) {
+	trimmed := strings.TrimSpace(text)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return nil, false
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(trimmed), &decoded); err != nil {
+		return nil, false
+	}
+
+	return decoded, true
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, secretKey := range secretKeys {
+		if strings.Contains(key, secretKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DiffDocuments returns only the top level keys of the two JSON objects whose values differ.
+// Documents which are not JSON objects are returned as they are.
+func DiffDocuments(before []byte, after []byte) ([]byte, []byte) {
+	var beforeObject, afterObject map[string]interface{}
+
+	if json.Unmarshal(before, &beforeObject) != nil || json.Unmarshal(after, &afterObject) != nil ||
+		beforeObject == nil || afterObject == nil {
+		return before, after
+	}
+
+	beforeDiff := make(map[string]interface{})
+	afterDiff := make(map[string]interface{})
+
+	for key, value := range beforeObject {
+		if afterValue, ok := afterObject[key]; !ok || !reflect.DeepEqual(value, afterValue) {
+			beforeDiff[key] = value
+		}
+	}
+
+	for key, value := range afterObject {
+		if beforeValue, ok := beforeObject[key]; !ok || !reflect.DeepEqual(value, beforeValue) {
+			afterDiff[key] = value
+		}
+	}
+
+	beforeDocument, _ := json.Marshal(beforeDiff)
+	afterDocument, _ := json.Marshal(afterDiff)
+
+	return beforeDocument, afterDocument
+}
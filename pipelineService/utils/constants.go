@@ -7,13 +7,176 @@ const (
 	AIRBYTE_DEFAULT_NAMESPACE_DEFINITION = "customformat"
 	AIRBYTE_DEFAULT_NAMESPACE_FORMAT     = "${SOURCE_NAMESPACE}"
 	AIRBYTE_DEFAULT_PREFIX               = "_airbyte_raw"
+	AIRBYTE_DATA_COLUMN                  = "_airbyte_data"
+	AIRBYTE_AB_ID_COLUMN                 = "_airbyte_ab_id"
+	AIRBYTE_EMITTED_AT_COLUMN            = "_airbyte_emitted_at"
 	AIRBYTE_DEFAULT_STATUS               = "active"
+	AIRBYTE_INACTIVE_STATUS              = "inactive"
 	AIRBYTE_CSV_DESTINATION              = "Local CSV"
 
 	SYNC             = "sync"
 	RESET_CONNECTION = "reset_connection"
 
+	AIRBYTE_JOB_STATUS_PENDING   = "pending"
+	AIRBYTE_JOB_STATUS_RUNNING   = "running"
+	AIRBYTE_JOB_STATUS_FAILED    = "failed"
+	AIRBYTE_JOB_STATUS_CANCELLED = "cancelled"
+	AIRBYTE_JOB_STATUS_SUCCEEDED = "succeeded"
+
+	PIPELINE_OPERATION_CANCEL = "cancel"
+	PIPELINE_OPERATION_RETRY  = "retry"
+	PIPELINE_OPERATION_RESET  = "reset"
+
+	PIPELINE_STATUS_SYNC_CANCELLED    = "Sync Cancelled"
+	PIPELINE_STATUS_SYNC_RETRYING     = "Sync Retry In-Progress"
+	PIPELINE_STATUS_RESET_IN_PROGRESS = "Reset In-Progress"
+
 	PREVIEW_DATA_LIMIT = 10
+	// PREVIEW_CACHE_MAX_ENTRIES bounds the results of previews cached when the preview cache is on
+	PREVIEW_CACHE_MAX_ENTRIES = 500
+
+	DICTIONARY_FORMAT_MARKDOWN = "markdown"
+	DICTIONARY_FORMAT_CSV      = "csv"
+
+	EXPORT_FORMAT_CSV       = "csv"
+	EXPORT_FORMAT_JSONL     = "jsonl"
+	EXPORT_FORMAT_PARQUET   = "parquet"
+	EXPORT_STATUS_PENDING   = "pending"
+	EXPORT_STATUS_COMPLETED = "completed"
+	EXPORT_STATUS_FAILED    = "failed"
+	EXPORT_STATUS_EXPIRED   = "expired"
+
+	PROFILE_TOP_VALUES = 5
+
+	QUALITY_CHECK_NOT_NULL        = "not_null"
+	QUALITY_CHECK_UNIQUE          = "unique"
+	QUALITY_CHECK_ACCEPTED_VALUES = "accepted_values"
+	QUALITY_CHECK_RANGE           = "range"
+	QUALITY_CHECK_REGEX           = "regex"
+	QUALITY_CHECK_ROW_COUNT_DELTA = "row_count_delta"
+	QUALITY_CHECK_FRESHNESS       = "freshness"
+	QUALITY_SEVERITY_WARNING      = "warning"
+	QUALITY_SEVERITY_ERROR        = "error"
+	QUALITY_RESULTS_LIMIT         = 100
+
+	DATA_PRODUCT_STATUS_DEGRADED = "degraded"
+
+	FRESHNESS_SLA_STATUS_PENDING  = "pending"
+	FRESHNESS_SLA_STATUS_MET      = "met"
+	FRESHNESS_SLA_STATUS_BREACHED = "breached"
+	FRESHNESS_SLA_EVENT_BREACHED  = "breached"
+	FRESHNESS_SLA_EVENT_RECOVERED = "recovered"
+
+	LINEAGE_NODE_SOURCE           = "source"
+	LINEAGE_NODE_TABLE            = "table"
+	LINEAGE_NODE_COLUMN           = "column"
+	LINEAGE_DIRECTION_UPSTREAM    = "upstream"
+	LINEAGE_DIRECTION_DOWNSTREAM  = "downstream"
+	LINEAGE_DIRECTION_BOTH        = "both"
+	LINEAGE_GRANULARITY_TABLE     = "table"
+	LINEAGE_GRANULARITY_COLUMN    = "column"
+	LINEAGE_ORIGIN_PIPELINE       = "pipeline"
+	LINEAGE_ORIGIN_TRANSFORMATION = "transformation"
+	LINEAGE_ORIGIN_DBT            = "dbt"
+	LINEAGE_SOURCE_NAMESPACE      = "airbyte"
+
+	OPENLINEAGE_PRODUCER             = "pipelineService"
+	OPENLINEAGE_NAMESPACE            = "pipelineService"
+	OPENLINEAGE_RUN_EVENT_SCHEMA     = "https://openlineage.io/spec/1-0-5/OpenLineage.json#/definitions/RunEvent"
+	OPENLINEAGE_SCHEMA_FACET         = "https://openlineage.io/spec/facets/1-0-0/SchemaDatasetFacet.json"
+	OPENLINEAGE_COLUMN_LINEAGE_FACET = "https://openlineage.io/spec/facets/1-0-1/ColumnLineageDatasetFacet.json"
+
+	CLASSIFICATION_EMAIL       = "email"
+	CLASSIFICATION_PHONE       = "phone"
+	CLASSIFICATION_NATIONAL_ID = "national_id"
+	CLASSIFICATION_CREDIT_CARD = "credit_card"
+	CLASSIFICATION_IP_ADDRESS  = "ip_address"
+
+	CLASSIFICATION_RULE_REGEX      = "regex"
+	CLASSIFICATION_RULE_DICTIONARY = "dictionary"
+	CLASSIFICATION_TARGET_NAME     = "column_name"
+	CLASSIFICATION_TARGET_VALUE    = "value"
+	CLASSIFICATION_MATCH_THRESHOLD = 0.5
+	// CLASSIFICATION_SAMPLE_ROWS is the number of rows sampled to classify an asset, a preview page is too small to
+	// reach the match threshold reliably
+	CLASSIFICATION_SAMPLE_ROWS = 500
+
+	MASKING_METHOD_HASH    = "hash"
+	MASKING_METHOD_REDACT  = "redact"
+	MASKING_METHOD_PARTIAL = "partial"
+	MASKING_METHOD_NULL    = "null"
+	MASKING_VISIBLE_CHARS  = 4
+
+	ROW_FILTER_EQ       = "eq"
+	ROW_FILTER_NEQ      = "neq"
+	ROW_FILTER_GT       = "gt"
+	ROW_FILTER_GTE      = "gte"
+	ROW_FILTER_LT       = "lt"
+	ROW_FILTER_LTE      = "lte"
+	ROW_FILTER_IN       = "in"
+	ROW_FILTER_NOT_IN   = "not_in"
+	ROW_FILTER_IS_NULL  = "is_null"
+	ROW_FILTER_NOT_NULL = "not_null"
+
+	REQUEST_ID_HEADER   = "X-Request-ID"
+	AUDIT_DEFAULT_LIMIT = 100
+	AUDIT_MAX_LIMIT     = 1000
+	REDACTED_VALUE      = "**********"
+	// AUDIT_MAX_BODY_BYTES bounds the request bodies recorded in the audit log, the larger ones aren't recorded
+	AUDIT_MAX_BODY_BYTES = 64 * 1024
+
+	USER_ROLE_ADMIN  = "admin"
+	USER_ROLE_EDITOR = "editor"
+	USER_ROLE_VIEWER = "viewer"
+
+	SCHEMA_CHANGE_STATUS_PENDING  = "pending"
+	SCHEMA_CHANGE_STATUS_APPROVED = "approved"
+	SCHEMA_CHANGE_STATUS_REJECTED = "rejected"
+
+	SCHEMA_CHANGE_STREAM_ADDED   = "stream_added"
+	SCHEMA_CHANGE_STREAM_REMOVED = "stream_removed"
+	SCHEMA_CHANGE_FIELD_ADDED    = "field_added"
+	SCHEMA_CHANGE_FIELD_REMOVED  = "field_removed"
+	SCHEMA_CHANGE_TYPE_CHANGED   = "type_changed"
 
 	AIRBYTE_CSV_SOURCE_DEFINITION_ID = "778daa7c-feaf-4db6-96f3-70fd645acc77"
+
+	CONNECTOR_TYPE_SOURCE        = "source"
+	CONNECTOR_TYPE_DESTINATION   = "destination"
+	AIRBYTE_RELEASE_STAGE_CUSTOM = "custom"
+
+	CONNECTOR_UPGRADE_STATUS_SCHEDULED   = "scheduled"
+	CONNECTOR_UPGRADE_STATUS_VALIDATED   = "validated"
+	CONNECTOR_UPGRADE_STATUS_FAILED      = "failed"
+	CONNECTOR_UPGRADE_STATUS_COMMITTED   = "committed"
+	CONNECTOR_UPGRADE_STATUS_ROLLED_BACK = "rolled_back"
+
+	SPEC_CHANGE_REQUIRED_ADDED   = "required_added"
+	SPEC_CHANGE_REQUIRED_REMOVED = "required_removed"
+
+	OAUTH_FLOW_STATUS_PENDING   = "pending"
+	OAUTH_FLOW_STATUS_COMPLETED = "completed"
+	OAUTH_FLOW_STATUS_FAILED    = "failed"
+	OAUTH_FLOW_STATUS_CONSUMED  = "consumed"
+
+	FILE_FORMAT_CSV     = "csv"
+	FILE_FORMAT_JSONL   = "jsonl"
+	FILE_FORMAT_EXCEL   = "excel"
+	FILE_FORMAT_PARQUET = "parquet"
+	FILE_PREVIEW_ROWS   = 20
+
+	FILE_STORE_LOCAL = "local"
+	FILE_STORE_S3    = "s3"
+
+	DESTINATION_TYPE_POSTGRES   = "postgres"
+	DESTINATION_TYPE_MYSQL      = "mysql"
+	DESTINATION_TYPE_CLICKHOUSE = "clickhouse"
+	DESTINATION_TYPE_MSSQL      = "mssql"
+	DESTINATION_TYPE_CSV        = "csv"
+	DESTINATION_TYPE_LOCAL_JSON = "local-json"
+
+	TUNNEL_METHOD_NONE         = "NO_TUNNEL"
+	TUNNEL_METHOD_SSH_KEY      = "SSH_KEY_AUTH"
+	TUNNEL_METHOD_SSH_PASSWORD = "SSH_PASSWORD_AUTH"
+	TUNNEL_DEFAULT_PORT        = "22"
 )
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgconn"
@@ -41,3 +42,37 @@ func GetUserAndWorkspaceIDFromContext(ctx *gin.Context) (int, int, string) {
 
 	return userId, workspaceId, airbyteWorkspaceId
 }
+
+// GetUserRoleFromContext returns the role the auth service returned for the session of the request, the role isn't
+// read from the request so a client can't claim one.
+func GetUserRoleFromContext(ctx *gin.Context) string {
+	return ctx.GetString("userRole")
+}
+
+// IsSuperAdminFromContext reports whether the auth service returned the session of the request for a super admin of
+// the platform, who manages the settings shared by all the workspaces.
+func IsSuperAdminFromContext(ctx *gin.Context) bool {
+	return ctx.GetBool("isSuperAdmin")
+}
+
+// IsInternalRoute reports whether the route is one of the internal routes called by the workers e.g.
+// pipelines/internal/:id/.
+func IsInternalRoute(route string) bool {
+	for _, segment := range strings.Split(route, "/") {
+		if segment == "internal" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanEditResources reports whether the role is allowed to review and apply changes on the workspace resources.
+func CanEditResources(role string) bool {
+	return role == USER_ROLE_ADMIN || role == USER_ROLE_EDITOR
+}
+
+// IsAdmin reports whether the role is allowed to manage the settings of the workspace.
+func IsAdmin(role string) bool {
+	return role == USER_ROLE_ADMIN
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// JSONSchemaField is a top level property of a stream's JSON schema.
+type JSONSchemaField struct {
+	Name     string
+	Type     string
+	Nullable bool
+}
+
+// JSONSchemaFields returns the top level properties of a stream's JSON schema sorted by name. The type of a field
+// ignores nullability, which is reported separately, and includes the format when there is one.
+func JSONSchemaFields(jsonSchema interface{}) []JSONSchemaField {
+	fields := make([]JSONSchemaField, 0)
+
+	schema, ok := jsonSchema.(map[string]interface{})
+	if !ok {
+		return fields
+	}
+
+	properties, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		return fields
+	}
+
+	for name, property := range properties {
+		propertySchema, _ := property.(map[string]interface{})
+		fieldType, nullable := jsonSchemaType(propertySchema)
+
+		fields = append(fields, JSONSchemaField{Name: name, Type: fieldType, Nullable: nullable})
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+
+	return fields
+}
+
+func jsonSchemaType(propertySchema map[string]interface{}) (string, bool) {
+	var types []string
+
+	nullable := false
+
+	switch t := propertySchema["type"].(type) {
+	case string:
+		types = append(types, t)
+	case []interface{}:
+		for _, value := range t {
+			name, ok := value.(string)
+			if !ok {
+				continue
+			}
+
+			if name == "null" {
+				nullable = true
+
+				continue
+			}
+
+			types = append(types, name)
+		}
+	}
+
+	sort.Strings(types)
+	fieldType := strings.Join(types, "|")
+
+	if format, ok := propertySchema["format"].(string); ok {
+		fieldType = fmt.Sprintf("%s(%s)", fieldType, format)
+	}
+
+	return fieldType, nullable
+}
@@ -0,0 +1,341 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// Parquet and thrift compact protocol constants, see https://github.com/apache/parquet-format.
+const (
+	parquetMagic         = "PAR1"
+	parquetRowGroupSize  = 10000
+	parquetTypeByteArray = 6
+	parquetOptional      = 1
+	parquetConvertedUTF8 = 0
+	parquetEncodingPlain = 0
+	parquetEncodingRLE   = 3
+	parquetDataPage      = 0
+	parquetUncompressed  = 0
+
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+type parquetColumnChunk struct {
+	offset    int64
+	size      int64
+	numValues int64
+}
+
+type parquetRowGroup struct {
+	chunks []parquetColumnChunk
+	size   int64
+	rows   int64
+}
+
+// ParquetWriter writes rows of nullable UTF8 columns to a Parquet file. The rows are buffered and written as a row
+// group of one plain encoded, uncompressed data page per column every parquetRowGroupSize rows.
+type ParquetWriter struct {
+	writer    io.Writer
+	offset    int64
+	columns   []string
+	values    [][]*string
+	rows      int64
+	rowGroups []parquetRowGroup
+}
+
+func NewParquetWriter(writer io.Writer, columns []string) (*ParquetWriter, error) {
+	parquetWriter := &ParquetWriter{writer: writer, columns: columns, values: make([][]*string, len(columns))}
+
+	return parquetWriter, parquetWriter.write([]byte(parquetMagic))
+}
+
+// WriteRow buffers a row, nil values are written as nulls.
+func (w *ParquetWriter) WriteRow(values []*string) error {
+	for i := range w.columns {
+		var value *string
+		if i < len(values) {
+			value = values[i]
+		}
+
+		w.values[i] = append(w.values[i], value)
+	}
+
+	w.rows++
+
+	if w.rows >= parquetRowGroupSize {
+		return w.flush()
+	}
+
+	return nil
+}
+
+// Close writes the buffered rows and the footer of the file, it doesn't close the underlying writer.
+func (w *ParquetWriter) Close() error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+
+	footer := w.fileMetaData()
+
+	length := make([]byte, 4)
+	binary.LittleEndian.PutUint32(length, uint32(len(footer)))
+
+	if err := w.write(footer); err != nil {
+		return err
+	}
+
+	if err := w.write(length); err != nil {
+		return err
+	}
+
+	return w.write([]byte(parquetMagic))
+}
+
+func (w *ParquetWriter) write(data []byte) error {
+	n, err := w.writer.Write(data)
+	w.offset += int64(n)
+
+	return err
+}
+
+func (w *ParquetWriter) flush() error {
+	if w.rows == 0 {
+		return nil
+	}
+
+	rowGroup := parquetRowGroup{rows: w.rows}
+
+	for i := range w.columns {
+		page := dataPage(w.values[i])
+
+		header := newThriftWriter()
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(page)))
+		header.i32(3, int32(len(page)))
+		header.beginStruct(5)
+		header.i32(1, int32(len(w.values[i])))
+		header.i32(2, parquetEncodingPlain)
+		header.i32(3, parquetEncodingRLE)
+		header.i32(4, parquetEncodingRLE)
+		header.endStruct()
+		header.stop()
+
+		chunk := parquetColumnChunk{
+			offset:    w.offset,
+			size:      int64(header.buf.Len() + len(page)),
+			numValues: int64(len(w.values[i])),
+		}
+
+		if err := w.write(header.buf.Bytes()); err != nil {
+			return err
+		}
+
+		if err := w.write(page); err != nil {
+			return err
+		}
+
+		rowGroup.chunks = append(rowGroup.chunks, chunk)
+		rowGroup.size += chunk.size
+		w.values[i] = w.values[i][:0]
+	}
+
+	w.rowGroups = append(w.rowGroups, rowGroup)
+	w.rows = 0
+
+	return nil
+}
+
+// dataPage encodes the definition levels of the values with the RLE encoding followed by the plain encoded values
+// which aren't null.
+func dataPage(values []*string) []byte {
+	var levels bytes.Buffer
+
+	for i := 0; i < len(values); {
+		defined := values[i] != nil
+
+		j := i
+		for j < len(values) && (values[j] != nil) == defined {
+			j++
+		}
+
+		writeUvarint(&levels, uint64(j-i)<<1)
+
+		if defined {
+			levels.WriteByte(1)
+		} else {
+			levels.WriteByte(0)
+		}
+
+		i = j
+	}
+
+	var page bytes.Buffer
+
+	length := make([]byte, 4)
+	binary.LittleEndian.PutUint32(length, uint32(levels.Len()))
+	page.Write(length)
+	page.Write(levels.Bytes())
+
+	for _, value := range values {
+		if value != nil {
+			binary.LittleEndian.PutUint32(length, uint32(len(*value)))
+			page.Write(length)
+			page.WriteString(*value)
+		}
+	}
+
+	return page.Bytes()
+}
+
+func (w *ParquetWriter) fileMetaData() []byte {
+	var totalRows int64
+	for _, rowGroup := range w.rowGroups {
+		totalRows += rowGroup.rows
+	}
+
+	metadata := newThriftWriter()
+	metadata.i32(1, 1)
+
+	metadata.listHeader(2, thriftStruct, len(w.columns)+1)
+	metadata.beginElement()
+	metadata.binary(4, "schema")
+	metadata.i32(5, int32(len(w.columns)))
+	metadata.endStruct()
+
+	for _, column := range w.columns {
+		metadata.beginElement()
+		metadata.i32(1, parquetTypeByteArray)
+		metadata.i32(3, parquetOptional)
+		metadata.binary(4, column)
+		metadata.i32(6, parquetConvertedUTF8)
+		metadata.endStruct()
+	}
+
+	metadata.i64(3, totalRows)
+
+	metadata.listHeader(4, thriftStruct, len(w.rowGroups))
+
+	for _, rowGroup := range w.rowGroups {
+		metadata.beginElement()
+		metadata.listHeader(1, thriftStruct, len(rowGroup.chunks))
+
+		for i, chunk := range rowGroup.chunks {
+			metadata.beginElement()
+			metadata.i64(2, chunk.offset)
+			metadata.beginStruct(3)
+			metadata.i32(1, parquetTypeByteArray)
+			metadata.listHeader(2, thriftI32, 2)
+			metadata.varint(parquetEncodingPlain)
+			metadata.varint(parquetEncodingRLE)
+			metadata.listHeader(3, thriftBinary, 1)
+			metadata.bytes(w.columns[i])
+			metadata.i32(4, parquetUncompressed)
+			metadata.i64(5, chunk.numValues)
+			metadata.i64(6, chunk.size)
+			metadata.i64(7, chunk.size)
+			metadata.i64(9, chunk.offset)
+			metadata.endStruct()
+			metadata.endStruct()
+		}
+
+		metadata.i64(2, rowGroup.size)
+		metadata.i64(3, rowGroup.rows)
+		metadata.endStruct()
+	}
+
+	metadata.binary(6, "pipelineService")
+	metadata.stop()
+
+	return metadata.buf.Bytes()
+}
+
+// thriftWriter encodes structs with the thrift compact protocol, the field IDs are delta encoded against the
+// previous field of the struct being written.
+type thriftWriter struct {
+	buf        bytes.Buffer
+	lastFields []int16
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{lastFields: []int16{0}}
+}
+
+func (t *thriftWriter) fieldHeader(id int16, fieldType byte) {
+	last := t.lastFields[len(t.lastFields)-1]
+
+	if delta := id - last; delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | fieldType)
+	} else {
+		t.buf.WriteByte(fieldType)
+		t.varint(int64(id))
+	}
+
+	t.lastFields[len(t.lastFields)-1] = id
+}
+
+// varint writes a zigzag encoded integer.
+func (t *thriftWriter) varint(value int64) {
+	writeUvarint(&t.buf, uint64((value<<1)^(value>>63)))
+}
+
+func (t *thriftWriter) bytes(value string) {
+	writeUvarint(&t.buf, uint64(len(value)))
+	t.buf.WriteString(value)
+}
+
+func (t *thriftWriter) i32(id int16, value int32) {
+	t.fieldHeader(id, thriftI32)
+	t.varint(int64(value))
+}
+
+func (t *thriftWriter) i64(id int16, value int64) {
+	t.fieldHeader(id, thriftI64)
+	t.varint(value)
+}
+
+func (t *thriftWriter) binary(id int16, value string) {
+	t.fieldHeader(id, thriftBinary)
+	t.bytes(value)
+}
+
+func (t *thriftWriter) listHeader(id int16, elementType byte, size int) {
+	t.fieldHeader(id, thriftList)
+
+	if size < 15 {
+		t.buf.WriteByte(byte(size)<<4 | elementType)
+
+		return
+	}
+
+	t.buf.WriteByte(0xF0 | elementType)
+	writeUvarint(&t.buf, uint64(size))
+}
+
+func (t *thriftWriter) beginStruct(id int16) {
+	t.fieldHeader(id, thriftStruct)
+	t.lastFields = append(t.lastFields, 0)
+}
+
+// beginElement starts a struct which is an element of a list, the elements have no field header.
+func (t *thriftWriter) beginElement() {
+	t.lastFields = append(t.lastFields, 0)
+}
+
+func (t *thriftWriter) endStruct() {
+	t.stop()
+	t.lastFields = t.lastFields[:len(t.lastFields)-1]
+}
+
+func (t *thriftWriter) stop() {
+	t.buf.WriteByte(0)
+}
+
+func writeUvarint(buf *bytes.Buffer, value uint64) {
+	encoded := make([]byte, binary.MaxVarintLen64)
+	buf.Write(encoded[:binary.PutUvarint(encoded, value)])
+}
@@ -0,0 +1,372 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+)
+
+// Parquet physical types and thrift compact types read from the footer, see https://github.com/apache/parquet-format.
+const (
+	parquetTypeBoolean           = 0
+	parquetTypeInt32             = 1
+	parquetTypeInt64             = 2
+	parquetTypeInt96             = 3
+	parquetTypeFloat             = 4
+	parquetTypeDouble            = 5
+	parquetTypeFixedLenByteArray = 7
+
+	parquetConvertedJSON      = 19
+	parquetConvertedTimestamp = 9
+	parquetConvertedDate      = 6
+
+	thriftBooleanTrue  = 1
+	thriftBooleanFalse = 2
+	thriftByte         = 3
+	thriftI16          = 4
+	thriftDouble       = 7
+	thriftSet          = 10
+	thriftMap          = 11
+
+	// a footer larger than this isn't the footer of a file we could preview
+	parquetMaxFooterSize = 64 << 20
+)
+
+var errInvalidParquet = errors.New("the file isn't a parquet file")
+
+// ParquetColumn is a leaf column of a Parquet schema, the columns nested in groups are named by their dotted path.
+type ParquetColumn struct {
+	Name string
+	Type string
+}
+
+type parquetSchemaElement struct {
+	name          string
+	physicalType  int32
+	hasType       bool
+	convertedType int32
+	hasConverted  bool
+	numChildren   int32
+}
+
+// ReadParquetSchema reads the schema and the number of rows of a Parquet file from its footer, the types of the
+// columns are named like the types of a JSON schema.
+func ReadParquetSchema(reader io.ReaderAt, size int64) ([]ParquetColumn, int64, error) {
+	if size < int64(2*len(parquetMagic)+4) {
+		return nil, 0, errInvalidParquet
+	}
+
+	tail := make([]byte, 4+len(parquetMagic))
+	if _, err := reader.ReadAt(tail, size-int64(len(tail))); err != nil {
+		return nil, 0, err
+	}
+
+	if string(tail[4:]) != parquetMagic {
+		return nil, 0, errInvalidParquet
+	}
+
+	footerSize := int64(binary.LittleEndian.Uint32(tail[:4]))
+	if footerSize > parquetMaxFooterSize || footerSize > size-int64(len(tail)+len(parquetMagic)) {
+		return nil, 0, errInvalidParquet
+	}
+
+	footer := make([]byte, footerSize)
+	if _, err := reader.ReadAt(footer, size-int64(len(tail))-footerSize); err != nil {
+		return nil, 0, err
+	}
+
+	elements, rows, err := readFileMetaData(&thriftReader{reader: bytes.NewReader(footer)})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(elements) == 0 {
+		return nil, 0, errInvalidParquet
+	}
+
+	columns := make([]ParquetColumn, 0, len(elements))
+	next := 1
+
+	// the first element is the root, the children of a group follow it depth first
+	var walk func(prefix []string, children int32)
+	walk = func(prefix []string, children int32) {
+		for i := int32(0); i < children && next < len(elements); i++ {
+			element := elements[next]
+			next++
+
+			path := append(append([]string{}, prefix...), element.name)
+
+			if element.numChildren > 0 {
+				walk(path, element.numChildren)
+
+				continue
+			}
+
+			columns = append(columns, ParquetColumn{Name: strings.Join(path, "."), Type: parquetColumnType(element)})
+		}
+	}
+
+	walk(nil, elements[0].numChildren)
+
+	return columns, rows, nil
+}
+
+func parquetColumnType(element parquetSchemaElement) string {
+	if element.hasConverted {
+		switch element.convertedType {
+		case parquetConvertedDate:
+			return "date"
+		case parquetConvertedTimestamp, parquetConvertedTimestamp + 1:
+			return "timestamp"
+		case parquetConvertedJSON:
+			return "object"
+		}
+	}
+
+	switch element.physicalType {
+	case parquetTypeBoolean:
+		return "boolean"
+	case parquetTypeInt32, parquetTypeInt64:
+		return "integer"
+	case parquetTypeInt96:
+		return "timestamp"
+	case parquetTypeFloat, parquetTypeDouble:
+		return "number"
+	case parquetTypeFixedLenByteArray:
+		return "binary"
+	default:
+		return "string"
+	}
+}
+
+// readFileMetaData reads the schema elements and the number of rows of the FileMetaData struct, skipping the row
+// groups and the other fields.
+func readFileMetaData(t *thriftReader) ([]parquetSchemaElement, int64, error) {
+	var elements []parquetSchemaElement
+
+	var rows int64
+
+	err := t.readStruct(func(id int16, fieldType byte) error {
+		switch {
+		case id == 2 && fieldType == thriftList:
+			elementType, size, err := t.listHeader()
+			if err != nil {
+				return err
+			}
+
+			for i := 0; i < size; i++ {
+				if elementType != thriftStruct {
+					if err := t.skip(elementType); err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				element, err := readSchemaElement(t)
+				if err != nil {
+					return err
+				}
+
+				elements = append(elements, element)
+			}
+
+			return nil
+		case id == 3 && fieldType == thriftI64:
+			value, err := t.varint()
+			rows = value
+
+			return err
+		default:
+			return t.skip(fieldType)
+		}
+	})
+
+	return elements, rows, err
+}
+
+func readSchemaElement(t *thriftReader) (parquetSchemaElement, error) {
+	element := parquetSchemaElement{}
+
+	err := t.readStruct(func(id int16, fieldType byte) error {
+		var err error
+
+		switch {
+		case id == 1 && fieldType == thriftI32:
+			var value int64
+			value, err = t.varint()
+			element.physicalType, element.hasType = int32(value), true
+		case id == 4 && fieldType == thriftBinary:
+			element.name, err = t.bytes()
+		case id == 5 && fieldType == thriftI32:
+			var value int64
+			value, err = t.varint()
+			element.numChildren = int32(value)
+		case id == 6 && fieldType == thriftI32:
+			var value int64
+			value, err = t.varint()
+			element.convertedType, element.hasConverted = int32(value), true
+		default:
+			err = t.skip(fieldType)
+		}
+
+		return err
+	})
+
+	return element, err
+}
+
+// thriftReader decodes structs of the thrift compact protocol written like the thriftWriter writes them.
+type thriftReader struct {
+	reader *bytes.Reader
+}
+
+// readStruct calls the field function with the ID and type of every field of the struct until its stop field, the
+// field function consumes the value.
+func (t *thriftReader) readStruct(field func(id int16, fieldType byte) error) error {
+	var last int16
+
+	for {
+		header, err := t.reader.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		fieldType := header & 0x0F
+		if fieldType == 0 {
+			return nil
+		}
+
+		id := last + int16(header>>4)
+		if header>>4 == 0 {
+			value, err := t.varint()
+			if err != nil {
+				return err
+			}
+
+			id = int16(value)
+		}
+
+		last = id
+
+		// the booleans of a struct are held by their field type
+		if fieldType == thriftBooleanTrue || fieldType == thriftBooleanFalse {
+			continue
+		}
+
+		if err := field(id, fieldType); err != nil {
+			return err
+		}
+	}
+}
+
+// varint reads a zigzag encoded integer.
+func (t *thriftReader) varint() (int64, error) {
+	value, err := binary.ReadUvarint(t.reader)
+
+	return int64(value>>1) ^ -int64(value&1), err
+}
+
+func (t *thriftReader) bytes() (string, error) {
+	size, err := binary.ReadUvarint(t.reader)
+	if err != nil {
+		return "", err
+	}
+
+	if size > uint64(t.reader.Len()) {
+		return "", errInvalidParquet
+	}
+
+	value := make([]byte, size)
+	_, err = io.ReadFull(t.reader, value)
+
+	return string(value), err
+}
+
+func (t *thriftReader) listHeader() (byte, int, error) {
+	header, err := t.reader.ReadByte()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	size := int(header >> 4)
+	if size == 15 {
+		value, err := binary.ReadUvarint(t.reader)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if value > uint64(t.reader.Len()) {
+			return 0, 0, errInvalidParquet
+		}
+
+		size = int(value)
+	}
+
+	return header & 0x0F, size, nil
+}
+
+// skip reads past a value of the type.
+func (t *thriftReader) skip(fieldType byte) error {
+	switch fieldType {
+	case thriftBooleanTrue, thriftBooleanFalse, thriftByte:
+		_, err := t.reader.ReadByte()
+
+		return err
+	case thriftI16, thriftI32, thriftI64:
+		_, err := t.varint()
+
+		return err
+	case thriftDouble:
+		_, err := t.reader.Seek(8, io.SeekCurrent)
+
+		return err
+	case thriftBinary:
+		_, err := t.bytes()
+
+		return err
+	case thriftList, thriftSet:
+		elementType, size, err := t.listHeader()
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < size; i++ {
+			if err := t.skip(elementType); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case thriftMap:
+		size, err := binary.ReadUvarint(t.reader)
+		if err != nil || size == 0 {
+			return err
+		}
+
+		types, err := t.reader.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		for i := uint64(0); i < size; i++ {
+			if err := t.skip(types >> 4); err != nil {
+				return err
+			}
+
+			if err := t.skip(types & 0x0F); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case thriftStruct:
+		return t.readStruct(func(id int16, fieldType byte) error {
+			return t.skip(fieldType)
+		})
+	default:
+		return errInvalidParquet
+	}
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"pipelineService/models/v1"
+)
+
+// previewCursorTypes are the types a keyset cursor value can be cast to, the type is read back from the client.
+var previewCursorTypes = map[string]bool{
+	"int2": true, "int4": true, "int8": true, "numeric": true, "float4": true, "float8": true,
+	"text": true, "varchar": true, "bpchar": true, "uuid": true, "bool": true, "boolean": true,
+	"date": true, "timestamp": true, "timestamptz": true,
+}
+
+func IsPreviewCursorType(columnType string) bool {
+	return previewCursorTypes[strings.ToLower(columnType)]
+}
+
+func EncodePreviewCursor(cursor models.PreviewCursor) string {
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodePreviewCursor(encoded string) (models.PreviewCursor, error) {
+	var cursor models.PreviewCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor, errors.New("invalid cursor")
+	}
+
+	if err = json.Unmarshal(data, &cursor); err != nil || !IsPreviewCursorType(cursor.Type) {
+		return cursor, errors.New("invalid cursor")
+	}
+
+	cursor.Type = strings.ToLower(cursor.Type)
+
+	return cursor, nil
+}